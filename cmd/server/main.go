@@ -13,11 +13,29 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/shinplay/internal"
+	"github.com/shinplay/internal/admin"
+	"github.com/shinplay/internal/audit"
 	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/auth/device"
+	"github.com/shinplay/internal/auth/emailchange"
+	"github.com/shinplay/internal/auth/guest"
 	"github.com/shinplay/internal/auth/otp"
+	"github.com/shinplay/internal/auth/pat"
+	"github.com/shinplay/internal/auth/phonechange"
+	"github.com/shinplay/internal/auth/qrlogin"
 	"github.com/shinplay/internal/auth/session"
+	"github.com/shinplay/internal/block"
+	"github.com/shinplay/internal/challenge"
 	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/dataexport"
 	"github.com/shinplay/internal/db"
+	"github.com/shinplay/internal/follow"
+	"github.com/shinplay/internal/impersonation"
+	"github.com/shinplay/internal/notification"
+	"github.com/shinplay/internal/oauth"
+	"github.com/shinplay/internal/rbac"
+	"github.com/shinplay/internal/risk"
+	"github.com/shinplay/internal/storage"
 	"github.com/shinplay/internal/user"
 	"go.uber.org/dig"
 )
@@ -30,20 +48,129 @@ func main() {
 	container.Provide(context.Background)
 	container.Provide(config.GetConfig)
 	container.Provide(db.InitializeDatabase)
+	container.Provide(storage.NewBlobStore)
+
+	container.Provide(audit.NewAuditRepository)
+	container.Provide(audit.NewAuditService)
+	container.Provide(audit.NewAuditHandler)
 
 	container.Provide(user.NewUserRepository)
 	container.Provide(user.NewUserService)
+	container.Provide(user.NewAccountPurger)
 
 	container.Provide(otp.NewOTPRepository)
 	container.Provide(otp.NewOTPService)
 
 	container.Provide(session.NewSessionRepository)
 
+	container.Provide(pat.NewPATRepository)
+	container.Provide(pat.NewPATService)
+	container.Provide(pat.NewPATHandler)
+
+	container.Provide(rbac.NewRBACRepository)
+	container.Provide(rbac.NewRBACService)
+	container.Provide(rbac.NewRBACHandler)
+
+	container.Provide(challenge.NewChallengeService)
+
+	container.Provide(risk.NewRiskEngine)
+	container.Provide(risk.NewRiskHandler)
+
 	container.Provide(auth.NewAuthService)
 	container.Provide(auth.NewAuthHandler)
 
+	container.Provide(device.NewDeviceRepository)
+	container.Provide(device.NewDeviceService)
+	container.Provide(device.NewDeviceHandler)
+
+	container.Provide(qrlogin.NewQRLoginRepository)
+	container.Provide(qrlogin.NewQRLoginService)
+	container.Provide(qrlogin.NewQRLoginHandler)
+
+	container.Provide(phonechange.NewPhoneChangeRepository)
+	container.Provide(phonechange.NewPhoneChangeService)
+	container.Provide(phonechange.NewPhoneChangeHandler)
+
+	container.Provide(emailchange.NewEmailChangeRepository)
+	container.Provide(emailchange.NewEmailChangeService)
+	container.Provide(emailchange.NewEmailChangeHandler)
+
+	container.Provide(guest.NewGuestRepository)
+	container.Provide(guest.NewGuestService)
+	container.Provide(guest.NewGuestHandler)
+
 	container.Provide(user.NewUserHandler)
 
+	container.Provide(follow.NewFollowRepository)
+	container.Provide(follow.NewFollowService)
+	container.Provide(follow.NewFollowHandler)
+	container.Provide(block.NewBlockRepository)
+	container.Provide(block.NewBlockService)
+	container.Provide(block.NewBlockHandler)
+
+	container.Provide(notification.NewMailer)
+	container.Provide(notification.NewNotificationService)
+
+	container.Provide(dataexport.NewDataExportRepository)
+	container.Provide(dataexport.NewDataExportService)
+	container.Provide(dataexport.NewDataExportHandler)
+
+	container.Provide(oauth.NewOAuthRepository)
+	container.Provide(oauth.NewOAuthService)
+	container.Provide(oauth.NewOAuthHandler)
+
+	container.Provide(impersonation.NewImpersonationRepository)
+	container.Provide(impersonation.NewImpersonationService)
+	container.Provide(impersonation.NewImpersonationHandler)
+
+	container.Provide(admin.NewAdminService)
+	container.Provide(admin.NewAdminHandler)
+
+	// normalize usernames set before case and lookalike folding
+	if err := container.Invoke(func(s *user.UserService) error { return s.BackfillUsernames() }); err != nil {
+		panic(err)
+	}
+
+	// make sure default roles exist and the first admin is granted
+	if err := container.Invoke(func(s *rbac.RBACService) error { return s.Bootstrap() }); err != nil {
+		panic(err)
+	}
+
+	// purge accounts whose deletion grace period has passed
+	if err := container.Invoke(func(p *user.AccountPurger) { go p.Run() }); err != nil {
+		panic(err)
+	}
+
+	// forget stale failure counters and expired blocks
+	if err := container.Invoke(func(e *risk.RiskEngine) { go e.Run() }); err != nil {
+		panic(err)
+	}
+
+	// remove data export archives once their download link expires
+	if err := container.Invoke(func(s *dataexport.DataExportService) { go s.Run() }); err != nil {
+		panic(err)
+	}
+
+	// forget device sign-in requests nobody approved
+	if err := container.Invoke(func(s *device.DeviceService) { go s.Run() }); err != nil {
+		panic(err)
+	}
+
+	// forget QR login requests nobody scanned
+	if err := container.Invoke(func(s *qrlogin.QRLoginService) { go s.Run() }); err != nil {
+		panic(err)
+	}
+
+	// anonymize guests that never came back
+	if err := container.Invoke(func(s *guest.GuestService) { go s.Run() }); err != nil {
+		panic(err)
+	}
+
+	// drop authorization codes that were never exchanged
+	if err := container.Invoke(func(s *oauth.OAuthService) { go s.Run() }); err != nil {
+		panic(err)
+	}
+
 	app := fiber.New(
 		fiber.Config{
 			AppName: "Shinplay API",
@@ -59,9 +186,9 @@ func main() {
 
 	app.Use(cors.New(cors.Config{ // CORS configuration
 		AllowOrigins:     cnf.Server.CORS, // Explicitly allow development origin
-		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
+		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
 		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, X-Request-ID",
-		ExposeHeaders:    "Content-Length, Content-Type",
+		ExposeHeaders:    "Content-Length, Content-Type, " + impersonation.HeaderImpersonatedBy,
 		AllowCredentials: true,  // Allow credentials for development
 		MaxAge:           86400, // 24 hours
 	}))
@@ -82,18 +209,113 @@ func main() {
 	err := container.Invoke(func(r internal.Routes) {
 
 		// auth routes
-		app.Post("/auth/whatsapp/send-otp", r.AuthHandler.SendWhatsAppOTP)
-		app.Post("/auth/whatsapp/verify-otp", r.AuthHandler.VerifyWhatsAppOTP)
-		app.Post("/auth/google/oauth", r.AuthHandler.GoogleOauthSignin)
+		app.Post("/auth/whatsapp/send-otp", r.RiskHandler.GuardOTPSend, r.AuthHandler.SendWhatsAppOTP)
+		app.Post("/auth/whatsapp/verify-otp", r.RiskHandler.Guard, r.AuthHandler.VerifyWhatsAppOTP)
+		app.Post("/auth/google/oauth", r.RiskHandler.Guard, r.AuthHandler.GoogleOauthSignin)
+		app.Post("/auth/guest", r.RiskHandler.Guard, r.GuestHandler.SignIn)
 		app.Get("/auth/refresh-token", r.AuthHandler.RefreshAccessToken)
 		app.Get("/auth/logout", r.AuthHandler.Logout)
+		app.Post("/auth/device/code", r.DeviceHandler.RequestCode)
+		app.Post("/auth/device/token", r.DeviceHandler.Token)
+		app.Post("/auth/qr", r.QRLoginHandler.Start)
+		app.Get("/auth/qr/:requestId/wait", r.QRLoginHandler.Wait)
+
+		// OAuth clients authenticate themselves, not a user
+		app.Post("/oauth/token", r.OAuthHandler.Token)
+
+		// signed links sent to the user, no session required
+		app.Get("/exports/:exportId/download", r.DataExportHandler.Download)
+		app.Post("/auth/email/verify", r.EmailChangeHandler.VerifyLink)
+
+		// uploaded files are public, keys are unguessable and never reused
+		if cnf.Storage.Provider == "local" {
+			app.Static("/blobs", cnf.Storage.LocalDir, fiber.Static{MaxAge: 365 * 24 * 60 * 60})
+		}
+
+		// public profiles, signed-in viewers may see more
+		app.Get("/users/:username", r.AuthHandler.OptionalAuthentication, r.ImpersonationHandler.Track, r.UserHandler.GetPublicProfile)
 
 		// beyond this point, all routes require authentication
 		app.Use(r.AuthHandler.AuthenticateUser) // Apply auth middleware
+		app.Use(r.ImpersonationHandler.Track)
+
+		// guest upgrade, the guest's own token identifies the account to keep
+		app.Post("/auth/guest/upgrade/whatsapp/send-otp", impersonation.Block, r.RiskHandler.GuardOTPSend, r.GuestHandler.StartPhoneUpgrade)
+		app.Post("/auth/guest/upgrade/whatsapp/verify-otp", impersonation.Block, r.RiskHandler.Guard, r.GuestHandler.CompletePhoneUpgrade)
+		app.Post("/auth/guest/upgrade/google", impersonation.Block, r.RiskHandler.Guard, r.GuestHandler.UpgradeWithGoogle)
 
 		// user routes
-		app.Get("/users/username", r.UserHandler.CheckUsernameAvailability)
-		app.Post("/users/username", r.UserHandler.ChangeUsername)
+		app.Get("/users/me", rbac.RequireScope(rbac.ScopeProfileRead), r.UserHandler.GetMe)
+		app.Patch("/users/me", rbac.RequireScope(rbac.ScopeProfileWrite), impersonation.Block, r.UserHandler.UpdateMe)
+		app.Put("/users/me/avatar", rbac.RequireScope(rbac.ScopeProfileWrite), impersonation.Block, r.UserHandler.UploadAvatar)
+		app.Delete("/users/me/avatar", rbac.RequireScope(rbac.ScopeProfileWrite), impersonation.Block, r.UserHandler.DeleteAvatar)
+		app.Get("/users/me/settings", rbac.RequireScope(rbac.ScopeProfileRead), r.UserHandler.GetSettings)
+		app.Patch("/users/me/settings", rbac.RequireScope(rbac.ScopeProfileWrite), impersonation.Block, r.UserHandler.UpdateSettings)
+		app.Get("/users/username", rbac.RequireScope(rbac.ScopeProfileRead), r.UserHandler.CheckUsernameAvailability)
+		app.Get("/search/users", rbac.RequireScope(rbac.ScopeProfileRead), r.UserHandler.SearchUsers)
+		app.Get("/users/me/blocks", rbac.RequireScope(rbac.ScopeSocialRead), r.BlockHandler.ListBlocked)
+		app.Get("/users/me/mutes", rbac.RequireScope(rbac.ScopeSocialRead), r.BlockHandler.ListMuted)
+		app.Get("/users/me/follow-requests", rbac.RequireScope(rbac.ScopeSocialRead), r.FollowHandler.ListRequests)
+		app.Post("/users/me/follow-requests/:username", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.FollowHandler.AcceptRequest)
+		app.Delete("/users/me/follow-requests/:username", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.FollowHandler.DeclineRequest)
+		app.Get("/users/:username/followers", rbac.RequireScope(rbac.ScopeSocialRead), r.FollowHandler.ListFollowers)
+		app.Get("/users/:username/following", rbac.RequireScope(rbac.ScopeSocialRead), r.FollowHandler.ListFollowing)
+		app.Post("/users/:username/follow", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.FollowHandler.Follow)
+		app.Delete("/users/:username/follow", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.FollowHandler.Unfollow)
+		app.Post("/users/:username/block", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.BlockHandler.Block)
+		app.Delete("/users/:username/block", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.BlockHandler.Unblock)
+		app.Post("/users/:username/mute", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.BlockHandler.Mute)
+		app.Delete("/users/:username/mute", rbac.RequireScope(rbac.ScopeSocialWrite), impersonation.Block, r.BlockHandler.Unmute)
+		app.Post("/users/username", rbac.RequireScope(rbac.ScopeProfileWrite), impersonation.Block, r.UserHandler.ChangeUsername)
+		app.Delete("/users/me", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.UserHandler.DeleteAccount)
+		app.Post("/users/me/phone", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.RiskHandler.GuardOTPSend, r.PhoneChangeHandler.RequestChange)
+		app.Post("/users/me/phone/verify", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.PhoneChangeHandler.Verify)
+		app.Post("/users/me/email", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.EmailChangeHandler.RequestChange)
+		app.Post("/users/me/email/verify", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.EmailChangeHandler.Verify)
+		app.Post("/users/me/exports", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.DataExportHandler.RequestExport)
+		app.Get("/users/me/exports/:exportId", rbac.RequireScope(rbac.ScopeAccountManage), r.DataExportHandler.GetExport)
+		app.Get("/users/me/tokens", rbac.RequireScope(rbac.ScopeAccountManage), r.PATHandler.ListTokens)
+		app.Post("/users/me/tokens", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.PATHandler.CreateToken)
+		app.Delete("/users/me/tokens/:tokenId", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.PATHandler.RevokeToken)
+		app.Get("/users/me/oauth/consents", rbac.RequireScope(rbac.ScopeAccountManage), r.OAuthHandler.ListConsents)
+		app.Delete("/users/me/oauth/consents/:clientId", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.OAuthHandler.RevokeConsent)
+
+		// approving a TV or console from the phone
+		app.Get("/auth/device/verify", rbac.RequireScope(rbac.ScopeAccountManage), r.DeviceHandler.GetRequest)
+		app.Post("/auth/device/verify", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.DeviceHandler.Decide)
+
+		// approving a browser by scanning its QR code
+		app.Get("/auth/qr/:requestId", rbac.RequireScope(rbac.ScopeAccountManage), r.QRLoginHandler.GetRequest)
+		app.Post("/auth/qr/:requestId", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.QRLoginHandler.Decide)
+
+		// ends the impersonation the request is made under
+		app.Post("/impersonation/stop", r.ImpersonationHandler.Stop)
+
+		// OAuth consent screen and claims for third-party apps
+		app.Get("/oauth/authorize", rbac.RequireScope(rbac.ScopeAccountManage), r.OAuthHandler.GetAuthorization)
+		app.Post("/oauth/authorize", rbac.RequireScope(rbac.ScopeAccountManage), impersonation.Block, r.OAuthHandler.Authorize)
+		app.Get("/oauth/userinfo", r.OAuthHandler.UserInfo)
+
+		// admin routes, only reachable by staff
+		adminGroup := app.Group("/admin", rbac.RequireRole(rbac.RoleAdmin, rbac.RoleSupport))
+		adminGroup.Get("/users", rbac.RequirePermission(rbac.PermissionUsersRead), r.AdminHandler.SearchUsers)
+		adminGroup.Get("/users/:authId", rbac.RequirePermission(rbac.PermissionUsersRead), r.AdminHandler.GetUser)
+		adminGroup.Patch("/users/:authId", rbac.RequirePermission(rbac.PermissionUsersWrite), r.AdminHandler.UpdateUser)
+		adminGroup.Get("/users/:authId/sessions", rbac.RequirePermission(rbac.PermissionUsersRead), r.AdminHandler.GetUserSessions)
+		adminGroup.Delete("/users/:authId/sessions", rbac.RequirePermission(rbac.PermissionSessionsRevoke), r.AdminHandler.RevokeUserSessions)
+		adminGroup.Get("/users/:authId/otps", rbac.RequirePermission(rbac.PermissionUsersRead), r.AdminHandler.GetUserOTPs)
+		adminGroup.Post("/users/:authId/suspend", rbac.RequirePermission(rbac.PermissionUsersBan), r.AdminHandler.SuspendUser)
+		adminGroup.Post("/users/:authId/ban", rbac.RequirePermission(rbac.PermissionUsersBan), r.AdminHandler.BanUser)
+		adminGroup.Delete("/users/:authId/restriction", rbac.RequirePermission(rbac.PermissionUsersBan), r.AdminHandler.LiftRestriction)
+		adminGroup.Get("/audit-events", rbac.RequirePermission(rbac.PermissionAuditRead), r.AuditHandler.ListEvents)
+		adminGroup.Get("/risk", rbac.RequirePermission(rbac.PermissionRiskRead), r.RiskHandler.GetState)
+		adminGroup.Get("/risk/blocks", rbac.RequirePermission(rbac.PermissionRiskRead), r.RiskHandler.ListBlocks)
+		adminGroup.Delete("/risk/blocks", rbac.RequirePermission(rbac.PermissionRiskManage), r.RiskHandler.Unblock)
+		adminGroup.Get("/oauth/clients", rbac.RequirePermission(rbac.PermissionOAuthManage), r.OAuthHandler.ListClients)
+		adminGroup.Post("/oauth/clients", rbac.RequirePermission(rbac.PermissionOAuthManage), r.OAuthHandler.RegisterClient)
+		adminGroup.Post("/users/:authId/impersonate", rbac.RequirePermission(rbac.PermissionImpersonate), r.ImpersonationHandler.Start)
+		adminGroup.Post("/users/:authId/roles", rbac.RequirePermission(rbac.PermissionRolesManage), r.RBACHandler.AssignRole)
+		adminGroup.Delete("/users/:authId/roles/:role", rbac.RequirePermission(rbac.PermissionRolesManage), r.RBACHandler.RevokeRole)
 	})
 
 	if err != nil {
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/auditevent"
+	"github.com/shinplay/ent/user"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (aec *AuditEventCreate) SetCreateTime(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreateTime(t)
+	return aec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreateTime(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreateTime(*t)
+	}
+	return aec
+}
+
+// SetEventType sets the "event_type" field.
+func (aec *AuditEventCreate) SetEventType(s string) *AuditEventCreate {
+	aec.mutation.SetEventType(s)
+	return aec
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (aec *AuditEventCreate) SetIPAddress(s string) *AuditEventCreate {
+	aec.mutation.SetIPAddress(s)
+	return aec
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableIPAddress(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetIPAddress(*s)
+	}
+	return aec
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aec *AuditEventCreate) SetUserAgent(s string) *AuditEventCreate {
+	aec.mutation.SetUserAgent(s)
+	return aec
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableUserAgent(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetUserAgent(*s)
+	}
+	return aec
+}
+
+// SetRequestID sets the "request_id" field.
+func (aec *AuditEventCreate) SetRequestID(s string) *AuditEventCreate {
+	aec.mutation.SetRequestID(s)
+	return aec
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableRequestID(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetRequestID(*s)
+	}
+	return aec
+}
+
+// SetMetadata sets the "metadata" field.
+func (aec *AuditEventCreate) SetMetadata(m map[string]interface{}) *AuditEventCreate {
+	aec.mutation.SetMetadata(m)
+	return aec
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (aec *AuditEventCreate) SetActorID(id int) *AuditEventCreate {
+	aec.mutation.SetActorID(id)
+	return aec
+}
+
+// SetNillableActorID sets the "actor" edge to the User entity by ID if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableActorID(id *int) *AuditEventCreate {
+	if id != nil {
+		aec = aec.SetActorID(*id)
+	}
+	return aec
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (aec *AuditEventCreate) SetActor(u *User) *AuditEventCreate {
+	return aec.SetActorID(u.ID)
+}
+
+// SetSubjectID sets the "subject" edge to the User entity by ID.
+func (aec *AuditEventCreate) SetSubjectID(id int) *AuditEventCreate {
+	aec.mutation.SetSubjectID(id)
+	return aec
+}
+
+// SetNillableSubjectID sets the "subject" edge to the User entity by ID if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableSubjectID(id *int) *AuditEventCreate {
+	if id != nil {
+		aec = aec.SetSubjectID(*id)
+	}
+	return aec
+}
+
+// SetSubject sets the "subject" edge to the User entity.
+func (aec *AuditEventCreate) SetSubject(u *User) *AuditEventCreate {
+	return aec.SetSubjectID(u.ID)
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.CreateTime(); !ok {
+		v := auditevent.DefaultCreateTime()
+		aec.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "AuditEvent.create_time"`)}
+	}
+	if _, ok := aec.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "AuditEvent.event_type"`)}
+	}
+	if v, ok := aec.mutation.EventType(); ok {
+		if err := auditevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.event_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditevent.Table, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	)
+	if value, ok := aec.mutation.CreateTime(); ok {
+		_spec.SetField(auditevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := aec.mutation.EventType(); ok {
+		_spec.SetField(auditevent.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := aec.mutation.IPAddress(); ok {
+		_spec.SetField(auditevent.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := aec.mutation.UserAgent(); ok {
+		_spec.SetField(auditevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := aec.mutation.RequestID(); ok {
+		_spec.SetField(auditevent.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := aec.mutation.Metadata(); ok {
+		_spec.SetField(auditevent.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := aec.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   auditevent.ActorTable,
+			Columns: []string{auditevent.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.audit_event_actor = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := aec.mutation.SubjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   auditevent.SubjectTable,
+			Columns: []string{auditevent.SubjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.audit_event_subject = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEventCreate
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/user"
+)
+
+// Block is the model entity for the Block schema.
+type Block struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// BlockerID holds the value of the "blocker_id" field.
+	BlockerID int `json:"blocker_id,omitempty"`
+	// BlockedID holds the value of the "blocked_id" field.
+	BlockedID int `json:"blocked_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlockQuery when eager-loading is set.
+	Edges        BlockEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlockEdges holds the relations/edges for other nodes in the graph.
+type BlockEdges struct {
+	// Blocker holds the value of the blocker edge.
+	Blocker *User `json:"blocker,omitempty"`
+	// Blocked holds the value of the blocked edge.
+	Blocked *User `json:"blocked,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BlockerOrErr returns the Blocker value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockEdges) BlockerOrErr() (*User, error) {
+	if e.Blocker != nil {
+		return e.Blocker, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "blocker"}
+}
+
+// BlockedOrErr returns the Blocked value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockEdges) BlockedOrErr() (*User, error) {
+	if e.Blocked != nil {
+		return e.Blocked, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "blocked"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Block) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldID, block.FieldBlockerID, block.FieldBlockedID:
+			values[i] = new(sql.NullInt64)
+		case block.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Block fields.
+func (b *Block) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int(value.Int64)
+		case block.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				b.CreateTime = value.Time
+			}
+		case block.FieldBlockerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field blocker_id", values[i])
+			} else if value.Valid {
+				b.BlockerID = int(value.Int64)
+			}
+		case block.FieldBlockedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field blocked_id", values[i])
+			} else if value.Valid {
+				b.BlockedID = int(value.Int64)
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Block.
+// This includes values selected through modifiers, order, etc.
+func (b *Block) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryBlocker queries the "blocker" edge of the Block entity.
+func (b *Block) QueryBlocker() *UserQuery {
+	return NewBlockClient(b.config).QueryBlocker(b)
+}
+
+// QueryBlocked queries the "blocked" edge of the Block entity.
+func (b *Block) QueryBlocked() *UserQuery {
+	return NewBlockClient(b.config).QueryBlocked(b)
+}
+
+// Update returns a builder for updating this Block.
+// Note that you need to call Block.Unwrap() before calling this method if this Block
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Block) Update() *BlockUpdateOne {
+	return NewBlockClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Block entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Block) Unwrap() *Block {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Block is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Block) String() string {
+	var builder strings.Builder
+	builder.WriteString("Block(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(b.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("blocker_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.BlockerID))
+	builder.WriteString(", ")
+	builder.WriteString("blocked_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.BlockedID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Blocks is a parsable slice of Block.
+type Blocks []*Block
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package block
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// BlockerID applies equality check predicate on the "blocker_id" field. It's identical to BlockerIDEQ.
+func BlockerID(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockerID, v))
+}
+
+// BlockedID applies equality check predicate on the "blocked_id" field. It's identical to BlockedIDEQ.
+func BlockedID(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockedID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// BlockerIDEQ applies the EQ predicate on the "blocker_id" field.
+func BlockerIDEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockerID, v))
+}
+
+// BlockerIDNEQ applies the NEQ predicate on the "blocker_id" field.
+func BlockerIDNEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldBlockerID, v))
+}
+
+// BlockerIDIn applies the In predicate on the "blocker_id" field.
+func BlockerIDIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldBlockerID, vs...))
+}
+
+// BlockerIDNotIn applies the NotIn predicate on the "blocker_id" field.
+func BlockerIDNotIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldBlockerID, vs...))
+}
+
+// BlockedIDEQ applies the EQ predicate on the "blocked_id" field.
+func BlockedIDEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockedID, v))
+}
+
+// BlockedIDNEQ applies the NEQ predicate on the "blocked_id" field.
+func BlockedIDNEQ(v int) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldBlockedID, v))
+}
+
+// BlockedIDIn applies the In predicate on the "blocked_id" field.
+func BlockedIDIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldBlockedID, vs...))
+}
+
+// BlockedIDNotIn applies the NotIn predicate on the "blocked_id" field.
+func BlockedIDNotIn(vs ...int) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldBlockedID, vs...))
+}
+
+// HasBlocker applies the HasEdge predicate on the "blocker" edge.
+func HasBlocker() predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlockerTable, BlockerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockerWith applies the HasEdge predicate on the "blocker" edge with a given conditions (other predicates).
+func HasBlockerWith(preds ...predicate.User) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := newBlockerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocked applies the HasEdge predicate on the "blocked" edge.
+func HasBlocked() predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlockedTable, BlockedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedWith applies the HasEdge predicate on the "blocked" edge with a given conditions (other predicates).
+func HasBlockedWith(preds ...predicate.User) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := newBlockedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Block) predicate.Block {
+	return predicate.Block(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Block) predicate.Block {
+	return predicate.Block(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Block) predicate.Block {
+	return predicate.Block(sql.NotPredicates(p))
+}
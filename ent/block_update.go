@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/predicate"
+)
+
+// BlockUpdate is the builder for updating Block entities.
+type BlockUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BlockMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (bu *BlockUpdate) Where(ps ...predicate.Block) *BlockUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (bu *BlockUpdate) Mutation() *BlockMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BlockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BlockUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BlockUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BlockUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BlockUpdate) check() error {
+	if bu.mutation.BlockerCleared() && len(bu.mutation.BlockerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocker"`)
+	}
+	if bu.mutation.BlockedCleared() && len(bu.mutation.BlockedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocked"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bu *BlockUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlockUpdate {
+	bu.modifiers = append(bu.modifiers, modifiers...)
+	return bu
+}
+
+func (bu *BlockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(bu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BlockUpdateOne is the builder for updating a single Block entity.
+type BlockUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BlockMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (buo *BlockUpdateOne) Mutation() *BlockMutation {
+	return buo.mutation
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (buo *BlockUpdateOne) Where(ps ...predicate.Block) *BlockUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BlockUpdateOne) Select(field string, fields ...string) *BlockUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Block entity.
+func (buo *BlockUpdateOne) Save(ctx context.Context) (*Block, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BlockUpdateOne) SaveX(ctx context.Context) *Block {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BlockUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BlockUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BlockUpdateOne) check() error {
+	if buo.mutation.BlockerCleared() && len(buo.mutation.BlockerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocker"`)
+	}
+	if buo.mutation.BlockedCleared() && len(buo.mutation.BlockedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocked"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (buo *BlockUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlockUpdateOne {
+	buo.modifiers = append(buo.modifiers, modifiers...)
+	return buo
+}
+
+func (buo *BlockUpdateOne) sqlSave(ctx context.Context) (_node *Block, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Block.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, block.FieldID)
+		for _, f := range fields {
+			if !block.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != block.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(buo.modifiers...)
+	_node = &Block{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
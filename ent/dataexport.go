@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/user"
+)
+
+// DataExport is the model entity for the DataExport schema.
+type DataExport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ExportID holds the value of the "export_id" field.
+	ExportID string `json:"export_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status dataexport.Status `json:"status,omitempty"`
+	// Location of the zip archive while it is available
+	FilePath string `json:"file_path,omitempty"`
+	// Archive and download link stop working after this time
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DataExportQuery when eager-loading is set.
+	Edges             DataExportEdges `json:"edges"`
+	user_data_exports *int
+	selectValues      sql.SelectValues
+}
+
+// DataExportEdges holds the relations/edges for other nodes in the graph.
+type DataExportEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DataExportEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataExport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dataexport.FieldID:
+			values[i] = new(sql.NullInt64)
+		case dataexport.FieldExportID, dataexport.FieldStatus, dataexport.FieldFilePath, dataexport.FieldError:
+			values[i] = new(sql.NullString)
+		case dataexport.FieldCreateTime, dataexport.FieldUpdateTime, dataexport.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case dataexport.ForeignKeys[0]: // user_data_exports
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataExport fields.
+func (de *DataExport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dataexport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			de.ID = int(value.Int64)
+		case dataexport.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				de.CreateTime = value.Time
+			}
+		case dataexport.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				de.UpdateTime = value.Time
+			}
+		case dataexport.FieldExportID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field export_id", values[i])
+			} else if value.Valid {
+				de.ExportID = value.String
+			}
+		case dataexport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				de.Status = dataexport.Status(value.String)
+			}
+		case dataexport.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				de.FilePath = value.String
+			}
+		case dataexport.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				de.ExpiresAt = new(time.Time)
+				*de.ExpiresAt = value.Time
+			}
+		case dataexport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				de.Error = value.String
+			}
+		case dataexport.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_data_exports", value)
+			} else if value.Valid {
+				de.user_data_exports = new(int)
+				*de.user_data_exports = int(value.Int64)
+			}
+		default:
+			de.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataExport.
+// This includes values selected through modifiers, order, etc.
+func (de *DataExport) Value(name string) (ent.Value, error) {
+	return de.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the DataExport entity.
+func (de *DataExport) QueryUser() *UserQuery {
+	return NewDataExportClient(de.config).QueryUser(de)
+}
+
+// Update returns a builder for updating this DataExport.
+// Note that you need to call DataExport.Unwrap() before calling this method if this DataExport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (de *DataExport) Update() *DataExportUpdateOne {
+	return NewDataExportClient(de.config).UpdateOne(de)
+}
+
+// Unwrap unwraps the DataExport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (de *DataExport) Unwrap() *DataExport {
+	_tx, ok := de.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataExport is not a transactional entity")
+	}
+	de.config.driver = _tx.drv
+	return de
+}
+
+// String implements the fmt.Stringer.
+func (de *DataExport) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataExport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", de.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(de.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(de.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("export_id=")
+	builder.WriteString(de.ExportID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", de.Status))
+	builder.WriteString(", ")
+	builder.WriteString("file_path=")
+	builder.WriteString(de.FilePath)
+	builder.WriteString(", ")
+	if v := de.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(de.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataExports is a parsable slice of DataExport.
+type DataExports []*DataExport
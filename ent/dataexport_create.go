@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/user"
+)
+
+// DataExportCreate is the builder for creating a DataExport entity.
+type DataExportCreate struct {
+	config
+	mutation *DataExportMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (dec *DataExportCreate) SetCreateTime(t time.Time) *DataExportCreate {
+	dec.mutation.SetCreateTime(t)
+	return dec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableCreateTime(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetCreateTime(*t)
+	}
+	return dec
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dec *DataExportCreate) SetUpdateTime(t time.Time) *DataExportCreate {
+	dec.mutation.SetUpdateTime(t)
+	return dec
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableUpdateTime(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetUpdateTime(*t)
+	}
+	return dec
+}
+
+// SetExportID sets the "export_id" field.
+func (dec *DataExportCreate) SetExportID(s string) *DataExportCreate {
+	dec.mutation.SetExportID(s)
+	return dec
+}
+
+// SetNillableExportID sets the "export_id" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableExportID(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetExportID(*s)
+	}
+	return dec
+}
+
+// SetStatus sets the "status" field.
+func (dec *DataExportCreate) SetStatus(d dataexport.Status) *DataExportCreate {
+	dec.mutation.SetStatus(d)
+	return dec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableStatus(d *dataexport.Status) *DataExportCreate {
+	if d != nil {
+		dec.SetStatus(*d)
+	}
+	return dec
+}
+
+// SetFilePath sets the "file_path" field.
+func (dec *DataExportCreate) SetFilePath(s string) *DataExportCreate {
+	dec.mutation.SetFilePath(s)
+	return dec
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableFilePath(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetFilePath(*s)
+	}
+	return dec
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dec *DataExportCreate) SetExpiresAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetExpiresAt(t)
+	return dec
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableExpiresAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetExpiresAt(*t)
+	}
+	return dec
+}
+
+// SetError sets the "error" field.
+func (dec *DataExportCreate) SetError(s string) *DataExportCreate {
+	dec.mutation.SetError(s)
+	return dec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableError(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetError(*s)
+	}
+	return dec
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dec *DataExportCreate) SetUserID(id int) *DataExportCreate {
+	dec.mutation.SetUserID(id)
+	return dec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dec *DataExportCreate) SetUser(u *User) *DataExportCreate {
+	return dec.SetUserID(u.ID)
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (dec *DataExportCreate) Mutation() *DataExportMutation {
+	return dec.mutation
+}
+
+// Save creates the DataExport in the database.
+func (dec *DataExportCreate) Save(ctx context.Context) (*DataExport, error) {
+	dec.defaults()
+	return withHooks(ctx, dec.sqlSave, dec.mutation, dec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dec *DataExportCreate) SaveX(ctx context.Context) *DataExport {
+	v, err := dec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dec *DataExportCreate) Exec(ctx context.Context) error {
+	_, err := dec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dec *DataExportCreate) ExecX(ctx context.Context) {
+	if err := dec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dec *DataExportCreate) defaults() {
+	if _, ok := dec.mutation.CreateTime(); !ok {
+		v := dataexport.DefaultCreateTime()
+		dec.mutation.SetCreateTime(v)
+	}
+	if _, ok := dec.mutation.UpdateTime(); !ok {
+		v := dataexport.DefaultUpdateTime()
+		dec.mutation.SetUpdateTime(v)
+	}
+	if _, ok := dec.mutation.ExportID(); !ok {
+		v := dataexport.DefaultExportID()
+		dec.mutation.SetExportID(v)
+	}
+	if _, ok := dec.mutation.Status(); !ok {
+		v := dataexport.DefaultStatus
+		dec.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dec *DataExportCreate) check() error {
+	if _, ok := dec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "DataExport.create_time"`)}
+	}
+	if _, ok := dec.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "DataExport.update_time"`)}
+	}
+	if _, ok := dec.mutation.ExportID(); !ok {
+		return &ValidationError{Name: "export_id", err: errors.New(`ent: missing required field "DataExport.export_id"`)}
+	}
+	if v, ok := dec.mutation.ExportID(); ok {
+		if err := dataexport.ExportIDValidator(v); err != nil {
+			return &ValidationError{Name: "export_id", err: fmt.Errorf(`ent: validator failed for field "DataExport.export_id": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DataExport.status"`)}
+	}
+	if v, ok := dec.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if len(dec.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DataExport.user"`)}
+	}
+	return nil
+}
+
+func (dec *DataExportCreate) sqlSave(ctx context.Context) (*DataExport, error) {
+	if err := dec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dec.mutation.id = &_node.ID
+	dec.mutation.done = true
+	return _node, nil
+}
+
+func (dec *DataExportCreate) createSpec() (*DataExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataExport{config: dec.config}
+		_spec = sqlgraph.NewCreateSpec(dataexport.Table, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	)
+	if value, ok := dec.mutation.CreateTime(); ok {
+		_spec.SetField(dataexport.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := dec.mutation.UpdateTime(); ok {
+		_spec.SetField(dataexport.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := dec.mutation.ExportID(); ok {
+		_spec.SetField(dataexport.FieldExportID, field.TypeString, value)
+		_node.ExportID = value
+	}
+	if value, ok := dec.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dec.mutation.FilePath(); ok {
+		_spec.SetField(dataexport.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := dec.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := dec.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if nodes := dec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.UserTable,
+			Columns: []string{dataexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_data_exports = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DataExportCreateBulk is the builder for creating many DataExport entities in bulk.
+type DataExportCreateBulk struct {
+	config
+	err      error
+	builders []*DataExportCreate
+}
+
+// Save creates the DataExport entities in the database.
+func (decb *DataExportCreateBulk) Save(ctx context.Context) ([]*DataExport, error) {
+	if decb.err != nil {
+		return nil, decb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(decb.builders))
+	nodes := make([]*DataExport, len(decb.builders))
+	mutators := make([]Mutator, len(decb.builders))
+	for i := range decb.builders {
+		func(i int, root context.Context) {
+			builder := decb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, decb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, decb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, decb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (decb *DataExportCreateBulk) SaveX(ctx context.Context) []*DataExport {
+	v, err := decb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (decb *DataExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := decb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (decb *DataExportCreateBulk) ExecX(ctx context.Context) {
+	if err := decb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
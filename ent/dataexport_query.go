@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// DataExportQuery is the builder for querying DataExport entities.
+type DataExportQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dataexport.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DataExport
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DataExportQuery builder.
+func (deq *DataExportQuery) Where(ps ...predicate.DataExport) *DataExportQuery {
+	deq.predicates = append(deq.predicates, ps...)
+	return deq
+}
+
+// Limit the number of records to be returned by this query.
+func (deq *DataExportQuery) Limit(limit int) *DataExportQuery {
+	deq.ctx.Limit = &limit
+	return deq
+}
+
+// Offset to start from.
+func (deq *DataExportQuery) Offset(offset int) *DataExportQuery {
+	deq.ctx.Offset = &offset
+	return deq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (deq *DataExportQuery) Unique(unique bool) *DataExportQuery {
+	deq.ctx.Unique = &unique
+	return deq
+}
+
+// Order specifies how the records should be ordered.
+func (deq *DataExportQuery) Order(o ...dataexport.OrderOption) *DataExportQuery {
+	deq.order = append(deq.order, o...)
+	return deq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (deq *DataExportQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: deq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := deq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := deq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dataexport.Table, dataexport.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dataexport.UserTable, dataexport.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(deq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DataExport entity from the query.
+// Returns a *NotFoundError when no DataExport was found.
+func (deq *DataExportQuery) First(ctx context.Context) (*DataExport, error) {
+	nodes, err := deq.Limit(1).All(setContextOp(ctx, deq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dataexport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (deq *DataExportQuery) FirstX(ctx context.Context) *DataExport {
+	node, err := deq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DataExport ID from the query.
+// Returns a *NotFoundError when no DataExport ID was found.
+func (deq *DataExportQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = deq.Limit(1).IDs(setContextOp(ctx, deq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dataexport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (deq *DataExportQuery) FirstIDX(ctx context.Context) int {
+	id, err := deq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DataExport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DataExport entity is found.
+// Returns a *NotFoundError when no DataExport entities are found.
+func (deq *DataExportQuery) Only(ctx context.Context) (*DataExport, error) {
+	nodes, err := deq.Limit(2).All(setContextOp(ctx, deq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dataexport.Label}
+	default:
+		return nil, &NotSingularError{dataexport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (deq *DataExportQuery) OnlyX(ctx context.Context) *DataExport {
+	node, err := deq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DataExport ID in the query.
+// Returns a *NotSingularError when more than one DataExport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (deq *DataExportQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = deq.Limit(2).IDs(setContextOp(ctx, deq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dataexport.Label}
+	default:
+		err = &NotSingularError{dataexport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (deq *DataExportQuery) OnlyIDX(ctx context.Context) int {
+	id, err := deq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DataExports.
+func (deq *DataExportQuery) All(ctx context.Context) ([]*DataExport, error) {
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryAll)
+	if err := deq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DataExport, *DataExportQuery]()
+	return withInterceptors[[]*DataExport](ctx, deq, qr, deq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (deq *DataExportQuery) AllX(ctx context.Context) []*DataExport {
+	nodes, err := deq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DataExport IDs.
+func (deq *DataExportQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if deq.ctx.Unique == nil && deq.path != nil {
+		deq.Unique(true)
+	}
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryIDs)
+	if err = deq.Select(dataexport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (deq *DataExportQuery) IDsX(ctx context.Context) []int {
+	ids, err := deq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (deq *DataExportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryCount)
+	if err := deq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, deq, querierCount[*DataExportQuery](), deq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (deq *DataExportQuery) CountX(ctx context.Context) int {
+	count, err := deq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (deq *DataExportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryExist)
+	switch _, err := deq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (deq *DataExportQuery) ExistX(ctx context.Context) bool {
+	exist, err := deq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DataExportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (deq *DataExportQuery) Clone() *DataExportQuery {
+	if deq == nil {
+		return nil
+	}
+	return &DataExportQuery{
+		config:     deq.config,
+		ctx:        deq.ctx.Clone(),
+		order:      append([]dataexport.OrderOption{}, deq.order...),
+		inters:     append([]Interceptor{}, deq.inters...),
+		predicates: append([]predicate.DataExport{}, deq.predicates...),
+		withUser:   deq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       deq.sql.Clone(),
+		path:      deq.path,
+		modifiers: append([]func(*sql.Selector){}, deq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (deq *DataExportQuery) WithUser(opts ...func(*UserQuery)) *DataExportQuery {
+	query := (&UserClient{config: deq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	deq.withUser = query
+	return deq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DataExport.Query().
+//		GroupBy(dataexport.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (deq *DataExportQuery) GroupBy(field string, fields ...string) *DataExportGroupBy {
+	deq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DataExportGroupBy{build: deq}
+	grbuild.flds = &deq.ctx.Fields
+	grbuild.label = dataexport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.DataExport.Query().
+//		Select(dataexport.FieldCreateTime).
+//		Scan(ctx, &v)
+func (deq *DataExportQuery) Select(fields ...string) *DataExportSelect {
+	deq.ctx.Fields = append(deq.ctx.Fields, fields...)
+	sbuild := &DataExportSelect{DataExportQuery: deq}
+	sbuild.label = dataexport.Label
+	sbuild.flds, sbuild.scan = &deq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DataExportSelect configured with the given aggregations.
+func (deq *DataExportQuery) Aggregate(fns ...AggregateFunc) *DataExportSelect {
+	return deq.Select().Aggregate(fns...)
+}
+
+func (deq *DataExportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range deq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, deq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range deq.ctx.Fields {
+		if !dataexport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if deq.path != nil {
+		prev, err := deq.path(ctx)
+		if err != nil {
+			return err
+		}
+		deq.sql = prev
+	}
+	return nil
+}
+
+func (deq *DataExportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DataExport, error) {
+	var (
+		nodes       = []*DataExport{}
+		withFKs     = deq.withFKs
+		_spec       = deq.querySpec()
+		loadedTypes = [1]bool{
+			deq.withUser != nil,
+		}
+	)
+	if deq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DataExport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DataExport{config: deq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(deq.modifiers) > 0 {
+		_spec.Modifiers = deq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, deq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := deq.withUser; query != nil {
+		if err := deq.loadUser(ctx, query, nodes, nil,
+			func(n *DataExport, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (deq *DataExportQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*DataExport, init func(*DataExport), assign func(*DataExport, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DataExport)
+	for i := range nodes {
+		if nodes[i].user_data_exports == nil {
+			continue
+		}
+		fk := *nodes[i].user_data_exports
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_data_exports" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (deq *DataExportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := deq.querySpec()
+	if len(deq.modifiers) > 0 {
+		_spec.Modifiers = deq.modifiers
+	}
+	_spec.Node.Columns = deq.ctx.Fields
+	if len(deq.ctx.Fields) > 0 {
+		_spec.Unique = deq.ctx.Unique != nil && *deq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, deq.driver, _spec)
+}
+
+func (deq *DataExportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	_spec.From = deq.sql
+	if unique := deq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if deq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := deq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.FieldID)
+		for i := range fields {
+			if fields[i] != dataexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := deq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := deq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := deq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := deq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (deq *DataExportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(deq.driver.Dialect())
+	t1 := builder.Table(dataexport.Table)
+	columns := deq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dataexport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if deq.sql != nil {
+		selector = deq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if deq.ctx.Unique != nil && *deq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range deq.modifiers {
+		m(selector)
+	}
+	for _, p := range deq.predicates {
+		p(selector)
+	}
+	for _, p := range deq.order {
+		p(selector)
+	}
+	if offset := deq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := deq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (deq *DataExportQuery) ForUpdate(opts ...sql.LockOption) *DataExportQuery {
+	if deq.driver.Dialect() == dialect.Postgres {
+		deq.Unique(false)
+	}
+	deq.modifiers = append(deq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return deq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (deq *DataExportQuery) ForShare(opts ...sql.LockOption) *DataExportQuery {
+	if deq.driver.Dialect() == dialect.Postgres {
+		deq.Unique(false)
+	}
+	deq.modifiers = append(deq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return deq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (deq *DataExportQuery) Modify(modifiers ...func(s *sql.Selector)) *DataExportSelect {
+	deq.modifiers = append(deq.modifiers, modifiers...)
+	return deq.Select()
+}
+
+// DataExportGroupBy is the group-by builder for DataExport entities.
+type DataExportGroupBy struct {
+	selector
+	build *DataExportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (degb *DataExportGroupBy) Aggregate(fns ...AggregateFunc) *DataExportGroupBy {
+	degb.fns = append(degb.fns, fns...)
+	return degb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (degb *DataExportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, degb.build.ctx, ent.OpQueryGroupBy)
+	if err := degb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DataExportQuery, *DataExportGroupBy](ctx, degb.build, degb, degb.build.inters, v)
+}
+
+func (degb *DataExportGroupBy) sqlScan(ctx context.Context, root *DataExportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(degb.fns))
+	for _, fn := range degb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*degb.flds)+len(degb.fns))
+		for _, f := range *degb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*degb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := degb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DataExportSelect is the builder for selecting fields of DataExport entities.
+type DataExportSelect struct {
+	*DataExportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (des *DataExportSelect) Aggregate(fns ...AggregateFunc) *DataExportSelect {
+	des.fns = append(des.fns, fns...)
+	return des
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (des *DataExportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, des.ctx, ent.OpQuerySelect)
+	if err := des.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DataExportQuery, *DataExportSelect](ctx, des.DataExportQuery, des, des.inters, v)
+}
+
+func (des *DataExportSelect) sqlScan(ctx context.Context, root *DataExportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(des.fns))
+	for _, fn := range des.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*des.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := des.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (des *DataExportSelect) Modify(modifiers ...func(s *sql.Selector)) *DataExportSelect {
+	des.modifiers = append(des.modifiers, modifiers...)
+	return des
+}
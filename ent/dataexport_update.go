@@ -0,0 +1,587 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// DataExportUpdate is the builder for updating DataExport entities.
+type DataExportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DataExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (deu *DataExportUpdate) Where(ps ...predicate.DataExport) *DataExportUpdate {
+	deu.mutation.Where(ps...)
+	return deu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (deu *DataExportUpdate) SetUpdateTime(t time.Time) *DataExportUpdate {
+	deu.mutation.SetUpdateTime(t)
+	return deu
+}
+
+// SetExportID sets the "export_id" field.
+func (deu *DataExportUpdate) SetExportID(s string) *DataExportUpdate {
+	deu.mutation.SetExportID(s)
+	return deu
+}
+
+// SetNillableExportID sets the "export_id" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableExportID(s *string) *DataExportUpdate {
+	if s != nil {
+		deu.SetExportID(*s)
+	}
+	return deu
+}
+
+// SetStatus sets the "status" field.
+func (deu *DataExportUpdate) SetStatus(d dataexport.Status) *DataExportUpdate {
+	deu.mutation.SetStatus(d)
+	return deu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableStatus(d *dataexport.Status) *DataExportUpdate {
+	if d != nil {
+		deu.SetStatus(*d)
+	}
+	return deu
+}
+
+// SetFilePath sets the "file_path" field.
+func (deu *DataExportUpdate) SetFilePath(s string) *DataExportUpdate {
+	deu.mutation.SetFilePath(s)
+	return deu
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableFilePath(s *string) *DataExportUpdate {
+	if s != nil {
+		deu.SetFilePath(*s)
+	}
+	return deu
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (deu *DataExportUpdate) ClearFilePath() *DataExportUpdate {
+	deu.mutation.ClearFilePath()
+	return deu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (deu *DataExportUpdate) SetExpiresAt(t time.Time) *DataExportUpdate {
+	deu.mutation.SetExpiresAt(t)
+	return deu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableExpiresAt(t *time.Time) *DataExportUpdate {
+	if t != nil {
+		deu.SetExpiresAt(*t)
+	}
+	return deu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (deu *DataExportUpdate) ClearExpiresAt() *DataExportUpdate {
+	deu.mutation.ClearExpiresAt()
+	return deu
+}
+
+// SetError sets the "error" field.
+func (deu *DataExportUpdate) SetError(s string) *DataExportUpdate {
+	deu.mutation.SetError(s)
+	return deu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableError(s *string) *DataExportUpdate {
+	if s != nil {
+		deu.SetError(*s)
+	}
+	return deu
+}
+
+// ClearError clears the value of the "error" field.
+func (deu *DataExportUpdate) ClearError() *DataExportUpdate {
+	deu.mutation.ClearError()
+	return deu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (deu *DataExportUpdate) SetUserID(id int) *DataExportUpdate {
+	deu.mutation.SetUserID(id)
+	return deu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (deu *DataExportUpdate) SetUser(u *User) *DataExportUpdate {
+	return deu.SetUserID(u.ID)
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (deu *DataExportUpdate) Mutation() *DataExportMutation {
+	return deu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (deu *DataExportUpdate) ClearUser() *DataExportUpdate {
+	deu.mutation.ClearUser()
+	return deu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (deu *DataExportUpdate) Save(ctx context.Context) (int, error) {
+	deu.defaults()
+	return withHooks(ctx, deu.sqlSave, deu.mutation, deu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deu *DataExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := deu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (deu *DataExportUpdate) Exec(ctx context.Context) error {
+	_, err := deu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deu *DataExportUpdate) ExecX(ctx context.Context) {
+	if err := deu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (deu *DataExportUpdate) defaults() {
+	if _, ok := deu.mutation.UpdateTime(); !ok {
+		v := dataexport.UpdateDefaultUpdateTime()
+		deu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deu *DataExportUpdate) check() error {
+	if v, ok := deu.mutation.ExportID(); ok {
+		if err := dataexport.ExportIDValidator(v); err != nil {
+			return &ValidationError{Name: "export_id", err: fmt.Errorf(`ent: validator failed for field "DataExport.export_id": %w`, err)}
+		}
+	}
+	if v, ok := deu.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if deu.mutation.UserCleared() && len(deu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (deu *DataExportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataExportUpdate {
+	deu.modifiers = append(deu.modifiers, modifiers...)
+	return deu
+}
+
+func (deu *DataExportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := deu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	if ps := deu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := deu.mutation.UpdateTime(); ok {
+		_spec.SetField(dataexport.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := deu.mutation.ExportID(); ok {
+		_spec.SetField(dataexport.FieldExportID, field.TypeString, value)
+	}
+	if value, ok := deu.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := deu.mutation.FilePath(); ok {
+		_spec.SetField(dataexport.FieldFilePath, field.TypeString, value)
+	}
+	if deu.mutation.FilePathCleared() {
+		_spec.ClearField(dataexport.FieldFilePath, field.TypeString)
+	}
+	if value, ok := deu.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if deu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := deu.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+	}
+	if deu.mutation.ErrorCleared() {
+		_spec.ClearField(dataexport.FieldError, field.TypeString)
+	}
+	if deu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.UserTable,
+			Columns: []string{dataexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := deu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.UserTable,
+			Columns: []string{dataexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(deu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, deu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	deu.mutation.done = true
+	return n, nil
+}
+
+// DataExportUpdateOne is the builder for updating a single DataExport entity.
+type DataExportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DataExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (deuo *DataExportUpdateOne) SetUpdateTime(t time.Time) *DataExportUpdateOne {
+	deuo.mutation.SetUpdateTime(t)
+	return deuo
+}
+
+// SetExportID sets the "export_id" field.
+func (deuo *DataExportUpdateOne) SetExportID(s string) *DataExportUpdateOne {
+	deuo.mutation.SetExportID(s)
+	return deuo
+}
+
+// SetNillableExportID sets the "export_id" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableExportID(s *string) *DataExportUpdateOne {
+	if s != nil {
+		deuo.SetExportID(*s)
+	}
+	return deuo
+}
+
+// SetStatus sets the "status" field.
+func (deuo *DataExportUpdateOne) SetStatus(d dataexport.Status) *DataExportUpdateOne {
+	deuo.mutation.SetStatus(d)
+	return deuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableStatus(d *dataexport.Status) *DataExportUpdateOne {
+	if d != nil {
+		deuo.SetStatus(*d)
+	}
+	return deuo
+}
+
+// SetFilePath sets the "file_path" field.
+func (deuo *DataExportUpdateOne) SetFilePath(s string) *DataExportUpdateOne {
+	deuo.mutation.SetFilePath(s)
+	return deuo
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableFilePath(s *string) *DataExportUpdateOne {
+	if s != nil {
+		deuo.SetFilePath(*s)
+	}
+	return deuo
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (deuo *DataExportUpdateOne) ClearFilePath() *DataExportUpdateOne {
+	deuo.mutation.ClearFilePath()
+	return deuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (deuo *DataExportUpdateOne) SetExpiresAt(t time.Time) *DataExportUpdateOne {
+	deuo.mutation.SetExpiresAt(t)
+	return deuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableExpiresAt(t *time.Time) *DataExportUpdateOne {
+	if t != nil {
+		deuo.SetExpiresAt(*t)
+	}
+	return deuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (deuo *DataExportUpdateOne) ClearExpiresAt() *DataExportUpdateOne {
+	deuo.mutation.ClearExpiresAt()
+	return deuo
+}
+
+// SetError sets the "error" field.
+func (deuo *DataExportUpdateOne) SetError(s string) *DataExportUpdateOne {
+	deuo.mutation.SetError(s)
+	return deuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableError(s *string) *DataExportUpdateOne {
+	if s != nil {
+		deuo.SetError(*s)
+	}
+	return deuo
+}
+
+// ClearError clears the value of the "error" field.
+func (deuo *DataExportUpdateOne) ClearError() *DataExportUpdateOne {
+	deuo.mutation.ClearError()
+	return deuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (deuo *DataExportUpdateOne) SetUserID(id int) *DataExportUpdateOne {
+	deuo.mutation.SetUserID(id)
+	return deuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (deuo *DataExportUpdateOne) SetUser(u *User) *DataExportUpdateOne {
+	return deuo.SetUserID(u.ID)
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (deuo *DataExportUpdateOne) Mutation() *DataExportMutation {
+	return deuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (deuo *DataExportUpdateOne) ClearUser() *DataExportUpdateOne {
+	deuo.mutation.ClearUser()
+	return deuo
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (deuo *DataExportUpdateOne) Where(ps ...predicate.DataExport) *DataExportUpdateOne {
+	deuo.mutation.Where(ps...)
+	return deuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (deuo *DataExportUpdateOne) Select(field string, fields ...string) *DataExportUpdateOne {
+	deuo.fields = append([]string{field}, fields...)
+	return deuo
+}
+
+// Save executes the query and returns the updated DataExport entity.
+func (deuo *DataExportUpdateOne) Save(ctx context.Context) (*DataExport, error) {
+	deuo.defaults()
+	return withHooks(ctx, deuo.sqlSave, deuo.mutation, deuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deuo *DataExportUpdateOne) SaveX(ctx context.Context) *DataExport {
+	node, err := deuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (deuo *DataExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := deuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deuo *DataExportUpdateOne) ExecX(ctx context.Context) {
+	if err := deuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (deuo *DataExportUpdateOne) defaults() {
+	if _, ok := deuo.mutation.UpdateTime(); !ok {
+		v := dataexport.UpdateDefaultUpdateTime()
+		deuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deuo *DataExportUpdateOne) check() error {
+	if v, ok := deuo.mutation.ExportID(); ok {
+		if err := dataexport.ExportIDValidator(v); err != nil {
+			return &ValidationError{Name: "export_id", err: fmt.Errorf(`ent: validator failed for field "DataExport.export_id": %w`, err)}
+		}
+	}
+	if v, ok := deuo.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if deuo.mutation.UserCleared() && len(deuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (deuo *DataExportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataExportUpdateOne {
+	deuo.modifiers = append(deuo.modifiers, modifiers...)
+	return deuo
+}
+
+func (deuo *DataExportUpdateOne) sqlSave(ctx context.Context) (_node *DataExport, err error) {
+	if err := deuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	id, ok := deuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := deuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.FieldID)
+		for _, f := range fields {
+			if !dataexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dataexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := deuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := deuo.mutation.UpdateTime(); ok {
+		_spec.SetField(dataexport.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := deuo.mutation.ExportID(); ok {
+		_spec.SetField(dataexport.FieldExportID, field.TypeString, value)
+	}
+	if value, ok := deuo.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := deuo.mutation.FilePath(); ok {
+		_spec.SetField(dataexport.FieldFilePath, field.TypeString, value)
+	}
+	if deuo.mutation.FilePathCleared() {
+		_spec.ClearField(dataexport.FieldFilePath, field.TypeString)
+	}
+	if value, ok := deuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if deuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := deuo.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+	}
+	if deuo.mutation.ErrorCleared() {
+		_spec.ClearField(dataexport.FieldError, field.TypeString)
+	}
+	if deuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.UserTable,
+			Columns: []string{dataexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := deuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.UserTable,
+			Columns: []string{dataexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(deuo.modifiers...)
+	_node = &DataExport{config: deuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, deuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	deuo.mutation.done = true
+	return _node, nil
+}
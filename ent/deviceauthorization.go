@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/user"
+)
+
+// DeviceAuthorization is the model entity for the DeviceAuthorization schema.
+type DeviceAuthorization struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// SHA-256 of the device code polled by the device
+	DeviceCodeHash string `json:"-"`
+	// Short code typed on the phone
+	UserCode string `json:"user_code,omitempty"`
+	// Status holds the value of the "status" field.
+	Status deviceauthorization.Status `json:"status,omitempty"`
+	// User agent of the requesting device
+	UserAgent string `json:"user_agent,omitempty"`
+	// IP address of the requesting device
+	IPAddress string `json:"ip_address,omitempty"`
+	// Seconds the device must wait between polls
+	Interval int `json:"interval,omitempty"`
+	// LastPolledAt holds the value of the "last_polled_at" field.
+	LastPolledAt *time.Time `json:"last_polled_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeviceAuthorizationQuery when eager-loading is set.
+	Edges                      DeviceAuthorizationEdges `json:"edges"`
+	user_device_authorizations *int
+	selectValues               sql.SelectValues
+}
+
+// DeviceAuthorizationEdges holds the relations/edges for other nodes in the graph.
+type DeviceAuthorizationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceAuthorizationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeviceAuthorization) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deviceauthorization.FieldID, deviceauthorization.FieldInterval:
+			values[i] = new(sql.NullInt64)
+		case deviceauthorization.FieldDeviceCodeHash, deviceauthorization.FieldUserCode, deviceauthorization.FieldStatus, deviceauthorization.FieldUserAgent, deviceauthorization.FieldIPAddress:
+			values[i] = new(sql.NullString)
+		case deviceauthorization.FieldCreateTime, deviceauthorization.FieldUpdateTime, deviceauthorization.FieldLastPolledAt, deviceauthorization.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case deviceauthorization.ForeignKeys[0]: // user_device_authorizations
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeviceAuthorization fields.
+func (da *DeviceAuthorization) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deviceauthorization.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			da.ID = int(value.Int64)
+		case deviceauthorization.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				da.CreateTime = value.Time
+			}
+		case deviceauthorization.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				da.UpdateTime = value.Time
+			}
+		case deviceauthorization.FieldDeviceCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_code_hash", values[i])
+			} else if value.Valid {
+				da.DeviceCodeHash = value.String
+			}
+		case deviceauthorization.FieldUserCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_code", values[i])
+			} else if value.Valid {
+				da.UserCode = value.String
+			}
+		case deviceauthorization.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				da.Status = deviceauthorization.Status(value.String)
+			}
+		case deviceauthorization.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				da.UserAgent = value.String
+			}
+		case deviceauthorization.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				da.IPAddress = value.String
+			}
+		case deviceauthorization.FieldInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval", values[i])
+			} else if value.Valid {
+				da.Interval = int(value.Int64)
+			}
+		case deviceauthorization.FieldLastPolledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_polled_at", values[i])
+			} else if value.Valid {
+				da.LastPolledAt = new(time.Time)
+				*da.LastPolledAt = value.Time
+			}
+		case deviceauthorization.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				da.ExpiresAt = value.Time
+			}
+		case deviceauthorization.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_device_authorizations", value)
+			} else if value.Valid {
+				da.user_device_authorizations = new(int)
+				*da.user_device_authorizations = int(value.Int64)
+			}
+		default:
+			da.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeviceAuthorization.
+// This includes values selected through modifiers, order, etc.
+func (da *DeviceAuthorization) Value(name string) (ent.Value, error) {
+	return da.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the DeviceAuthorization entity.
+func (da *DeviceAuthorization) QueryUser() *UserQuery {
+	return NewDeviceAuthorizationClient(da.config).QueryUser(da)
+}
+
+// Update returns a builder for updating this DeviceAuthorization.
+// Note that you need to call DeviceAuthorization.Unwrap() before calling this method if this DeviceAuthorization
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (da *DeviceAuthorization) Update() *DeviceAuthorizationUpdateOne {
+	return NewDeviceAuthorizationClient(da.config).UpdateOne(da)
+}
+
+// Unwrap unwraps the DeviceAuthorization entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (da *DeviceAuthorization) Unwrap() *DeviceAuthorization {
+	_tx, ok := da.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceAuthorization is not a transactional entity")
+	}
+	da.config.driver = _tx.drv
+	return da
+}
+
+// String implements the fmt.Stringer.
+func (da *DeviceAuthorization) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeviceAuthorization(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", da.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(da.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(da.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("device_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("user_code=")
+	builder.WriteString(da.UserCode)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", da.Status))
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(da.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(da.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("interval=")
+	builder.WriteString(fmt.Sprintf("%v", da.Interval))
+	builder.WriteString(", ")
+	if v := da.LastPolledAt; v != nil {
+		builder.WriteString("last_polled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(da.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeviceAuthorizations is a parsable slice of DeviceAuthorization.
+type DeviceAuthorizations []*DeviceAuthorization
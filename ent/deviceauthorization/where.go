@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceauthorization
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeviceCodeHash applies equality check predicate on the "device_code_hash" field. It's identical to DeviceCodeHashEQ.
+func DeviceCodeHash(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDeviceCodeHash, v))
+}
+
+// UserCode applies equality check predicate on the "user_code" field. It's identical to UserCodeEQ.
+func UserCode(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserCode, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// Interval applies equality check predicate on the "interval" field. It's identical to IntervalEQ.
+func Interval(v int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldInterval, v))
+}
+
+// LastPolledAt applies equality check predicate on the "last_polled_at" field. It's identical to LastPolledAtEQ.
+func LastPolledAt(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldLastPolledAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// DeviceCodeHashEQ applies the EQ predicate on the "device_code_hash" field.
+func DeviceCodeHashEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashNEQ applies the NEQ predicate on the "device_code_hash" field.
+func DeviceCodeHashNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashIn applies the In predicate on the "device_code_hash" field.
+func DeviceCodeHashIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldDeviceCodeHash, vs...))
+}
+
+// DeviceCodeHashNotIn applies the NotIn predicate on the "device_code_hash" field.
+func DeviceCodeHashNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldDeviceCodeHash, vs...))
+}
+
+// DeviceCodeHashGT applies the GT predicate on the "device_code_hash" field.
+func DeviceCodeHashGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashGTE applies the GTE predicate on the "device_code_hash" field.
+func DeviceCodeHashGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashLT applies the LT predicate on the "device_code_hash" field.
+func DeviceCodeHashLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashLTE applies the LTE predicate on the "device_code_hash" field.
+func DeviceCodeHashLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashContains applies the Contains predicate on the "device_code_hash" field.
+func DeviceCodeHashContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashHasPrefix applies the HasPrefix predicate on the "device_code_hash" field.
+func DeviceCodeHashHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashHasSuffix applies the HasSuffix predicate on the "device_code_hash" field.
+func DeviceCodeHashHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashEqualFold applies the EqualFold predicate on the "device_code_hash" field.
+func DeviceCodeHashEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashContainsFold applies the ContainsFold predicate on the "device_code_hash" field.
+func DeviceCodeHashContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldDeviceCodeHash, v))
+}
+
+// UserCodeEQ applies the EQ predicate on the "user_code" field.
+func UserCodeEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserCode, v))
+}
+
+// UserCodeNEQ applies the NEQ predicate on the "user_code" field.
+func UserCodeNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldUserCode, v))
+}
+
+// UserCodeIn applies the In predicate on the "user_code" field.
+func UserCodeIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldUserCode, vs...))
+}
+
+// UserCodeNotIn applies the NotIn predicate on the "user_code" field.
+func UserCodeNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldUserCode, vs...))
+}
+
+// UserCodeGT applies the GT predicate on the "user_code" field.
+func UserCodeGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldUserCode, v))
+}
+
+// UserCodeGTE applies the GTE predicate on the "user_code" field.
+func UserCodeGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldUserCode, v))
+}
+
+// UserCodeLT applies the LT predicate on the "user_code" field.
+func UserCodeLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldUserCode, v))
+}
+
+// UserCodeLTE applies the LTE predicate on the "user_code" field.
+func UserCodeLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldUserCode, v))
+}
+
+// UserCodeContains applies the Contains predicate on the "user_code" field.
+func UserCodeContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldUserCode, v))
+}
+
+// UserCodeHasPrefix applies the HasPrefix predicate on the "user_code" field.
+func UserCodeHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldUserCode, v))
+}
+
+// UserCodeHasSuffix applies the HasSuffix predicate on the "user_code" field.
+func UserCodeHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldUserCode, v))
+}
+
+// UserCodeEqualFold applies the EqualFold predicate on the "user_code" field.
+func UserCodeEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldUserCode, v))
+}
+
+// UserCodeContainsFold applies the ContainsFold predicate on the "user_code" field.
+func UserCodeContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldUserCode, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressIsNil applies the IsNil predicate on the "ip_address" field.
+func IPAddressIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldIPAddress))
+}
+
+// IPAddressNotNil applies the NotNil predicate on the "ip_address" field.
+func IPAddressNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldIPAddress))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// IntervalEQ applies the EQ predicate on the "interval" field.
+func IntervalEQ(v int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldInterval, v))
+}
+
+// IntervalNEQ applies the NEQ predicate on the "interval" field.
+func IntervalNEQ(v int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldInterval, v))
+}
+
+// IntervalIn applies the In predicate on the "interval" field.
+func IntervalIn(vs ...int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldInterval, vs...))
+}
+
+// IntervalNotIn applies the NotIn predicate on the "interval" field.
+func IntervalNotIn(vs ...int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldInterval, vs...))
+}
+
+// IntervalGT applies the GT predicate on the "interval" field.
+func IntervalGT(v int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldInterval, v))
+}
+
+// IntervalGTE applies the GTE predicate on the "interval" field.
+func IntervalGTE(v int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldInterval, v))
+}
+
+// IntervalLT applies the LT predicate on the "interval" field.
+func IntervalLT(v int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldInterval, v))
+}
+
+// IntervalLTE applies the LTE predicate on the "interval" field.
+func IntervalLTE(v int) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldInterval, v))
+}
+
+// LastPolledAtEQ applies the EQ predicate on the "last_polled_at" field.
+func LastPolledAtEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldLastPolledAt, v))
+}
+
+// LastPolledAtNEQ applies the NEQ predicate on the "last_polled_at" field.
+func LastPolledAtNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldLastPolledAt, v))
+}
+
+// LastPolledAtIn applies the In predicate on the "last_polled_at" field.
+func LastPolledAtIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldLastPolledAt, vs...))
+}
+
+// LastPolledAtNotIn applies the NotIn predicate on the "last_polled_at" field.
+func LastPolledAtNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldLastPolledAt, vs...))
+}
+
+// LastPolledAtGT applies the GT predicate on the "last_polled_at" field.
+func LastPolledAtGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldLastPolledAt, v))
+}
+
+// LastPolledAtGTE applies the GTE predicate on the "last_polled_at" field.
+func LastPolledAtGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldLastPolledAt, v))
+}
+
+// LastPolledAtLT applies the LT predicate on the "last_polled_at" field.
+func LastPolledAtLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldLastPolledAt, v))
+}
+
+// LastPolledAtLTE applies the LTE predicate on the "last_polled_at" field.
+func LastPolledAtLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldLastPolledAt, v))
+}
+
+// LastPolledAtIsNil applies the IsNil predicate on the "last_polled_at" field.
+func LastPolledAtIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldLastPolledAt))
+}
+
+// LastPolledAtNotNil applies the NotNil predicate on the "last_polled_at" field.
+func LastPolledAtNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldLastPolledAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.NotPredicates(p))
+}
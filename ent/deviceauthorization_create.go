@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/user"
+)
+
+// DeviceAuthorizationCreate is the builder for creating a DeviceAuthorization entity.
+type DeviceAuthorizationCreate struct {
+	config
+	mutation *DeviceAuthorizationMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (dac *DeviceAuthorizationCreate) SetCreateTime(t time.Time) *DeviceAuthorizationCreate {
+	dac.mutation.SetCreateTime(t)
+	return dac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (dac *DeviceAuthorizationCreate) SetNillableCreateTime(t *time.Time) *DeviceAuthorizationCreate {
+	if t != nil {
+		dac.SetCreateTime(*t)
+	}
+	return dac
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dac *DeviceAuthorizationCreate) SetUpdateTime(t time.Time) *DeviceAuthorizationCreate {
+	dac.mutation.SetUpdateTime(t)
+	return dac
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (dac *DeviceAuthorizationCreate) SetNillableUpdateTime(t *time.Time) *DeviceAuthorizationCreate {
+	if t != nil {
+		dac.SetUpdateTime(*t)
+	}
+	return dac
+}
+
+// SetDeviceCodeHash sets the "device_code_hash" field.
+func (dac *DeviceAuthorizationCreate) SetDeviceCodeHash(s string) *DeviceAuthorizationCreate {
+	dac.mutation.SetDeviceCodeHash(s)
+	return dac
+}
+
+// SetUserCode sets the "user_code" field.
+func (dac *DeviceAuthorizationCreate) SetUserCode(s string) *DeviceAuthorizationCreate {
+	dac.mutation.SetUserCode(s)
+	return dac
+}
+
+// SetStatus sets the "status" field.
+func (dac *DeviceAuthorizationCreate) SetStatus(d deviceauthorization.Status) *DeviceAuthorizationCreate {
+	dac.mutation.SetStatus(d)
+	return dac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dac *DeviceAuthorizationCreate) SetNillableStatus(d *deviceauthorization.Status) *DeviceAuthorizationCreate {
+	if d != nil {
+		dac.SetStatus(*d)
+	}
+	return dac
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (dac *DeviceAuthorizationCreate) SetUserAgent(s string) *DeviceAuthorizationCreate {
+	dac.mutation.SetUserAgent(s)
+	return dac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (dac *DeviceAuthorizationCreate) SetNillableUserAgent(s *string) *DeviceAuthorizationCreate {
+	if s != nil {
+		dac.SetUserAgent(*s)
+	}
+	return dac
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (dac *DeviceAuthorizationCreate) SetIPAddress(s string) *DeviceAuthorizationCreate {
+	dac.mutation.SetIPAddress(s)
+	return dac
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (dac *DeviceAuthorizationCreate) SetNillableIPAddress(s *string) *DeviceAuthorizationCreate {
+	if s != nil {
+		dac.SetIPAddress(*s)
+	}
+	return dac
+}
+
+// SetInterval sets the "interval" field.
+func (dac *DeviceAuthorizationCreate) SetInterval(i int) *DeviceAuthorizationCreate {
+	dac.mutation.SetInterval(i)
+	return dac
+}
+
+// SetLastPolledAt sets the "last_polled_at" field.
+func (dac *DeviceAuthorizationCreate) SetLastPolledAt(t time.Time) *DeviceAuthorizationCreate {
+	dac.mutation.SetLastPolledAt(t)
+	return dac
+}
+
+// SetNillableLastPolledAt sets the "last_polled_at" field if the given value is not nil.
+func (dac *DeviceAuthorizationCreate) SetNillableLastPolledAt(t *time.Time) *DeviceAuthorizationCreate {
+	if t != nil {
+		dac.SetLastPolledAt(*t)
+	}
+	return dac
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dac *DeviceAuthorizationCreate) SetExpiresAt(t time.Time) *DeviceAuthorizationCreate {
+	dac.mutation.SetExpiresAt(t)
+	return dac
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dac *DeviceAuthorizationCreate) SetUserID(id int) *DeviceAuthorizationCreate {
+	dac.mutation.SetUserID(id)
+	return dac
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (dac *DeviceAuthorizationCreate) SetNillableUserID(id *int) *DeviceAuthorizationCreate {
+	if id != nil {
+		dac = dac.SetUserID(*id)
+	}
+	return dac
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dac *DeviceAuthorizationCreate) SetUser(u *User) *DeviceAuthorizationCreate {
+	return dac.SetUserID(u.ID)
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (dac *DeviceAuthorizationCreate) Mutation() *DeviceAuthorizationMutation {
+	return dac.mutation
+}
+
+// Save creates the DeviceAuthorization in the database.
+func (dac *DeviceAuthorizationCreate) Save(ctx context.Context) (*DeviceAuthorization, error) {
+	dac.defaults()
+	return withHooks(ctx, dac.sqlSave, dac.mutation, dac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dac *DeviceAuthorizationCreate) SaveX(ctx context.Context) *DeviceAuthorization {
+	v, err := dac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dac *DeviceAuthorizationCreate) Exec(ctx context.Context) error {
+	_, err := dac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dac *DeviceAuthorizationCreate) ExecX(ctx context.Context) {
+	if err := dac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dac *DeviceAuthorizationCreate) defaults() {
+	if _, ok := dac.mutation.CreateTime(); !ok {
+		v := deviceauthorization.DefaultCreateTime()
+		dac.mutation.SetCreateTime(v)
+	}
+	if _, ok := dac.mutation.UpdateTime(); !ok {
+		v := deviceauthorization.DefaultUpdateTime()
+		dac.mutation.SetUpdateTime(v)
+	}
+	if _, ok := dac.mutation.Status(); !ok {
+		v := deviceauthorization.DefaultStatus
+		dac.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dac *DeviceAuthorizationCreate) check() error {
+	if _, ok := dac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "DeviceAuthorization.create_time"`)}
+	}
+	if _, ok := dac.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "DeviceAuthorization.update_time"`)}
+	}
+	if _, ok := dac.mutation.DeviceCodeHash(); !ok {
+		return &ValidationError{Name: "device_code_hash", err: errors.New(`ent: missing required field "DeviceAuthorization.device_code_hash"`)}
+	}
+	if v, ok := dac.mutation.DeviceCodeHash(); ok {
+		if err := deviceauthorization.DeviceCodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_code_hash", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.device_code_hash": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.UserCode(); !ok {
+		return &ValidationError{Name: "user_code", err: errors.New(`ent: missing required field "DeviceAuthorization.user_code"`)}
+	}
+	if v, ok := dac.mutation.UserCode(); ok {
+		if err := deviceauthorization.UserCodeValidator(v); err != nil {
+			return &ValidationError{Name: "user_code", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.user_code": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeviceAuthorization.status"`)}
+	}
+	if v, ok := dac.mutation.Status(); ok {
+		if err := deviceauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.status": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "DeviceAuthorization.interval"`)}
+	}
+	if v, ok := dac.mutation.Interval(); ok {
+		if err := deviceauthorization.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.interval": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "DeviceAuthorization.expires_at"`)}
+	}
+	return nil
+}
+
+func (dac *DeviceAuthorizationCreate) sqlSave(ctx context.Context) (*DeviceAuthorization, error) {
+	if err := dac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dac.mutation.id = &_node.ID
+	dac.mutation.done = true
+	return _node, nil
+}
+
+func (dac *DeviceAuthorizationCreate) createSpec() (*DeviceAuthorization, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceAuthorization{config: dac.config}
+		_spec = sqlgraph.NewCreateSpec(deviceauthorization.Table, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt))
+	)
+	if value, ok := dac.mutation.CreateTime(); ok {
+		_spec.SetField(deviceauthorization.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := dac.mutation.UpdateTime(); ok {
+		_spec.SetField(deviceauthorization.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := dac.mutation.DeviceCodeHash(); ok {
+		_spec.SetField(deviceauthorization.FieldDeviceCodeHash, field.TypeString, value)
+		_node.DeviceCodeHash = value
+	}
+	if value, ok := dac.mutation.UserCode(); ok {
+		_spec.SetField(deviceauthorization.FieldUserCode, field.TypeString, value)
+		_node.UserCode = value
+	}
+	if value, ok := dac.mutation.Status(); ok {
+		_spec.SetField(deviceauthorization.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dac.mutation.UserAgent(); ok {
+		_spec.SetField(deviceauthorization.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := dac.mutation.IPAddress(); ok {
+		_spec.SetField(deviceauthorization.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := dac.mutation.Interval(); ok {
+		_spec.SetField(deviceauthorization.FieldInterval, field.TypeInt, value)
+		_node.Interval = value
+	}
+	if value, ok := dac.mutation.LastPolledAt(); ok {
+		_spec.SetField(deviceauthorization.FieldLastPolledAt, field.TypeTime, value)
+		_node.LastPolledAt = &value
+	}
+	if value, ok := dac.mutation.ExpiresAt(); ok {
+		_spec.SetField(deviceauthorization.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := dac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_device_authorizations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeviceAuthorizationCreateBulk is the builder for creating many DeviceAuthorization entities in bulk.
+type DeviceAuthorizationCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceAuthorizationCreate
+}
+
+// Save creates the DeviceAuthorization entities in the database.
+func (dacb *DeviceAuthorizationCreateBulk) Save(ctx context.Context) ([]*DeviceAuthorization, error) {
+	if dacb.err != nil {
+		return nil, dacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dacb.builders))
+	nodes := make([]*DeviceAuthorization, len(dacb.builders))
+	mutators := make([]Mutator, len(dacb.builders))
+	for i := range dacb.builders {
+		func(i int, root context.Context) {
+			builder := dacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceAuthorizationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dacb *DeviceAuthorizationCreateBulk) SaveX(ctx context.Context) []*DeviceAuthorization {
+	v, err := dacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dacb *DeviceAuthorizationCreateBulk) Exec(ctx context.Context) error {
+	_, err := dacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dacb *DeviceAuthorizationCreateBulk) ExecX(ctx context.Context) {
+	if err := dacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
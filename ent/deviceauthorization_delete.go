@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/predicate"
+)
+
+// DeviceAuthorizationDelete is the builder for deleting a DeviceAuthorization entity.
+type DeviceAuthorizationDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeviceAuthorizationMutation
+}
+
+// Where appends a list predicates to the DeviceAuthorizationDelete builder.
+func (dad *DeviceAuthorizationDelete) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationDelete {
+	dad.mutation.Where(ps...)
+	return dad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dad *DeviceAuthorizationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dad.sqlExec, dad.mutation, dad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dad *DeviceAuthorizationDelete) ExecX(ctx context.Context) int {
+	n, err := dad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dad *DeviceAuthorizationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deviceauthorization.Table, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt))
+	if ps := dad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dad.mutation.done = true
+	return affected, err
+}
+
+// DeviceAuthorizationDeleteOne is the builder for deleting a single DeviceAuthorization entity.
+type DeviceAuthorizationDeleteOne struct {
+	dad *DeviceAuthorizationDelete
+}
+
+// Where appends a list predicates to the DeviceAuthorizationDelete builder.
+func (dado *DeviceAuthorizationDeleteOne) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationDeleteOne {
+	dado.dad.mutation.Where(ps...)
+	return dado
+}
+
+// Exec executes the deletion query.
+func (dado *DeviceAuthorizationDeleteOne) Exec(ctx context.Context) error {
+	n, err := dado.dad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deviceauthorization.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dado *DeviceAuthorizationDeleteOne) ExecX(ctx context.Context) {
+	if err := dado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
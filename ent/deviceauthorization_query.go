@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// DeviceAuthorizationQuery is the builder for querying DeviceAuthorization entities.
+type DeviceAuthorizationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []deviceauthorization.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DeviceAuthorization
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeviceAuthorizationQuery builder.
+func (daq *DeviceAuthorizationQuery) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationQuery {
+	daq.predicates = append(daq.predicates, ps...)
+	return daq
+}
+
+// Limit the number of records to be returned by this query.
+func (daq *DeviceAuthorizationQuery) Limit(limit int) *DeviceAuthorizationQuery {
+	daq.ctx.Limit = &limit
+	return daq
+}
+
+// Offset to start from.
+func (daq *DeviceAuthorizationQuery) Offset(offset int) *DeviceAuthorizationQuery {
+	daq.ctx.Offset = &offset
+	return daq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (daq *DeviceAuthorizationQuery) Unique(unique bool) *DeviceAuthorizationQuery {
+	daq.ctx.Unique = &unique
+	return daq
+}
+
+// Order specifies how the records should be ordered.
+func (daq *DeviceAuthorizationQuery) Order(o ...deviceauthorization.OrderOption) *DeviceAuthorizationQuery {
+	daq.order = append(daq.order, o...)
+	return daq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (daq *DeviceAuthorizationQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: daq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := daq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := daq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deviceauthorization.Table, deviceauthorization.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deviceauthorization.UserTable, deviceauthorization.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(daq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DeviceAuthorization entity from the query.
+// Returns a *NotFoundError when no DeviceAuthorization was found.
+func (daq *DeviceAuthorizationQuery) First(ctx context.Context) (*DeviceAuthorization, error) {
+	nodes, err := daq.Limit(1).All(setContextOp(ctx, daq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deviceauthorization.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) FirstX(ctx context.Context) *DeviceAuthorization {
+	node, err := daq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeviceAuthorization ID from the query.
+// Returns a *NotFoundError when no DeviceAuthorization ID was found.
+func (daq *DeviceAuthorizationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = daq.Limit(1).IDs(setContextOp(ctx, daq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deviceauthorization.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) FirstIDX(ctx context.Context) int {
+	id, err := daq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeviceAuthorization entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeviceAuthorization entity is found.
+// Returns a *NotFoundError when no DeviceAuthorization entities are found.
+func (daq *DeviceAuthorizationQuery) Only(ctx context.Context) (*DeviceAuthorization, error) {
+	nodes, err := daq.Limit(2).All(setContextOp(ctx, daq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deviceauthorization.Label}
+	default:
+		return nil, &NotSingularError{deviceauthorization.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) OnlyX(ctx context.Context) *DeviceAuthorization {
+	node, err := daq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeviceAuthorization ID in the query.
+// Returns a *NotSingularError when more than one DeviceAuthorization ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (daq *DeviceAuthorizationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = daq.Limit(2).IDs(setContextOp(ctx, daq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deviceauthorization.Label}
+	default:
+		err = &NotSingularError{deviceauthorization.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := daq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeviceAuthorizations.
+func (daq *DeviceAuthorizationQuery) All(ctx context.Context) ([]*DeviceAuthorization, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryAll)
+	if err := daq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DeviceAuthorization, *DeviceAuthorizationQuery]()
+	return withInterceptors[[]*DeviceAuthorization](ctx, daq, qr, daq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) AllX(ctx context.Context) []*DeviceAuthorization {
+	nodes, err := daq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeviceAuthorization IDs.
+func (daq *DeviceAuthorizationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if daq.ctx.Unique == nil && daq.path != nil {
+		daq.Unique(true)
+	}
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryIDs)
+	if err = daq.Select(deviceauthorization.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) IDsX(ctx context.Context) []int {
+	ids, err := daq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (daq *DeviceAuthorizationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryCount)
+	if err := daq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, daq, querierCount[*DeviceAuthorizationQuery](), daq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) CountX(ctx context.Context) int {
+	count, err := daq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (daq *DeviceAuthorizationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryExist)
+	switch _, err := daq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (daq *DeviceAuthorizationQuery) ExistX(ctx context.Context) bool {
+	exist, err := daq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeviceAuthorizationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (daq *DeviceAuthorizationQuery) Clone() *DeviceAuthorizationQuery {
+	if daq == nil {
+		return nil
+	}
+	return &DeviceAuthorizationQuery{
+		config:     daq.config,
+		ctx:        daq.ctx.Clone(),
+		order:      append([]deviceauthorization.OrderOption{}, daq.order...),
+		inters:     append([]Interceptor{}, daq.inters...),
+		predicates: append([]predicate.DeviceAuthorization{}, daq.predicates...),
+		withUser:   daq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       daq.sql.Clone(),
+		path:      daq.path,
+		modifiers: append([]func(*sql.Selector){}, daq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (daq *DeviceAuthorizationQuery) WithUser(opts ...func(*UserQuery)) *DeviceAuthorizationQuery {
+	query := (&UserClient{config: daq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	daq.withUser = query
+	return daq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeviceAuthorization.Query().
+//		GroupBy(deviceauthorization.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (daq *DeviceAuthorizationQuery) GroupBy(field string, fields ...string) *DeviceAuthorizationGroupBy {
+	daq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeviceAuthorizationGroupBy{build: daq}
+	grbuild.flds = &daq.ctx.Fields
+	grbuild.label = deviceauthorization.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.DeviceAuthorization.Query().
+//		Select(deviceauthorization.FieldCreateTime).
+//		Scan(ctx, &v)
+func (daq *DeviceAuthorizationQuery) Select(fields ...string) *DeviceAuthorizationSelect {
+	daq.ctx.Fields = append(daq.ctx.Fields, fields...)
+	sbuild := &DeviceAuthorizationSelect{DeviceAuthorizationQuery: daq}
+	sbuild.label = deviceauthorization.Label
+	sbuild.flds, sbuild.scan = &daq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeviceAuthorizationSelect configured with the given aggregations.
+func (daq *DeviceAuthorizationQuery) Aggregate(fns ...AggregateFunc) *DeviceAuthorizationSelect {
+	return daq.Select().Aggregate(fns...)
+}
+
+func (daq *DeviceAuthorizationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range daq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, daq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range daq.ctx.Fields {
+		if !deviceauthorization.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if daq.path != nil {
+		prev, err := daq.path(ctx)
+		if err != nil {
+			return err
+		}
+		daq.sql = prev
+	}
+	return nil
+}
+
+func (daq *DeviceAuthorizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeviceAuthorization, error) {
+	var (
+		nodes       = []*DeviceAuthorization{}
+		withFKs     = daq.withFKs
+		_spec       = daq.querySpec()
+		loadedTypes = [1]bool{
+			daq.withUser != nil,
+		}
+	)
+	if daq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceauthorization.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DeviceAuthorization).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DeviceAuthorization{config: daq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(daq.modifiers) > 0 {
+		_spec.Modifiers = daq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, daq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := daq.withUser; query != nil {
+		if err := daq.loadUser(ctx, query, nodes, nil,
+			func(n *DeviceAuthorization, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (daq *DeviceAuthorizationQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*DeviceAuthorization, init func(*DeviceAuthorization), assign func(*DeviceAuthorization, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DeviceAuthorization)
+	for i := range nodes {
+		if nodes[i].user_device_authorizations == nil {
+			continue
+		}
+		fk := *nodes[i].user_device_authorizations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_device_authorizations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (daq *DeviceAuthorizationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := daq.querySpec()
+	if len(daq.modifiers) > 0 {
+		_spec.Modifiers = daq.modifiers
+	}
+	_spec.Node.Columns = daq.ctx.Fields
+	if len(daq.ctx.Fields) > 0 {
+		_spec.Unique = daq.ctx.Unique != nil && *daq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, daq.driver, _spec)
+}
+
+func (daq *DeviceAuthorizationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(deviceauthorization.Table, deviceauthorization.Columns, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt))
+	_spec.From = daq.sql
+	if unique := daq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if daq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := daq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceauthorization.FieldID)
+		for i := range fields {
+			if fields[i] != deviceauthorization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := daq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := daq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := daq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := daq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (daq *DeviceAuthorizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(daq.driver.Dialect())
+	t1 := builder.Table(deviceauthorization.Table)
+	columns := daq.ctx.Fields
+	if len(columns) == 0 {
+		columns = deviceauthorization.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if daq.sql != nil {
+		selector = daq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if daq.ctx.Unique != nil && *daq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range daq.modifiers {
+		m(selector)
+	}
+	for _, p := range daq.predicates {
+		p(selector)
+	}
+	for _, p := range daq.order {
+		p(selector)
+	}
+	if offset := daq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := daq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (daq *DeviceAuthorizationQuery) ForUpdate(opts ...sql.LockOption) *DeviceAuthorizationQuery {
+	if daq.driver.Dialect() == dialect.Postgres {
+		daq.Unique(false)
+	}
+	daq.modifiers = append(daq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return daq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (daq *DeviceAuthorizationQuery) ForShare(opts ...sql.LockOption) *DeviceAuthorizationQuery {
+	if daq.driver.Dialect() == dialect.Postgres {
+		daq.Unique(false)
+	}
+	daq.modifiers = append(daq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return daq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (daq *DeviceAuthorizationQuery) Modify(modifiers ...func(s *sql.Selector)) *DeviceAuthorizationSelect {
+	daq.modifiers = append(daq.modifiers, modifiers...)
+	return daq.Select()
+}
+
+// DeviceAuthorizationGroupBy is the group-by builder for DeviceAuthorization entities.
+type DeviceAuthorizationGroupBy struct {
+	selector
+	build *DeviceAuthorizationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dagb *DeviceAuthorizationGroupBy) Aggregate(fns ...AggregateFunc) *DeviceAuthorizationGroupBy {
+	dagb.fns = append(dagb.fns, fns...)
+	return dagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dagb *DeviceAuthorizationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dagb.build.ctx, ent.OpQueryGroupBy)
+	if err := dagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeviceAuthorizationQuery, *DeviceAuthorizationGroupBy](ctx, dagb.build, dagb, dagb.build.inters, v)
+}
+
+func (dagb *DeviceAuthorizationGroupBy) sqlScan(ctx context.Context, root *DeviceAuthorizationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dagb.fns))
+	for _, fn := range dagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dagb.flds)+len(dagb.fns))
+		for _, f := range *dagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeviceAuthorizationSelect is the builder for selecting fields of DeviceAuthorization entities.
+type DeviceAuthorizationSelect struct {
+	*DeviceAuthorizationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (das *DeviceAuthorizationSelect) Aggregate(fns ...AggregateFunc) *DeviceAuthorizationSelect {
+	das.fns = append(das.fns, fns...)
+	return das
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (das *DeviceAuthorizationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, das.ctx, ent.OpQuerySelect)
+	if err := das.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeviceAuthorizationQuery, *DeviceAuthorizationSelect](ctx, das.DeviceAuthorizationQuery, das, das.inters, v)
+}
+
+func (das *DeviceAuthorizationSelect) sqlScan(ctx context.Context, root *DeviceAuthorizationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(das.fns))
+	for _, fn := range das.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*das.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := das.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (das *DeviceAuthorizationSelect) Modify(modifiers ...func(s *sql.Selector)) *DeviceAuthorizationSelect {
+	das.modifiers = append(das.modifiers, modifiers...)
+	return das
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// DeviceAuthorizationUpdate is the builder for updating DeviceAuthorization entities.
+type DeviceAuthorizationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeviceAuthorizationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeviceAuthorizationUpdate builder.
+func (dau *DeviceAuthorizationUpdate) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationUpdate {
+	dau.mutation.Where(ps...)
+	return dau
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dau *DeviceAuthorizationUpdate) SetUpdateTime(t time.Time) *DeviceAuthorizationUpdate {
+	dau.mutation.SetUpdateTime(t)
+	return dau
+}
+
+// SetStatus sets the "status" field.
+func (dau *DeviceAuthorizationUpdate) SetStatus(d deviceauthorization.Status) *DeviceAuthorizationUpdate {
+	dau.mutation.SetStatus(d)
+	return dau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dau *DeviceAuthorizationUpdate) SetNillableStatus(d *deviceauthorization.Status) *DeviceAuthorizationUpdate {
+	if d != nil {
+		dau.SetStatus(*d)
+	}
+	return dau
+}
+
+// SetInterval sets the "interval" field.
+func (dau *DeviceAuthorizationUpdate) SetInterval(i int) *DeviceAuthorizationUpdate {
+	dau.mutation.ResetInterval()
+	dau.mutation.SetInterval(i)
+	return dau
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (dau *DeviceAuthorizationUpdate) SetNillableInterval(i *int) *DeviceAuthorizationUpdate {
+	if i != nil {
+		dau.SetInterval(*i)
+	}
+	return dau
+}
+
+// AddInterval adds i to the "interval" field.
+func (dau *DeviceAuthorizationUpdate) AddInterval(i int) *DeviceAuthorizationUpdate {
+	dau.mutation.AddInterval(i)
+	return dau
+}
+
+// SetLastPolledAt sets the "last_polled_at" field.
+func (dau *DeviceAuthorizationUpdate) SetLastPolledAt(t time.Time) *DeviceAuthorizationUpdate {
+	dau.mutation.SetLastPolledAt(t)
+	return dau
+}
+
+// SetNillableLastPolledAt sets the "last_polled_at" field if the given value is not nil.
+func (dau *DeviceAuthorizationUpdate) SetNillableLastPolledAt(t *time.Time) *DeviceAuthorizationUpdate {
+	if t != nil {
+		dau.SetLastPolledAt(*t)
+	}
+	return dau
+}
+
+// ClearLastPolledAt clears the value of the "last_polled_at" field.
+func (dau *DeviceAuthorizationUpdate) ClearLastPolledAt() *DeviceAuthorizationUpdate {
+	dau.mutation.ClearLastPolledAt()
+	return dau
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dau *DeviceAuthorizationUpdate) SetUserID(id int) *DeviceAuthorizationUpdate {
+	dau.mutation.SetUserID(id)
+	return dau
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (dau *DeviceAuthorizationUpdate) SetNillableUserID(id *int) *DeviceAuthorizationUpdate {
+	if id != nil {
+		dau = dau.SetUserID(*id)
+	}
+	return dau
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dau *DeviceAuthorizationUpdate) SetUser(u *User) *DeviceAuthorizationUpdate {
+	return dau.SetUserID(u.ID)
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (dau *DeviceAuthorizationUpdate) Mutation() *DeviceAuthorizationMutation {
+	return dau.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (dau *DeviceAuthorizationUpdate) ClearUser() *DeviceAuthorizationUpdate {
+	dau.mutation.ClearUser()
+	return dau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dau *DeviceAuthorizationUpdate) Save(ctx context.Context) (int, error) {
+	dau.defaults()
+	return withHooks(ctx, dau.sqlSave, dau.mutation, dau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dau *DeviceAuthorizationUpdate) SaveX(ctx context.Context) int {
+	affected, err := dau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dau *DeviceAuthorizationUpdate) Exec(ctx context.Context) error {
+	_, err := dau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dau *DeviceAuthorizationUpdate) ExecX(ctx context.Context) {
+	if err := dau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dau *DeviceAuthorizationUpdate) defaults() {
+	if _, ok := dau.mutation.UpdateTime(); !ok {
+		v := deviceauthorization.UpdateDefaultUpdateTime()
+		dau.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dau *DeviceAuthorizationUpdate) check() error {
+	if v, ok := dau.mutation.Status(); ok {
+		if err := deviceauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.status": %w`, err)}
+		}
+	}
+	if v, ok := dau.mutation.Interval(); ok {
+		if err := deviceauthorization.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dau *DeviceAuthorizationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceAuthorizationUpdate {
+	dau.modifiers = append(dau.modifiers, modifiers...)
+	return dau
+}
+
+func (dau *DeviceAuthorizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceauthorization.Table, deviceauthorization.Columns, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt))
+	if ps := dau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dau.mutation.UpdateTime(); ok {
+		_spec.SetField(deviceauthorization.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := dau.mutation.Status(); ok {
+		_spec.SetField(deviceauthorization.FieldStatus, field.TypeEnum, value)
+	}
+	if dau.mutation.UserAgentCleared() {
+		_spec.ClearField(deviceauthorization.FieldUserAgent, field.TypeString)
+	}
+	if dau.mutation.IPAddressCleared() {
+		_spec.ClearField(deviceauthorization.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := dau.mutation.Interval(); ok {
+		_spec.SetField(deviceauthorization.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := dau.mutation.AddedInterval(); ok {
+		_spec.AddField(deviceauthorization.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := dau.mutation.LastPolledAt(); ok {
+		_spec.SetField(deviceauthorization.FieldLastPolledAt, field.TypeTime, value)
+	}
+	if dau.mutation.LastPolledAtCleared() {
+		_spec.ClearField(deviceauthorization.FieldLastPolledAt, field.TypeTime)
+	}
+	if dau.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dau.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(dau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dau.mutation.done = true
+	return n, nil
+}
+
+// DeviceAuthorizationUpdateOne is the builder for updating a single DeviceAuthorization entity.
+type DeviceAuthorizationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeviceAuthorizationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dauo *DeviceAuthorizationUpdateOne) SetUpdateTime(t time.Time) *DeviceAuthorizationUpdateOne {
+	dauo.mutation.SetUpdateTime(t)
+	return dauo
+}
+
+// SetStatus sets the "status" field.
+func (dauo *DeviceAuthorizationUpdateOne) SetStatus(d deviceauthorization.Status) *DeviceAuthorizationUpdateOne {
+	dauo.mutation.SetStatus(d)
+	return dauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dauo *DeviceAuthorizationUpdateOne) SetNillableStatus(d *deviceauthorization.Status) *DeviceAuthorizationUpdateOne {
+	if d != nil {
+		dauo.SetStatus(*d)
+	}
+	return dauo
+}
+
+// SetInterval sets the "interval" field.
+func (dauo *DeviceAuthorizationUpdateOne) SetInterval(i int) *DeviceAuthorizationUpdateOne {
+	dauo.mutation.ResetInterval()
+	dauo.mutation.SetInterval(i)
+	return dauo
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (dauo *DeviceAuthorizationUpdateOne) SetNillableInterval(i *int) *DeviceAuthorizationUpdateOne {
+	if i != nil {
+		dauo.SetInterval(*i)
+	}
+	return dauo
+}
+
+// AddInterval adds i to the "interval" field.
+func (dauo *DeviceAuthorizationUpdateOne) AddInterval(i int) *DeviceAuthorizationUpdateOne {
+	dauo.mutation.AddInterval(i)
+	return dauo
+}
+
+// SetLastPolledAt sets the "last_polled_at" field.
+func (dauo *DeviceAuthorizationUpdateOne) SetLastPolledAt(t time.Time) *DeviceAuthorizationUpdateOne {
+	dauo.mutation.SetLastPolledAt(t)
+	return dauo
+}
+
+// SetNillableLastPolledAt sets the "last_polled_at" field if the given value is not nil.
+func (dauo *DeviceAuthorizationUpdateOne) SetNillableLastPolledAt(t *time.Time) *DeviceAuthorizationUpdateOne {
+	if t != nil {
+		dauo.SetLastPolledAt(*t)
+	}
+	return dauo
+}
+
+// ClearLastPolledAt clears the value of the "last_polled_at" field.
+func (dauo *DeviceAuthorizationUpdateOne) ClearLastPolledAt() *DeviceAuthorizationUpdateOne {
+	dauo.mutation.ClearLastPolledAt()
+	return dauo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dauo *DeviceAuthorizationUpdateOne) SetUserID(id int) *DeviceAuthorizationUpdateOne {
+	dauo.mutation.SetUserID(id)
+	return dauo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (dauo *DeviceAuthorizationUpdateOne) SetNillableUserID(id *int) *DeviceAuthorizationUpdateOne {
+	if id != nil {
+		dauo = dauo.SetUserID(*id)
+	}
+	return dauo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dauo *DeviceAuthorizationUpdateOne) SetUser(u *User) *DeviceAuthorizationUpdateOne {
+	return dauo.SetUserID(u.ID)
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (dauo *DeviceAuthorizationUpdateOne) Mutation() *DeviceAuthorizationMutation {
+	return dauo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (dauo *DeviceAuthorizationUpdateOne) ClearUser() *DeviceAuthorizationUpdateOne {
+	dauo.mutation.ClearUser()
+	return dauo
+}
+
+// Where appends a list predicates to the DeviceAuthorizationUpdate builder.
+func (dauo *DeviceAuthorizationUpdateOne) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationUpdateOne {
+	dauo.mutation.Where(ps...)
+	return dauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dauo *DeviceAuthorizationUpdateOne) Select(field string, fields ...string) *DeviceAuthorizationUpdateOne {
+	dauo.fields = append([]string{field}, fields...)
+	return dauo
+}
+
+// Save executes the query and returns the updated DeviceAuthorization entity.
+func (dauo *DeviceAuthorizationUpdateOne) Save(ctx context.Context) (*DeviceAuthorization, error) {
+	dauo.defaults()
+	return withHooks(ctx, dauo.sqlSave, dauo.mutation, dauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dauo *DeviceAuthorizationUpdateOne) SaveX(ctx context.Context) *DeviceAuthorization {
+	node, err := dauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dauo *DeviceAuthorizationUpdateOne) Exec(ctx context.Context) error {
+	_, err := dauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dauo *DeviceAuthorizationUpdateOne) ExecX(ctx context.Context) {
+	if err := dauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dauo *DeviceAuthorizationUpdateOne) defaults() {
+	if _, ok := dauo.mutation.UpdateTime(); !ok {
+		v := deviceauthorization.UpdateDefaultUpdateTime()
+		dauo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dauo *DeviceAuthorizationUpdateOne) check() error {
+	if v, ok := dauo.mutation.Status(); ok {
+		if err := deviceauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.status": %w`, err)}
+		}
+	}
+	if v, ok := dauo.mutation.Interval(); ok {
+		if err := deviceauthorization.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dauo *DeviceAuthorizationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceAuthorizationUpdateOne {
+	dauo.modifiers = append(dauo.modifiers, modifiers...)
+	return dauo
+}
+
+func (dauo *DeviceAuthorizationUpdateOne) sqlSave(ctx context.Context) (_node *DeviceAuthorization, err error) {
+	if err := dauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceauthorization.Table, deviceauthorization.Columns, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt))
+	id, ok := dauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceAuthorization.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceauthorization.FieldID)
+		for _, f := range fields {
+			if !deviceauthorization.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deviceauthorization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dauo.mutation.UpdateTime(); ok {
+		_spec.SetField(deviceauthorization.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := dauo.mutation.Status(); ok {
+		_spec.SetField(deviceauthorization.FieldStatus, field.TypeEnum, value)
+	}
+	if dauo.mutation.UserAgentCleared() {
+		_spec.ClearField(deviceauthorization.FieldUserAgent, field.TypeString)
+	}
+	if dauo.mutation.IPAddressCleared() {
+		_spec.ClearField(deviceauthorization.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := dauo.mutation.Interval(); ok {
+		_spec.SetField(deviceauthorization.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := dauo.mutation.AddedInterval(); ok {
+		_spec.AddField(deviceauthorization.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := dauo.mutation.LastPolledAt(); ok {
+		_spec.SetField(deviceauthorization.FieldLastPolledAt, field.TypeTime, value)
+	}
+	if dauo.mutation.LastPolledAtCleared() {
+		_spec.ClearField(deviceauthorization.FieldLastPolledAt, field.TypeTime)
+	}
+	if dauo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dauo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(dauo.modifiers...)
+	_node = &DeviceAuthorization{config: dauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/user"
+)
+
+// EmailVerification is the model entity for the EmailVerification schema.
+type EmailVerification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// SHA-256 of the code typed in the app
+	CodeHash string `json:"-"`
+	// SHA-256 of the token in the emailed link
+	TokenHash string `json:"-"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailVerificationQuery when eager-loading is set.
+	Edges                    EmailVerificationEdges `json:"edges"`
+	user_email_verifications *int
+	selectValues             sql.SelectValues
+}
+
+// EmailVerificationEdges holds the relations/edges for other nodes in the graph.
+type EmailVerificationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailVerificationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailVerification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID, emailverification.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case emailverification.FieldEmail, emailverification.FieldCodeHash, emailverification.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case emailverification.FieldCreateTime, emailverification.FieldUpdateTime, emailverification.FieldExpiresAt, emailverification.FieldVerifiedAt:
+			values[i] = new(sql.NullTime)
+		case emailverification.ForeignKeys[0]: // user_email_verifications
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailVerification fields.
+func (ev *EmailVerification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ev.ID = int(value.Int64)
+		case emailverification.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ev.CreateTime = value.Time
+			}
+		case emailverification.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ev.UpdateTime = value.Time
+			}
+		case emailverification.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ev.Email = value.String
+			}
+		case emailverification.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				ev.CodeHash = value.String
+			}
+		case emailverification.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				ev.TokenHash = value.String
+			}
+		case emailverification.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				ev.Attempts = int(value.Int64)
+			}
+		case emailverification.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ev.ExpiresAt = value.Time
+			}
+		case emailverification.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				ev.VerifiedAt = new(time.Time)
+				*ev.VerifiedAt = value.Time
+			}
+		case emailverification.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_email_verifications", value)
+			} else if value.Valid {
+				ev.user_email_verifications = new(int)
+				*ev.user_email_verifications = int(value.Int64)
+			}
+		default:
+			ev.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailVerification.
+// This includes values selected through modifiers, order, etc.
+func (ev *EmailVerification) Value(name string) (ent.Value, error) {
+	return ev.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EmailVerification entity.
+func (ev *EmailVerification) QueryUser() *UserQuery {
+	return NewEmailVerificationClient(ev.config).QueryUser(ev)
+}
+
+// Update returns a builder for updating this EmailVerification.
+// Note that you need to call EmailVerification.Unwrap() before calling this method if this EmailVerification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ev *EmailVerification) Update() *EmailVerificationUpdateOne {
+	return NewEmailVerificationClient(ev.config).UpdateOne(ev)
+}
+
+// Unwrap unwraps the EmailVerification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ev *EmailVerification) Unwrap() *EmailVerification {
+	_tx, ok := ev.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailVerification is not a transactional entity")
+	}
+	ev.config.driver = _tx.drv
+	return ev
+}
+
+// String implements the fmt.Stringer.
+func (ev *EmailVerification) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailVerification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ev.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ev.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ev.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(ev.Email)
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ev.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ev.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ev.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailVerifications is a parsable slice of EmailVerification.
+type EmailVerifications []*EmailVerification
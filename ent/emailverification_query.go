@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// EmailVerificationQuery is the builder for querying EmailVerification entities.
+type EmailVerificationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []emailverification.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EmailVerification
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmailVerificationQuery builder.
+func (evq *EmailVerificationQuery) Where(ps ...predicate.EmailVerification) *EmailVerificationQuery {
+	evq.predicates = append(evq.predicates, ps...)
+	return evq
+}
+
+// Limit the number of records to be returned by this query.
+func (evq *EmailVerificationQuery) Limit(limit int) *EmailVerificationQuery {
+	evq.ctx.Limit = &limit
+	return evq
+}
+
+// Offset to start from.
+func (evq *EmailVerificationQuery) Offset(offset int) *EmailVerificationQuery {
+	evq.ctx.Offset = &offset
+	return evq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (evq *EmailVerificationQuery) Unique(unique bool) *EmailVerificationQuery {
+	evq.ctx.Unique = &unique
+	return evq
+}
+
+// Order specifies how the records should be ordered.
+func (evq *EmailVerificationQuery) Order(o ...emailverification.OrderOption) *EmailVerificationQuery {
+	evq.order = append(evq.order, o...)
+	return evq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (evq *EmailVerificationQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: evq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := evq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := evq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailverification.Table, emailverification.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailverification.UserTable, emailverification.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(evq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EmailVerification entity from the query.
+// Returns a *NotFoundError when no EmailVerification was found.
+func (evq *EmailVerificationQuery) First(ctx context.Context) (*EmailVerification, error) {
+	nodes, err := evq.Limit(1).All(setContextOp(ctx, evq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{emailverification.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (evq *EmailVerificationQuery) FirstX(ctx context.Context) *EmailVerification {
+	node, err := evq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmailVerification ID from the query.
+// Returns a *NotFoundError when no EmailVerification ID was found.
+func (evq *EmailVerificationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = evq.Limit(1).IDs(setContextOp(ctx, evq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{emailverification.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (evq *EmailVerificationQuery) FirstIDX(ctx context.Context) int {
+	id, err := evq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmailVerification entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmailVerification entity is found.
+// Returns a *NotFoundError when no EmailVerification entities are found.
+func (evq *EmailVerificationQuery) Only(ctx context.Context) (*EmailVerification, error) {
+	nodes, err := evq.Limit(2).All(setContextOp(ctx, evq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{emailverification.Label}
+	default:
+		return nil, &NotSingularError{emailverification.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (evq *EmailVerificationQuery) OnlyX(ctx context.Context) *EmailVerification {
+	node, err := evq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmailVerification ID in the query.
+// Returns a *NotSingularError when more than one EmailVerification ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (evq *EmailVerificationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = evq.Limit(2).IDs(setContextOp(ctx, evq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = &NotSingularError{emailverification.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (evq *EmailVerificationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := evq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmailVerifications.
+func (evq *EmailVerificationQuery) All(ctx context.Context) ([]*EmailVerification, error) {
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryAll)
+	if err := evq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmailVerification, *EmailVerificationQuery]()
+	return withInterceptors[[]*EmailVerification](ctx, evq, qr, evq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (evq *EmailVerificationQuery) AllX(ctx context.Context) []*EmailVerification {
+	nodes, err := evq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmailVerification IDs.
+func (evq *EmailVerificationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if evq.ctx.Unique == nil && evq.path != nil {
+		evq.Unique(true)
+	}
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryIDs)
+	if err = evq.Select(emailverification.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (evq *EmailVerificationQuery) IDsX(ctx context.Context) []int {
+	ids, err := evq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (evq *EmailVerificationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryCount)
+	if err := evq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, evq, querierCount[*EmailVerificationQuery](), evq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (evq *EmailVerificationQuery) CountX(ctx context.Context) int {
+	count, err := evq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (evq *EmailVerificationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, evq.ctx, ent.OpQueryExist)
+	switch _, err := evq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (evq *EmailVerificationQuery) ExistX(ctx context.Context) bool {
+	exist, err := evq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmailVerificationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (evq *EmailVerificationQuery) Clone() *EmailVerificationQuery {
+	if evq == nil {
+		return nil
+	}
+	return &EmailVerificationQuery{
+		config:     evq.config,
+		ctx:        evq.ctx.Clone(),
+		order:      append([]emailverification.OrderOption{}, evq.order...),
+		inters:     append([]Interceptor{}, evq.inters...),
+		predicates: append([]predicate.EmailVerification{}, evq.predicates...),
+		withUser:   evq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       evq.sql.Clone(),
+		path:      evq.path,
+		modifiers: append([]func(*sql.Selector){}, evq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (evq *EmailVerificationQuery) WithUser(opts ...func(*UserQuery)) *EmailVerificationQuery {
+	query := (&UserClient{config: evq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	evq.withUser = query
+	return evq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmailVerification.Query().
+//		GroupBy(emailverification.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (evq *EmailVerificationQuery) GroupBy(field string, fields ...string) *EmailVerificationGroupBy {
+	evq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmailVerificationGroupBy{build: evq}
+	grbuild.flds = &evq.ctx.Fields
+	grbuild.label = emailverification.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.EmailVerification.Query().
+//		Select(emailverification.FieldCreateTime).
+//		Scan(ctx, &v)
+func (evq *EmailVerificationQuery) Select(fields ...string) *EmailVerificationSelect {
+	evq.ctx.Fields = append(evq.ctx.Fields, fields...)
+	sbuild := &EmailVerificationSelect{EmailVerificationQuery: evq}
+	sbuild.label = emailverification.Label
+	sbuild.flds, sbuild.scan = &evq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmailVerificationSelect configured with the given aggregations.
+func (evq *EmailVerificationQuery) Aggregate(fns ...AggregateFunc) *EmailVerificationSelect {
+	return evq.Select().Aggregate(fns...)
+}
+
+func (evq *EmailVerificationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range evq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, evq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range evq.ctx.Fields {
+		if !emailverification.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if evq.path != nil {
+		prev, err := evq.path(ctx)
+		if err != nil {
+			return err
+		}
+		evq.sql = prev
+	}
+	return nil
+}
+
+func (evq *EmailVerificationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmailVerification, error) {
+	var (
+		nodes       = []*EmailVerification{}
+		withFKs     = evq.withFKs
+		_spec       = evq.querySpec()
+		loadedTypes = [1]bool{
+			evq.withUser != nil,
+		}
+	)
+	if evq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmailVerification).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmailVerification{config: evq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(evq.modifiers) > 0 {
+		_spec.Modifiers = evq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, evq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := evq.withUser; query != nil {
+		if err := evq.loadUser(ctx, query, nodes, nil,
+			func(n *EmailVerification, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (evq *EmailVerificationQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*EmailVerification, init func(*EmailVerification), assign func(*EmailVerification, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EmailVerification)
+	for i := range nodes {
+		if nodes[i].user_email_verifications == nil {
+			continue
+		}
+		fk := *nodes[i].user_email_verifications
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_email_verifications" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (evq *EmailVerificationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := evq.querySpec()
+	if len(evq.modifiers) > 0 {
+		_spec.Modifiers = evq.modifiers
+	}
+	_spec.Node.Columns = evq.ctx.Fields
+	if len(evq.ctx.Fields) > 0 {
+		_spec.Unique = evq.ctx.Unique != nil && *evq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, evq.driver, _spec)
+}
+
+func (evq *EmailVerificationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	_spec.From = evq.sql
+	if unique := evq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if evq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := evq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.FieldID)
+		for i := range fields {
+			if fields[i] != emailverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := evq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := evq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := evq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := evq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (evq *EmailVerificationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(evq.driver.Dialect())
+	t1 := builder.Table(emailverification.Table)
+	columns := evq.ctx.Fields
+	if len(columns) == 0 {
+		columns = emailverification.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if evq.sql != nil {
+		selector = evq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if evq.ctx.Unique != nil && *evq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range evq.modifiers {
+		m(selector)
+	}
+	for _, p := range evq.predicates {
+		p(selector)
+	}
+	for _, p := range evq.order {
+		p(selector)
+	}
+	if offset := evq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := evq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (evq *EmailVerificationQuery) ForUpdate(opts ...sql.LockOption) *EmailVerificationQuery {
+	if evq.driver.Dialect() == dialect.Postgres {
+		evq.Unique(false)
+	}
+	evq.modifiers = append(evq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return evq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (evq *EmailVerificationQuery) ForShare(opts ...sql.LockOption) *EmailVerificationQuery {
+	if evq.driver.Dialect() == dialect.Postgres {
+		evq.Unique(false)
+	}
+	evq.modifiers = append(evq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return evq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (evq *EmailVerificationQuery) Modify(modifiers ...func(s *sql.Selector)) *EmailVerificationSelect {
+	evq.modifiers = append(evq.modifiers, modifiers...)
+	return evq.Select()
+}
+
+// EmailVerificationGroupBy is the group-by builder for EmailVerification entities.
+type EmailVerificationGroupBy struct {
+	selector
+	build *EmailVerificationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (evgb *EmailVerificationGroupBy) Aggregate(fns ...AggregateFunc) *EmailVerificationGroupBy {
+	evgb.fns = append(evgb.fns, fns...)
+	return evgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (evgb *EmailVerificationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, evgb.build.ctx, ent.OpQueryGroupBy)
+	if err := evgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailVerificationQuery, *EmailVerificationGroupBy](ctx, evgb.build, evgb, evgb.build.inters, v)
+}
+
+func (evgb *EmailVerificationGroupBy) sqlScan(ctx context.Context, root *EmailVerificationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(evgb.fns))
+	for _, fn := range evgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*evgb.flds)+len(evgb.fns))
+		for _, f := range *evgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*evgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := evgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmailVerificationSelect is the builder for selecting fields of EmailVerification entities.
+type EmailVerificationSelect struct {
+	*EmailVerificationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (evs *EmailVerificationSelect) Aggregate(fns ...AggregateFunc) *EmailVerificationSelect {
+	evs.fns = append(evs.fns, fns...)
+	return evs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (evs *EmailVerificationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, evs.ctx, ent.OpQuerySelect)
+	if err := evs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailVerificationQuery, *EmailVerificationSelect](ctx, evs.EmailVerificationQuery, evs, evs.inters, v)
+}
+
+func (evs *EmailVerificationSelect) sqlScan(ctx context.Context, root *EmailVerificationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(evs.fns))
+	for _, fn := range evs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*evs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := evs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (evs *EmailVerificationSelect) Modify(modifiers ...func(s *sql.Selector)) *EmailVerificationSelect {
+	evs.modifiers = append(evs.modifiers, modifiers...)
+	return evs
+}
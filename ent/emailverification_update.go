@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// EmailVerificationUpdate is the builder for updating EmailVerification entities.
+type EmailVerificationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EmailVerificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (evu *EmailVerificationUpdate) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdate {
+	evu.mutation.Where(ps...)
+	return evu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (evu *EmailVerificationUpdate) SetUpdateTime(t time.Time) *EmailVerificationUpdate {
+	evu.mutation.SetUpdateTime(t)
+	return evu
+}
+
+// SetAttempts sets the "attempts" field.
+func (evu *EmailVerificationUpdate) SetAttempts(i int) *EmailVerificationUpdate {
+	evu.mutation.ResetAttempts()
+	evu.mutation.SetAttempts(i)
+	return evu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableAttempts(i *int) *EmailVerificationUpdate {
+	if i != nil {
+		evu.SetAttempts(*i)
+	}
+	return evu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (evu *EmailVerificationUpdate) AddAttempts(i int) *EmailVerificationUpdate {
+	evu.mutation.AddAttempts(i)
+	return evu
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (evu *EmailVerificationUpdate) SetVerifiedAt(t time.Time) *EmailVerificationUpdate {
+	evu.mutation.SetVerifiedAt(t)
+	return evu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableVerifiedAt(t *time.Time) *EmailVerificationUpdate {
+	if t != nil {
+		evu.SetVerifiedAt(*t)
+	}
+	return evu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (evu *EmailVerificationUpdate) ClearVerifiedAt() *EmailVerificationUpdate {
+	evu.mutation.ClearVerifiedAt()
+	return evu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (evu *EmailVerificationUpdate) SetUserID(id int) *EmailVerificationUpdate {
+	evu.mutation.SetUserID(id)
+	return evu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (evu *EmailVerificationUpdate) SetUser(u *User) *EmailVerificationUpdate {
+	return evu.SetUserID(u.ID)
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evu *EmailVerificationUpdate) Mutation() *EmailVerificationMutation {
+	return evu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (evu *EmailVerificationUpdate) ClearUser() *EmailVerificationUpdate {
+	evu.mutation.ClearUser()
+	return evu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (evu *EmailVerificationUpdate) Save(ctx context.Context) (int, error) {
+	evu.defaults()
+	return withHooks(ctx, evu.sqlSave, evu.mutation, evu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := evu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (evu *EmailVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := evu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) ExecX(ctx context.Context) {
+	if err := evu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evu *EmailVerificationUpdate) defaults() {
+	if _, ok := evu.mutation.UpdateTime(); !ok {
+		v := emailverification.UpdateDefaultUpdateTime()
+		evu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evu *EmailVerificationUpdate) check() error {
+	if v, ok := evu.mutation.Attempts(); ok {
+		if err := emailverification.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.attempts": %w`, err)}
+		}
+	}
+	if evu.mutation.UserCleared() && len(evu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailVerification.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (evu *EmailVerificationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailVerificationUpdate {
+	evu.modifiers = append(evu.modifiers, modifiers...)
+	return evu
+}
+
+func (evu *EmailVerificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := evu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	if ps := evu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evu.mutation.UpdateTime(); ok {
+		_spec.SetField(emailverification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := evu.mutation.Attempts(); ok {
+		_spec.SetField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := evu.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := evu.mutation.VerifiedAt(); ok {
+		_spec.SetField(emailverification.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if evu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(emailverification.FieldVerifiedAt, field.TypeTime)
+	}
+	if evu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverification.UserTable,
+			Columns: []string{emailverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := evu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverification.UserTable,
+			Columns: []string{emailverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(evu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, evu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	evu.mutation.done = true
+	return n, nil
+}
+
+// EmailVerificationUpdateOne is the builder for updating a single EmailVerification entity.
+type EmailVerificationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailVerificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (evuo *EmailVerificationUpdateOne) SetUpdateTime(t time.Time) *EmailVerificationUpdateOne {
+	evuo.mutation.SetUpdateTime(t)
+	return evuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (evuo *EmailVerificationUpdateOne) SetAttempts(i int) *EmailVerificationUpdateOne {
+	evuo.mutation.ResetAttempts()
+	evuo.mutation.SetAttempts(i)
+	return evuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableAttempts(i *int) *EmailVerificationUpdateOne {
+	if i != nil {
+		evuo.SetAttempts(*i)
+	}
+	return evuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (evuo *EmailVerificationUpdateOne) AddAttempts(i int) *EmailVerificationUpdateOne {
+	evuo.mutation.AddAttempts(i)
+	return evuo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (evuo *EmailVerificationUpdateOne) SetVerifiedAt(t time.Time) *EmailVerificationUpdateOne {
+	evuo.mutation.SetVerifiedAt(t)
+	return evuo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableVerifiedAt(t *time.Time) *EmailVerificationUpdateOne {
+	if t != nil {
+		evuo.SetVerifiedAt(*t)
+	}
+	return evuo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (evuo *EmailVerificationUpdateOne) ClearVerifiedAt() *EmailVerificationUpdateOne {
+	evuo.mutation.ClearVerifiedAt()
+	return evuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (evuo *EmailVerificationUpdateOne) SetUserID(id int) *EmailVerificationUpdateOne {
+	evuo.mutation.SetUserID(id)
+	return evuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (evuo *EmailVerificationUpdateOne) SetUser(u *User) *EmailVerificationUpdateOne {
+	return evuo.SetUserID(u.ID)
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evuo *EmailVerificationUpdateOne) Mutation() *EmailVerificationMutation {
+	return evuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (evuo *EmailVerificationUpdateOne) ClearUser() *EmailVerificationUpdateOne {
+	evuo.mutation.ClearUser()
+	return evuo
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (evuo *EmailVerificationUpdateOne) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdateOne {
+	evuo.mutation.Where(ps...)
+	return evuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (evuo *EmailVerificationUpdateOne) Select(field string, fields ...string) *EmailVerificationUpdateOne {
+	evuo.fields = append([]string{field}, fields...)
+	return evuo
+}
+
+// Save executes the query and returns the updated EmailVerification entity.
+func (evuo *EmailVerificationUpdateOne) Save(ctx context.Context) (*EmailVerification, error) {
+	evuo.defaults()
+	return withHooks(ctx, evuo.sqlSave, evuo.mutation, evuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) SaveX(ctx context.Context) *EmailVerification {
+	node, err := evuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (evuo *EmailVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := evuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := evuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evuo *EmailVerificationUpdateOne) defaults() {
+	if _, ok := evuo.mutation.UpdateTime(); !ok {
+		v := emailverification.UpdateDefaultUpdateTime()
+		evuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evuo *EmailVerificationUpdateOne) check() error {
+	if v, ok := evuo.mutation.Attempts(); ok {
+		if err := emailverification.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.attempts": %w`, err)}
+		}
+	}
+	if evuo.mutation.UserCleared() && len(evuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailVerification.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (evuo *EmailVerificationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailVerificationUpdateOne {
+	evuo.modifiers = append(evuo.modifiers, modifiers...)
+	return evuo
+}
+
+func (evuo *EmailVerificationUpdateOne) sqlSave(ctx context.Context) (_node *EmailVerification, err error) {
+	if err := evuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	id, ok := evuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := evuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.FieldID)
+		for _, f := range fields {
+			if !emailverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := evuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evuo.mutation.UpdateTime(); ok {
+		_spec.SetField(emailverification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := evuo.mutation.Attempts(); ok {
+		_spec.SetField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := evuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := evuo.mutation.VerifiedAt(); ok {
+		_spec.SetField(emailverification.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if evuo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(emailverification.FieldVerifiedAt, field.TypeTime)
+	}
+	if evuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverification.UserTable,
+			Columns: []string{emailverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := evuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverification.UserTable,
+			Columns: []string{emailverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(evuo.modifiers...)
+	_node = &EmailVerification{config: evuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, evuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	evuo.mutation.done = true
+	return _node, nil
+}
@@ -12,9 +12,25 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/auditevent"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/impersonation"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
 	"github.com/shinplay/ent/otp"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/role"
 	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +91,25 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			otp.Table:     otp.ValidColumn,
-			session.Table: session.ValidColumn,
-			user.Table:    user.ValidColumn,
+			auditevent.Table:             auditevent.ValidColumn,
+			block.Table:                  block.ValidColumn,
+			dataexport.Table:             dataexport.ValidColumn,
+			deviceauthorization.Table:    deviceauthorization.ValidColumn,
+			emailverification.Table:      emailverification.ValidColumn,
+			follow.Table:                 follow.ValidColumn,
+			impersonation.Table:          impersonation.ValidColumn,
+			mute.Table:                   mute.ValidColumn,
+			oauthauthorizationcode.Table: oauthauthorizationcode.ValidColumn,
+			oauthclient.Table:            oauthclient.ValidColumn,
+			oauthconsent.Table:           oauthconsent.ValidColumn,
+			otp.Table:                    otp.ValidColumn,
+			personalaccesstoken.Table:    personalaccesstoken.ValidColumn,
+			phonechangerequest.Table:     phonechangerequest.ValidColumn,
+			qrloginrequest.Table:         qrloginrequest.ValidColumn,
+			role.Table:                   role.ValidColumn,
+			session.Table:                session.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			usernamehistory.Table:        usernamehistory.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/user"
+)
+
+// Follow is the model entity for the Follow schema.
+type Follow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// FollowerID holds the value of the "follower_id" field.
+	FollowerID int `json:"follower_id,omitempty"`
+	// FolloweeID holds the value of the "followee_id" field.
+	FolloweeID int `json:"followee_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status follow.Status `json:"status,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FollowQuery when eager-loading is set.
+	Edges        FollowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FollowEdges holds the relations/edges for other nodes in the graph.
+type FollowEdges struct {
+	// Follower holds the value of the follower edge.
+	Follower *User `json:"follower,omitempty"`
+	// Followee holds the value of the followee edge.
+	Followee *User `json:"followee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FollowerOrErr returns the Follower value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FollowerOrErr() (*User, error) {
+	if e.Follower != nil {
+		return e.Follower, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "follower"}
+}
+
+// FolloweeOrErr returns the Followee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FolloweeOrErr() (*User, error) {
+	if e.Followee != nil {
+		return e.Followee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "followee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Follow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case follow.FieldID, follow.FieldFollowerID, follow.FieldFolloweeID:
+			values[i] = new(sql.NullInt64)
+		case follow.FieldStatus:
+			values[i] = new(sql.NullString)
+		case follow.FieldCreateTime, follow.FieldAcceptedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Follow fields.
+func (f *Follow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case follow.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			f.ID = int(value.Int64)
+		case follow.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				f.CreateTime = value.Time
+			}
+		case follow.FieldFollowerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field follower_id", values[i])
+			} else if value.Valid {
+				f.FollowerID = int(value.Int64)
+			}
+		case follow.FieldFolloweeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field followee_id", values[i])
+			} else if value.Valid {
+				f.FolloweeID = int(value.Int64)
+			}
+		case follow.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				f.Status = follow.Status(value.String)
+			}
+		case follow.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				f.AcceptedAt = new(time.Time)
+				*f.AcceptedAt = value.Time
+			}
+		default:
+			f.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Follow.
+// This includes values selected through modifiers, order, etc.
+func (f *Follow) Value(name string) (ent.Value, error) {
+	return f.selectValues.Get(name)
+}
+
+// QueryFollower queries the "follower" edge of the Follow entity.
+func (f *Follow) QueryFollower() *UserQuery {
+	return NewFollowClient(f.config).QueryFollower(f)
+}
+
+// QueryFollowee queries the "followee" edge of the Follow entity.
+func (f *Follow) QueryFollowee() *UserQuery {
+	return NewFollowClient(f.config).QueryFollowee(f)
+}
+
+// Update returns a builder for updating this Follow.
+// Note that you need to call Follow.Unwrap() before calling this method if this Follow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Follow) Update() *FollowUpdateOne {
+	return NewFollowClient(f.config).UpdateOne(f)
+}
+
+// Unwrap unwraps the Follow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *Follow) Unwrap() *Follow {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Follow is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Follow) String() string {
+	var builder strings.Builder
+	builder.WriteString("Follow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", f.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(f.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("follower_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.FollowerID))
+	builder.WriteString(", ")
+	builder.WriteString("followee_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.FolloweeID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", f.Status))
+	builder.WriteString(", ")
+	if v := f.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Follows is a parsable slice of Follow.
+type Follows []*Follow
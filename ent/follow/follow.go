@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package follow
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the follow type in the database.
+	Label = "follow"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldFollowerID holds the string denoting the follower_id field in the database.
+	FieldFollowerID = "follower_id"
+	// FieldFolloweeID holds the string denoting the followee_id field in the database.
+	FieldFolloweeID = "followee_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAcceptedAt holds the string denoting the accepted_at field in the database.
+	FieldAcceptedAt = "accepted_at"
+	// EdgeFollower holds the string denoting the follower edge name in mutations.
+	EdgeFollower = "follower"
+	// EdgeFollowee holds the string denoting the followee edge name in mutations.
+	EdgeFollowee = "followee"
+	// Table holds the table name of the follow in the database.
+	Table = "follows"
+	// FollowerTable is the table that holds the follower relation/edge.
+	FollowerTable = "follows"
+	// FollowerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FollowerInverseTable = "users"
+	// FollowerColumn is the table column denoting the follower relation/edge.
+	FollowerColumn = "follower_id"
+	// FolloweeTable is the table that holds the followee relation/edge.
+	FolloweeTable = "follows"
+	// FolloweeInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FolloweeInverseTable = "users"
+	// FolloweeColumn is the table column denoting the followee relation/edge.
+	FolloweeColumn = "followee_id"
+)
+
+// Columns holds all SQL columns for follow fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldFollowerID,
+	FieldFolloweeID,
+	FieldStatus,
+	FieldAcceptedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusAccepted:
+		return nil
+	default:
+		return fmt.Errorf("follow: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Follow queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByFollowerID orders the results by the follower_id field.
+func ByFollowerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowerID, opts...).ToFunc()
+}
+
+// ByFolloweeID orders the results by the followee_id field.
+func ByFolloweeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFolloweeID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAcceptedAt orders the results by the accepted_at field.
+func ByAcceptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedAt, opts...).ToFunc()
+}
+
+// ByFollowerField orders the results by follower field.
+func ByFollowerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFolloweeField orders the results by followee field.
+func ByFolloweeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFolloweeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFollowerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FollowerTable, FollowerColumn),
+	)
+}
+func newFolloweeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FolloweeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FolloweeTable, FolloweeColumn),
+	)
+}
@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package follow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Follow {
+	return predicate.Follow(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// FollowerID applies equality check predicate on the "follower_id" field. It's identical to FollowerIDEQ.
+func FollowerID(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowerID, v))
+}
+
+// FolloweeID applies equality check predicate on the "followee_id" field. It's identical to FolloweeIDEQ.
+func FolloweeID(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFolloweeID, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// FollowerIDEQ applies the EQ predicate on the "follower_id" field.
+func FollowerIDEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFollowerID, v))
+}
+
+// FollowerIDNEQ applies the NEQ predicate on the "follower_id" field.
+func FollowerIDNEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldFollowerID, v))
+}
+
+// FollowerIDIn applies the In predicate on the "follower_id" field.
+func FollowerIDIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldFollowerID, vs...))
+}
+
+// FollowerIDNotIn applies the NotIn predicate on the "follower_id" field.
+func FollowerIDNotIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldFollowerID, vs...))
+}
+
+// FolloweeIDEQ applies the EQ predicate on the "followee_id" field.
+func FolloweeIDEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldFolloweeID, v))
+}
+
+// FolloweeIDNEQ applies the NEQ predicate on the "followee_id" field.
+func FolloweeIDNEQ(v int) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldFolloweeID, v))
+}
+
+// FolloweeIDIn applies the In predicate on the "followee_id" field.
+func FolloweeIDIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldFolloweeID, vs...))
+}
+
+// FolloweeIDNotIn applies the NotIn predicate on the "followee_id" field.
+func FolloweeIDNotIn(vs ...int) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldFolloweeID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.Follow {
+	return predicate.Follow(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.Follow {
+	return predicate.Follow(sql.FieldIsNull(FieldAcceptedAt))
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.Follow {
+	return predicate.Follow(sql.FieldNotNull(FieldAcceptedAt))
+}
+
+// HasFollower applies the HasEdge predicate on the "follower" edge.
+func HasFollower() predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FollowerTable, FollowerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowerWith applies the HasEdge predicate on the "follower" edge with a given conditions (other predicates).
+func HasFollowerWith(preds ...predicate.User) predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := newFollowerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowee applies the HasEdge predicate on the "followee" edge.
+func HasFollowee() predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FolloweeTable, FolloweeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFolloweeWith applies the HasEdge predicate on the "followee" edge with a given conditions (other predicates).
+func HasFolloweeWith(preds ...predicate.User) predicate.Follow {
+	return predicate.Follow(func(s *sql.Selector) {
+		step := newFolloweeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Follow) predicate.Follow {
+	return predicate.Follow(sql.NotPredicates(p))
+}
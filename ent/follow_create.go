@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/user"
+)
+
+// FollowCreate is the builder for creating a Follow entity.
+type FollowCreate struct {
+	config
+	mutation *FollowMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (fc *FollowCreate) SetCreateTime(t time.Time) *FollowCreate {
+	fc.mutation.SetCreateTime(t)
+	return fc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (fc *FollowCreate) SetNillableCreateTime(t *time.Time) *FollowCreate {
+	if t != nil {
+		fc.SetCreateTime(*t)
+	}
+	return fc
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (fc *FollowCreate) SetFollowerID(i int) *FollowCreate {
+	fc.mutation.SetFollowerID(i)
+	return fc
+}
+
+// SetFolloweeID sets the "followee_id" field.
+func (fc *FollowCreate) SetFolloweeID(i int) *FollowCreate {
+	fc.mutation.SetFolloweeID(i)
+	return fc
+}
+
+// SetStatus sets the "status" field.
+func (fc *FollowCreate) SetStatus(f follow.Status) *FollowCreate {
+	fc.mutation.SetStatus(f)
+	return fc
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (fc *FollowCreate) SetAcceptedAt(t time.Time) *FollowCreate {
+	fc.mutation.SetAcceptedAt(t)
+	return fc
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (fc *FollowCreate) SetNillableAcceptedAt(t *time.Time) *FollowCreate {
+	if t != nil {
+		fc.SetAcceptedAt(*t)
+	}
+	return fc
+}
+
+// SetFollower sets the "follower" edge to the User entity.
+func (fc *FollowCreate) SetFollower(u *User) *FollowCreate {
+	return fc.SetFollowerID(u.ID)
+}
+
+// SetFollowee sets the "followee" edge to the User entity.
+func (fc *FollowCreate) SetFollowee(u *User) *FollowCreate {
+	return fc.SetFolloweeID(u.ID)
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (fc *FollowCreate) Mutation() *FollowMutation {
+	return fc.mutation
+}
+
+// Save creates the Follow in the database.
+func (fc *FollowCreate) Save(ctx context.Context) (*Follow, error) {
+	fc.defaults()
+	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FollowCreate) SaveX(ctx context.Context) *Follow {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fc *FollowCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FollowCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FollowCreate) defaults() {
+	if _, ok := fc.mutation.CreateTime(); !ok {
+		v := follow.DefaultCreateTime()
+		fc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FollowCreate) check() error {
+	if _, ok := fc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Follow.create_time"`)}
+	}
+	if _, ok := fc.mutation.FollowerID(); !ok {
+		return &ValidationError{Name: "follower_id", err: errors.New(`ent: missing required field "Follow.follower_id"`)}
+	}
+	if _, ok := fc.mutation.FolloweeID(); !ok {
+		return &ValidationError{Name: "followee_id", err: errors.New(`ent: missing required field "Follow.followee_id"`)}
+	}
+	if _, ok := fc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Follow.status"`)}
+	}
+	if v, ok := fc.mutation.Status(); ok {
+		if err := follow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Follow.status": %w`, err)}
+		}
+	}
+	if len(fc.mutation.FollowerIDs()) == 0 {
+		return &ValidationError{Name: "follower", err: errors.New(`ent: missing required edge "Follow.follower"`)}
+	}
+	if len(fc.mutation.FolloweeIDs()) == 0 {
+		return &ValidationError{Name: "followee", err: errors.New(`ent: missing required edge "Follow.followee"`)}
+	}
+	return nil
+}
+
+func (fc *FollowCreate) sqlSave(ctx context.Context) (*Follow, error) {
+	if err := fc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fc.mutation.id = &_node.ID
+	fc.mutation.done = true
+	return _node, nil
+}
+
+func (fc *FollowCreate) createSpec() (*Follow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Follow{config: fc.config}
+		_spec = sqlgraph.NewCreateSpec(follow.Table, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
+	)
+	if value, ok := fc.mutation.CreateTime(); ok {
+		_spec.SetField(follow.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := fc.mutation.Status(); ok {
+		_spec.SetField(follow.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := fc.mutation.AcceptedAt(); ok {
+		_spec.SetField(follow.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if nodes := fc.mutation.FollowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   follow.FollowerTable,
+			Columns: []string{follow.FollowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FollowerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.FolloweeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   follow.FolloweeTable,
+			Columns: []string{follow.FolloweeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FolloweeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FollowCreateBulk is the builder for creating many Follow entities in bulk.
+type FollowCreateBulk struct {
+	config
+	err      error
+	builders []*FollowCreate
+}
+
+// Save creates the Follow entities in the database.
+func (fcb *FollowCreateBulk) Save(ctx context.Context) ([]*Follow, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
+	nodes := make([]*Follow, len(fcb.builders))
+	mutators := make([]Mutator, len(fcb.builders))
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FollowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FollowCreateBulk) SaveX(ctx context.Context) []*Follow {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcb *FollowCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FollowCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/predicate"
+)
+
+// FollowUpdate is the builder for updating Follow entities.
+type FollowUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FollowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (fu *FollowUpdate) Where(ps ...predicate.Follow) *FollowUpdate {
+	fu.mutation.Where(ps...)
+	return fu
+}
+
+// SetStatus sets the "status" field.
+func (fu *FollowUpdate) SetStatus(f follow.Status) *FollowUpdate {
+	fu.mutation.SetStatus(f)
+	return fu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fu *FollowUpdate) SetNillableStatus(f *follow.Status) *FollowUpdate {
+	if f != nil {
+		fu.SetStatus(*f)
+	}
+	return fu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (fu *FollowUpdate) SetAcceptedAt(t time.Time) *FollowUpdate {
+	fu.mutation.SetAcceptedAt(t)
+	return fu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (fu *FollowUpdate) SetNillableAcceptedAt(t *time.Time) *FollowUpdate {
+	if t != nil {
+		fu.SetAcceptedAt(*t)
+	}
+	return fu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (fu *FollowUpdate) ClearAcceptedAt() *FollowUpdate {
+	fu.mutation.ClearAcceptedAt()
+	return fu
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (fu *FollowUpdate) Mutation() *FollowMutation {
+	return fu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fu *FollowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FollowUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FollowUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FollowUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fu *FollowUpdate) check() error {
+	if v, ok := fu.mutation.Status(); ok {
+		if err := follow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Follow.status": %w`, err)}
+		}
+	}
+	if fu.mutation.FollowerCleared() && len(fu.mutation.FollowerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if fu.mutation.FolloweeCleared() && len(fu.mutation.FolloweeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.followee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fu *FollowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowUpdate {
+	fu.modifiers = append(fu.modifiers, modifiers...)
+	return fu
+}
+
+func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
+	if ps := fu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fu.mutation.Status(); ok {
+		_spec.SetField(follow.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := fu.mutation.AcceptedAt(); ok {
+		_spec.SetField(follow.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if fu.mutation.AcceptedAtCleared() {
+		_spec.ClearField(follow.FieldAcceptedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(fu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fu.mutation.done = true
+	return n, nil
+}
+
+// FollowUpdateOne is the builder for updating a single Follow entity.
+type FollowUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FollowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (fuo *FollowUpdateOne) SetStatus(f follow.Status) *FollowUpdateOne {
+	fuo.mutation.SetStatus(f)
+	return fuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fuo *FollowUpdateOne) SetNillableStatus(f *follow.Status) *FollowUpdateOne {
+	if f != nil {
+		fuo.SetStatus(*f)
+	}
+	return fuo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (fuo *FollowUpdateOne) SetAcceptedAt(t time.Time) *FollowUpdateOne {
+	fuo.mutation.SetAcceptedAt(t)
+	return fuo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (fuo *FollowUpdateOne) SetNillableAcceptedAt(t *time.Time) *FollowUpdateOne {
+	if t != nil {
+		fuo.SetAcceptedAt(*t)
+	}
+	return fuo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (fuo *FollowUpdateOne) ClearAcceptedAt() *FollowUpdateOne {
+	fuo.mutation.ClearAcceptedAt()
+	return fuo
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (fuo *FollowUpdateOne) Mutation() *FollowMutation {
+	return fuo.mutation
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (fuo *FollowUpdateOne) Where(ps ...predicate.Follow) *FollowUpdateOne {
+	fuo.mutation.Where(ps...)
+	return fuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fuo *FollowUpdateOne) Select(field string, fields ...string) *FollowUpdateOne {
+	fuo.fields = append([]string{field}, fields...)
+	return fuo
+}
+
+// Save executes the query and returns the updated Follow entity.
+func (fuo *FollowUpdateOne) Save(ctx context.Context) (*Follow, error) {
+	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FollowUpdateOne) SaveX(ctx context.Context) *Follow {
+	node, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fuo *FollowUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FollowUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FollowUpdateOne) check() error {
+	if v, ok := fuo.mutation.Status(); ok {
+		if err := follow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Follow.status": %w`, err)}
+		}
+	}
+	if fuo.mutation.FollowerCleared() && len(fuo.mutation.FollowerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if fuo.mutation.FolloweeCleared() && len(fuo.mutation.FolloweeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.followee"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fuo *FollowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowUpdateOne {
+	fuo.modifiers = append(fuo.modifiers, modifiers...)
+	return fuo
+}
+
+func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Follow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, follow.FieldID)
+		for _, f := range fields {
+			if !follow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != follow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fuo.mutation.Status(); ok {
+		_spec.SetField(follow.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := fuo.mutation.AcceptedAt(); ok {
+		_spec.SetField(follow.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if fuo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(follow.FieldAcceptedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(fuo.modifiers...)
+	_node = &Follow{config: fuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/impersonation"
+	"github.com/shinplay/ent/user"
+)
+
+// Impersonation is the model entity for the Impersonation schema.
+type Impersonation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ImpersonationID holds the value of the "impersonation_id" field.
+	ImpersonationID string `json:"impersonation_id,omitempty"`
+	// Why support needed to act as the user, e.g. a ticket reference
+	Reason string `json:"reason,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImpersonationQuery when eager-loading is set.
+	Edges                 ImpersonationEdges `json:"edges"`
+	impersonation_actor   *int
+	impersonation_subject *int
+	selectValues          sql.SelectValues
+}
+
+// ImpersonationEdges holds the relations/edges for other nodes in the graph.
+type ImpersonationEdges struct {
+	// Actor holds the value of the actor edge.
+	Actor *User `json:"actor,omitempty"`
+	// Subject holds the value of the subject edge.
+	Subject *User `json:"subject,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImpersonationEdges) ActorOrErr() (*User, error) {
+	if e.Actor != nil {
+		return e.Actor, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// SubjectOrErr returns the Subject value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImpersonationEdges) SubjectOrErr() (*User, error) {
+	if e.Subject != nil {
+		return e.Subject, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "subject"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Impersonation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case impersonation.FieldID:
+			values[i] = new(sql.NullInt64)
+		case impersonation.FieldImpersonationID, impersonation.FieldReason:
+			values[i] = new(sql.NullString)
+		case impersonation.FieldCreateTime, impersonation.FieldUpdateTime, impersonation.FieldExpiresAt, impersonation.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		case impersonation.ForeignKeys[0]: // impersonation_actor
+			values[i] = new(sql.NullInt64)
+		case impersonation.ForeignKeys[1]: // impersonation_subject
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Impersonation fields.
+func (i *Impersonation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case impersonation.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case impersonation.FieldCreateTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[j])
+			} else if value.Valid {
+				i.CreateTime = value.Time
+			}
+		case impersonation.FieldUpdateTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[j])
+			} else if value.Valid {
+				i.UpdateTime = value.Time
+			}
+		case impersonation.FieldImpersonationID:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonation_id", values[j])
+			} else if value.Valid {
+				i.ImpersonationID = value.String
+			}
+		case impersonation.FieldReason:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[j])
+			} else if value.Valid {
+				i.Reason = value.String
+			}
+		case impersonation.FieldExpiresAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[j])
+			} else if value.Valid {
+				i.ExpiresAt = value.Time
+			}
+		case impersonation.FieldEndedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[j])
+			} else if value.Valid {
+				i.EndedAt = new(time.Time)
+				*i.EndedAt = value.Time
+			}
+		case impersonation.ForeignKeys[0]:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field impersonation_actor", value)
+			} else if value.Valid {
+				i.impersonation_actor = new(int)
+				*i.impersonation_actor = int(value.Int64)
+			}
+		case impersonation.ForeignKeys[1]:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field impersonation_subject", value)
+			} else if value.Valid {
+				i.impersonation_subject = new(int)
+				*i.impersonation_subject = int(value.Int64)
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Impersonation.
+// This includes values selected through modifiers, order, etc.
+func (i *Impersonation) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QueryActor queries the "actor" edge of the Impersonation entity.
+func (i *Impersonation) QueryActor() *UserQuery {
+	return NewImpersonationClient(i.config).QueryActor(i)
+}
+
+// QuerySubject queries the "subject" edge of the Impersonation entity.
+func (i *Impersonation) QuerySubject() *UserQuery {
+	return NewImpersonationClient(i.config).QuerySubject(i)
+}
+
+// Update returns a builder for updating this Impersonation.
+// Note that you need to call Impersonation.Unwrap() before calling this method if this Impersonation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Impersonation) Update() *ImpersonationUpdateOne {
+	return NewImpersonationClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Impersonation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Impersonation) Unwrap() *Impersonation {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Impersonation is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Impersonation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Impersonation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(i.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(i.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("impersonation_id=")
+	builder.WriteString(i.ImpersonationID)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(i.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(i.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := i.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Impersonations is a parsable slice of Impersonation.
+type Impersonations []*Impersonation
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package impersonation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ImpersonationID applies equality check predicate on the "impersonation_id" field. It's identical to ImpersonationIDEQ.
+func ImpersonationID(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldImpersonationID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldReason, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ImpersonationIDEQ applies the EQ predicate on the "impersonation_id" field.
+func ImpersonationIDEQ(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldImpersonationID, v))
+}
+
+// ImpersonationIDNEQ applies the NEQ predicate on the "impersonation_id" field.
+func ImpersonationIDNEQ(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNEQ(FieldImpersonationID, v))
+}
+
+// ImpersonationIDIn applies the In predicate on the "impersonation_id" field.
+func ImpersonationIDIn(vs ...string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIn(FieldImpersonationID, vs...))
+}
+
+// ImpersonationIDNotIn applies the NotIn predicate on the "impersonation_id" field.
+func ImpersonationIDNotIn(vs ...string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotIn(FieldImpersonationID, vs...))
+}
+
+// ImpersonationIDGT applies the GT predicate on the "impersonation_id" field.
+func ImpersonationIDGT(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGT(FieldImpersonationID, v))
+}
+
+// ImpersonationIDGTE applies the GTE predicate on the "impersonation_id" field.
+func ImpersonationIDGTE(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGTE(FieldImpersonationID, v))
+}
+
+// ImpersonationIDLT applies the LT predicate on the "impersonation_id" field.
+func ImpersonationIDLT(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLT(FieldImpersonationID, v))
+}
+
+// ImpersonationIDLTE applies the LTE predicate on the "impersonation_id" field.
+func ImpersonationIDLTE(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLTE(FieldImpersonationID, v))
+}
+
+// ImpersonationIDContains applies the Contains predicate on the "impersonation_id" field.
+func ImpersonationIDContains(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldContains(FieldImpersonationID, v))
+}
+
+// ImpersonationIDHasPrefix applies the HasPrefix predicate on the "impersonation_id" field.
+func ImpersonationIDHasPrefix(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldHasPrefix(FieldImpersonationID, v))
+}
+
+// ImpersonationIDHasSuffix applies the HasSuffix predicate on the "impersonation_id" field.
+func ImpersonationIDHasSuffix(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldHasSuffix(FieldImpersonationID, v))
+}
+
+// ImpersonationIDEqualFold applies the EqualFold predicate on the "impersonation_id" field.
+func ImpersonationIDEqualFold(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEqualFold(FieldImpersonationID, v))
+}
+
+// ImpersonationIDContainsFold applies the ContainsFold predicate on the "impersonation_id" field.
+func ImpersonationIDContainsFold(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldContainsFold(FieldImpersonationID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Impersonation {
+	return predicate.Impersonation(sql.FieldNotNull(FieldEndedAt))
+}
+
+// HasActor applies the HasEdge predicate on the "actor" edge.
+func HasActor() predicate.Impersonation {
+	return predicate.Impersonation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ActorTable, ActorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActorWith applies the HasEdge predicate on the "actor" edge with a given conditions (other predicates).
+func HasActorWith(preds ...predicate.User) predicate.Impersonation {
+	return predicate.Impersonation(func(s *sql.Selector) {
+		step := newActorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSubject applies the HasEdge predicate on the "subject" edge.
+func HasSubject() predicate.Impersonation {
+	return predicate.Impersonation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SubjectTable, SubjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubjectWith applies the HasEdge predicate on the "subject" edge with a given conditions (other predicates).
+func HasSubjectWith(preds ...predicate.User) predicate.Impersonation {
+	return predicate.Impersonation(func(s *sql.Selector) {
+		step := newSubjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Impersonation) predicate.Impersonation {
+	return predicate.Impersonation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Impersonation) predicate.Impersonation {
+	return predicate.Impersonation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Impersonation) predicate.Impersonation {
+	return predicate.Impersonation(sql.NotPredicates(p))
+}
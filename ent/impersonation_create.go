@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/impersonation"
+	"github.com/shinplay/ent/user"
+)
+
+// ImpersonationCreate is the builder for creating a Impersonation entity.
+type ImpersonationCreate struct {
+	config
+	mutation *ImpersonationMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ic *ImpersonationCreate) SetCreateTime(t time.Time) *ImpersonationCreate {
+	ic.mutation.SetCreateTime(t)
+	return ic
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableCreateTime(t *time.Time) *ImpersonationCreate {
+	if t != nil {
+		ic.SetCreateTime(*t)
+	}
+	return ic
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ic *ImpersonationCreate) SetUpdateTime(t time.Time) *ImpersonationCreate {
+	ic.mutation.SetUpdateTime(t)
+	return ic
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableUpdateTime(t *time.Time) *ImpersonationCreate {
+	if t != nil {
+		ic.SetUpdateTime(*t)
+	}
+	return ic
+}
+
+// SetImpersonationID sets the "impersonation_id" field.
+func (ic *ImpersonationCreate) SetImpersonationID(s string) *ImpersonationCreate {
+	ic.mutation.SetImpersonationID(s)
+	return ic
+}
+
+// SetNillableImpersonationID sets the "impersonation_id" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableImpersonationID(s *string) *ImpersonationCreate {
+	if s != nil {
+		ic.SetImpersonationID(*s)
+	}
+	return ic
+}
+
+// SetReason sets the "reason" field.
+func (ic *ImpersonationCreate) SetReason(s string) *ImpersonationCreate {
+	ic.mutation.SetReason(s)
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *ImpersonationCreate) SetExpiresAt(t time.Time) *ImpersonationCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (ic *ImpersonationCreate) SetEndedAt(t time.Time) *ImpersonationCreate {
+	ic.mutation.SetEndedAt(t)
+	return ic
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableEndedAt(t *time.Time) *ImpersonationCreate {
+	if t != nil {
+		ic.SetEndedAt(*t)
+	}
+	return ic
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (ic *ImpersonationCreate) SetActorID(id int) *ImpersonationCreate {
+	ic.mutation.SetActorID(id)
+	return ic
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (ic *ImpersonationCreate) SetActor(u *User) *ImpersonationCreate {
+	return ic.SetActorID(u.ID)
+}
+
+// SetSubjectID sets the "subject" edge to the User entity by ID.
+func (ic *ImpersonationCreate) SetSubjectID(id int) *ImpersonationCreate {
+	ic.mutation.SetSubjectID(id)
+	return ic
+}
+
+// SetSubject sets the "subject" edge to the User entity.
+func (ic *ImpersonationCreate) SetSubject(u *User) *ImpersonationCreate {
+	return ic.SetSubjectID(u.ID)
+}
+
+// Mutation returns the ImpersonationMutation object of the builder.
+func (ic *ImpersonationCreate) Mutation() *ImpersonationMutation {
+	return ic.mutation
+}
+
+// Save creates the Impersonation in the database.
+func (ic *ImpersonationCreate) Save(ctx context.Context) (*Impersonation, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *ImpersonationCreate) SaveX(ctx context.Context) *Impersonation {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *ImpersonationCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *ImpersonationCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *ImpersonationCreate) defaults() {
+	if _, ok := ic.mutation.CreateTime(); !ok {
+		v := impersonation.DefaultCreateTime()
+		ic.mutation.SetCreateTime(v)
+	}
+	if _, ok := ic.mutation.UpdateTime(); !ok {
+		v := impersonation.DefaultUpdateTime()
+		ic.mutation.SetUpdateTime(v)
+	}
+	if _, ok := ic.mutation.ImpersonationID(); !ok {
+		v := impersonation.DefaultImpersonationID()
+		ic.mutation.SetImpersonationID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *ImpersonationCreate) check() error {
+	if _, ok := ic.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Impersonation.create_time"`)}
+	}
+	if _, ok := ic.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Impersonation.update_time"`)}
+	}
+	if _, ok := ic.mutation.ImpersonationID(); !ok {
+		return &ValidationError{Name: "impersonation_id", err: errors.New(`ent: missing required field "Impersonation.impersonation_id"`)}
+	}
+	if v, ok := ic.mutation.ImpersonationID(); ok {
+		if err := impersonation.ImpersonationIDValidator(v); err != nil {
+			return &ValidationError{Name: "impersonation_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.impersonation_id": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Impersonation.reason"`)}
+	}
+	if v, ok := ic.mutation.Reason(); ok {
+		if err := impersonation.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Impersonation.reason": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Impersonation.expires_at"`)}
+	}
+	if len(ic.mutation.ActorIDs()) == 0 {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required edge "Impersonation.actor"`)}
+	}
+	if len(ic.mutation.SubjectIDs()) == 0 {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required edge "Impersonation.subject"`)}
+	}
+	return nil
+}
+
+func (ic *ImpersonationCreate) sqlSave(ctx context.Context) (*Impersonation, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *ImpersonationCreate) createSpec() (*Impersonation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Impersonation{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(impersonation.Table, sqlgraph.NewFieldSpec(impersonation.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.CreateTime(); ok {
+		_spec.SetField(impersonation.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ic.mutation.UpdateTime(); ok {
+		_spec.SetField(impersonation.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ic.mutation.ImpersonationID(); ok {
+		_spec.SetField(impersonation.FieldImpersonationID, field.TypeString, value)
+		_node.ImpersonationID = value
+	}
+	if value, ok := ic.mutation.Reason(); ok {
+		_spec.SetField(impersonation.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ic.mutation.EndedAt(); ok {
+		_spec.SetField(impersonation.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if nodes := ic.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   impersonation.ActorTable,
+			Columns: []string{impersonation.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.impersonation_actor = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.SubjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   impersonation.SubjectTable,
+			Columns: []string{impersonation.SubjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.impersonation_subject = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImpersonationCreateBulk is the builder for creating many Impersonation entities in bulk.
+type ImpersonationCreateBulk struct {
+	config
+	err      error
+	builders []*ImpersonationCreate
+}
+
+// Save creates the Impersonation entities in the database.
+func (icb *ImpersonationCreateBulk) Save(ctx context.Context) ([]*Impersonation, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Impersonation, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImpersonationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *ImpersonationCreateBulk) SaveX(ctx context.Context) []*Impersonation {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *ImpersonationCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *ImpersonationCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,6 +9,381 @@ import (
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "audit_event_actor", Type: field.TypeInt, Nullable: true},
+		{Name: "audit_event_subject", Type: field.TypeInt, Nullable: true},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_events_users_actor",
+				Columns:    []*schema.Column{AuditEventsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "audit_events_users_subject",
+				Columns:    []*schema.Column{AuditEventsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_event_type_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[2], AuditEventsColumns[1]},
+			},
+		},
+	}
+	// BlocksColumns holds the columns for the "blocks" table.
+	BlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "blocker_id", Type: field.TypeInt},
+		{Name: "blocked_id", Type: field.TypeInt},
+	}
+	// BlocksTable holds the schema information for the "blocks" table.
+	BlocksTable = &schema.Table{
+		Name:       "blocks",
+		Columns:    BlocksColumns,
+		PrimaryKey: []*schema.Column{BlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blocks_users_blocks",
+				Columns:    []*schema.Column{BlocksColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blocks_users_blocked_by",
+				Columns:    []*schema.Column{BlocksColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "block_blocker_id_blocked_id",
+				Unique:  true,
+				Columns: []*schema.Column{BlocksColumns[2], BlocksColumns[3]},
+			},
+			{
+				Name:    "block_blocked_id_blocker_id",
+				Unique:  false,
+				Columns: []*schema.Column{BlocksColumns[3], BlocksColumns[2]},
+			},
+			{
+				Name:    "block_blocker_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{BlocksColumns[2], BlocksColumns[1]},
+			},
+		},
+	}
+	// DataExportsColumns holds the columns for the "data_exports" table.
+	DataExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "export_id", Type: field.TypeString, Unique: true, Size: 24},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "ready", "failed"}, Default: "pending"},
+		{Name: "file_path", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "user_data_exports", Type: field.TypeInt},
+	}
+	// DataExportsTable holds the schema information for the "data_exports" table.
+	DataExportsTable = &schema.Table{
+		Name:       "data_exports",
+		Columns:    DataExportsColumns,
+		PrimaryKey: []*schema.Column{DataExportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "data_exports_users_data_exports",
+				Columns:    []*schema.Column{DataExportsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// DeviceAuthorizationsColumns holds the columns for the "device_authorizations" table.
+	DeviceAuthorizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "device_code_hash", Type: field.TypeString, Unique: true},
+		{Name: "user_code", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "denied", "consumed"}, Default: "pending"},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "interval", Type: field.TypeInt},
+		{Name: "last_polled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_device_authorizations", Type: field.TypeInt, Nullable: true},
+	}
+	// DeviceAuthorizationsTable holds the schema information for the "device_authorizations" table.
+	DeviceAuthorizationsTable = &schema.Table{
+		Name:       "device_authorizations",
+		Columns:    DeviceAuthorizationsColumns,
+		PrimaryKey: []*schema.Column{DeviceAuthorizationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_authorizations_users_device_authorizations",
+				Columns:    []*schema.Column{DeviceAuthorizationsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// EmailVerificationsColumns holds the columns for the "email_verifications" table.
+	EmailVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_email_verifications", Type: field.TypeInt},
+	}
+	// EmailVerificationsTable holds the schema information for the "email_verifications" table.
+	EmailVerificationsTable = &schema.Table{
+		Name:       "email_verifications",
+		Columns:    EmailVerificationsColumns,
+		PrimaryKey: []*schema.Column{EmailVerificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_verifications_users_email_verifications",
+				Columns:    []*schema.Column{EmailVerificationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// FollowsColumns holds the columns for the "follows" table.
+	FollowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted"}},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "follower_id", Type: field.TypeInt},
+		{Name: "followee_id", Type: field.TypeInt},
+	}
+	// FollowsTable holds the schema information for the "follows" table.
+	FollowsTable = &schema.Table{
+		Name:       "follows",
+		Columns:    FollowsColumns,
+		PrimaryKey: []*schema.Column{FollowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "follows_users_following",
+				Columns:    []*schema.Column{FollowsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "follows_users_followers",
+				Columns:    []*schema.Column{FollowsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "follow_follower_id_followee_id",
+				Unique:  true,
+				Columns: []*schema.Column{FollowsColumns[4], FollowsColumns[5]},
+			},
+			{
+				Name:    "follow_followee_id_status_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[5], FollowsColumns[2], FollowsColumns[1]},
+			},
+			{
+				Name:    "follow_follower_id_status_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[4], FollowsColumns[2], FollowsColumns[1]},
+			},
+		},
+	}
+	// ImpersonationsColumns holds the columns for the "impersonations" table.
+	ImpersonationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "impersonation_id", Type: field.TypeString, Unique: true},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "impersonation_actor", Type: field.TypeInt},
+		{Name: "impersonation_subject", Type: field.TypeInt},
+	}
+	// ImpersonationsTable holds the schema information for the "impersonations" table.
+	ImpersonationsTable = &schema.Table{
+		Name:       "impersonations",
+		Columns:    ImpersonationsColumns,
+		PrimaryKey: []*schema.Column{ImpersonationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "impersonations_users_actor",
+				Columns:    []*schema.Column{ImpersonationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "impersonations_users_subject",
+				Columns:    []*schema.Column{ImpersonationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// MutesColumns holds the columns for the "mutes" table.
+	MutesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "muter_id", Type: field.TypeInt},
+		{Name: "muted_id", Type: field.TypeInt},
+	}
+	// MutesTable holds the schema information for the "mutes" table.
+	MutesTable = &schema.Table{
+		Name:       "mutes",
+		Columns:    MutesColumns,
+		PrimaryKey: []*schema.Column{MutesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mutes_users_mutes",
+				Columns:    []*schema.Column{MutesColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "mutes_users_muted_by",
+				Columns:    []*schema.Column{MutesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mute_muter_id_muted_id",
+				Unique:  true,
+				Columns: []*schema.Column{MutesColumns[2], MutesColumns[3]},
+			},
+			{
+				Name:    "mute_muter_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{MutesColumns[2], MutesColumns[1]},
+			},
+		},
+	}
+	// OauthAuthorizationCodesColumns holds the columns for the "oauth_authorization_codes" table.
+	OauthAuthorizationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "redirect_uri", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "code_challenge", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "oauth_client_authorization_codes", Type: field.TypeInt},
+		{Name: "user_oauth_authorization_codes", Type: field.TypeInt},
+	}
+	// OauthAuthorizationCodesTable holds the schema information for the "oauth_authorization_codes" table.
+	OauthAuthorizationCodesTable = &schema.Table{
+		Name:       "oauth_authorization_codes",
+		Columns:    OauthAuthorizationCodesColumns,
+		PrimaryKey: []*schema.Column{OauthAuthorizationCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_authorization_codes_oauth_clients_authorization_codes",
+				Columns:    []*schema.Column{OauthAuthorizationCodesColumns[8]},
+				RefColumns: []*schema.Column{OauthClientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "oauth_authorization_codes_users_oauth_authorization_codes",
+				Columns:    []*schema.Column{OauthAuthorizationCodesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// OauthClientsColumns holds the columns for the "oauth_clients" table.
+	OauthClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "client_id", Type: field.TypeString, Unique: true},
+		{Name: "client_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "homepage_url", Type: field.TypeString, Nullable: true},
+		{Name: "redirect_uris", Type: field.TypeJSON},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// OauthClientsTable holds the schema information for the "oauth_clients" table.
+	OauthClientsTable = &schema.Table{
+		Name:       "oauth_clients",
+		Columns:    OauthClientsColumns,
+		PrimaryKey: []*schema.Column{OauthClientsColumns[0]},
+	}
+	// OauthConsentsColumns holds the columns for the "oauth_consents" table.
+	OauthConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "oauth_client_consents", Type: field.TypeInt},
+		{Name: "user_oauth_consents", Type: field.TypeInt},
+	}
+	// OauthConsentsTable holds the schema information for the "oauth_consents" table.
+	OauthConsentsTable = &schema.Table{
+		Name:       "oauth_consents",
+		Columns:    OauthConsentsColumns,
+		PrimaryKey: []*schema.Column{OauthConsentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_consents_oauth_clients_consents",
+				Columns:    []*schema.Column{OauthConsentsColumns[4]},
+				RefColumns: []*schema.Column{OauthClientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "oauth_consents_users_oauth_consents",
+				Columns:    []*schema.Column{OauthConsentsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthconsent_user_oauth_consents_oauth_client_consents",
+				Unique:  true,
+				Columns: []*schema.Column{OauthConsentsColumns[5], OauthConsentsColumns[4]},
+			},
+		},
+	}
 	// OtpsColumns holds the columns for the "otps" table.
 	OtpsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -32,6 +407,104 @@ var (
 			},
 		},
 	}
+	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
+	PersonalAccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "token_prefix", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_personal_access_tokens", Type: field.TypeInt},
+	}
+	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
+	PersonalAccessTokensTable = &schema.Table{
+		Name:       "personal_access_tokens",
+		Columns:    PersonalAccessTokensColumns,
+		PrimaryKey: []*schema.Column{PersonalAccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "personal_access_tokens_users_personal_access_tokens",
+				Columns:    []*schema.Column{PersonalAccessTokensColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PhoneChangeRequestsColumns holds the columns for the "phone_change_requests" table.
+	PhoneChangeRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "new_phone_number", Type: field.TypeString, Size: 15},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_phone_change_requests", Type: field.TypeInt},
+	}
+	// PhoneChangeRequestsTable holds the schema information for the "phone_change_requests" table.
+	PhoneChangeRequestsTable = &schema.Table{
+		Name:       "phone_change_requests",
+		Columns:    PhoneChangeRequestsColumns,
+		PrimaryKey: []*schema.Column{PhoneChangeRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "phone_change_requests_users_phone_change_requests",
+				Columns:    []*schema.Column{PhoneChangeRequestsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// QrLoginRequestsColumns holds the columns for the "qr_login_requests" table.
+	QrLoginRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "request_id", Type: field.TypeString, Unique: true},
+		{Name: "browser_secret_hash", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "denied", "consumed"}, Default: "pending"},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "scanned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_qr_login_requests", Type: field.TypeInt, Nullable: true},
+	}
+	// QrLoginRequestsTable holds the schema information for the "qr_login_requests" table.
+	QrLoginRequestsTable = &schema.Table{
+		Name:       "qr_login_requests",
+		Columns:    QrLoginRequestsColumns,
+		PrimaryKey: []*schema.Column{QrLoginRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "qr_login_requests_users_qr_login_requests",
+				Columns:    []*schema.Column{QrLoginRequestsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -42,6 +515,8 @@ var (
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true},
 		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "oauth_client_sessions", Type: field.TypeInt, Nullable: true},
 		{Name: "user_sessions", Type: field.TypeInt},
 	}
 	// SessionsTable holds the schema information for the "sessions" table.
@@ -50,9 +525,15 @@ var (
 		Columns:    SessionsColumns,
 		PrimaryKey: []*schema.Column{SessionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_oauth_clients_sessions",
+				Columns:    []*schema.Column{SessionsColumns[9]},
+				RefColumns: []*schema.Column{OauthClientsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "sessions_users_sessions",
-				Columns:    []*schema.Column{SessionsColumns[8]},
+				Columns:    []*schema.Column{SessionsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -64,31 +545,214 @@ var (
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "auth_id", Type: field.TypeString, Unique: true, Size: 24},
-		{Name: "username", Type: field.TypeString, Unique: true, Nullable: true, Size: 40},
-		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "username", Type: field.TypeString, Unique: true, Nullable: true, Size: 120},
+		{Name: "username_normalized", Type: field.TypeString, Unique: true, Nullable: true, Size: 120},
+		{Name: "username_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
 		{Name: "phone_number", Type: field.TypeString, Unique: true, Nullable: true, Size: 15},
 		{Name: "first_name", Type: field.TypeString, Nullable: true},
 		{Name: "last_name", Type: field.TypeString, Nullable: true},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "birthday", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "locale", Type: field.TypeString, Nullable: true, Size: 35},
+		{Name: "avatar_key", Type: field.TypeString, Nullable: true},
+		{Name: "avatar_urls", Type: field.TypeJSON, Nullable: true},
+		{Name: "profile_visibility", Type: field.TypeJSON, Nullable: true},
+		{Name: "settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "private", Type: field.TypeBool, Default: false},
+		{Name: "follower_count", Type: field.TypeInt, Default: 0},
+		{Name: "following_count", Type: field.TypeInt, Default: 0},
+		{Name: "timezone", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "login_count", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "suspended", "banned", "deleted"}, Default: "active"},
+		{Name: "suspended_until", Type: field.TypeTime, Nullable: true},
+		{Name: "restriction_reason", Type: field.TypeString, Nullable: true},
+		{Name: "restricted_by", Type: field.TypeString, Nullable: true},
+		{Name: "restricted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_requested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "guest", Type: field.TypeBool, Default: false},
+		{Name: "guest_device_hash", Type: field.TypeString, Unique: true, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_email",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "email_verified_at IS NOT NULL",
+				},
+			},
+			{
+				Name:    "user_username_normalized",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "user_display_name",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[12]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "user_first_name",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "user_last_name",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// UsernameHistoriesColumns holds the columns for the "username_histories" table.
+	UsernameHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "username", Type: field.TypeString},
+		{Name: "username_normalized", Type: field.TypeString},
+		{Name: "hold_until", Type: field.TypeTime},
+		{Name: "user_username_history", Type: field.TypeInt},
+	}
+	// UsernameHistoriesTable holds the schema information for the "username_histories" table.
+	UsernameHistoriesTable = &schema.Table{
+		Name:       "username_histories",
+		Columns:    UsernameHistoriesColumns,
+		PrimaryKey: []*schema.Column{UsernameHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "username_histories_users_username_history",
+				Columns:    []*schema.Column{UsernameHistoriesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usernamehistory_username_normalized_hold_until",
+				Unique:  false,
+				Columns: []*schema.Column{UsernameHistoriesColumns[3], UsernameHistoriesColumns[4]},
+			},
+		},
+	}
+	// UserRolesColumns holds the columns for the "user_roles" table.
+	UserRolesColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// UserRolesTable holds the schema information for the "user_roles" table.
+	UserRolesTable = &schema.Table{
+		Name:       "user_roles",
+		Columns:    UserRolesColumns,
+		PrimaryKey: []*schema.Column{UserRolesColumns[0], UserRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_roles_user_id",
+				Columns:    []*schema.Column{UserRolesColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_roles_role_id",
+				Columns:    []*schema.Column{UserRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
+		BlocksTable,
+		DataExportsTable,
+		DeviceAuthorizationsTable,
+		EmailVerificationsTable,
+		FollowsTable,
+		ImpersonationsTable,
+		MutesTable,
+		OauthAuthorizationCodesTable,
+		OauthClientsTable,
+		OauthConsentsTable,
 		OtpsTable,
+		PersonalAccessTokensTable,
+		PhoneChangeRequestsTable,
+		QrLoginRequestsTable,
+		RolesTable,
 		SessionsTable,
 		UsersTable,
+		UsernameHistoriesTable,
+		UserRolesTable,
 	}
 )
 
 func init() {
+	AuditEventsTable.ForeignKeys[0].RefTable = UsersTable
+	AuditEventsTable.ForeignKeys[1].RefTable = UsersTable
+	BlocksTable.ForeignKeys[0].RefTable = UsersTable
+	BlocksTable.ForeignKeys[1].RefTable = UsersTable
+	DataExportsTable.ForeignKeys[0].RefTable = UsersTable
+	DeviceAuthorizationsTable.ForeignKeys[0].RefTable = UsersTable
+	EmailVerificationsTable.ForeignKeys[0].RefTable = UsersTable
+	FollowsTable.ForeignKeys[0].RefTable = UsersTable
+	FollowsTable.ForeignKeys[1].RefTable = UsersTable
+	ImpersonationsTable.ForeignKeys[0].RefTable = UsersTable
+	ImpersonationsTable.ForeignKeys[1].RefTable = UsersTable
+	MutesTable.ForeignKeys[0].RefTable = UsersTable
+	MutesTable.ForeignKeys[1].RefTable = UsersTable
+	OauthAuthorizationCodesTable.ForeignKeys[0].RefTable = OauthClientsTable
+	OauthAuthorizationCodesTable.ForeignKeys[1].RefTable = UsersTable
+	OauthAuthorizationCodesTable.Annotation = &entsql.Annotation{
+		Table: "oauth_authorization_codes",
+	}
+	OauthClientsTable.Annotation = &entsql.Annotation{
+		Table: "oauth_clients",
+	}
+	OauthConsentsTable.ForeignKeys[0].RefTable = OauthClientsTable
+	OauthConsentsTable.ForeignKeys[1].RefTable = UsersTable
+	OauthConsentsTable.Annotation = &entsql.Annotation{
+		Table: "oauth_consents",
+	}
 	OtpsTable.ForeignKeys[0].RefTable = UsersTable
 	OtpsTable.Annotation = &entsql.Annotation{
 		Table: "otps",
 	}
-	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	PersonalAccessTokensTable.ForeignKeys[0].RefTable = UsersTable
+	PhoneChangeRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	QrLoginRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = OauthClientsTable
+	SessionsTable.ForeignKeys[1].RefTable = UsersTable
+	UsernameHistoriesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/user"
+)
+
+// Mute is the model entity for the Mute schema.
+type Mute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// MuterID holds the value of the "muter_id" field.
+	MuterID int `json:"muter_id,omitempty"`
+	// MutedID holds the value of the "muted_id" field.
+	MutedID int `json:"muted_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MuteQuery when eager-loading is set.
+	Edges        MuteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MuteEdges holds the relations/edges for other nodes in the graph.
+type MuteEdges struct {
+	// Muter holds the value of the muter edge.
+	Muter *User `json:"muter,omitempty"`
+	// Muted holds the value of the muted edge.
+	Muted *User `json:"muted,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MuterOrErr returns the Muter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MuteEdges) MuterOrErr() (*User, error) {
+	if e.Muter != nil {
+		return e.Muter, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "muter"}
+}
+
+// MutedOrErr returns the Muted value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MuteEdges) MutedOrErr() (*User, error) {
+	if e.Muted != nil {
+		return e.Muted, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "muted"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Mute) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mute.FieldID, mute.FieldMuterID, mute.FieldMutedID:
+			values[i] = new(sql.NullInt64)
+		case mute.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Mute fields.
+func (m *Mute) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mute.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case mute.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				m.CreateTime = value.Time
+			}
+		case mute.FieldMuterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field muter_id", values[i])
+			} else if value.Valid {
+				m.MuterID = int(value.Int64)
+			}
+		case mute.FieldMutedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_id", values[i])
+			} else if value.Valid {
+				m.MutedID = int(value.Int64)
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Mute.
+// This includes values selected through modifiers, order, etc.
+func (m *Mute) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryMuter queries the "muter" edge of the Mute entity.
+func (m *Mute) QueryMuter() *UserQuery {
+	return NewMuteClient(m.config).QueryMuter(m)
+}
+
+// QueryMuted queries the "muted" edge of the Mute entity.
+func (m *Mute) QueryMuted() *UserQuery {
+	return NewMuteClient(m.config).QueryMuted(m)
+}
+
+// Update returns a builder for updating this Mute.
+// Note that you need to call Mute.Unwrap() before calling this method if this Mute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Mute) Update() *MuteUpdateOne {
+	return NewMuteClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Mute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Mute) Unwrap() *Mute {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Mute is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Mute) String() string {
+	var builder strings.Builder
+	builder.WriteString("Mute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("muter_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.MuterID))
+	builder.WriteString(", ")
+	builder.WriteString("muted_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.MutedID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Mutes is a parsable slice of Mute.
+type Mutes []*Mute
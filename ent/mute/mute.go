@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mute
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the mute type in the database.
+	Label = "mute"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldMuterID holds the string denoting the muter_id field in the database.
+	FieldMuterID = "muter_id"
+	// FieldMutedID holds the string denoting the muted_id field in the database.
+	FieldMutedID = "muted_id"
+	// EdgeMuter holds the string denoting the muter edge name in mutations.
+	EdgeMuter = "muter"
+	// EdgeMuted holds the string denoting the muted edge name in mutations.
+	EdgeMuted = "muted"
+	// Table holds the table name of the mute in the database.
+	Table = "mutes"
+	// MuterTable is the table that holds the muter relation/edge.
+	MuterTable = "mutes"
+	// MuterInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	MuterInverseTable = "users"
+	// MuterColumn is the table column denoting the muter relation/edge.
+	MuterColumn = "muter_id"
+	// MutedTable is the table that holds the muted relation/edge.
+	MutedTable = "mutes"
+	// MutedInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	MutedInverseTable = "users"
+	// MutedColumn is the table column denoting the muted relation/edge.
+	MutedColumn = "muted_id"
+)
+
+// Columns holds all SQL columns for mute fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldMuterID,
+	FieldMutedID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the Mute queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByMuterID orders the results by the muter_id field.
+func ByMuterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMuterID, opts...).ToFunc()
+}
+
+// ByMutedID orders the results by the muted_id field.
+func ByMutedID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMutedID, opts...).ToFunc()
+}
+
+// ByMuterField orders the results by muter field.
+func ByMuterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMuterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMutedField orders the results by muted field.
+func ByMutedField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutedStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMuterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MuterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MuterTable, MuterColumn),
+	)
+}
+func newMutedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MutedTable, MutedColumn),
+	)
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mute
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Mute {
+	return predicate.Mute(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Mute {
+	return predicate.Mute(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Mute {
+	return predicate.Mute(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Mute {
+	return predicate.Mute(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Mute {
+	return predicate.Mute(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Mute {
+	return predicate.Mute(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Mute {
+	return predicate.Mute(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// MuterID applies equality check predicate on the "muter_id" field. It's identical to MuterIDEQ.
+func MuterID(v int) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldMuterID, v))
+}
+
+// MutedID applies equality check predicate on the "muted_id" field. It's identical to MutedIDEQ.
+func MutedID(v int) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldMutedID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Mute {
+	return predicate.Mute(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// MuterIDEQ applies the EQ predicate on the "muter_id" field.
+func MuterIDEQ(v int) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldMuterID, v))
+}
+
+// MuterIDNEQ applies the NEQ predicate on the "muter_id" field.
+func MuterIDNEQ(v int) predicate.Mute {
+	return predicate.Mute(sql.FieldNEQ(FieldMuterID, v))
+}
+
+// MuterIDIn applies the In predicate on the "muter_id" field.
+func MuterIDIn(vs ...int) predicate.Mute {
+	return predicate.Mute(sql.FieldIn(FieldMuterID, vs...))
+}
+
+// MuterIDNotIn applies the NotIn predicate on the "muter_id" field.
+func MuterIDNotIn(vs ...int) predicate.Mute {
+	return predicate.Mute(sql.FieldNotIn(FieldMuterID, vs...))
+}
+
+// MutedIDEQ applies the EQ predicate on the "muted_id" field.
+func MutedIDEQ(v int) predicate.Mute {
+	return predicate.Mute(sql.FieldEQ(FieldMutedID, v))
+}
+
+// MutedIDNEQ applies the NEQ predicate on the "muted_id" field.
+func MutedIDNEQ(v int) predicate.Mute {
+	return predicate.Mute(sql.FieldNEQ(FieldMutedID, v))
+}
+
+// MutedIDIn applies the In predicate on the "muted_id" field.
+func MutedIDIn(vs ...int) predicate.Mute {
+	return predicate.Mute(sql.FieldIn(FieldMutedID, vs...))
+}
+
+// MutedIDNotIn applies the NotIn predicate on the "muted_id" field.
+func MutedIDNotIn(vs ...int) predicate.Mute {
+	return predicate.Mute(sql.FieldNotIn(FieldMutedID, vs...))
+}
+
+// HasMuter applies the HasEdge predicate on the "muter" edge.
+func HasMuter() predicate.Mute {
+	return predicate.Mute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MuterTable, MuterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMuterWith applies the HasEdge predicate on the "muter" edge with a given conditions (other predicates).
+func HasMuterWith(preds ...predicate.User) predicate.Mute {
+	return predicate.Mute(func(s *sql.Selector) {
+		step := newMuterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMuted applies the HasEdge predicate on the "muted" edge.
+func HasMuted() predicate.Mute {
+	return predicate.Mute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MutedTable, MutedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutedWith applies the HasEdge predicate on the "muted" edge with a given conditions (other predicates).
+func HasMutedWith(preds ...predicate.User) predicate.Mute {
+	return predicate.Mute(func(s *sql.Selector) {
+		step := newMutedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Mute) predicate.Mute {
+	return predicate.Mute(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Mute) predicate.Mute {
+	return predicate.Mute(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Mute) predicate.Mute {
+	return predicate.Mute(sql.NotPredicates(p))
+}
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/user"
+)
+
+// MuteCreate is the builder for creating a Mute entity.
+type MuteCreate struct {
+	config
+	mutation *MuteMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (mc *MuteCreate) SetCreateTime(t time.Time) *MuteCreate {
+	mc.mutation.SetCreateTime(t)
+	return mc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (mc *MuteCreate) SetNillableCreateTime(t *time.Time) *MuteCreate {
+	if t != nil {
+		mc.SetCreateTime(*t)
+	}
+	return mc
+}
+
+// SetMuterID sets the "muter_id" field.
+func (mc *MuteCreate) SetMuterID(i int) *MuteCreate {
+	mc.mutation.SetMuterID(i)
+	return mc
+}
+
+// SetMutedID sets the "muted_id" field.
+func (mc *MuteCreate) SetMutedID(i int) *MuteCreate {
+	mc.mutation.SetMutedID(i)
+	return mc
+}
+
+// SetMuter sets the "muter" edge to the User entity.
+func (mc *MuteCreate) SetMuter(u *User) *MuteCreate {
+	return mc.SetMuterID(u.ID)
+}
+
+// SetMuted sets the "muted" edge to the User entity.
+func (mc *MuteCreate) SetMuted(u *User) *MuteCreate {
+	return mc.SetMutedID(u.ID)
+}
+
+// Mutation returns the MuteMutation object of the builder.
+func (mc *MuteCreate) Mutation() *MuteMutation {
+	return mc.mutation
+}
+
+// Save creates the Mute in the database.
+func (mc *MuteCreate) Save(ctx context.Context) (*Mute, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MuteCreate) SaveX(ctx context.Context) *Mute {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MuteCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MuteCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MuteCreate) defaults() {
+	if _, ok := mc.mutation.CreateTime(); !ok {
+		v := mute.DefaultCreateTime()
+		mc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MuteCreate) check() error {
+	if _, ok := mc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Mute.create_time"`)}
+	}
+	if _, ok := mc.mutation.MuterID(); !ok {
+		return &ValidationError{Name: "muter_id", err: errors.New(`ent: missing required field "Mute.muter_id"`)}
+	}
+	if _, ok := mc.mutation.MutedID(); !ok {
+		return &ValidationError{Name: "muted_id", err: errors.New(`ent: missing required field "Mute.muted_id"`)}
+	}
+	if len(mc.mutation.MuterIDs()) == 0 {
+		return &ValidationError{Name: "muter", err: errors.New(`ent: missing required edge "Mute.muter"`)}
+	}
+	if len(mc.mutation.MutedIDs()) == 0 {
+		return &ValidationError{Name: "muted", err: errors.New(`ent: missing required edge "Mute.muted"`)}
+	}
+	return nil
+}
+
+func (mc *MuteCreate) sqlSave(ctx context.Context) (*Mute, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MuteCreate) createSpec() (*Mute, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Mute{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(mute.Table, sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt))
+	)
+	if value, ok := mc.mutation.CreateTime(); ok {
+		_spec.SetField(mute.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if nodes := mc.mutation.MuterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.MuterTable,
+			Columns: []string{mute.MuterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MuterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.MutedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.MutedTable,
+			Columns: []string{mute.MutedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MutedID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MuteCreateBulk is the builder for creating many Mute entities in bulk.
+type MuteCreateBulk struct {
+	config
+	err      error
+	builders []*MuteCreate
+}
+
+// Save creates the Mute entities in the database.
+func (mcb *MuteCreateBulk) Save(ctx context.Context) ([]*Mute, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Mute, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MuteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MuteCreateBulk) SaveX(ctx context.Context) []*Mute {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MuteCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MuteCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
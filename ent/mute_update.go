@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/predicate"
+)
+
+// MuteUpdate is the builder for updating Mute entities.
+type MuteUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MuteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MuteUpdate builder.
+func (mu *MuteUpdate) Where(ps ...predicate.Mute) *MuteUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// Mutation returns the MuteMutation object of the builder.
+func (mu *MuteUpdate) Mutation() *MuteMutation {
+	return mu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MuteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MuteUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MuteUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MuteUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MuteUpdate) check() error {
+	if mu.mutation.MuterCleared() && len(mu.mutation.MuterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.muter"`)
+	}
+	if mu.mutation.MutedCleared() && len(mu.mutation.MutedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.muted"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mu *MuteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MuteUpdate {
+	mu.modifiers = append(mu.modifiers, modifiers...)
+	return mu
+}
+
+func (mu *MuteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mute.Table, mute.Columns, sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(mu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MuteUpdateOne is the builder for updating a single Mute entity.
+type MuteUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MuteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the MuteMutation object of the builder.
+func (muo *MuteUpdateOne) Mutation() *MuteMutation {
+	return muo.mutation
+}
+
+// Where appends a list predicates to the MuteUpdate builder.
+func (muo *MuteUpdateOne) Where(ps ...predicate.Mute) *MuteUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MuteUpdateOne) Select(field string, fields ...string) *MuteUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Mute entity.
+func (muo *MuteUpdateOne) Save(ctx context.Context) (*Mute, error) {
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MuteUpdateOne) SaveX(ctx context.Context) *Mute {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MuteUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MuteUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MuteUpdateOne) check() error {
+	if muo.mutation.MuterCleared() && len(muo.mutation.MuterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.muter"`)
+	}
+	if muo.mutation.MutedCleared() && len(muo.mutation.MutedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.muted"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (muo *MuteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MuteUpdateOne {
+	muo.modifiers = append(muo.modifiers, modifiers...)
+	return muo
+}
+
+func (muo *MuteUpdateOne) sqlSave(ctx context.Context) (_node *Mute, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mute.Table, mute.Columns, sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Mute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mute.FieldID)
+		for _, f := range fields {
+			if !mute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(muo.modifiers...)
+	_node = &Mute{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
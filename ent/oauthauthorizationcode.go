@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthAuthorizationCode is the model entity for the OAuthAuthorizationCode schema.
+type OAuthAuthorizationCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// SHA-256 of the code
+	CodeHash string `json:"-"`
+	// RedirectURI holds the value of the "redirect_uri" field.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// PKCE challenge, always S256
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OAuthAuthorizationCodeQuery when eager-loading is set.
+	Edges                            OAuthAuthorizationCodeEdges `json:"edges"`
+	oauth_client_authorization_codes *int
+	user_oauth_authorization_codes   *int
+	selectValues                     sql.SelectValues
+}
+
+// OAuthAuthorizationCodeEdges holds the relations/edges for other nodes in the graph.
+type OAuthAuthorizationCodeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Client holds the value of the client edge.
+	Client *OAuthClient `json:"client,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthAuthorizationCodeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ClientOrErr returns the Client value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthAuthorizationCodeEdges) ClientOrErr() (*OAuthClient, error) {
+	if e.Client != nil {
+		return e.Client, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: oauthclient.Label}
+	}
+	return nil, &NotLoadedError{edge: "client"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthAuthorizationCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldScopes:
+			values[i] = new([]byte)
+		case oauthauthorizationcode.FieldID:
+			values[i] = new(sql.NullInt64)
+		case oauthauthorizationcode.FieldCodeHash, oauthauthorizationcode.FieldRedirectURI, oauthauthorizationcode.FieldCodeChallenge:
+			values[i] = new(sql.NullString)
+		case oauthauthorizationcode.FieldCreateTime, oauthauthorizationcode.FieldExpiresAt, oauthauthorizationcode.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		case oauthauthorizationcode.ForeignKeys[0]: // oauth_client_authorization_codes
+			values[i] = new(sql.NullInt64)
+		case oauthauthorizationcode.ForeignKeys[1]: // user_oauth_authorization_codes
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthAuthorizationCode fields.
+func (oac *OAuthAuthorizationCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oac.ID = int(value.Int64)
+		case oauthauthorizationcode.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				oac.CreateTime = value.Time
+			}
+		case oauthauthorizationcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				oac.CodeHash = value.String
+			}
+		case oauthauthorizationcode.FieldRedirectURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uri", values[i])
+			} else if value.Valid {
+				oac.RedirectURI = value.String
+			}
+		case oauthauthorizationcode.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oac.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthauthorizationcode.FieldCodeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge", values[i])
+			} else if value.Valid {
+				oac.CodeChallenge = value.String
+			}
+		case oauthauthorizationcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				oac.ExpiresAt = value.Time
+			}
+		case oauthauthorizationcode.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				oac.UsedAt = new(time.Time)
+				*oac.UsedAt = value.Time
+			}
+		case oauthauthorizationcode.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field oauth_client_authorization_codes", value)
+			} else if value.Valid {
+				oac.oauth_client_authorization_codes = new(int)
+				*oac.oauth_client_authorization_codes = int(value.Int64)
+			}
+		case oauthauthorizationcode.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_oauth_authorization_codes", value)
+			} else if value.Valid {
+				oac.user_oauth_authorization_codes = new(int)
+				*oac.user_oauth_authorization_codes = int(value.Int64)
+			}
+		default:
+			oac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthAuthorizationCode.
+// This includes values selected through modifiers, order, etc.
+func (oac *OAuthAuthorizationCode) Value(name string) (ent.Value, error) {
+	return oac.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the OAuthAuthorizationCode entity.
+func (oac *OAuthAuthorizationCode) QueryUser() *UserQuery {
+	return NewOAuthAuthorizationCodeClient(oac.config).QueryUser(oac)
+}
+
+// QueryClient queries the "client" edge of the OAuthAuthorizationCode entity.
+func (oac *OAuthAuthorizationCode) QueryClient() *OAuthClientQuery {
+	return NewOAuthAuthorizationCodeClient(oac.config).QueryClient(oac)
+}
+
+// Update returns a builder for updating this OAuthAuthorizationCode.
+// Note that you need to call OAuthAuthorizationCode.Unwrap() before calling this method if this OAuthAuthorizationCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oac *OAuthAuthorizationCode) Update() *OAuthAuthorizationCodeUpdateOne {
+	return NewOAuthAuthorizationCodeClient(oac.config).UpdateOne(oac)
+}
+
+// Unwrap unwraps the OAuthAuthorizationCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oac *OAuthAuthorizationCode) Unwrap() *OAuthAuthorizationCode {
+	_tx, ok := oac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthAuthorizationCode is not a transactional entity")
+	}
+	oac.config.driver = _tx.drv
+	return oac
+}
+
+// String implements the fmt.Stringer.
+func (oac *OAuthAuthorizationCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthAuthorizationCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oac.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(oac.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uri=")
+	builder.WriteString(oac.RedirectURI)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oac.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge=")
+	builder.WriteString(oac.CodeChallenge)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(oac.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := oac.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthAuthorizationCodes is a parsable slice of OAuthAuthorizationCode.
+type OAuthAuthorizationCodes []*OAuthAuthorizationCode
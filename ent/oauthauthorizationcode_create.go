@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthAuthorizationCodeCreate is the builder for creating a OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeCreate struct {
+	config
+	mutation *OAuthAuthorizationCodeMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetCreateTime(t time.Time) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetCreateTime(t)
+	return oacc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (oacc *OAuthAuthorizationCodeCreate) SetNillableCreateTime(t *time.Time) *OAuthAuthorizationCodeCreate {
+	if t != nil {
+		oacc.SetCreateTime(*t)
+	}
+	return oacc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetCodeHash(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeHash(s)
+	return oacc
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetRedirectURI(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetRedirectURI(s)
+	return oacc
+}
+
+// SetScopes sets the "scopes" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetScopes(s []string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetScopes(s)
+	return oacc
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetCodeChallenge(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeChallenge(s)
+	return oacc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetExpiresAt(t time.Time) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetExpiresAt(t)
+	return oacc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetUsedAt(t time.Time) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetUsedAt(t)
+	return oacc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (oacc *OAuthAuthorizationCodeCreate) SetNillableUsedAt(t *time.Time) *OAuthAuthorizationCodeCreate {
+	if t != nil {
+		oacc.SetUsedAt(*t)
+	}
+	return oacc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (oacc *OAuthAuthorizationCodeCreate) SetUserID(id int) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetUserID(id)
+	return oacc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (oacc *OAuthAuthorizationCodeCreate) SetUser(u *User) *OAuthAuthorizationCodeCreate {
+	return oacc.SetUserID(u.ID)
+}
+
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (oacc *OAuthAuthorizationCodeCreate) SetClientID(id int) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetClientID(id)
+	return oacc
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (oacc *OAuthAuthorizationCodeCreate) SetClient(o *OAuthClient) *OAuthAuthorizationCodeCreate {
+	return oacc.SetClientID(o.ID)
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (oacc *OAuthAuthorizationCodeCreate) Mutation() *OAuthAuthorizationCodeMutation {
+	return oacc.mutation
+}
+
+// Save creates the OAuthAuthorizationCode in the database.
+func (oacc *OAuthAuthorizationCodeCreate) Save(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	oacc.defaults()
+	return withHooks(ctx, oacc.sqlSave, oacc.mutation, oacc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oacc *OAuthAuthorizationCodeCreate) SaveX(ctx context.Context) *OAuthAuthorizationCode {
+	v, err := oacc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oacc *OAuthAuthorizationCodeCreate) Exec(ctx context.Context) error {
+	_, err := oacc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacc *OAuthAuthorizationCodeCreate) ExecX(ctx context.Context) {
+	if err := oacc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oacc *OAuthAuthorizationCodeCreate) defaults() {
+	if _, ok := oacc.mutation.CreateTime(); !ok {
+		v := oauthauthorizationcode.DefaultCreateTime()
+		oacc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oacc *OAuthAuthorizationCodeCreate) check() error {
+	if _, ok := oacc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.create_time"`)}
+	}
+	if _, ok := oacc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_hash"`)}
+	}
+	if v, ok := oacc.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.redirect_uri"`)}
+	}
+	if _, ok := oacc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.scopes"`)}
+	}
+	if _, ok := oacc.mutation.CodeChallenge(); !ok {
+		return &ValidationError{Name: "code_challenge", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_challenge"`)}
+	}
+	if v, ok := oacc.mutation.CodeChallenge(); ok {
+		if err := oauthauthorizationcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.expires_at"`)}
+	}
+	if len(oacc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OAuthAuthorizationCode.user"`)}
+	}
+	if len(oacc.mutation.ClientIDs()) == 0 {
+		return &ValidationError{Name: "client", err: errors.New(`ent: missing required edge "OAuthAuthorizationCode.client"`)}
+	}
+	return nil
+}
+
+func (oacc *OAuthAuthorizationCodeCreate) sqlSave(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	if err := oacc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oacc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oacc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	oacc.mutation.id = &_node.ID
+	oacc.mutation.done = true
+	return _node, nil
+}
+
+func (oacc *OAuthAuthorizationCodeCreate) createSpec() (*OAuthAuthorizationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthAuthorizationCode{config: oacc.config}
+		_spec = sqlgraph.NewCreateSpec(oauthauthorizationcode.Table, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	)
+	if value, ok := oacc.mutation.CreateTime(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := oacc.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := oacc.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := oacc.mutation.Scopes(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := oacc.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := oacc.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := oacc.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if nodes := oacc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_oauth_authorization_codes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oacc.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.ClientTable,
+			Columns: []string{oauthauthorizationcode.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.oauth_client_authorization_codes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OAuthAuthorizationCodeCreateBulk is the builder for creating many OAuthAuthorizationCode entities in bulk.
+type OAuthAuthorizationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthAuthorizationCodeCreate
+}
+
+// Save creates the OAuthAuthorizationCode entities in the database.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) Save(ctx context.Context) ([]*OAuthAuthorizationCode, error) {
+	if oaccb.err != nil {
+		return nil, oaccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oaccb.builders))
+	nodes := make([]*OAuthAuthorizationCode, len(oaccb.builders))
+	mutators := make([]Mutator, len(oaccb.builders))
+	for i := range oaccb.builders {
+		func(i int, root context.Context) {
+			builder := oaccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthAuthorizationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oaccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oaccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oaccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) SaveX(ctx context.Context) []*OAuthAuthorizationCode {
+	v, err := oaccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := oaccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := oaccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
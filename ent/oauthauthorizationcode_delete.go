@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/predicate"
+)
+
+// OAuthAuthorizationCodeDelete is the builder for deleting a OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *OAuthAuthorizationCodeMutation
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeDelete builder.
+func (oacd *OAuthAuthorizationCodeDelete) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeDelete {
+	oacd.mutation.Where(ps...)
+	return oacd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (oacd *OAuthAuthorizationCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, oacd.sqlExec, oacd.mutation, oacd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacd *OAuthAuthorizationCodeDelete) ExecX(ctx context.Context) int {
+	n, err := oacd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (oacd *OAuthAuthorizationCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(oauthauthorizationcode.Table, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	if ps := oacd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, oacd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	oacd.mutation.done = true
+	return affected, err
+}
+
+// OAuthAuthorizationCodeDeleteOne is the builder for deleting a single OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeDeleteOne struct {
+	oacd *OAuthAuthorizationCodeDelete
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeDelete builder.
+func (oacdo *OAuthAuthorizationCodeDeleteOne) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeDeleteOne {
+	oacdo.oacd.mutation.Where(ps...)
+	return oacdo
+}
+
+// Exec executes the deletion query.
+func (oacdo *OAuthAuthorizationCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := oacdo.oacd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oauthauthorizationcode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacdo *OAuthAuthorizationCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := oacdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
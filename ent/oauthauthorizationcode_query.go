@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthAuthorizationCodeQuery is the builder for querying OAuthAuthorizationCode entities.
+type OAuthAuthorizationCodeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []oauthauthorizationcode.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OAuthAuthorizationCode
+	withUser   *UserQuery
+	withClient *OAuthClientQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OAuthAuthorizationCodeQuery builder.
+func (oacq *OAuthAuthorizationCodeQuery) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeQuery {
+	oacq.predicates = append(oacq.predicates, ps...)
+	return oacq
+}
+
+// Limit the number of records to be returned by this query.
+func (oacq *OAuthAuthorizationCodeQuery) Limit(limit int) *OAuthAuthorizationCodeQuery {
+	oacq.ctx.Limit = &limit
+	return oacq
+}
+
+// Offset to start from.
+func (oacq *OAuthAuthorizationCodeQuery) Offset(offset int) *OAuthAuthorizationCodeQuery {
+	oacq.ctx.Offset = &offset
+	return oacq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oacq *OAuthAuthorizationCodeQuery) Unique(unique bool) *OAuthAuthorizationCodeQuery {
+	oacq.ctx.Unique = &unique
+	return oacq
+}
+
+// Order specifies how the records should be ordered.
+func (oacq *OAuthAuthorizationCodeQuery) Order(o ...oauthauthorizationcode.OrderOption) *OAuthAuthorizationCodeQuery {
+	oacq.order = append(oacq.order, o...)
+	return oacq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (oacq *OAuthAuthorizationCodeQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: oacq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oacq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oacq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthauthorizationcode.UserTable, oauthauthorizationcode.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oacq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryClient chains the current query on the "client" edge.
+func (oacq *OAuthAuthorizationCodeQuery) QueryClient() *OAuthClientQuery {
+	query := (&OAuthClientClient{config: oacq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oacq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oacq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID, selector),
+			sqlgraph.To(oauthclient.Table, oauthclient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthauthorizationcode.ClientTable, oauthauthorizationcode.ClientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oacq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OAuthAuthorizationCode entity from the query.
+// Returns a *NotFoundError when no OAuthAuthorizationCode was found.
+func (oacq *OAuthAuthorizationCodeQuery) First(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	nodes, err := oacq.Limit(1).All(setContextOp(ctx, oacq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oauthauthorizationcode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) FirstX(ctx context.Context) *OAuthAuthorizationCode {
+	node, err := oacq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OAuthAuthorizationCode ID from the query.
+// Returns a *NotFoundError when no OAuthAuthorizationCode ID was found.
+func (oacq *OAuthAuthorizationCodeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oacq.Limit(1).IDs(setContextOp(ctx, oacq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oauthauthorizationcode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) FirstIDX(ctx context.Context) int {
+	id, err := oacq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OAuthAuthorizationCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OAuthAuthorizationCode entity is found.
+// Returns a *NotFoundError when no OAuthAuthorizationCode entities are found.
+func (oacq *OAuthAuthorizationCodeQuery) Only(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	nodes, err := oacq.Limit(2).All(setContextOp(ctx, oacq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oauthauthorizationcode.Label}
+	default:
+		return nil, &NotSingularError{oauthauthorizationcode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) OnlyX(ctx context.Context) *OAuthAuthorizationCode {
+	node, err := oacq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OAuthAuthorizationCode ID in the query.
+// Returns a *NotSingularError when more than one OAuthAuthorizationCode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oacq *OAuthAuthorizationCodeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oacq.Limit(2).IDs(setContextOp(ctx, oacq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oauthauthorizationcode.Label}
+	default:
+		err = &NotSingularError{oauthauthorizationcode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := oacq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OAuthAuthorizationCodes.
+func (oacq *OAuthAuthorizationCodeQuery) All(ctx context.Context) ([]*OAuthAuthorizationCode, error) {
+	ctx = setContextOp(ctx, oacq.ctx, ent.OpQueryAll)
+	if err := oacq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OAuthAuthorizationCode, *OAuthAuthorizationCodeQuery]()
+	return withInterceptors[[]*OAuthAuthorizationCode](ctx, oacq, qr, oacq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) AllX(ctx context.Context) []*OAuthAuthorizationCode {
+	nodes, err := oacq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OAuthAuthorizationCode IDs.
+func (oacq *OAuthAuthorizationCodeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if oacq.ctx.Unique == nil && oacq.path != nil {
+		oacq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oacq.ctx, ent.OpQueryIDs)
+	if err = oacq.Select(oauthauthorizationcode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) IDsX(ctx context.Context) []int {
+	ids, err := oacq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oacq *OAuthAuthorizationCodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oacq.ctx, ent.OpQueryCount)
+	if err := oacq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oacq, querierCount[*OAuthAuthorizationCodeQuery](), oacq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) CountX(ctx context.Context) int {
+	count, err := oacq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oacq *OAuthAuthorizationCodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oacq.ctx, ent.OpQueryExist)
+	switch _, err := oacq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oacq *OAuthAuthorizationCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := oacq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OAuthAuthorizationCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oacq *OAuthAuthorizationCodeQuery) Clone() *OAuthAuthorizationCodeQuery {
+	if oacq == nil {
+		return nil
+	}
+	return &OAuthAuthorizationCodeQuery{
+		config:     oacq.config,
+		ctx:        oacq.ctx.Clone(),
+		order:      append([]oauthauthorizationcode.OrderOption{}, oacq.order...),
+		inters:     append([]Interceptor{}, oacq.inters...),
+		predicates: append([]predicate.OAuthAuthorizationCode{}, oacq.predicates...),
+		withUser:   oacq.withUser.Clone(),
+		withClient: oacq.withClient.Clone(),
+		// clone intermediate query.
+		sql:       oacq.sql.Clone(),
+		path:      oacq.path,
+		modifiers: append([]func(*sql.Selector){}, oacq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (oacq *OAuthAuthorizationCodeQuery) WithUser(opts ...func(*UserQuery)) *OAuthAuthorizationCodeQuery {
+	query := (&UserClient{config: oacq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oacq.withUser = query
+	return oacq
+}
+
+// WithClient tells the query-builder to eager-load the nodes that are connected to
+// the "client" edge. The optional arguments are used to configure the query builder of the edge.
+func (oacq *OAuthAuthorizationCodeQuery) WithClient(opts ...func(*OAuthClientQuery)) *OAuthAuthorizationCodeQuery {
+	query := (&OAuthClientClient{config: oacq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oacq.withClient = query
+	return oacq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OAuthAuthorizationCode.Query().
+//		GroupBy(oauthauthorizationcode.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oacq *OAuthAuthorizationCodeQuery) GroupBy(field string, fields ...string) *OAuthAuthorizationCodeGroupBy {
+	oacq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OAuthAuthorizationCodeGroupBy{build: oacq}
+	grbuild.flds = &oacq.ctx.Fields
+	grbuild.label = oauthauthorizationcode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.OAuthAuthorizationCode.Query().
+//		Select(oauthauthorizationcode.FieldCreateTime).
+//		Scan(ctx, &v)
+func (oacq *OAuthAuthorizationCodeQuery) Select(fields ...string) *OAuthAuthorizationCodeSelect {
+	oacq.ctx.Fields = append(oacq.ctx.Fields, fields...)
+	sbuild := &OAuthAuthorizationCodeSelect{OAuthAuthorizationCodeQuery: oacq}
+	sbuild.label = oauthauthorizationcode.Label
+	sbuild.flds, sbuild.scan = &oacq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OAuthAuthorizationCodeSelect configured with the given aggregations.
+func (oacq *OAuthAuthorizationCodeQuery) Aggregate(fns ...AggregateFunc) *OAuthAuthorizationCodeSelect {
+	return oacq.Select().Aggregate(fns...)
+}
+
+func (oacq *OAuthAuthorizationCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oacq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oacq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oacq.ctx.Fields {
+		if !oauthauthorizationcode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if oacq.path != nil {
+		prev, err := oacq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oacq.sql = prev
+	}
+	return nil
+}
+
+func (oacq *OAuthAuthorizationCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OAuthAuthorizationCode, error) {
+	var (
+		nodes       = []*OAuthAuthorizationCode{}
+		withFKs     = oacq.withFKs
+		_spec       = oacq.querySpec()
+		loadedTypes = [2]bool{
+			oacq.withUser != nil,
+			oacq.withClient != nil,
+		}
+	)
+	if oacq.withUser != nil || oacq.withClient != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthauthorizationcode.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OAuthAuthorizationCode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OAuthAuthorizationCode{config: oacq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(oacq.modifiers) > 0 {
+		_spec.Modifiers = oacq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oacq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := oacq.withUser; query != nil {
+		if err := oacq.loadUser(ctx, query, nodes, nil,
+			func(n *OAuthAuthorizationCode, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oacq.withClient; query != nil {
+		if err := oacq.loadClient(ctx, query, nodes, nil,
+			func(n *OAuthAuthorizationCode, e *OAuthClient) { n.Edges.Client = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oacq *OAuthAuthorizationCodeQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*OAuthAuthorizationCode, init func(*OAuthAuthorizationCode), assign func(*OAuthAuthorizationCode, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OAuthAuthorizationCode)
+	for i := range nodes {
+		if nodes[i].user_oauth_authorization_codes == nil {
+			continue
+		}
+		fk := *nodes[i].user_oauth_authorization_codes
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_oauth_authorization_codes" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (oacq *OAuthAuthorizationCodeQuery) loadClient(ctx context.Context, query *OAuthClientQuery, nodes []*OAuthAuthorizationCode, init func(*OAuthAuthorizationCode), assign func(*OAuthAuthorizationCode, *OAuthClient)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OAuthAuthorizationCode)
+	for i := range nodes {
+		if nodes[i].oauth_client_authorization_codes == nil {
+			continue
+		}
+		fk := *nodes[i].oauth_client_authorization_codes
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(oauthclient.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "oauth_client_authorization_codes" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (oacq *OAuthAuthorizationCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oacq.querySpec()
+	if len(oacq.modifiers) > 0 {
+		_spec.Modifiers = oacq.modifiers
+	}
+	_spec.Node.Columns = oacq.ctx.Fields
+	if len(oacq.ctx.Fields) > 0 {
+		_spec.Unique = oacq.ctx.Unique != nil && *oacq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oacq.driver, _spec)
+}
+
+func (oacq *OAuthAuthorizationCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	_spec.From = oacq.sql
+	if unique := oacq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oacq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oacq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthauthorizationcode.FieldID)
+		for i := range fields {
+			if fields[i] != oauthauthorizationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := oacq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oacq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oacq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oacq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oacq *OAuthAuthorizationCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oacq.driver.Dialect())
+	t1 := builder.Table(oauthauthorizationcode.Table)
+	columns := oacq.ctx.Fields
+	if len(columns) == 0 {
+		columns = oauthauthorizationcode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oacq.sql != nil {
+		selector = oacq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oacq.ctx.Unique != nil && *oacq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range oacq.modifiers {
+		m(selector)
+	}
+	for _, p := range oacq.predicates {
+		p(selector)
+	}
+	for _, p := range oacq.order {
+		p(selector)
+	}
+	if offset := oacq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oacq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oacq *OAuthAuthorizationCodeQuery) ForUpdate(opts ...sql.LockOption) *OAuthAuthorizationCodeQuery {
+	if oacq.driver.Dialect() == dialect.Postgres {
+		oacq.Unique(false)
+	}
+	oacq.modifiers = append(oacq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oacq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oacq *OAuthAuthorizationCodeQuery) ForShare(opts ...sql.LockOption) *OAuthAuthorizationCodeQuery {
+	if oacq.driver.Dialect() == dialect.Postgres {
+		oacq.Unique(false)
+	}
+	oacq.modifiers = append(oacq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oacq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (oacq *OAuthAuthorizationCodeQuery) Modify(modifiers ...func(s *sql.Selector)) *OAuthAuthorizationCodeSelect {
+	oacq.modifiers = append(oacq.modifiers, modifiers...)
+	return oacq.Select()
+}
+
+// OAuthAuthorizationCodeGroupBy is the group-by builder for OAuthAuthorizationCode entities.
+type OAuthAuthorizationCodeGroupBy struct {
+	selector
+	build *OAuthAuthorizationCodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (oacgb *OAuthAuthorizationCodeGroupBy) Aggregate(fns ...AggregateFunc) *OAuthAuthorizationCodeGroupBy {
+	oacgb.fns = append(oacgb.fns, fns...)
+	return oacgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oacgb *OAuthAuthorizationCodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oacgb.build.ctx, ent.OpQueryGroupBy)
+	if err := oacgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OAuthAuthorizationCodeQuery, *OAuthAuthorizationCodeGroupBy](ctx, oacgb.build, oacgb, oacgb.build.inters, v)
+}
+
+func (oacgb *OAuthAuthorizationCodeGroupBy) sqlScan(ctx context.Context, root *OAuthAuthorizationCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(oacgb.fns))
+	for _, fn := range oacgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*oacgb.flds)+len(oacgb.fns))
+		for _, f := range *oacgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*oacgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oacgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OAuthAuthorizationCodeSelect is the builder for selecting fields of OAuthAuthorizationCode entities.
+type OAuthAuthorizationCodeSelect struct {
+	*OAuthAuthorizationCodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oacs *OAuthAuthorizationCodeSelect) Aggregate(fns ...AggregateFunc) *OAuthAuthorizationCodeSelect {
+	oacs.fns = append(oacs.fns, fns...)
+	return oacs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oacs *OAuthAuthorizationCodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oacs.ctx, ent.OpQuerySelect)
+	if err := oacs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OAuthAuthorizationCodeQuery, *OAuthAuthorizationCodeSelect](ctx, oacs.OAuthAuthorizationCodeQuery, oacs, oacs.inters, v)
+}
+
+func (oacs *OAuthAuthorizationCodeSelect) sqlScan(ctx context.Context, root *OAuthAuthorizationCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oacs.fns))
+	for _, fn := range oacs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oacs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oacs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (oacs *OAuthAuthorizationCodeSelect) Modify(modifiers ...func(s *sql.Selector)) *OAuthAuthorizationCodeSelect {
+	oacs.modifiers = append(oacs.modifiers, modifiers...)
+	return oacs
+}
@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthAuthorizationCodeUpdate is the builder for updating OAuthAuthorizationCode entities.
+type OAuthAuthorizationCodeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OAuthAuthorizationCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeUpdate builder.
+func (oacu *OAuthAuthorizationCodeUpdate) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.Where(ps...)
+	return oacu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetUsedAt(t time.Time) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetUsedAt(t)
+	return oacu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableUsedAt(t *time.Time) *OAuthAuthorizationCodeUpdate {
+	if t != nil {
+		oacu.SetUsedAt(*t)
+	}
+	return oacu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (oacu *OAuthAuthorizationCodeUpdate) ClearUsedAt() *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.ClearUsedAt()
+	return oacu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (oacu *OAuthAuthorizationCodeUpdate) SetUserID(id int) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetUserID(id)
+	return oacu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (oacu *OAuthAuthorizationCodeUpdate) SetUser(u *User) *OAuthAuthorizationCodeUpdate {
+	return oacu.SetUserID(u.ID)
+}
+
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (oacu *OAuthAuthorizationCodeUpdate) SetClientID(id int) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetClientID(id)
+	return oacu
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (oacu *OAuthAuthorizationCodeUpdate) SetClient(o *OAuthClient) *OAuthAuthorizationCodeUpdate {
+	return oacu.SetClientID(o.ID)
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (oacu *OAuthAuthorizationCodeUpdate) Mutation() *OAuthAuthorizationCodeMutation {
+	return oacu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (oacu *OAuthAuthorizationCodeUpdate) ClearUser() *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.ClearUser()
+	return oacu
+}
+
+// ClearClient clears the "client" edge to the OAuthClient entity.
+func (oacu *OAuthAuthorizationCodeUpdate) ClearClient() *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.ClearClient()
+	return oacu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oacu *OAuthAuthorizationCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oacu.sqlSave, oacu.mutation, oacu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oacu *OAuthAuthorizationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := oacu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oacu *OAuthAuthorizationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := oacu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacu *OAuthAuthorizationCodeUpdate) ExecX(ctx context.Context) {
+	if err := oacu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oacu *OAuthAuthorizationCodeUpdate) check() error {
+	if oacu.mutation.UserCleared() && len(oacu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.user"`)
+	}
+	if oacu.mutation.ClientCleared() && len(oacu.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.client"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (oacu *OAuthAuthorizationCodeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthAuthorizationCodeUpdate {
+	oacu.modifiers = append(oacu.modifiers, modifiers...)
+	return oacu
+}
+
+func (oacu *OAuthAuthorizationCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oacu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	if ps := oacu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oacu.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if oacu.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	if oacu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oacu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oacu.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.ClientTable,
+			Columns: []string{oauthauthorizationcode.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oacu.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.ClientTable,
+			Columns: []string{oauthauthorizationcode.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(oacu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, oacu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oacu.mutation.done = true
+	return n, nil
+}
+
+// OAuthAuthorizationCodeUpdateOne is the builder for updating a single OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OAuthAuthorizationCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUsedAt sets the "used_at" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetUsedAt(t time.Time) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetUsedAt(t)
+	return oacuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableUsedAt(t *time.Time) *OAuthAuthorizationCodeUpdateOne {
+	if t != nil {
+		oacuo.SetUsedAt(*t)
+	}
+	return oacuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) ClearUsedAt() *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.ClearUsedAt()
+	return oacuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetUserID(id int) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetUserID(id)
+	return oacuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetUser(u *User) *OAuthAuthorizationCodeUpdateOne {
+	return oacuo.SetUserID(u.ID)
+}
+
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetClientID(id int) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetClientID(id)
+	return oacuo
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetClient(o *OAuthClient) *OAuthAuthorizationCodeUpdateOne {
+	return oacuo.SetClientID(o.ID)
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Mutation() *OAuthAuthorizationCodeMutation {
+	return oacuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) ClearUser() *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.ClearUser()
+	return oacuo
+}
+
+// ClearClient clears the "client" edge to the OAuthClient entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) ClearClient() *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.ClearClient()
+	return oacuo
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeUpdate builder.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.Where(ps...)
+	return oacuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Select(field string, fields ...string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.fields = append([]string{field}, fields...)
+	return oacuo
+}
+
+// Save executes the query and returns the updated OAuthAuthorizationCode entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Save(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	return withHooks(ctx, oacuo.sqlSave, oacuo.mutation, oacuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SaveX(ctx context.Context) *OAuthAuthorizationCode {
+	node, err := oacuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := oacuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := oacuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) check() error {
+	if oacuo.mutation.UserCleared() && len(oacuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.user"`)
+	}
+	if oacuo.mutation.ClientCleared() && len(oacuo.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.client"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.modifiers = append(oacuo.modifiers, modifiers...)
+	return oacuo
+}
+
+func (oacuo *OAuthAuthorizationCodeUpdateOne) sqlSave(ctx context.Context) (_node *OAuthAuthorizationCode, err error) {
+	if err := oacuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	id, ok := oacuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthAuthorizationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oacuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthauthorizationcode.FieldID)
+		for _, f := range fields {
+			if !oauthauthorizationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthauthorizationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oacuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oacuo.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if oacuo.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	if oacuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oacuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oacuo.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.ClientTable,
+			Columns: []string{oauthauthorizationcode.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oacuo.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.ClientTable,
+			Columns: []string{oauthauthorizationcode.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(oacuo.modifiers...)
+	_node = &OAuthAuthorizationCode{config: oacuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oacuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oacuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/session"
+)
+
+// OAuthClientCreate is the builder for creating a OAuthClient entity.
+type OAuthClientCreate struct {
+	config
+	mutation *OAuthClientMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (occ *OAuthClientCreate) SetCreateTime(t time.Time) *OAuthClientCreate {
+	occ.mutation.SetCreateTime(t)
+	return occ
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableCreateTime(t *time.Time) *OAuthClientCreate {
+	if t != nil {
+		occ.SetCreateTime(*t)
+	}
+	return occ
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (occ *OAuthClientCreate) SetUpdateTime(t time.Time) *OAuthClientCreate {
+	occ.mutation.SetUpdateTime(t)
+	return occ
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableUpdateTime(t *time.Time) *OAuthClientCreate {
+	if t != nil {
+		occ.SetUpdateTime(*t)
+	}
+	return occ
+}
+
+// SetClientID sets the "client_id" field.
+func (occ *OAuthClientCreate) SetClientID(s string) *OAuthClientCreate {
+	occ.mutation.SetClientID(s)
+	return occ
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableClientID(s *string) *OAuthClientCreate {
+	if s != nil {
+		occ.SetClientID(*s)
+	}
+	return occ
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (occ *OAuthClientCreate) SetClientSecretHash(s string) *OAuthClientCreate {
+	occ.mutation.SetClientSecretHash(s)
+	return occ
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableClientSecretHash(s *string) *OAuthClientCreate {
+	if s != nil {
+		occ.SetClientSecretHash(*s)
+	}
+	return occ
+}
+
+// SetName sets the "name" field.
+func (occ *OAuthClientCreate) SetName(s string) *OAuthClientCreate {
+	occ.mutation.SetName(s)
+	return occ
+}
+
+// SetHomepageURL sets the "homepage_url" field.
+func (occ *OAuthClientCreate) SetHomepageURL(s string) *OAuthClientCreate {
+	occ.mutation.SetHomepageURL(s)
+	return occ
+}
+
+// SetNillableHomepageURL sets the "homepage_url" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableHomepageURL(s *string) *OAuthClientCreate {
+	if s != nil {
+		occ.SetHomepageURL(*s)
+	}
+	return occ
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (occ *OAuthClientCreate) SetRedirectUris(s []string) *OAuthClientCreate {
+	occ.mutation.SetRedirectUris(s)
+	return occ
+}
+
+// SetScopes sets the "scopes" field.
+func (occ *OAuthClientCreate) SetScopes(s []string) *OAuthClientCreate {
+	occ.mutation.SetScopes(s)
+	return occ
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (occ *OAuthClientCreate) SetDisabledAt(t time.Time) *OAuthClientCreate {
+	occ.mutation.SetDisabledAt(t)
+	return occ
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableDisabledAt(t *time.Time) *OAuthClientCreate {
+	if t != nil {
+		occ.SetDisabledAt(*t)
+	}
+	return occ
+}
+
+// AddConsentIDs adds the "consents" edge to the OAuthConsent entity by IDs.
+func (occ *OAuthClientCreate) AddConsentIDs(ids ...int) *OAuthClientCreate {
+	occ.mutation.AddConsentIDs(ids...)
+	return occ
+}
+
+// AddConsents adds the "consents" edges to the OAuthConsent entity.
+func (occ *OAuthClientCreate) AddConsents(o ...*OAuthConsent) *OAuthClientCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return occ.AddConsentIDs(ids...)
+}
+
+// AddAuthorizationCodeIDs adds the "authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (occ *OAuthClientCreate) AddAuthorizationCodeIDs(ids ...int) *OAuthClientCreate {
+	occ.mutation.AddAuthorizationCodeIDs(ids...)
+	return occ
+}
+
+// AddAuthorizationCodes adds the "authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (occ *OAuthClientCreate) AddAuthorizationCodes(o ...*OAuthAuthorizationCode) *OAuthClientCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return occ.AddAuthorizationCodeIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (occ *OAuthClientCreate) AddSessionIDs(ids ...int) *OAuthClientCreate {
+	occ.mutation.AddSessionIDs(ids...)
+	return occ
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (occ *OAuthClientCreate) AddSessions(s ...*Session) *OAuthClientCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return occ.AddSessionIDs(ids...)
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (occ *OAuthClientCreate) Mutation() *OAuthClientMutation {
+	return occ.mutation
+}
+
+// Save creates the OAuthClient in the database.
+func (occ *OAuthClientCreate) Save(ctx context.Context) (*OAuthClient, error) {
+	occ.defaults()
+	return withHooks(ctx, occ.sqlSave, occ.mutation, occ.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OAuthClientCreate) SaveX(ctx context.Context) *OAuthClient {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OAuthClientCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OAuthClientCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OAuthClientCreate) defaults() {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		v := oauthclient.DefaultCreateTime()
+		occ.mutation.SetCreateTime(v)
+	}
+	if _, ok := occ.mutation.UpdateTime(); !ok {
+		v := oauthclient.DefaultUpdateTime()
+		occ.mutation.SetUpdateTime(v)
+	}
+	if _, ok := occ.mutation.ClientID(); !ok {
+		v := oauthclient.DefaultClientID()
+		occ.mutation.SetClientID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OAuthClientCreate) check() error {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OAuthClient.create_time"`)}
+	}
+	if _, ok := occ.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OAuthClient.update_time"`)}
+	}
+	if _, ok := occ.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OAuthClient.client_id"`)}
+	}
+	if v, ok := occ.mutation.ClientID(); ok {
+		if err := oauthclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.client_id": %w`, err)}
+		}
+	}
+	if _, ok := occ.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OAuthClient.name"`)}
+	}
+	if v, ok := occ.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := occ.mutation.RedirectUris(); !ok {
+		return &ValidationError{Name: "redirect_uris", err: errors.New(`ent: missing required field "OAuthClient.redirect_uris"`)}
+	}
+	if _, ok := occ.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "OAuthClient.scopes"`)}
+	}
+	return nil
+}
+
+func (occ *OAuthClientCreate) sqlSave(ctx context.Context) (*OAuthClient, error) {
+	if err := occ.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	occ.mutation.id = &_node.ID
+	occ.mutation.done = true
+	return _node, nil
+}
+
+func (occ *OAuthClientCreate) createSpec() (*OAuthClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthClient{config: occ.config}
+		_spec = sqlgraph.NewCreateSpec(oauthclient.Table, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt))
+	)
+	if value, ok := occ.mutation.CreateTime(); ok {
+		_spec.SetField(oauthclient.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := occ.mutation.UpdateTime(); ok {
+		_spec.SetField(oauthclient.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := occ.mutation.ClientID(); ok {
+		_spec.SetField(oauthclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := occ.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = value
+	}
+	if value, ok := occ.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := occ.mutation.HomepageURL(); ok {
+		_spec.SetField(oauthclient.FieldHomepageURL, field.TypeString, value)
+		_node.HomepageURL = value
+	}
+	if value, ok := occ.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := occ.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := occ.mutation.DisabledAt(); ok {
+		_spec.SetField(oauthclient.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
+	if nodes := occ.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.ConsentsTable,
+			Columns: []string{oauthclient.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := occ.mutation.AuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.AuthorizationCodesTable,
+			Columns: []string{oauthclient.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := occ.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.SessionsTable,
+			Columns: []string{oauthclient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OAuthClientCreateBulk is the builder for creating many OAuthClient entities in bulk.
+type OAuthClientCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthClientCreate
+}
+
+// Save creates the OAuthClient entities in the database.
+func (occb *OAuthClientCreateBulk) Save(ctx context.Context) ([]*OAuthClient, error) {
+	if occb.err != nil {
+		return nil, occb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OAuthClient, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OAuthClientCreateBulk) SaveX(ctx context.Context) []*OAuthClient {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OAuthClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OAuthClientCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
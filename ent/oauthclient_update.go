@@ -0,0 +1,1014 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/session"
+)
+
+// OAuthClientUpdate is the builder for updating OAuthClient entities.
+type OAuthClientUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OAuthClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (ocu *OAuthClientUpdate) Where(ps ...predicate.OAuthClient) *OAuthClientUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocu *OAuthClientUpdate) SetUpdateTime(t time.Time) *OAuthClientUpdate {
+	ocu.mutation.SetUpdateTime(t)
+	return ocu
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (ocu *OAuthClientUpdate) SetClientSecretHash(s string) *OAuthClientUpdate {
+	ocu.mutation.SetClientSecretHash(s)
+	return ocu
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableClientSecretHash(s *string) *OAuthClientUpdate {
+	if s != nil {
+		ocu.SetClientSecretHash(*s)
+	}
+	return ocu
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (ocu *OAuthClientUpdate) ClearClientSecretHash() *OAuthClientUpdate {
+	ocu.mutation.ClearClientSecretHash()
+	return ocu
+}
+
+// SetName sets the "name" field.
+func (ocu *OAuthClientUpdate) SetName(s string) *OAuthClientUpdate {
+	ocu.mutation.SetName(s)
+	return ocu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableName(s *string) *OAuthClientUpdate {
+	if s != nil {
+		ocu.SetName(*s)
+	}
+	return ocu
+}
+
+// SetHomepageURL sets the "homepage_url" field.
+func (ocu *OAuthClientUpdate) SetHomepageURL(s string) *OAuthClientUpdate {
+	ocu.mutation.SetHomepageURL(s)
+	return ocu
+}
+
+// SetNillableHomepageURL sets the "homepage_url" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableHomepageURL(s *string) *OAuthClientUpdate {
+	if s != nil {
+		ocu.SetHomepageURL(*s)
+	}
+	return ocu
+}
+
+// ClearHomepageURL clears the value of the "homepage_url" field.
+func (ocu *OAuthClientUpdate) ClearHomepageURL() *OAuthClientUpdate {
+	ocu.mutation.ClearHomepageURL()
+	return ocu
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocu *OAuthClientUpdate) SetRedirectUris(s []string) *OAuthClientUpdate {
+	ocu.mutation.SetRedirectUris(s)
+	return ocu
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocu *OAuthClientUpdate) AppendRedirectUris(s []string) *OAuthClientUpdate {
+	ocu.mutation.AppendRedirectUris(s)
+	return ocu
+}
+
+// SetScopes sets the "scopes" field.
+func (ocu *OAuthClientUpdate) SetScopes(s []string) *OAuthClientUpdate {
+	ocu.mutation.SetScopes(s)
+	return ocu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocu *OAuthClientUpdate) AppendScopes(s []string) *OAuthClientUpdate {
+	ocu.mutation.AppendScopes(s)
+	return ocu
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (ocu *OAuthClientUpdate) SetDisabledAt(t time.Time) *OAuthClientUpdate {
+	ocu.mutation.SetDisabledAt(t)
+	return ocu
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableDisabledAt(t *time.Time) *OAuthClientUpdate {
+	if t != nil {
+		ocu.SetDisabledAt(*t)
+	}
+	return ocu
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (ocu *OAuthClientUpdate) ClearDisabledAt() *OAuthClientUpdate {
+	ocu.mutation.ClearDisabledAt()
+	return ocu
+}
+
+// AddConsentIDs adds the "consents" edge to the OAuthConsent entity by IDs.
+func (ocu *OAuthClientUpdate) AddConsentIDs(ids ...int) *OAuthClientUpdate {
+	ocu.mutation.AddConsentIDs(ids...)
+	return ocu
+}
+
+// AddConsents adds the "consents" edges to the OAuthConsent entity.
+func (ocu *OAuthClientUpdate) AddConsents(o ...*OAuthConsent) *OAuthClientUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocu.AddConsentIDs(ids...)
+}
+
+// AddAuthorizationCodeIDs adds the "authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (ocu *OAuthClientUpdate) AddAuthorizationCodeIDs(ids ...int) *OAuthClientUpdate {
+	ocu.mutation.AddAuthorizationCodeIDs(ids...)
+	return ocu
+}
+
+// AddAuthorizationCodes adds the "authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (ocu *OAuthClientUpdate) AddAuthorizationCodes(o ...*OAuthAuthorizationCode) *OAuthClientUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocu.AddAuthorizationCodeIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (ocu *OAuthClientUpdate) AddSessionIDs(ids ...int) *OAuthClientUpdate {
+	ocu.mutation.AddSessionIDs(ids...)
+	return ocu
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (ocu *OAuthClientUpdate) AddSessions(s ...*Session) *OAuthClientUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ocu.AddSessionIDs(ids...)
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (ocu *OAuthClientUpdate) Mutation() *OAuthClientMutation {
+	return ocu.mutation
+}
+
+// ClearConsents clears all "consents" edges to the OAuthConsent entity.
+func (ocu *OAuthClientUpdate) ClearConsents() *OAuthClientUpdate {
+	ocu.mutation.ClearConsents()
+	return ocu
+}
+
+// RemoveConsentIDs removes the "consents" edge to OAuthConsent entities by IDs.
+func (ocu *OAuthClientUpdate) RemoveConsentIDs(ids ...int) *OAuthClientUpdate {
+	ocu.mutation.RemoveConsentIDs(ids...)
+	return ocu
+}
+
+// RemoveConsents removes "consents" edges to OAuthConsent entities.
+func (ocu *OAuthClientUpdate) RemoveConsents(o ...*OAuthConsent) *OAuthClientUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocu.RemoveConsentIDs(ids...)
+}
+
+// ClearAuthorizationCodes clears all "authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (ocu *OAuthClientUpdate) ClearAuthorizationCodes() *OAuthClientUpdate {
+	ocu.mutation.ClearAuthorizationCodes()
+	return ocu
+}
+
+// RemoveAuthorizationCodeIDs removes the "authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (ocu *OAuthClientUpdate) RemoveAuthorizationCodeIDs(ids ...int) *OAuthClientUpdate {
+	ocu.mutation.RemoveAuthorizationCodeIDs(ids...)
+	return ocu
+}
+
+// RemoveAuthorizationCodes removes "authorization_codes" edges to OAuthAuthorizationCode entities.
+func (ocu *OAuthClientUpdate) RemoveAuthorizationCodes(o ...*OAuthAuthorizationCode) *OAuthClientUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocu.RemoveAuthorizationCodeIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (ocu *OAuthClientUpdate) ClearSessions() *OAuthClientUpdate {
+	ocu.mutation.ClearSessions()
+	return ocu
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (ocu *OAuthClientUpdate) RemoveSessionIDs(ids ...int) *OAuthClientUpdate {
+	ocu.mutation.RemoveSessionIDs(ids...)
+	return ocu
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (ocu *OAuthClientUpdate) RemoveSessions(s ...*Session) *OAuthClientUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ocu.RemoveSessionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OAuthClientUpdate) Save(ctx context.Context) (int, error) {
+	ocu.defaults()
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OAuthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OAuthClientUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OAuthClientUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocu *OAuthClientUpdate) defaults() {
+	if _, ok := ocu.mutation.UpdateTime(); !ok {
+		v := oauthclient.UpdateDefaultUpdateTime()
+		ocu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OAuthClientUpdate) check() error {
+	if v, ok := ocu.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ocu *OAuthClientUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthClientUpdate {
+	ocu.modifiers = append(ocu.modifiers, modifiers...)
+	return ocu
+}
+
+func (ocu *OAuthClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ocu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt))
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.UpdateTime(); ok {
+		_spec.SetField(oauthclient.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocu.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if ocu.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := ocu.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocu.mutation.HomepageURL(); ok {
+		_spec.SetField(oauthclient.FieldHomepageURL, field.TypeString, value)
+	}
+	if ocu.mutation.HomepageURLCleared() {
+		_spec.ClearField(oauthclient.FieldHomepageURL, field.TypeString)
+	}
+	if value, ok := ocu.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := ocu.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if value, ok := ocu.mutation.DisabledAt(); ok {
+		_spec.SetField(oauthclient.FieldDisabledAt, field.TypeTime, value)
+	}
+	if ocu.mutation.DisabledAtCleared() {
+		_spec.ClearField(oauthclient.FieldDisabledAt, field.TypeTime)
+	}
+	if ocu.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.ConsentsTable,
+			Columns: []string{oauthclient.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.RemovedConsentsIDs(); len(nodes) > 0 && !ocu.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.ConsentsTable,
+			Columns: []string{oauthclient.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.ConsentsTable,
+			Columns: []string{oauthclient.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ocu.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.AuthorizationCodesTable,
+			Columns: []string{oauthclient.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.RemovedAuthorizationCodesIDs(); len(nodes) > 0 && !ocu.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.AuthorizationCodesTable,
+			Columns: []string{oauthclient.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.AuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.AuthorizationCodesTable,
+			Columns: []string{oauthclient.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ocu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.SessionsTable,
+			Columns: []string{oauthclient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !ocu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.SessionsTable,
+			Columns: []string{oauthclient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.SessionsTable,
+			Columns: []string{oauthclient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ocu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocu.mutation.done = true
+	return n, nil
+}
+
+// OAuthClientUpdateOne is the builder for updating a single OAuthClient entity.
+type OAuthClientUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OAuthClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocuo *OAuthClientUpdateOne) SetUpdateTime(t time.Time) *OAuthClientUpdateOne {
+	ocuo.mutation.SetUpdateTime(t)
+	return ocuo
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (ocuo *OAuthClientUpdateOne) SetClientSecretHash(s string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetClientSecretHash(s)
+	return ocuo
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableClientSecretHash(s *string) *OAuthClientUpdateOne {
+	if s != nil {
+		ocuo.SetClientSecretHash(*s)
+	}
+	return ocuo
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (ocuo *OAuthClientUpdateOne) ClearClientSecretHash() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearClientSecretHash()
+	return ocuo
+}
+
+// SetName sets the "name" field.
+func (ocuo *OAuthClientUpdateOne) SetName(s string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetName(s)
+	return ocuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableName(s *string) *OAuthClientUpdateOne {
+	if s != nil {
+		ocuo.SetName(*s)
+	}
+	return ocuo
+}
+
+// SetHomepageURL sets the "homepage_url" field.
+func (ocuo *OAuthClientUpdateOne) SetHomepageURL(s string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetHomepageURL(s)
+	return ocuo
+}
+
+// SetNillableHomepageURL sets the "homepage_url" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableHomepageURL(s *string) *OAuthClientUpdateOne {
+	if s != nil {
+		ocuo.SetHomepageURL(*s)
+	}
+	return ocuo
+}
+
+// ClearHomepageURL clears the value of the "homepage_url" field.
+func (ocuo *OAuthClientUpdateOne) ClearHomepageURL() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearHomepageURL()
+	return ocuo
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocuo *OAuthClientUpdateOne) SetRedirectUris(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetRedirectUris(s)
+	return ocuo
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocuo *OAuthClientUpdateOne) AppendRedirectUris(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.AppendRedirectUris(s)
+	return ocuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ocuo *OAuthClientUpdateOne) SetScopes(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetScopes(s)
+	return ocuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocuo *OAuthClientUpdateOne) AppendScopes(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.AppendScopes(s)
+	return ocuo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (ocuo *OAuthClientUpdateOne) SetDisabledAt(t time.Time) *OAuthClientUpdateOne {
+	ocuo.mutation.SetDisabledAt(t)
+	return ocuo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableDisabledAt(t *time.Time) *OAuthClientUpdateOne {
+	if t != nil {
+		ocuo.SetDisabledAt(*t)
+	}
+	return ocuo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (ocuo *OAuthClientUpdateOne) ClearDisabledAt() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearDisabledAt()
+	return ocuo
+}
+
+// AddConsentIDs adds the "consents" edge to the OAuthConsent entity by IDs.
+func (ocuo *OAuthClientUpdateOne) AddConsentIDs(ids ...int) *OAuthClientUpdateOne {
+	ocuo.mutation.AddConsentIDs(ids...)
+	return ocuo
+}
+
+// AddConsents adds the "consents" edges to the OAuthConsent entity.
+func (ocuo *OAuthClientUpdateOne) AddConsents(o ...*OAuthConsent) *OAuthClientUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocuo.AddConsentIDs(ids...)
+}
+
+// AddAuthorizationCodeIDs adds the "authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (ocuo *OAuthClientUpdateOne) AddAuthorizationCodeIDs(ids ...int) *OAuthClientUpdateOne {
+	ocuo.mutation.AddAuthorizationCodeIDs(ids...)
+	return ocuo
+}
+
+// AddAuthorizationCodes adds the "authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (ocuo *OAuthClientUpdateOne) AddAuthorizationCodes(o ...*OAuthAuthorizationCode) *OAuthClientUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocuo.AddAuthorizationCodeIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (ocuo *OAuthClientUpdateOne) AddSessionIDs(ids ...int) *OAuthClientUpdateOne {
+	ocuo.mutation.AddSessionIDs(ids...)
+	return ocuo
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (ocuo *OAuthClientUpdateOne) AddSessions(s ...*Session) *OAuthClientUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ocuo.AddSessionIDs(ids...)
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (ocuo *OAuthClientUpdateOne) Mutation() *OAuthClientMutation {
+	return ocuo.mutation
+}
+
+// ClearConsents clears all "consents" edges to the OAuthConsent entity.
+func (ocuo *OAuthClientUpdateOne) ClearConsents() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearConsents()
+	return ocuo
+}
+
+// RemoveConsentIDs removes the "consents" edge to OAuthConsent entities by IDs.
+func (ocuo *OAuthClientUpdateOne) RemoveConsentIDs(ids ...int) *OAuthClientUpdateOne {
+	ocuo.mutation.RemoveConsentIDs(ids...)
+	return ocuo
+}
+
+// RemoveConsents removes "consents" edges to OAuthConsent entities.
+func (ocuo *OAuthClientUpdateOne) RemoveConsents(o ...*OAuthConsent) *OAuthClientUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocuo.RemoveConsentIDs(ids...)
+}
+
+// ClearAuthorizationCodes clears all "authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (ocuo *OAuthClientUpdateOne) ClearAuthorizationCodes() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearAuthorizationCodes()
+	return ocuo
+}
+
+// RemoveAuthorizationCodeIDs removes the "authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (ocuo *OAuthClientUpdateOne) RemoveAuthorizationCodeIDs(ids ...int) *OAuthClientUpdateOne {
+	ocuo.mutation.RemoveAuthorizationCodeIDs(ids...)
+	return ocuo
+}
+
+// RemoveAuthorizationCodes removes "authorization_codes" edges to OAuthAuthorizationCode entities.
+func (ocuo *OAuthClientUpdateOne) RemoveAuthorizationCodes(o ...*OAuthAuthorizationCode) *OAuthClientUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocuo.RemoveAuthorizationCodeIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (ocuo *OAuthClientUpdateOne) ClearSessions() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearSessions()
+	return ocuo
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (ocuo *OAuthClientUpdateOne) RemoveSessionIDs(ids ...int) *OAuthClientUpdateOne {
+	ocuo.mutation.RemoveSessionIDs(ids...)
+	return ocuo
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (ocuo *OAuthClientUpdateOne) RemoveSessions(s ...*Session) *OAuthClientUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ocuo.RemoveSessionIDs(ids...)
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (ocuo *OAuthClientUpdateOne) Where(ps ...predicate.OAuthClient) *OAuthClientUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OAuthClientUpdateOne) Select(field string, fields ...string) *OAuthClientUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OAuthClient entity.
+func (ocuo *OAuthClientUpdateOne) Save(ctx context.Context) (*OAuthClient, error) {
+	ocuo.defaults()
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OAuthClientUpdateOne) SaveX(ctx context.Context) *OAuthClient {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OAuthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OAuthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocuo *OAuthClientUpdateOne) defaults() {
+	if _, ok := ocuo.mutation.UpdateTime(); !ok {
+		v := oauthclient.UpdateDefaultUpdateTime()
+		ocuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OAuthClientUpdateOne) check() error {
+	if v, ok := ocuo.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ocuo *OAuthClientUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthClientUpdateOne {
+	ocuo.modifiers = append(ocuo.modifiers, modifiers...)
+	return ocuo
+}
+
+func (ocuo *OAuthClientUpdateOne) sqlSave(ctx context.Context) (_node *OAuthClient, err error) {
+	if err := ocuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt))
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.UpdateTime(); ok {
+		_spec.SetField(oauthclient.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocuo.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if ocuo.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := ocuo.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocuo.mutation.HomepageURL(); ok {
+		_spec.SetField(oauthclient.FieldHomepageURL, field.TypeString, value)
+	}
+	if ocuo.mutation.HomepageURLCleared() {
+		_spec.ClearField(oauthclient.FieldHomepageURL, field.TypeString)
+	}
+	if value, ok := ocuo.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if value, ok := ocuo.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if value, ok := ocuo.mutation.DisabledAt(); ok {
+		_spec.SetField(oauthclient.FieldDisabledAt, field.TypeTime, value)
+	}
+	if ocuo.mutation.DisabledAtCleared() {
+		_spec.ClearField(oauthclient.FieldDisabledAt, field.TypeTime)
+	}
+	if ocuo.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.ConsentsTable,
+			Columns: []string{oauthclient.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.RemovedConsentsIDs(); len(nodes) > 0 && !ocuo.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.ConsentsTable,
+			Columns: []string{oauthclient.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.ConsentsTable,
+			Columns: []string{oauthclient.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ocuo.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.AuthorizationCodesTable,
+			Columns: []string{oauthclient.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.RemovedAuthorizationCodesIDs(); len(nodes) > 0 && !ocuo.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.AuthorizationCodesTable,
+			Columns: []string{oauthclient.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.AuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.AuthorizationCodesTable,
+			Columns: []string{oauthclient.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ocuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.SessionsTable,
+			Columns: []string{oauthclient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !ocuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.SessionsTable,
+			Columns: []string{oauthclient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.SessionsTable,
+			Columns: []string{oauthclient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ocuo.modifiers...)
+	_node = &OAuthClient{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthConsent is the model entity for the OAuthConsent schema.
+type OAuthConsent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OAuthConsentQuery when eager-loading is set.
+	Edges                 OAuthConsentEdges `json:"edges"`
+	oauth_client_consents *int
+	user_oauth_consents   *int
+	selectValues          sql.SelectValues
+}
+
+// OAuthConsentEdges holds the relations/edges for other nodes in the graph.
+type OAuthConsentEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Client holds the value of the client edge.
+	Client *OAuthClient `json:"client,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthConsentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ClientOrErr returns the Client value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthConsentEdges) ClientOrErr() (*OAuthClient, error) {
+	if e.Client != nil {
+		return e.Client, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: oauthclient.Label}
+	}
+	return nil, &NotLoadedError{edge: "client"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthConsent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthconsent.FieldScopes:
+			values[i] = new([]byte)
+		case oauthconsent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case oauthconsent.FieldCreateTime, oauthconsent.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case oauthconsent.ForeignKeys[0]: // oauth_client_consents
+			values[i] = new(sql.NullInt64)
+		case oauthconsent.ForeignKeys[1]: // user_oauth_consents
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthConsent fields.
+func (oc *OAuthConsent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthconsent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oc.ID = int(value.Int64)
+		case oauthconsent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				oc.CreateTime = value.Time
+			}
+		case oauthconsent.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				oc.UpdateTime = value.Time
+			}
+		case oauthconsent.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthconsent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field oauth_client_consents", value)
+			} else if value.Valid {
+				oc.oauth_client_consents = new(int)
+				*oc.oauth_client_consents = int(value.Int64)
+			}
+		case oauthconsent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_oauth_consents", value)
+			} else if value.Valid {
+				oc.user_oauth_consents = new(int)
+				*oc.user_oauth_consents = int(value.Int64)
+			}
+		default:
+			oc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthConsent.
+// This includes values selected through modifiers, order, etc.
+func (oc *OAuthConsent) Value(name string) (ent.Value, error) {
+	return oc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the OAuthConsent entity.
+func (oc *OAuthConsent) QueryUser() *UserQuery {
+	return NewOAuthConsentClient(oc.config).QueryUser(oc)
+}
+
+// QueryClient queries the "client" edge of the OAuthConsent entity.
+func (oc *OAuthConsent) QueryClient() *OAuthClientQuery {
+	return NewOAuthConsentClient(oc.config).QueryClient(oc)
+}
+
+// Update returns a builder for updating this OAuthConsent.
+// Note that you need to call OAuthConsent.Unwrap() before calling this method if this OAuthConsent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oc *OAuthConsent) Update() *OAuthConsentUpdateOne {
+	return NewOAuthConsentClient(oc.config).UpdateOne(oc)
+}
+
+// Unwrap unwraps the OAuthConsent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oc *OAuthConsent) Unwrap() *OAuthConsent {
+	_tx, ok := oc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthConsent is not a transactional entity")
+	}
+	oc.config.driver = _tx.drv
+	return oc
+}
+
+// String implements the fmt.Stringer.
+func (oc *OAuthConsent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthConsent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oc.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(oc.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(oc.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Scopes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthConsents is a parsable slice of OAuthConsent.
+type OAuthConsents []*OAuthConsent
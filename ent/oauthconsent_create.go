@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthConsentCreate is the builder for creating a OAuthConsent entity.
+type OAuthConsentCreate struct {
+	config
+	mutation *OAuthConsentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (occ *OAuthConsentCreate) SetCreateTime(t time.Time) *OAuthConsentCreate {
+	occ.mutation.SetCreateTime(t)
+	return occ
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (occ *OAuthConsentCreate) SetNillableCreateTime(t *time.Time) *OAuthConsentCreate {
+	if t != nil {
+		occ.SetCreateTime(*t)
+	}
+	return occ
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (occ *OAuthConsentCreate) SetUpdateTime(t time.Time) *OAuthConsentCreate {
+	occ.mutation.SetUpdateTime(t)
+	return occ
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (occ *OAuthConsentCreate) SetNillableUpdateTime(t *time.Time) *OAuthConsentCreate {
+	if t != nil {
+		occ.SetUpdateTime(*t)
+	}
+	return occ
+}
+
+// SetScopes sets the "scopes" field.
+func (occ *OAuthConsentCreate) SetScopes(s []string) *OAuthConsentCreate {
+	occ.mutation.SetScopes(s)
+	return occ
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (occ *OAuthConsentCreate) SetUserID(id int) *OAuthConsentCreate {
+	occ.mutation.SetUserID(id)
+	return occ
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (occ *OAuthConsentCreate) SetUser(u *User) *OAuthConsentCreate {
+	return occ.SetUserID(u.ID)
+}
+
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (occ *OAuthConsentCreate) SetClientID(id int) *OAuthConsentCreate {
+	occ.mutation.SetClientID(id)
+	return occ
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (occ *OAuthConsentCreate) SetClient(o *OAuthClient) *OAuthConsentCreate {
+	return occ.SetClientID(o.ID)
+}
+
+// Mutation returns the OAuthConsentMutation object of the builder.
+func (occ *OAuthConsentCreate) Mutation() *OAuthConsentMutation {
+	return occ.mutation
+}
+
+// Save creates the OAuthConsent in the database.
+func (occ *OAuthConsentCreate) Save(ctx context.Context) (*OAuthConsent, error) {
+	occ.defaults()
+	return withHooks(ctx, occ.sqlSave, occ.mutation, occ.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OAuthConsentCreate) SaveX(ctx context.Context) *OAuthConsent {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OAuthConsentCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OAuthConsentCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OAuthConsentCreate) defaults() {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		v := oauthconsent.DefaultCreateTime()
+		occ.mutation.SetCreateTime(v)
+	}
+	if _, ok := occ.mutation.UpdateTime(); !ok {
+		v := oauthconsent.DefaultUpdateTime()
+		occ.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OAuthConsentCreate) check() error {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OAuthConsent.create_time"`)}
+	}
+	if _, ok := occ.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OAuthConsent.update_time"`)}
+	}
+	if _, ok := occ.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "OAuthConsent.scopes"`)}
+	}
+	if len(occ.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OAuthConsent.user"`)}
+	}
+	if len(occ.mutation.ClientIDs()) == 0 {
+		return &ValidationError{Name: "client", err: errors.New(`ent: missing required edge "OAuthConsent.client"`)}
+	}
+	return nil
+}
+
+func (occ *OAuthConsentCreate) sqlSave(ctx context.Context) (*OAuthConsent, error) {
+	if err := occ.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	occ.mutation.id = &_node.ID
+	occ.mutation.done = true
+	return _node, nil
+}
+
+func (occ *OAuthConsentCreate) createSpec() (*OAuthConsent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthConsent{config: occ.config}
+		_spec = sqlgraph.NewCreateSpec(oauthconsent.Table, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt))
+	)
+	if value, ok := occ.mutation.CreateTime(); ok {
+		_spec.SetField(oauthconsent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := occ.mutation.UpdateTime(); ok {
+		_spec.SetField(oauthconsent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := occ.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if nodes := occ.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.UserTable,
+			Columns: []string{oauthconsent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_oauth_consents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := occ.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.ClientTable,
+			Columns: []string{oauthconsent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.oauth_client_consents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OAuthConsentCreateBulk is the builder for creating many OAuthConsent entities in bulk.
+type OAuthConsentCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthConsentCreate
+}
+
+// Save creates the OAuthConsent entities in the database.
+func (occb *OAuthConsentCreateBulk) Save(ctx context.Context) ([]*OAuthConsent, error) {
+	if occb.err != nil {
+		return nil, occb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OAuthConsent, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OAuthConsentCreateBulk) SaveX(ctx context.Context) []*OAuthConsent {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OAuthConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OAuthConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
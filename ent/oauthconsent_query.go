@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthConsentQuery is the builder for querying OAuthConsent entities.
+type OAuthConsentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []oauthconsent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OAuthConsent
+	withUser   *UserQuery
+	withClient *OAuthClientQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OAuthConsentQuery builder.
+func (ocq *OAuthConsentQuery) Where(ps ...predicate.OAuthConsent) *OAuthConsentQuery {
+	ocq.predicates = append(ocq.predicates, ps...)
+	return ocq
+}
+
+// Limit the number of records to be returned by this query.
+func (ocq *OAuthConsentQuery) Limit(limit int) *OAuthConsentQuery {
+	ocq.ctx.Limit = &limit
+	return ocq
+}
+
+// Offset to start from.
+func (ocq *OAuthConsentQuery) Offset(offset int) *OAuthConsentQuery {
+	ocq.ctx.Offset = &offset
+	return ocq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ocq *OAuthConsentQuery) Unique(unique bool) *OAuthConsentQuery {
+	ocq.ctx.Unique = &unique
+	return ocq
+}
+
+// Order specifies how the records should be ordered.
+func (ocq *OAuthConsentQuery) Order(o ...oauthconsent.OrderOption) *OAuthConsentQuery {
+	ocq.order = append(ocq.order, o...)
+	return ocq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ocq *OAuthConsentQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ocq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ocq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthconsent.Table, oauthconsent.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthconsent.UserTable, oauthconsent.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ocq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryClient chains the current query on the "client" edge.
+func (ocq *OAuthConsentQuery) QueryClient() *OAuthClientQuery {
+	query := (&OAuthClientClient{config: ocq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ocq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthconsent.Table, oauthconsent.FieldID, selector),
+			sqlgraph.To(oauthclient.Table, oauthclient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthconsent.ClientTable, oauthconsent.ClientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ocq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OAuthConsent entity from the query.
+// Returns a *NotFoundError when no OAuthConsent was found.
+func (ocq *OAuthConsentQuery) First(ctx context.Context) (*OAuthConsent, error) {
+	nodes, err := ocq.Limit(1).All(setContextOp(ctx, ocq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oauthconsent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) FirstX(ctx context.Context) *OAuthConsent {
+	node, err := ocq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OAuthConsent ID from the query.
+// Returns a *NotFoundError when no OAuthConsent ID was found.
+func (ocq *OAuthConsentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocq.Limit(1).IDs(setContextOp(ctx, ocq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oauthconsent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) FirstIDX(ctx context.Context) int {
+	id, err := ocq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OAuthConsent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OAuthConsent entity is found.
+// Returns a *NotFoundError when no OAuthConsent entities are found.
+func (ocq *OAuthConsentQuery) Only(ctx context.Context) (*OAuthConsent, error) {
+	nodes, err := ocq.Limit(2).All(setContextOp(ctx, ocq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oauthconsent.Label}
+	default:
+		return nil, &NotSingularError{oauthconsent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) OnlyX(ctx context.Context) *OAuthConsent {
+	node, err := ocq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OAuthConsent ID in the query.
+// Returns a *NotSingularError when more than one OAuthConsent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ocq *OAuthConsentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocq.Limit(2).IDs(setContextOp(ctx, ocq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oauthconsent.Label}
+	default:
+		err = &NotSingularError{oauthconsent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ocq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OAuthConsents.
+func (ocq *OAuthConsentQuery) All(ctx context.Context) ([]*OAuthConsent, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryAll)
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OAuthConsent, *OAuthConsentQuery]()
+	return withInterceptors[[]*OAuthConsent](ctx, ocq, qr, ocq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) AllX(ctx context.Context) []*OAuthConsent {
+	nodes, err := ocq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OAuthConsent IDs.
+func (ocq *OAuthConsentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ocq.ctx.Unique == nil && ocq.path != nil {
+		ocq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryIDs)
+	if err = ocq.Select(oauthconsent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) IDsX(ctx context.Context) []int {
+	ids, err := ocq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ocq *OAuthConsentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryCount)
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ocq, querierCount[*OAuthConsentQuery](), ocq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) CountX(ctx context.Context) int {
+	count, err := ocq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ocq *OAuthConsentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ocq.ctx, ent.OpQueryExist)
+	switch _, err := ocq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ocq *OAuthConsentQuery) ExistX(ctx context.Context) bool {
+	exist, err := ocq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OAuthConsentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ocq *OAuthConsentQuery) Clone() *OAuthConsentQuery {
+	if ocq == nil {
+		return nil
+	}
+	return &OAuthConsentQuery{
+		config:     ocq.config,
+		ctx:        ocq.ctx.Clone(),
+		order:      append([]oauthconsent.OrderOption{}, ocq.order...),
+		inters:     append([]Interceptor{}, ocq.inters...),
+		predicates: append([]predicate.OAuthConsent{}, ocq.predicates...),
+		withUser:   ocq.withUser.Clone(),
+		withClient: ocq.withClient.Clone(),
+		// clone intermediate query.
+		sql:       ocq.sql.Clone(),
+		path:      ocq.path,
+		modifiers: append([]func(*sql.Selector){}, ocq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ocq *OAuthConsentQuery) WithUser(opts ...func(*UserQuery)) *OAuthConsentQuery {
+	query := (&UserClient{config: ocq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ocq.withUser = query
+	return ocq
+}
+
+// WithClient tells the query-builder to eager-load the nodes that are connected to
+// the "client" edge. The optional arguments are used to configure the query builder of the edge.
+func (ocq *OAuthConsentQuery) WithClient(opts ...func(*OAuthClientQuery)) *OAuthConsentQuery {
+	query := (&OAuthClientClient{config: ocq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ocq.withClient = query
+	return ocq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OAuthConsent.Query().
+//		GroupBy(oauthconsent.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ocq *OAuthConsentQuery) GroupBy(field string, fields ...string) *OAuthConsentGroupBy {
+	ocq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OAuthConsentGroupBy{build: ocq}
+	grbuild.flds = &ocq.ctx.Fields
+	grbuild.label = oauthconsent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.OAuthConsent.Query().
+//		Select(oauthconsent.FieldCreateTime).
+//		Scan(ctx, &v)
+func (ocq *OAuthConsentQuery) Select(fields ...string) *OAuthConsentSelect {
+	ocq.ctx.Fields = append(ocq.ctx.Fields, fields...)
+	sbuild := &OAuthConsentSelect{OAuthConsentQuery: ocq}
+	sbuild.label = oauthconsent.Label
+	sbuild.flds, sbuild.scan = &ocq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OAuthConsentSelect configured with the given aggregations.
+func (ocq *OAuthConsentQuery) Aggregate(fns ...AggregateFunc) *OAuthConsentSelect {
+	return ocq.Select().Aggregate(fns...)
+}
+
+func (ocq *OAuthConsentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ocq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ocq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ocq.ctx.Fields {
+		if !oauthconsent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ocq.path != nil {
+		prev, err := ocq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ocq.sql = prev
+	}
+	return nil
+}
+
+func (ocq *OAuthConsentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OAuthConsent, error) {
+	var (
+		nodes       = []*OAuthConsent{}
+		withFKs     = ocq.withFKs
+		_spec       = ocq.querySpec()
+		loadedTypes = [2]bool{
+			ocq.withUser != nil,
+			ocq.withClient != nil,
+		}
+	)
+	if ocq.withUser != nil || ocq.withClient != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthconsent.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OAuthConsent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OAuthConsent{config: ocq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ocq.modifiers) > 0 {
+		_spec.Modifiers = ocq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ocq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ocq.withUser; query != nil {
+		if err := ocq.loadUser(ctx, query, nodes, nil,
+			func(n *OAuthConsent, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ocq.withClient; query != nil {
+		if err := ocq.loadClient(ctx, query, nodes, nil,
+			func(n *OAuthConsent, e *OAuthClient) { n.Edges.Client = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ocq *OAuthConsentQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*OAuthConsent, init func(*OAuthConsent), assign func(*OAuthConsent, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OAuthConsent)
+	for i := range nodes {
+		if nodes[i].user_oauth_consents == nil {
+			continue
+		}
+		fk := *nodes[i].user_oauth_consents
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_oauth_consents" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ocq *OAuthConsentQuery) loadClient(ctx context.Context, query *OAuthClientQuery, nodes []*OAuthConsent, init func(*OAuthConsent), assign func(*OAuthConsent, *OAuthClient)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OAuthConsent)
+	for i := range nodes {
+		if nodes[i].oauth_client_consents == nil {
+			continue
+		}
+		fk := *nodes[i].oauth_client_consents
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(oauthclient.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "oauth_client_consents" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ocq *OAuthConsentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ocq.querySpec()
+	if len(ocq.modifiers) > 0 {
+		_spec.Modifiers = ocq.modifiers
+	}
+	_spec.Node.Columns = ocq.ctx.Fields
+	if len(ocq.ctx.Fields) > 0 {
+		_spec.Unique = ocq.ctx.Unique != nil && *ocq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ocq.driver, _spec)
+}
+
+func (ocq *OAuthConsentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt))
+	_spec.From = ocq.sql
+	if unique := ocq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ocq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ocq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthconsent.FieldID)
+		for i := range fields {
+			if fields[i] != oauthconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ocq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ocq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ocq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ocq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ocq *OAuthConsentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ocq.driver.Dialect())
+	t1 := builder.Table(oauthconsent.Table)
+	columns := ocq.ctx.Fields
+	if len(columns) == 0 {
+		columns = oauthconsent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ocq.sql != nil {
+		selector = ocq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ocq.ctx.Unique != nil && *ocq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ocq.modifiers {
+		m(selector)
+	}
+	for _, p := range ocq.predicates {
+		p(selector)
+	}
+	for _, p := range ocq.order {
+		p(selector)
+	}
+	if offset := ocq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ocq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ocq *OAuthConsentQuery) ForUpdate(opts ...sql.LockOption) *OAuthConsentQuery {
+	if ocq.driver.Dialect() == dialect.Postgres {
+		ocq.Unique(false)
+	}
+	ocq.modifiers = append(ocq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ocq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ocq *OAuthConsentQuery) ForShare(opts ...sql.LockOption) *OAuthConsentQuery {
+	if ocq.driver.Dialect() == dialect.Postgres {
+		ocq.Unique(false)
+	}
+	ocq.modifiers = append(ocq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ocq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ocq *OAuthConsentQuery) Modify(modifiers ...func(s *sql.Selector)) *OAuthConsentSelect {
+	ocq.modifiers = append(ocq.modifiers, modifiers...)
+	return ocq.Select()
+}
+
+// OAuthConsentGroupBy is the group-by builder for OAuthConsent entities.
+type OAuthConsentGroupBy struct {
+	selector
+	build *OAuthConsentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ocgb *OAuthConsentGroupBy) Aggregate(fns ...AggregateFunc) *OAuthConsentGroupBy {
+	ocgb.fns = append(ocgb.fns, fns...)
+	return ocgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocgb *OAuthConsentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ocgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OAuthConsentQuery, *OAuthConsentGroupBy](ctx, ocgb.build, ocgb, ocgb.build.inters, v)
+}
+
+func (ocgb *OAuthConsentGroupBy) sqlScan(ctx context.Context, root *OAuthConsentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ocgb.fns))
+	for _, fn := range ocgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ocgb.flds)+len(ocgb.fns))
+		for _, f := range *ocgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ocgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OAuthConsentSelect is the builder for selecting fields of OAuthConsent entities.
+type OAuthConsentSelect struct {
+	*OAuthConsentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ocs *OAuthConsentSelect) Aggregate(fns ...AggregateFunc) *OAuthConsentSelect {
+	ocs.fns = append(ocs.fns, fns...)
+	return ocs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocs *OAuthConsentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ocs.ctx, ent.OpQuerySelect)
+	if err := ocs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OAuthConsentQuery, *OAuthConsentSelect](ctx, ocs.OAuthConsentQuery, ocs, ocs.inters, v)
+}
+
+func (ocs *OAuthConsentSelect) sqlScan(ctx context.Context, root *OAuthConsentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ocs.fns))
+	for _, fn := range ocs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ocs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ocs *OAuthConsentSelect) Modify(modifiers ...func(s *sql.Selector)) *OAuthConsentSelect {
+	ocs.modifiers = append(ocs.modifiers, modifiers...)
+	return ocs
+}
@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// OAuthConsentUpdate is the builder for updating OAuthConsent entities.
+type OAuthConsentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OAuthConsentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OAuthConsentUpdate builder.
+func (ocu *OAuthConsentUpdate) Where(ps ...predicate.OAuthConsent) *OAuthConsentUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocu *OAuthConsentUpdate) SetUpdateTime(t time.Time) *OAuthConsentUpdate {
+	ocu.mutation.SetUpdateTime(t)
+	return ocu
+}
+
+// SetScopes sets the "scopes" field.
+func (ocu *OAuthConsentUpdate) SetScopes(s []string) *OAuthConsentUpdate {
+	ocu.mutation.SetScopes(s)
+	return ocu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocu *OAuthConsentUpdate) AppendScopes(s []string) *OAuthConsentUpdate {
+	ocu.mutation.AppendScopes(s)
+	return ocu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ocu *OAuthConsentUpdate) SetUserID(id int) *OAuthConsentUpdate {
+	ocu.mutation.SetUserID(id)
+	return ocu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ocu *OAuthConsentUpdate) SetUser(u *User) *OAuthConsentUpdate {
+	return ocu.SetUserID(u.ID)
+}
+
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (ocu *OAuthConsentUpdate) SetClientID(id int) *OAuthConsentUpdate {
+	ocu.mutation.SetClientID(id)
+	return ocu
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (ocu *OAuthConsentUpdate) SetClient(o *OAuthClient) *OAuthConsentUpdate {
+	return ocu.SetClientID(o.ID)
+}
+
+// Mutation returns the OAuthConsentMutation object of the builder.
+func (ocu *OAuthConsentUpdate) Mutation() *OAuthConsentMutation {
+	return ocu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ocu *OAuthConsentUpdate) ClearUser() *OAuthConsentUpdate {
+	ocu.mutation.ClearUser()
+	return ocu
+}
+
+// ClearClient clears the "client" edge to the OAuthClient entity.
+func (ocu *OAuthConsentUpdate) ClearClient() *OAuthConsentUpdate {
+	ocu.mutation.ClearClient()
+	return ocu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OAuthConsentUpdate) Save(ctx context.Context) (int, error) {
+	ocu.defaults()
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OAuthConsentUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OAuthConsentUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OAuthConsentUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocu *OAuthConsentUpdate) defaults() {
+	if _, ok := ocu.mutation.UpdateTime(); !ok {
+		v := oauthconsent.UpdateDefaultUpdateTime()
+		ocu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OAuthConsentUpdate) check() error {
+	if ocu.mutation.UserCleared() && len(ocu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthConsent.user"`)
+	}
+	if ocu.mutation.ClientCleared() && len(ocu.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthConsent.client"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ocu *OAuthConsentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthConsentUpdate {
+	ocu.modifiers = append(ocu.modifiers, modifiers...)
+	return ocu
+}
+
+func (ocu *OAuthConsentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ocu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt))
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.UpdateTime(); ok {
+		_spec.SetField(oauthconsent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocu.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthconsent.FieldScopes, value)
+		})
+	}
+	if ocu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.UserTable,
+			Columns: []string{oauthconsent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.UserTable,
+			Columns: []string{oauthconsent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ocu.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.ClientTable,
+			Columns: []string{oauthconsent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.ClientTable,
+			Columns: []string{oauthconsent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ocu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocu.mutation.done = true
+	return n, nil
+}
+
+// OAuthConsentUpdateOne is the builder for updating a single OAuthConsent entity.
+type OAuthConsentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OAuthConsentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocuo *OAuthConsentUpdateOne) SetUpdateTime(t time.Time) *OAuthConsentUpdateOne {
+	ocuo.mutation.SetUpdateTime(t)
+	return ocuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ocuo *OAuthConsentUpdateOne) SetScopes(s []string) *OAuthConsentUpdateOne {
+	ocuo.mutation.SetScopes(s)
+	return ocuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ocuo *OAuthConsentUpdateOne) AppendScopes(s []string) *OAuthConsentUpdateOne {
+	ocuo.mutation.AppendScopes(s)
+	return ocuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ocuo *OAuthConsentUpdateOne) SetUserID(id int) *OAuthConsentUpdateOne {
+	ocuo.mutation.SetUserID(id)
+	return ocuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ocuo *OAuthConsentUpdateOne) SetUser(u *User) *OAuthConsentUpdateOne {
+	return ocuo.SetUserID(u.ID)
+}
+
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (ocuo *OAuthConsentUpdateOne) SetClientID(id int) *OAuthConsentUpdateOne {
+	ocuo.mutation.SetClientID(id)
+	return ocuo
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (ocuo *OAuthConsentUpdateOne) SetClient(o *OAuthClient) *OAuthConsentUpdateOne {
+	return ocuo.SetClientID(o.ID)
+}
+
+// Mutation returns the OAuthConsentMutation object of the builder.
+func (ocuo *OAuthConsentUpdateOne) Mutation() *OAuthConsentMutation {
+	return ocuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ocuo *OAuthConsentUpdateOne) ClearUser() *OAuthConsentUpdateOne {
+	ocuo.mutation.ClearUser()
+	return ocuo
+}
+
+// ClearClient clears the "client" edge to the OAuthClient entity.
+func (ocuo *OAuthConsentUpdateOne) ClearClient() *OAuthConsentUpdateOne {
+	ocuo.mutation.ClearClient()
+	return ocuo
+}
+
+// Where appends a list predicates to the OAuthConsentUpdate builder.
+func (ocuo *OAuthConsentUpdateOne) Where(ps ...predicate.OAuthConsent) *OAuthConsentUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OAuthConsentUpdateOne) Select(field string, fields ...string) *OAuthConsentUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OAuthConsent entity.
+func (ocuo *OAuthConsentUpdateOne) Save(ctx context.Context) (*OAuthConsent, error) {
+	ocuo.defaults()
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OAuthConsentUpdateOne) SaveX(ctx context.Context) *OAuthConsent {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OAuthConsentUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OAuthConsentUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocuo *OAuthConsentUpdateOne) defaults() {
+	if _, ok := ocuo.mutation.UpdateTime(); !ok {
+		v := oauthconsent.UpdateDefaultUpdateTime()
+		ocuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OAuthConsentUpdateOne) check() error {
+	if ocuo.mutation.UserCleared() && len(ocuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthConsent.user"`)
+	}
+	if ocuo.mutation.ClientCleared() && len(ocuo.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthConsent.client"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ocuo *OAuthConsentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthConsentUpdateOne {
+	ocuo.modifiers = append(ocuo.modifiers, modifiers...)
+	return ocuo
+}
+
+func (ocuo *OAuthConsentUpdateOne) sqlSave(ctx context.Context) (_node *OAuthConsent, err error) {
+	if err := ocuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt))
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthConsent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthconsent.FieldID)
+		for _, f := range fields {
+			if !oauthconsent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.UpdateTime(); ok {
+		_spec.SetField(oauthconsent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ocuo.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthconsent.FieldScopes, value)
+		})
+	}
+	if ocuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.UserTable,
+			Columns: []string{oauthconsent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.UserTable,
+			Columns: []string{oauthconsent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ocuo.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.ClientTable,
+			Columns: []string{oauthconsent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthconsent.ClientTable,
+			Columns: []string{oauthconsent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ocuo.modifiers...)
+	_node = &OAuthConsent{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocuo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type OTPQuery struct {
 	predicates []predicate.OTP
 	withUser   *UserQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -277,8 +279,9 @@ func (oq *OTPQuery) Clone() *OTPQuery {
 		predicates: append([]predicate.OTP{}, oq.predicates...),
 		withUser:   oq.withUser.Clone(),
 		// clone intermediate query.
-		sql:  oq.sql.Clone(),
-		path: oq.path,
+		sql:       oq.sql.Clone(),
+		path:      oq.path,
+		modifiers: append([]func(*sql.Selector){}, oq.modifiers...),
 	}
 }
 
@@ -391,6 +394,9 @@ func (oq *OTPQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OTP, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +450,9 @@ func (oq *OTPQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*OTP
 
 func (oq *OTPQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oq.querySpec()
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	_spec.Node.Columns = oq.ctx.Fields
 	if len(oq.ctx.Fields) > 0 {
 		_spec.Unique = oq.ctx.Unique != nil && *oq.ctx.Unique
@@ -506,6 +515,9 @@ func (oq *OTPQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if oq.ctx.Unique != nil && *oq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range oq.modifiers {
+		m(selector)
+	}
 	for _, p := range oq.predicates {
 		p(selector)
 	}
@@ -523,6 +535,38 @@ func (oq *OTPQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oq *OTPQuery) ForUpdate(opts ...sql.LockOption) *OTPQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oq *OTPQuery) ForShare(opts ...sql.LockOption) *OTPQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (oq *OTPQuery) Modify(modifiers ...func(s *sql.Selector)) *OTPSelect {
+	oq.modifiers = append(oq.modifiers, modifiers...)
+	return oq.Select()
+}
+
 // OTPGroupBy is the group-by builder for OTP entities.
 type OTPGroupBy struct {
 	selector
@@ -612,3 +656,9 @@ func (os *OTPSelect) sqlScan(ctx context.Context, root *OTPQuery, v any) error {
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (os *OTPSelect) Modify(modifiers ...func(s *sql.Selector)) *OTPSelect {
+	os.modifiers = append(os.modifiers, modifiers...)
+	return os
+}
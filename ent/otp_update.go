@@ -19,8 +19,9 @@ import (
 // OTPUpdate is the builder for updating OTP entities.
 type OTPUpdate struct {
 	config
-	hooks    []Hook
-	mutation *OTPMutation
+	hooks     []Hook
+	mutation  *OTPMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the OTPUpdate builder.
@@ -134,6 +135,12 @@ func (ou *OTPUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ou *OTPUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OTPUpdate {
+	ou.modifiers = append(ou.modifiers, modifiers...)
+	return ou
+}
+
 func (ou *OTPUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := ou.check(); err != nil {
 		return n, err
@@ -184,6 +191,7 @@ func (ou *OTPUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ou.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{otp.Label}
@@ -199,9 +207,10 @@ func (ou *OTPUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // OTPUpdateOne is the builder for updating a single OTP entity.
 type OTPUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *OTPMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *OTPMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -322,6 +331,12 @@ func (ouo *OTPUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ouo *OTPUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OTPUpdateOne {
+	ouo.modifiers = append(ouo.modifiers, modifiers...)
+	return ouo
+}
+
 func (ouo *OTPUpdateOne) sqlSave(ctx context.Context) (_node *OTP, err error) {
 	if err := ouo.check(); err != nil {
 		return _node, err
@@ -389,6 +404,7 @@ func (ouo *OTPUpdateOne) sqlSave(ctx context.Context) (_node *OTP, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ouo.modifiers...)
 	_node = &OTP{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/user"
+)
+
+// PersonalAccessToken is the model entity for the PersonalAccessToken schema.
+type PersonalAccessToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// First characters of the token, shown to help users recognise it
+	TokenPrefix string `json:"token_prefix,omitempty"`
+	// SHA-256 of the token, the token itself is never stored
+	TokenHash string `json:"-"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PersonalAccessTokenQuery when eager-loading is set.
+	Edges                       PersonalAccessTokenEdges `json:"edges"`
+	user_personal_access_tokens *int
+	selectValues                sql.SelectValues
+}
+
+// PersonalAccessTokenEdges holds the relations/edges for other nodes in the graph.
+type PersonalAccessTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PersonalAccessTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PersonalAccessToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case personalaccesstoken.FieldScopes:
+			values[i] = new([]byte)
+		case personalaccesstoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case personalaccesstoken.FieldName, personalaccesstoken.FieldTokenPrefix, personalaccesstoken.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case personalaccesstoken.FieldCreateTime, personalaccesstoken.FieldUpdateTime, personalaccesstoken.FieldExpiresAt, personalaccesstoken.FieldLastUsedAt, personalaccesstoken.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		case personalaccesstoken.ForeignKeys[0]: // user_personal_access_tokens
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PersonalAccessToken fields.
+func (pat *PersonalAccessToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case personalaccesstoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pat.ID = int(value.Int64)
+		case personalaccesstoken.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				pat.CreateTime = value.Time
+			}
+		case personalaccesstoken.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				pat.UpdateTime = value.Time
+			}
+		case personalaccesstoken.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pat.Name = value.String
+			}
+		case personalaccesstoken.FieldTokenPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_prefix", values[i])
+			} else if value.Valid {
+				pat.TokenPrefix = value.String
+			}
+		case personalaccesstoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				pat.TokenHash = value.String
+			}
+		case personalaccesstoken.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pat.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case personalaccesstoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pat.ExpiresAt = value.Time
+			}
+		case personalaccesstoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				pat.LastUsedAt = new(time.Time)
+				*pat.LastUsedAt = value.Time
+			}
+		case personalaccesstoken.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				pat.RevokedAt = new(time.Time)
+				*pat.RevokedAt = value.Time
+			}
+		case personalaccesstoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_personal_access_tokens", value)
+			} else if value.Valid {
+				pat.user_personal_access_tokens = new(int)
+				*pat.user_personal_access_tokens = int(value.Int64)
+			}
+		default:
+			pat.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PersonalAccessToken.
+// This includes values selected through modifiers, order, etc.
+func (pat *PersonalAccessToken) Value(name string) (ent.Value, error) {
+	return pat.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PersonalAccessToken entity.
+func (pat *PersonalAccessToken) QueryUser() *UserQuery {
+	return NewPersonalAccessTokenClient(pat.config).QueryUser(pat)
+}
+
+// Update returns a builder for updating this PersonalAccessToken.
+// Note that you need to call PersonalAccessToken.Unwrap() before calling this method if this PersonalAccessToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pat *PersonalAccessToken) Update() *PersonalAccessTokenUpdateOne {
+	return NewPersonalAccessTokenClient(pat.config).UpdateOne(pat)
+}
+
+// Unwrap unwraps the PersonalAccessToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pat *PersonalAccessToken) Unwrap() *PersonalAccessToken {
+	_tx, ok := pat.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PersonalAccessToken is not a transactional entity")
+	}
+	pat.config.driver = _tx.drv
+	return pat
+}
+
+// String implements the fmt.Stringer.
+func (pat *PersonalAccessToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("PersonalAccessToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pat.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(pat.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(pat.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pat.Name)
+	builder.WriteString(", ")
+	builder.WriteString("token_prefix=")
+	builder.WriteString(pat.TokenPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", pat.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(pat.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := pat.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pat.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PersonalAccessTokens is a parsable slice of PersonalAccessToken.
+type PersonalAccessTokens []*PersonalAccessToken
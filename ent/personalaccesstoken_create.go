@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/user"
+)
+
+// PersonalAccessTokenCreate is the builder for creating a PersonalAccessToken entity.
+type PersonalAccessTokenCreate struct {
+	config
+	mutation *PersonalAccessTokenMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (patc *PersonalAccessTokenCreate) SetCreateTime(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetCreateTime(t)
+	return patc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableCreateTime(t *time.Time) *PersonalAccessTokenCreate {
+	if t != nil {
+		patc.SetCreateTime(*t)
+	}
+	return patc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (patc *PersonalAccessTokenCreate) SetUpdateTime(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetUpdateTime(t)
+	return patc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableUpdateTime(t *time.Time) *PersonalAccessTokenCreate {
+	if t != nil {
+		patc.SetUpdateTime(*t)
+	}
+	return patc
+}
+
+// SetName sets the "name" field.
+func (patc *PersonalAccessTokenCreate) SetName(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetName(s)
+	return patc
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (patc *PersonalAccessTokenCreate) SetTokenPrefix(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetTokenPrefix(s)
+	return patc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (patc *PersonalAccessTokenCreate) SetTokenHash(s string) *PersonalAccessTokenCreate {
+	patc.mutation.SetTokenHash(s)
+	return patc
+}
+
+// SetScopes sets the "scopes" field.
+func (patc *PersonalAccessTokenCreate) SetScopes(s []string) *PersonalAccessTokenCreate {
+	patc.mutation.SetScopes(s)
+	return patc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patc *PersonalAccessTokenCreate) SetExpiresAt(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetExpiresAt(t)
+	return patc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patc *PersonalAccessTokenCreate) SetLastUsedAt(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetLastUsedAt(t)
+	return patc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenCreate {
+	if t != nil {
+		patc.SetLastUsedAt(*t)
+	}
+	return patc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (patc *PersonalAccessTokenCreate) SetRevokedAt(t time.Time) *PersonalAccessTokenCreate {
+	patc.mutation.SetRevokedAt(t)
+	return patc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (patc *PersonalAccessTokenCreate) SetNillableRevokedAt(t *time.Time) *PersonalAccessTokenCreate {
+	if t != nil {
+		patc.SetRevokedAt(*t)
+	}
+	return patc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (patc *PersonalAccessTokenCreate) SetUserID(id int) *PersonalAccessTokenCreate {
+	patc.mutation.SetUserID(id)
+	return patc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (patc *PersonalAccessTokenCreate) SetUser(u *User) *PersonalAccessTokenCreate {
+	return patc.SetUserID(u.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patc *PersonalAccessTokenCreate) Mutation() *PersonalAccessTokenMutation {
+	return patc.mutation
+}
+
+// Save creates the PersonalAccessToken in the database.
+func (patc *PersonalAccessTokenCreate) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	patc.defaults()
+	return withHooks(ctx, patc.sqlSave, patc.mutation, patc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (patc *PersonalAccessTokenCreate) SaveX(ctx context.Context) *PersonalAccessToken {
+	v, err := patc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (patc *PersonalAccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := patc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patc *PersonalAccessTokenCreate) ExecX(ctx context.Context) {
+	if err := patc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (patc *PersonalAccessTokenCreate) defaults() {
+	if _, ok := patc.mutation.CreateTime(); !ok {
+		v := personalaccesstoken.DefaultCreateTime()
+		patc.mutation.SetCreateTime(v)
+	}
+	if _, ok := patc.mutation.UpdateTime(); !ok {
+		v := personalaccesstoken.DefaultUpdateTime()
+		patc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (patc *PersonalAccessTokenCreate) check() error {
+	if _, ok := patc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "PersonalAccessToken.create_time"`)}
+	}
+	if _, ok := patc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "PersonalAccessToken.update_time"`)}
+	}
+	if _, ok := patc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PersonalAccessToken.name"`)}
+	}
+	if v, ok := patc.mutation.Name(); ok {
+		if err := personalaccesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
+		}
+	}
+	if _, ok := patc.mutation.TokenPrefix(); !ok {
+		return &ValidationError{Name: "token_prefix", err: errors.New(`ent: missing required field "PersonalAccessToken.token_prefix"`)}
+	}
+	if v, ok := patc.mutation.TokenPrefix(); ok {
+		if err := personalaccesstoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_prefix": %w`, err)}
+		}
+	}
+	if _, ok := patc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "PersonalAccessToken.token_hash"`)}
+	}
+	if v, ok := patc.mutation.TokenHash(); ok {
+		if err := personalaccesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := patc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PersonalAccessToken.expires_at"`)}
+	}
+	if len(patc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PersonalAccessToken.user"`)}
+	}
+	return nil
+}
+
+func (patc *PersonalAccessTokenCreate) sqlSave(ctx context.Context) (*PersonalAccessToken, error) {
+	if err := patc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := patc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, patc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	patc.mutation.id = &_node.ID
+	patc.mutation.done = true
+	return _node, nil
+}
+
+func (patc *PersonalAccessTokenCreate) createSpec() (*PersonalAccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PersonalAccessToken{config: patc.config}
+		_spec = sqlgraph.NewCreateSpec(personalaccesstoken.Table, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt))
+	)
+	if value, ok := patc.mutation.CreateTime(); ok {
+		_spec.SetField(personalaccesstoken.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := patc.mutation.UpdateTime(); ok {
+		_spec.SetField(personalaccesstoken.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := patc.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := patc.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
+	if value, ok := patc.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := patc.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := patc.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := patc.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := patc.mutation.RevokedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := patc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_personal_access_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PersonalAccessTokenCreateBulk is the builder for creating many PersonalAccessToken entities in bulk.
+type PersonalAccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*PersonalAccessTokenCreate
+}
+
+// Save creates the PersonalAccessToken entities in the database.
+func (patcb *PersonalAccessTokenCreateBulk) Save(ctx context.Context) ([]*PersonalAccessToken, error) {
+	if patcb.err != nil {
+		return nil, patcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(patcb.builders))
+	nodes := make([]*PersonalAccessToken, len(patcb.builders))
+	mutators := make([]Mutator, len(patcb.builders))
+	for i := range patcb.builders {
+		func(i int, root context.Context) {
+			builder := patcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersonalAccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, patcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, patcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, patcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patcb *PersonalAccessTokenCreateBulk) SaveX(ctx context.Context) []*PersonalAccessToken {
+	v, err := patcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (patcb *PersonalAccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := patcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patcb *PersonalAccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := patcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// PersonalAccessTokenUpdate is the builder for updating PersonalAccessToken entities.
+type PersonalAccessTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PersonalAccessTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PersonalAccessTokenUpdate builder.
+func (patu *PersonalAccessTokenUpdate) Where(ps ...predicate.PersonalAccessToken) *PersonalAccessTokenUpdate {
+	patu.mutation.Where(ps...)
+	return patu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (patu *PersonalAccessTokenUpdate) SetUpdateTime(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetUpdateTime(t)
+	return patu
+}
+
+// SetName sets the "name" field.
+func (patu *PersonalAccessTokenUpdate) SetName(s string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetName(s)
+	return patu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableName(s *string) *PersonalAccessTokenUpdate {
+	if s != nil {
+		patu.SetName(*s)
+	}
+	return patu
+}
+
+// SetScopes sets the "scopes" field.
+func (patu *PersonalAccessTokenUpdate) SetScopes(s []string) *PersonalAccessTokenUpdate {
+	patu.mutation.SetScopes(s)
+	return patu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (patu *PersonalAccessTokenUpdate) AppendScopes(s []string) *PersonalAccessTokenUpdate {
+	patu.mutation.AppendScopes(s)
+	return patu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (patu *PersonalAccessTokenUpdate) ClearScopes() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearScopes()
+	return patu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patu *PersonalAccessTokenUpdate) SetExpiresAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetExpiresAt(t)
+	return patu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableExpiresAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetExpiresAt(*t)
+	}
+	return patu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patu *PersonalAccessTokenUpdate) SetLastUsedAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetLastUsedAt(t)
+	return patu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetLastUsedAt(*t)
+	}
+	return patu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (patu *PersonalAccessTokenUpdate) ClearLastUsedAt() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearLastUsedAt()
+	return patu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (patu *PersonalAccessTokenUpdate) SetRevokedAt(t time.Time) *PersonalAccessTokenUpdate {
+	patu.mutation.SetRevokedAt(t)
+	return patu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (patu *PersonalAccessTokenUpdate) SetNillableRevokedAt(t *time.Time) *PersonalAccessTokenUpdate {
+	if t != nil {
+		patu.SetRevokedAt(*t)
+	}
+	return patu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (patu *PersonalAccessTokenUpdate) ClearRevokedAt() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearRevokedAt()
+	return patu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (patu *PersonalAccessTokenUpdate) SetUserID(id int) *PersonalAccessTokenUpdate {
+	patu.mutation.SetUserID(id)
+	return patu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (patu *PersonalAccessTokenUpdate) SetUser(u *User) *PersonalAccessTokenUpdate {
+	return patu.SetUserID(u.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patu *PersonalAccessTokenUpdate) Mutation() *PersonalAccessTokenMutation {
+	return patu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (patu *PersonalAccessTokenUpdate) ClearUser() *PersonalAccessTokenUpdate {
+	patu.mutation.ClearUser()
+	return patu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (patu *PersonalAccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	patu.defaults()
+	return withHooks(ctx, patu.sqlSave, patu.mutation, patu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patu *PersonalAccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := patu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (patu *PersonalAccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := patu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patu *PersonalAccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := patu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (patu *PersonalAccessTokenUpdate) defaults() {
+	if _, ok := patu.mutation.UpdateTime(); !ok {
+		v := personalaccesstoken.UpdateDefaultUpdateTime()
+		patu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (patu *PersonalAccessTokenUpdate) check() error {
+	if v, ok := patu.mutation.Name(); ok {
+		if err := personalaccesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
+		}
+	}
+	if patu.mutation.UserCleared() && len(patu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PersonalAccessToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (patu *PersonalAccessTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PersonalAccessTokenUpdate {
+	patu.modifiers = append(patu.modifiers, modifiers...)
+	return patu
+}
+
+func (patu *PersonalAccessTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := patu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personalaccesstoken.Table, personalaccesstoken.Columns, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt))
+	if ps := patu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := patu.mutation.UpdateTime(); ok {
+		_spec.SetField(personalaccesstoken.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := patu.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := patu.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := patu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if patu.mutation.ScopesCleared() {
+		_spec.ClearField(personalaccesstoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := patu.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := patu.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if patu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := patu.mutation.RevokedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if patu.mutation.RevokedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	if patu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := patu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(patu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, patu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	patu.mutation.done = true
+	return n, nil
+}
+
+// PersonalAccessTokenUpdateOne is the builder for updating a single PersonalAccessToken entity.
+type PersonalAccessTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PersonalAccessTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetUpdateTime(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetUpdateTime(t)
+	return patuo
+}
+
+// SetName sets the "name" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetName(s string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetName(s)
+	return patuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableName(s *string) *PersonalAccessTokenUpdateOne {
+	if s != nil {
+		patuo.SetName(*s)
+	}
+	return patuo
+}
+
+// SetScopes sets the "scopes" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetScopes(s []string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetScopes(s)
+	return patuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (patuo *PersonalAccessTokenUpdateOne) AppendScopes(s []string) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.AppendScopes(s)
+	return patuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearScopes() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearScopes()
+	return patuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetExpiresAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetExpiresAt(t)
+	return patuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetExpiresAt(*t)
+	}
+	return patuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetLastUsedAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetLastUsedAt(t)
+	return patuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetLastUsedAt(*t)
+	}
+	return patuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearLastUsedAt() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearLastUsedAt()
+	return patuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) SetRevokedAt(t time.Time) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetRevokedAt(t)
+	return patuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (patuo *PersonalAccessTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *PersonalAccessTokenUpdateOne {
+	if t != nil {
+		patuo.SetRevokedAt(*t)
+	}
+	return patuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (patuo *PersonalAccessTokenUpdateOne) ClearRevokedAt() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearRevokedAt()
+	return patuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (patuo *PersonalAccessTokenUpdateOne) SetUserID(id int) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.SetUserID(id)
+	return patuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (patuo *PersonalAccessTokenUpdateOne) SetUser(u *User) *PersonalAccessTokenUpdateOne {
+	return patuo.SetUserID(u.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (patuo *PersonalAccessTokenUpdateOne) Mutation() *PersonalAccessTokenMutation {
+	return patuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (patuo *PersonalAccessTokenUpdateOne) ClearUser() *PersonalAccessTokenUpdateOne {
+	patuo.mutation.ClearUser()
+	return patuo
+}
+
+// Where appends a list predicates to the PersonalAccessTokenUpdate builder.
+func (patuo *PersonalAccessTokenUpdateOne) Where(ps ...predicate.PersonalAccessToken) *PersonalAccessTokenUpdateOne {
+	patuo.mutation.Where(ps...)
+	return patuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (patuo *PersonalAccessTokenUpdateOne) Select(field string, fields ...string) *PersonalAccessTokenUpdateOne {
+	patuo.fields = append([]string{field}, fields...)
+	return patuo
+}
+
+// Save executes the query and returns the updated PersonalAccessToken entity.
+func (patuo *PersonalAccessTokenUpdateOne) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	patuo.defaults()
+	return withHooks(ctx, patuo.sqlSave, patuo.mutation, patuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (patuo *PersonalAccessTokenUpdateOne) SaveX(ctx context.Context) *PersonalAccessToken {
+	node, err := patuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (patuo *PersonalAccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := patuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (patuo *PersonalAccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := patuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (patuo *PersonalAccessTokenUpdateOne) defaults() {
+	if _, ok := patuo.mutation.UpdateTime(); !ok {
+		v := personalaccesstoken.UpdateDefaultUpdateTime()
+		patuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (patuo *PersonalAccessTokenUpdateOne) check() error {
+	if v, ok := patuo.mutation.Name(); ok {
+		if err := personalaccesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
+		}
+	}
+	if patuo.mutation.UserCleared() && len(patuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PersonalAccessToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (patuo *PersonalAccessTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PersonalAccessTokenUpdateOne {
+	patuo.modifiers = append(patuo.modifiers, modifiers...)
+	return patuo
+}
+
+func (patuo *PersonalAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *PersonalAccessToken, err error) {
+	if err := patuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personalaccesstoken.Table, personalaccesstoken.Columns, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt))
+	id, ok := patuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PersonalAccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := patuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, personalaccesstoken.FieldID)
+		for _, f := range fields {
+			if !personalaccesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != personalaccesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := patuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := patuo.mutation.UpdateTime(); ok {
+		_spec.SetField(personalaccesstoken.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := patuo.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := patuo.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := patuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if patuo.mutation.ScopesCleared() {
+		_spec.ClearField(personalaccesstoken.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := patuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := patuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if patuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := patuo.mutation.RevokedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if patuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	if patuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := patuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(patuo.modifiers...)
+	_node = &PersonalAccessToken{config: patuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, patuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	patuo.mutation.done = true
+	return _node, nil
+}
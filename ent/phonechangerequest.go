@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/user"
+)
+
+// PhoneChangeRequest is the model entity for the PhoneChangeRequest schema.
+type PhoneChangeRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// NewPhoneNumber holds the value of the "new_phone_number" field.
+	NewPhoneNumber string `json:"new_phone_number,omitempty"`
+	// SHA-256 of the code sent to the new number
+	CodeHash string `json:"-"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PhoneChangeRequestQuery when eager-loading is set.
+	Edges                      PhoneChangeRequestEdges `json:"edges"`
+	user_phone_change_requests *int
+	selectValues               sql.SelectValues
+}
+
+// PhoneChangeRequestEdges holds the relations/edges for other nodes in the graph.
+type PhoneChangeRequestEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PhoneChangeRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PhoneChangeRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case phonechangerequest.FieldID, phonechangerequest.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case phonechangerequest.FieldNewPhoneNumber, phonechangerequest.FieldCodeHash:
+			values[i] = new(sql.NullString)
+		case phonechangerequest.FieldCreateTime, phonechangerequest.FieldUpdateTime, phonechangerequest.FieldExpiresAt, phonechangerequest.FieldVerifiedAt:
+			values[i] = new(sql.NullTime)
+		case phonechangerequest.ForeignKeys[0]: // user_phone_change_requests
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PhoneChangeRequest fields.
+func (pcr *PhoneChangeRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case phonechangerequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pcr.ID = int(value.Int64)
+		case phonechangerequest.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				pcr.CreateTime = value.Time
+			}
+		case phonechangerequest.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				pcr.UpdateTime = value.Time
+			}
+		case phonechangerequest.FieldNewPhoneNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_phone_number", values[i])
+			} else if value.Valid {
+				pcr.NewPhoneNumber = value.String
+			}
+		case phonechangerequest.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				pcr.CodeHash = value.String
+			}
+		case phonechangerequest.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				pcr.Attempts = int(value.Int64)
+			}
+		case phonechangerequest.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pcr.ExpiresAt = value.Time
+			}
+		case phonechangerequest.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				pcr.VerifiedAt = new(time.Time)
+				*pcr.VerifiedAt = value.Time
+			}
+		case phonechangerequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_phone_change_requests", value)
+			} else if value.Valid {
+				pcr.user_phone_change_requests = new(int)
+				*pcr.user_phone_change_requests = int(value.Int64)
+			}
+		default:
+			pcr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PhoneChangeRequest.
+// This includes values selected through modifiers, order, etc.
+func (pcr *PhoneChangeRequest) Value(name string) (ent.Value, error) {
+	return pcr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PhoneChangeRequest entity.
+func (pcr *PhoneChangeRequest) QueryUser() *UserQuery {
+	return NewPhoneChangeRequestClient(pcr.config).QueryUser(pcr)
+}
+
+// Update returns a builder for updating this PhoneChangeRequest.
+// Note that you need to call PhoneChangeRequest.Unwrap() before calling this method if this PhoneChangeRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pcr *PhoneChangeRequest) Update() *PhoneChangeRequestUpdateOne {
+	return NewPhoneChangeRequestClient(pcr.config).UpdateOne(pcr)
+}
+
+// Unwrap unwraps the PhoneChangeRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pcr *PhoneChangeRequest) Unwrap() *PhoneChangeRequest {
+	_tx, ok := pcr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PhoneChangeRequest is not a transactional entity")
+	}
+	pcr.config.driver = _tx.drv
+	return pcr
+}
+
+// String implements the fmt.Stringer.
+func (pcr *PhoneChangeRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("PhoneChangeRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pcr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(pcr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(pcr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("new_phone_number=")
+	builder.WriteString(pcr.NewPhoneNumber)
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", pcr.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(pcr.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := pcr.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PhoneChangeRequests is a parsable slice of PhoneChangeRequest.
+type PhoneChangeRequests []*PhoneChangeRequest
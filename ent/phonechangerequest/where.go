@@ -0,0 +1,469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package phonechangerequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// NewPhoneNumber applies equality check predicate on the "new_phone_number" field. It's identical to NewPhoneNumberEQ.
+func NewPhoneNumber(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldNewPhoneNumber, v))
+}
+
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldAttempts, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NewPhoneNumberEQ applies the EQ predicate on the "new_phone_number" field.
+func NewPhoneNumberEQ(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberNEQ applies the NEQ predicate on the "new_phone_number" field.
+func NewPhoneNumberNEQ(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberIn applies the In predicate on the "new_phone_number" field.
+func NewPhoneNumberIn(vs ...string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldNewPhoneNumber, vs...))
+}
+
+// NewPhoneNumberNotIn applies the NotIn predicate on the "new_phone_number" field.
+func NewPhoneNumberNotIn(vs ...string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldNewPhoneNumber, vs...))
+}
+
+// NewPhoneNumberGT applies the GT predicate on the "new_phone_number" field.
+func NewPhoneNumberGT(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberGTE applies the GTE predicate on the "new_phone_number" field.
+func NewPhoneNumberGTE(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberLT applies the LT predicate on the "new_phone_number" field.
+func NewPhoneNumberLT(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberLTE applies the LTE predicate on the "new_phone_number" field.
+func NewPhoneNumberLTE(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberContains applies the Contains predicate on the "new_phone_number" field.
+func NewPhoneNumberContains(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldContains(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberHasPrefix applies the HasPrefix predicate on the "new_phone_number" field.
+func NewPhoneNumberHasPrefix(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldHasPrefix(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberHasSuffix applies the HasSuffix predicate on the "new_phone_number" field.
+func NewPhoneNumberHasSuffix(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldHasSuffix(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberEqualFold applies the EqualFold predicate on the "new_phone_number" field.
+func NewPhoneNumberEqualFold(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEqualFold(FieldNewPhoneNumber, v))
+}
+
+// NewPhoneNumberContainsFold applies the ContainsFold predicate on the "new_phone_number" field.
+func NewPhoneNumberContainsFold(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldContainsFold(FieldNewPhoneNumber, v))
+}
+
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldContains(FieldCodeHash, v))
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldHasPrefix(FieldCodeHash, v))
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldHasSuffix(FieldCodeHash, v))
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEqualFold(FieldCodeHash, v))
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldContainsFold(FieldCodeHash, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PhoneChangeRequest) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PhoneChangeRequest) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PhoneChangeRequest) predicate.PhoneChangeRequest {
+	return predicate.PhoneChangeRequest(sql.NotPredicates(p))
+}
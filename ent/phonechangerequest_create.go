@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/user"
+)
+
+// PhoneChangeRequestCreate is the builder for creating a PhoneChangeRequest entity.
+type PhoneChangeRequestCreate struct {
+	config
+	mutation *PhoneChangeRequestMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (pcrc *PhoneChangeRequestCreate) SetCreateTime(t time.Time) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetCreateTime(t)
+	return pcrc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (pcrc *PhoneChangeRequestCreate) SetNillableCreateTime(t *time.Time) *PhoneChangeRequestCreate {
+	if t != nil {
+		pcrc.SetCreateTime(*t)
+	}
+	return pcrc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (pcrc *PhoneChangeRequestCreate) SetUpdateTime(t time.Time) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetUpdateTime(t)
+	return pcrc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (pcrc *PhoneChangeRequestCreate) SetNillableUpdateTime(t *time.Time) *PhoneChangeRequestCreate {
+	if t != nil {
+		pcrc.SetUpdateTime(*t)
+	}
+	return pcrc
+}
+
+// SetNewPhoneNumber sets the "new_phone_number" field.
+func (pcrc *PhoneChangeRequestCreate) SetNewPhoneNumber(s string) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetNewPhoneNumber(s)
+	return pcrc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (pcrc *PhoneChangeRequestCreate) SetCodeHash(s string) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetCodeHash(s)
+	return pcrc
+}
+
+// SetAttempts sets the "attempts" field.
+func (pcrc *PhoneChangeRequestCreate) SetAttempts(i int) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetAttempts(i)
+	return pcrc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pcrc *PhoneChangeRequestCreate) SetNillableAttempts(i *int) *PhoneChangeRequestCreate {
+	if i != nil {
+		pcrc.SetAttempts(*i)
+	}
+	return pcrc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pcrc *PhoneChangeRequestCreate) SetExpiresAt(t time.Time) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetExpiresAt(t)
+	return pcrc
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (pcrc *PhoneChangeRequestCreate) SetVerifiedAt(t time.Time) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetVerifiedAt(t)
+	return pcrc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (pcrc *PhoneChangeRequestCreate) SetNillableVerifiedAt(t *time.Time) *PhoneChangeRequestCreate {
+	if t != nil {
+		pcrc.SetVerifiedAt(*t)
+	}
+	return pcrc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pcrc *PhoneChangeRequestCreate) SetUserID(id int) *PhoneChangeRequestCreate {
+	pcrc.mutation.SetUserID(id)
+	return pcrc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pcrc *PhoneChangeRequestCreate) SetUser(u *User) *PhoneChangeRequestCreate {
+	return pcrc.SetUserID(u.ID)
+}
+
+// Mutation returns the PhoneChangeRequestMutation object of the builder.
+func (pcrc *PhoneChangeRequestCreate) Mutation() *PhoneChangeRequestMutation {
+	return pcrc.mutation
+}
+
+// Save creates the PhoneChangeRequest in the database.
+func (pcrc *PhoneChangeRequestCreate) Save(ctx context.Context) (*PhoneChangeRequest, error) {
+	pcrc.defaults()
+	return withHooks(ctx, pcrc.sqlSave, pcrc.mutation, pcrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcrc *PhoneChangeRequestCreate) SaveX(ctx context.Context) *PhoneChangeRequest {
+	v, err := pcrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcrc *PhoneChangeRequestCreate) Exec(ctx context.Context) error {
+	_, err := pcrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcrc *PhoneChangeRequestCreate) ExecX(ctx context.Context) {
+	if err := pcrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcrc *PhoneChangeRequestCreate) defaults() {
+	if _, ok := pcrc.mutation.CreateTime(); !ok {
+		v := phonechangerequest.DefaultCreateTime()
+		pcrc.mutation.SetCreateTime(v)
+	}
+	if _, ok := pcrc.mutation.UpdateTime(); !ok {
+		v := phonechangerequest.DefaultUpdateTime()
+		pcrc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := pcrc.mutation.Attempts(); !ok {
+		v := phonechangerequest.DefaultAttempts
+		pcrc.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcrc *PhoneChangeRequestCreate) check() error {
+	if _, ok := pcrc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "PhoneChangeRequest.create_time"`)}
+	}
+	if _, ok := pcrc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "PhoneChangeRequest.update_time"`)}
+	}
+	if _, ok := pcrc.mutation.NewPhoneNumber(); !ok {
+		return &ValidationError{Name: "new_phone_number", err: errors.New(`ent: missing required field "PhoneChangeRequest.new_phone_number"`)}
+	}
+	if v, ok := pcrc.mutation.NewPhoneNumber(); ok {
+		if err := phonechangerequest.NewPhoneNumberValidator(v); err != nil {
+			return &ValidationError{Name: "new_phone_number", err: fmt.Errorf(`ent: validator failed for field "PhoneChangeRequest.new_phone_number": %w`, err)}
+		}
+	}
+	if _, ok := pcrc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "PhoneChangeRequest.code_hash"`)}
+	}
+	if v, ok := pcrc.mutation.CodeHash(); ok {
+		if err := phonechangerequest.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "PhoneChangeRequest.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := pcrc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "PhoneChangeRequest.attempts"`)}
+	}
+	if v, ok := pcrc.mutation.Attempts(); ok {
+		if err := phonechangerequest.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PhoneChangeRequest.attempts": %w`, err)}
+		}
+	}
+	if _, ok := pcrc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PhoneChangeRequest.expires_at"`)}
+	}
+	if len(pcrc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PhoneChangeRequest.user"`)}
+	}
+	return nil
+}
+
+func (pcrc *PhoneChangeRequestCreate) sqlSave(ctx context.Context) (*PhoneChangeRequest, error) {
+	if err := pcrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pcrc.mutation.id = &_node.ID
+	pcrc.mutation.done = true
+	return _node, nil
+}
+
+func (pcrc *PhoneChangeRequestCreate) createSpec() (*PhoneChangeRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PhoneChangeRequest{config: pcrc.config}
+		_spec = sqlgraph.NewCreateSpec(phonechangerequest.Table, sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt))
+	)
+	if value, ok := pcrc.mutation.CreateTime(); ok {
+		_spec.SetField(phonechangerequest.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := pcrc.mutation.UpdateTime(); ok {
+		_spec.SetField(phonechangerequest.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := pcrc.mutation.NewPhoneNumber(); ok {
+		_spec.SetField(phonechangerequest.FieldNewPhoneNumber, field.TypeString, value)
+		_node.NewPhoneNumber = value
+	}
+	if value, ok := pcrc.mutation.CodeHash(); ok {
+		_spec.SetField(phonechangerequest.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := pcrc.mutation.Attempts(); ok {
+		_spec.SetField(phonechangerequest.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := pcrc.mutation.ExpiresAt(); ok {
+		_spec.SetField(phonechangerequest.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := pcrc.mutation.VerifiedAt(); ok {
+		_spec.SetField(phonechangerequest.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if nodes := pcrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   phonechangerequest.UserTable,
+			Columns: []string{phonechangerequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_phone_change_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PhoneChangeRequestCreateBulk is the builder for creating many PhoneChangeRequest entities in bulk.
+type PhoneChangeRequestCreateBulk struct {
+	config
+	err      error
+	builders []*PhoneChangeRequestCreate
+}
+
+// Save creates the PhoneChangeRequest entities in the database.
+func (pcrcb *PhoneChangeRequestCreateBulk) Save(ctx context.Context) ([]*PhoneChangeRequest, error) {
+	if pcrcb.err != nil {
+		return nil, pcrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcrcb.builders))
+	nodes := make([]*PhoneChangeRequest, len(pcrcb.builders))
+	mutators := make([]Mutator, len(pcrcb.builders))
+	for i := range pcrcb.builders {
+		func(i int, root context.Context) {
+			builder := pcrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PhoneChangeRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcrcb *PhoneChangeRequestCreateBulk) SaveX(ctx context.Context) []*PhoneChangeRequest {
+	v, err := pcrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcrcb *PhoneChangeRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcrcb *PhoneChangeRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := pcrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
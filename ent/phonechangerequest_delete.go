@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/predicate"
+)
+
+// PhoneChangeRequestDelete is the builder for deleting a PhoneChangeRequest entity.
+type PhoneChangeRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *PhoneChangeRequestMutation
+}
+
+// Where appends a list predicates to the PhoneChangeRequestDelete builder.
+func (pcrd *PhoneChangeRequestDelete) Where(ps ...predicate.PhoneChangeRequest) *PhoneChangeRequestDelete {
+	pcrd.mutation.Where(ps...)
+	return pcrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pcrd *PhoneChangeRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcrd.sqlExec, pcrd.mutation, pcrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcrd *PhoneChangeRequestDelete) ExecX(ctx context.Context) int {
+	n, err := pcrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pcrd *PhoneChangeRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(phonechangerequest.Table, sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt))
+	if ps := pcrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pcrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pcrd.mutation.done = true
+	return affected, err
+}
+
+// PhoneChangeRequestDeleteOne is the builder for deleting a single PhoneChangeRequest entity.
+type PhoneChangeRequestDeleteOne struct {
+	pcrd *PhoneChangeRequestDelete
+}
+
+// Where appends a list predicates to the PhoneChangeRequestDelete builder.
+func (pcrdo *PhoneChangeRequestDeleteOne) Where(ps ...predicate.PhoneChangeRequest) *PhoneChangeRequestDeleteOne {
+	pcrdo.pcrd.mutation.Where(ps...)
+	return pcrdo
+}
+
+// Exec executes the deletion query.
+func (pcrdo *PhoneChangeRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcrdo.pcrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{phonechangerequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcrdo *PhoneChangeRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := pcrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// PhoneChangeRequestQuery is the builder for querying PhoneChangeRequest entities.
+type PhoneChangeRequestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []phonechangerequest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PhoneChangeRequest
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PhoneChangeRequestQuery builder.
+func (pcrq *PhoneChangeRequestQuery) Where(ps ...predicate.PhoneChangeRequest) *PhoneChangeRequestQuery {
+	pcrq.predicates = append(pcrq.predicates, ps...)
+	return pcrq
+}
+
+// Limit the number of records to be returned by this query.
+func (pcrq *PhoneChangeRequestQuery) Limit(limit int) *PhoneChangeRequestQuery {
+	pcrq.ctx.Limit = &limit
+	return pcrq
+}
+
+// Offset to start from.
+func (pcrq *PhoneChangeRequestQuery) Offset(offset int) *PhoneChangeRequestQuery {
+	pcrq.ctx.Offset = &offset
+	return pcrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pcrq *PhoneChangeRequestQuery) Unique(unique bool) *PhoneChangeRequestQuery {
+	pcrq.ctx.Unique = &unique
+	return pcrq
+}
+
+// Order specifies how the records should be ordered.
+func (pcrq *PhoneChangeRequestQuery) Order(o ...phonechangerequest.OrderOption) *PhoneChangeRequestQuery {
+	pcrq.order = append(pcrq.order, o...)
+	return pcrq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (pcrq *PhoneChangeRequestQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: pcrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(phonechangerequest.Table, phonechangerequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, phonechangerequest.UserTable, phonechangerequest.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PhoneChangeRequest entity from the query.
+// Returns a *NotFoundError when no PhoneChangeRequest was found.
+func (pcrq *PhoneChangeRequestQuery) First(ctx context.Context) (*PhoneChangeRequest, error) {
+	nodes, err := pcrq.Limit(1).All(setContextOp(ctx, pcrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{phonechangerequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) FirstX(ctx context.Context) *PhoneChangeRequest {
+	node, err := pcrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PhoneChangeRequest ID from the query.
+// Returns a *NotFoundError when no PhoneChangeRequest ID was found.
+func (pcrq *PhoneChangeRequestQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcrq.Limit(1).IDs(setContextOp(ctx, pcrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{phonechangerequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) FirstIDX(ctx context.Context) int {
+	id, err := pcrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PhoneChangeRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PhoneChangeRequest entity is found.
+// Returns a *NotFoundError when no PhoneChangeRequest entities are found.
+func (pcrq *PhoneChangeRequestQuery) Only(ctx context.Context) (*PhoneChangeRequest, error) {
+	nodes, err := pcrq.Limit(2).All(setContextOp(ctx, pcrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{phonechangerequest.Label}
+	default:
+		return nil, &NotSingularError{phonechangerequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) OnlyX(ctx context.Context) *PhoneChangeRequest {
+	node, err := pcrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PhoneChangeRequest ID in the query.
+// Returns a *NotSingularError when more than one PhoneChangeRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pcrq *PhoneChangeRequestQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcrq.Limit(2).IDs(setContextOp(ctx, pcrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{phonechangerequest.Label}
+	default:
+		err = &NotSingularError{phonechangerequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pcrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PhoneChangeRequests.
+func (pcrq *PhoneChangeRequestQuery) All(ctx context.Context) ([]*PhoneChangeRequest, error) {
+	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryAll)
+	if err := pcrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PhoneChangeRequest, *PhoneChangeRequestQuery]()
+	return withInterceptors[[]*PhoneChangeRequest](ctx, pcrq, qr, pcrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) AllX(ctx context.Context) []*PhoneChangeRequest {
+	nodes, err := pcrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PhoneChangeRequest IDs.
+func (pcrq *PhoneChangeRequestQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pcrq.ctx.Unique == nil && pcrq.path != nil {
+		pcrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryIDs)
+	if err = pcrq.Select(phonechangerequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) IDsX(ctx context.Context) []int {
+	ids, err := pcrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pcrq *PhoneChangeRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryCount)
+	if err := pcrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pcrq, querierCount[*PhoneChangeRequestQuery](), pcrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) CountX(ctx context.Context) int {
+	count, err := pcrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pcrq *PhoneChangeRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryExist)
+	switch _, err := pcrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pcrq *PhoneChangeRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := pcrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PhoneChangeRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pcrq *PhoneChangeRequestQuery) Clone() *PhoneChangeRequestQuery {
+	if pcrq == nil {
+		return nil
+	}
+	return &PhoneChangeRequestQuery{
+		config:     pcrq.config,
+		ctx:        pcrq.ctx.Clone(),
+		order:      append([]phonechangerequest.OrderOption{}, pcrq.order...),
+		inters:     append([]Interceptor{}, pcrq.inters...),
+		predicates: append([]predicate.PhoneChangeRequest{}, pcrq.predicates...),
+		withUser:   pcrq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       pcrq.sql.Clone(),
+		path:      pcrq.path,
+		modifiers: append([]func(*sql.Selector){}, pcrq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcrq *PhoneChangeRequestQuery) WithUser(opts ...func(*UserQuery)) *PhoneChangeRequestQuery {
+	query := (&UserClient{config: pcrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcrq.withUser = query
+	return pcrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PhoneChangeRequest.Query().
+//		GroupBy(phonechangerequest.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pcrq *PhoneChangeRequestQuery) GroupBy(field string, fields ...string) *PhoneChangeRequestGroupBy {
+	pcrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PhoneChangeRequestGroupBy{build: pcrq}
+	grbuild.flds = &pcrq.ctx.Fields
+	grbuild.label = phonechangerequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.PhoneChangeRequest.Query().
+//		Select(phonechangerequest.FieldCreateTime).
+//		Scan(ctx, &v)
+func (pcrq *PhoneChangeRequestQuery) Select(fields ...string) *PhoneChangeRequestSelect {
+	pcrq.ctx.Fields = append(pcrq.ctx.Fields, fields...)
+	sbuild := &PhoneChangeRequestSelect{PhoneChangeRequestQuery: pcrq}
+	sbuild.label = phonechangerequest.Label
+	sbuild.flds, sbuild.scan = &pcrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PhoneChangeRequestSelect configured with the given aggregations.
+func (pcrq *PhoneChangeRequestQuery) Aggregate(fns ...AggregateFunc) *PhoneChangeRequestSelect {
+	return pcrq.Select().Aggregate(fns...)
+}
+
+func (pcrq *PhoneChangeRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pcrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pcrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pcrq.ctx.Fields {
+		if !phonechangerequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pcrq.path != nil {
+		prev, err := pcrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pcrq.sql = prev
+	}
+	return nil
+}
+
+func (pcrq *PhoneChangeRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PhoneChangeRequest, error) {
+	var (
+		nodes       = []*PhoneChangeRequest{}
+		withFKs     = pcrq.withFKs
+		_spec       = pcrq.querySpec()
+		loadedTypes = [1]bool{
+			pcrq.withUser != nil,
+		}
+	)
+	if pcrq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, phonechangerequest.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PhoneChangeRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PhoneChangeRequest{config: pcrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pcrq.modifiers) > 0 {
+		_spec.Modifiers = pcrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pcrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pcrq.withUser; query != nil {
+		if err := pcrq.loadUser(ctx, query, nodes, nil,
+			func(n *PhoneChangeRequest, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pcrq *PhoneChangeRequestQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*PhoneChangeRequest, init func(*PhoneChangeRequest), assign func(*PhoneChangeRequest, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PhoneChangeRequest)
+	for i := range nodes {
+		if nodes[i].user_phone_change_requests == nil {
+			continue
+		}
+		fk := *nodes[i].user_phone_change_requests
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_phone_change_requests" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pcrq *PhoneChangeRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pcrq.querySpec()
+	if len(pcrq.modifiers) > 0 {
+		_spec.Modifiers = pcrq.modifiers
+	}
+	_spec.Node.Columns = pcrq.ctx.Fields
+	if len(pcrq.ctx.Fields) > 0 {
+		_spec.Unique = pcrq.ctx.Unique != nil && *pcrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pcrq.driver, _spec)
+}
+
+func (pcrq *PhoneChangeRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(phonechangerequest.Table, phonechangerequest.Columns, sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt))
+	_spec.From = pcrq.sql
+	if unique := pcrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pcrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pcrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, phonechangerequest.FieldID)
+		for i := range fields {
+			if fields[i] != phonechangerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pcrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pcrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pcrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pcrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pcrq *PhoneChangeRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pcrq.driver.Dialect())
+	t1 := builder.Table(phonechangerequest.Table)
+	columns := pcrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = phonechangerequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pcrq.sql != nil {
+		selector = pcrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pcrq.ctx.Unique != nil && *pcrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pcrq.modifiers {
+		m(selector)
+	}
+	for _, p := range pcrq.predicates {
+		p(selector)
+	}
+	for _, p := range pcrq.order {
+		p(selector)
+	}
+	if offset := pcrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pcrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pcrq *PhoneChangeRequestQuery) ForUpdate(opts ...sql.LockOption) *PhoneChangeRequestQuery {
+	if pcrq.driver.Dialect() == dialect.Postgres {
+		pcrq.Unique(false)
+	}
+	pcrq.modifiers = append(pcrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pcrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pcrq *PhoneChangeRequestQuery) ForShare(opts ...sql.LockOption) *PhoneChangeRequestQuery {
+	if pcrq.driver.Dialect() == dialect.Postgres {
+		pcrq.Unique(false)
+	}
+	pcrq.modifiers = append(pcrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pcrq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pcrq *PhoneChangeRequestQuery) Modify(modifiers ...func(s *sql.Selector)) *PhoneChangeRequestSelect {
+	pcrq.modifiers = append(pcrq.modifiers, modifiers...)
+	return pcrq.Select()
+}
+
+// PhoneChangeRequestGroupBy is the group-by builder for PhoneChangeRequest entities.
+type PhoneChangeRequestGroupBy struct {
+	selector
+	build *PhoneChangeRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcrgb *PhoneChangeRequestGroupBy) Aggregate(fns ...AggregateFunc) *PhoneChangeRequestGroupBy {
+	pcrgb.fns = append(pcrgb.fns, fns...)
+	return pcrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcrgb *PhoneChangeRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pcrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PhoneChangeRequestQuery, *PhoneChangeRequestGroupBy](ctx, pcrgb.build, pcrgb, pcrgb.build.inters, v)
+}
+
+func (pcrgb *PhoneChangeRequestGroupBy) sqlScan(ctx context.Context, root *PhoneChangeRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pcrgb.fns))
+	for _, fn := range pcrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pcrgb.flds)+len(pcrgb.fns))
+		for _, f := range *pcrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pcrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PhoneChangeRequestSelect is the builder for selecting fields of PhoneChangeRequest entities.
+type PhoneChangeRequestSelect struct {
+	*PhoneChangeRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pcrs *PhoneChangeRequestSelect) Aggregate(fns ...AggregateFunc) *PhoneChangeRequestSelect {
+	pcrs.fns = append(pcrs.fns, fns...)
+	return pcrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcrs *PhoneChangeRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcrs.ctx, ent.OpQuerySelect)
+	if err := pcrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PhoneChangeRequestQuery, *PhoneChangeRequestSelect](ctx, pcrs.PhoneChangeRequestQuery, pcrs, pcrs.inters, v)
+}
+
+func (pcrs *PhoneChangeRequestSelect) sqlScan(ctx context.Context, root *PhoneChangeRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pcrs.fns))
+	for _, fn := range pcrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pcrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pcrs *PhoneChangeRequestSelect) Modify(modifiers ...func(s *sql.Selector)) *PhoneChangeRequestSelect {
+	pcrs.modifiers = append(pcrs.modifiers, modifiers...)
+	return pcrs
+}
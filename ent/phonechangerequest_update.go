@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// PhoneChangeRequestUpdate is the builder for updating PhoneChangeRequest entities.
+type PhoneChangeRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PhoneChangeRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PhoneChangeRequestUpdate builder.
+func (pcru *PhoneChangeRequestUpdate) Where(ps ...predicate.PhoneChangeRequest) *PhoneChangeRequestUpdate {
+	pcru.mutation.Where(ps...)
+	return pcru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (pcru *PhoneChangeRequestUpdate) SetUpdateTime(t time.Time) *PhoneChangeRequestUpdate {
+	pcru.mutation.SetUpdateTime(t)
+	return pcru
+}
+
+// SetAttempts sets the "attempts" field.
+func (pcru *PhoneChangeRequestUpdate) SetAttempts(i int) *PhoneChangeRequestUpdate {
+	pcru.mutation.ResetAttempts()
+	pcru.mutation.SetAttempts(i)
+	return pcru
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pcru *PhoneChangeRequestUpdate) SetNillableAttempts(i *int) *PhoneChangeRequestUpdate {
+	if i != nil {
+		pcru.SetAttempts(*i)
+	}
+	return pcru
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pcru *PhoneChangeRequestUpdate) AddAttempts(i int) *PhoneChangeRequestUpdate {
+	pcru.mutation.AddAttempts(i)
+	return pcru
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (pcru *PhoneChangeRequestUpdate) SetVerifiedAt(t time.Time) *PhoneChangeRequestUpdate {
+	pcru.mutation.SetVerifiedAt(t)
+	return pcru
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (pcru *PhoneChangeRequestUpdate) SetNillableVerifiedAt(t *time.Time) *PhoneChangeRequestUpdate {
+	if t != nil {
+		pcru.SetVerifiedAt(*t)
+	}
+	return pcru
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (pcru *PhoneChangeRequestUpdate) ClearVerifiedAt() *PhoneChangeRequestUpdate {
+	pcru.mutation.ClearVerifiedAt()
+	return pcru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pcru *PhoneChangeRequestUpdate) SetUserID(id int) *PhoneChangeRequestUpdate {
+	pcru.mutation.SetUserID(id)
+	return pcru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pcru *PhoneChangeRequestUpdate) SetUser(u *User) *PhoneChangeRequestUpdate {
+	return pcru.SetUserID(u.ID)
+}
+
+// Mutation returns the PhoneChangeRequestMutation object of the builder.
+func (pcru *PhoneChangeRequestUpdate) Mutation() *PhoneChangeRequestMutation {
+	return pcru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pcru *PhoneChangeRequestUpdate) ClearUser() *PhoneChangeRequestUpdate {
+	pcru.mutation.ClearUser()
+	return pcru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcru *PhoneChangeRequestUpdate) Save(ctx context.Context) (int, error) {
+	pcru.defaults()
+	return withHooks(ctx, pcru.sqlSave, pcru.mutation, pcru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcru *PhoneChangeRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcru *PhoneChangeRequestUpdate) Exec(ctx context.Context) error {
+	_, err := pcru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcru *PhoneChangeRequestUpdate) ExecX(ctx context.Context) {
+	if err := pcru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcru *PhoneChangeRequestUpdate) defaults() {
+	if _, ok := pcru.mutation.UpdateTime(); !ok {
+		v := phonechangerequest.UpdateDefaultUpdateTime()
+		pcru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcru *PhoneChangeRequestUpdate) check() error {
+	if v, ok := pcru.mutation.Attempts(); ok {
+		if err := phonechangerequest.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PhoneChangeRequest.attempts": %w`, err)}
+		}
+	}
+	if pcru.mutation.UserCleared() && len(pcru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PhoneChangeRequest.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcru *PhoneChangeRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PhoneChangeRequestUpdate {
+	pcru.modifiers = append(pcru.modifiers, modifiers...)
+	return pcru
+}
+
+func (pcru *PhoneChangeRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(phonechangerequest.Table, phonechangerequest.Columns, sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt))
+	if ps := pcru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcru.mutation.UpdateTime(); ok {
+		_spec.SetField(phonechangerequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := pcru.mutation.Attempts(); ok {
+		_spec.SetField(phonechangerequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcru.mutation.AddedAttempts(); ok {
+		_spec.AddField(phonechangerequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcru.mutation.VerifiedAt(); ok {
+		_spec.SetField(phonechangerequest.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if pcru.mutation.VerifiedAtCleared() {
+		_spec.ClearField(phonechangerequest.FieldVerifiedAt, field.TypeTime)
+	}
+	if pcru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   phonechangerequest.UserTable,
+			Columns: []string{phonechangerequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   phonechangerequest.UserTable,
+			Columns: []string{phonechangerequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pcru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pcru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{phonechangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcru.mutation.done = true
+	return n, nil
+}
+
+// PhoneChangeRequestUpdateOne is the builder for updating a single PhoneChangeRequest entity.
+type PhoneChangeRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PhoneChangeRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (pcruo *PhoneChangeRequestUpdateOne) SetUpdateTime(t time.Time) *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.SetUpdateTime(t)
+	return pcruo
+}
+
+// SetAttempts sets the "attempts" field.
+func (pcruo *PhoneChangeRequestUpdateOne) SetAttempts(i int) *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.ResetAttempts()
+	pcruo.mutation.SetAttempts(i)
+	return pcruo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pcruo *PhoneChangeRequestUpdateOne) SetNillableAttempts(i *int) *PhoneChangeRequestUpdateOne {
+	if i != nil {
+		pcruo.SetAttempts(*i)
+	}
+	return pcruo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pcruo *PhoneChangeRequestUpdateOne) AddAttempts(i int) *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.AddAttempts(i)
+	return pcruo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (pcruo *PhoneChangeRequestUpdateOne) SetVerifiedAt(t time.Time) *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.SetVerifiedAt(t)
+	return pcruo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (pcruo *PhoneChangeRequestUpdateOne) SetNillableVerifiedAt(t *time.Time) *PhoneChangeRequestUpdateOne {
+	if t != nil {
+		pcruo.SetVerifiedAt(*t)
+	}
+	return pcruo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (pcruo *PhoneChangeRequestUpdateOne) ClearVerifiedAt() *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.ClearVerifiedAt()
+	return pcruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pcruo *PhoneChangeRequestUpdateOne) SetUserID(id int) *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.SetUserID(id)
+	return pcruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pcruo *PhoneChangeRequestUpdateOne) SetUser(u *User) *PhoneChangeRequestUpdateOne {
+	return pcruo.SetUserID(u.ID)
+}
+
+// Mutation returns the PhoneChangeRequestMutation object of the builder.
+func (pcruo *PhoneChangeRequestUpdateOne) Mutation() *PhoneChangeRequestMutation {
+	return pcruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pcruo *PhoneChangeRequestUpdateOne) ClearUser() *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.ClearUser()
+	return pcruo
+}
+
+// Where appends a list predicates to the PhoneChangeRequestUpdate builder.
+func (pcruo *PhoneChangeRequestUpdateOne) Where(ps ...predicate.PhoneChangeRequest) *PhoneChangeRequestUpdateOne {
+	pcruo.mutation.Where(ps...)
+	return pcruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcruo *PhoneChangeRequestUpdateOne) Select(field string, fields ...string) *PhoneChangeRequestUpdateOne {
+	pcruo.fields = append([]string{field}, fields...)
+	return pcruo
+}
+
+// Save executes the query and returns the updated PhoneChangeRequest entity.
+func (pcruo *PhoneChangeRequestUpdateOne) Save(ctx context.Context) (*PhoneChangeRequest, error) {
+	pcruo.defaults()
+	return withHooks(ctx, pcruo.sqlSave, pcruo.mutation, pcruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcruo *PhoneChangeRequestUpdateOne) SaveX(ctx context.Context) *PhoneChangeRequest {
+	node, err := pcruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcruo *PhoneChangeRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcruo *PhoneChangeRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := pcruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcruo *PhoneChangeRequestUpdateOne) defaults() {
+	if _, ok := pcruo.mutation.UpdateTime(); !ok {
+		v := phonechangerequest.UpdateDefaultUpdateTime()
+		pcruo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcruo *PhoneChangeRequestUpdateOne) check() error {
+	if v, ok := pcruo.mutation.Attempts(); ok {
+		if err := phonechangerequest.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PhoneChangeRequest.attempts": %w`, err)}
+		}
+	}
+	if pcruo.mutation.UserCleared() && len(pcruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PhoneChangeRequest.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pcruo *PhoneChangeRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PhoneChangeRequestUpdateOne {
+	pcruo.modifiers = append(pcruo.modifiers, modifiers...)
+	return pcruo
+}
+
+func (pcruo *PhoneChangeRequestUpdateOne) sqlSave(ctx context.Context) (_node *PhoneChangeRequest, err error) {
+	if err := pcruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(phonechangerequest.Table, phonechangerequest.Columns, sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt))
+	id, ok := pcruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PhoneChangeRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, phonechangerequest.FieldID)
+		for _, f := range fields {
+			if !phonechangerequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != phonechangerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcruo.mutation.UpdateTime(); ok {
+		_spec.SetField(phonechangerequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := pcruo.mutation.Attempts(); ok {
+		_spec.SetField(phonechangerequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcruo.mutation.AddedAttempts(); ok {
+		_spec.AddField(phonechangerequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcruo.mutation.VerifiedAt(); ok {
+		_spec.SetField(phonechangerequest.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if pcruo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(phonechangerequest.FieldVerifiedAt, field.TypeTime)
+	}
+	if pcruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   phonechangerequest.UserTable,
+			Columns: []string{phonechangerequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   phonechangerequest.UserTable,
+			Columns: []string{phonechangerequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pcruo.modifiers...)
+	_node = &PhoneChangeRequest{config: pcruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{phonechangerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/user"
+)
+
+// QRLoginRequest is the model entity for the QRLoginRequest schema.
+type QRLoginRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Encoded in the QR code
+	RequestID string `json:"request_id,omitempty"`
+	// SHA-256 of the secret kept in the requesting browser's cookie
+	BrowserSecretHash string `json:"-"`
+	// Status holds the value of the "status" field.
+	Status qrloginrequest.Status `json:"status,omitempty"`
+	// User agent of the requesting browser
+	UserAgent string `json:"user_agent,omitempty"`
+	// IP address of the requesting browser
+	IPAddress string `json:"ip_address,omitempty"`
+	// Approximate location of the requesting browser
+	Location string `json:"location,omitempty"`
+	// ScannedAt holds the value of the "scanned_at" field.
+	ScannedAt *time.Time `json:"scanned_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QRLoginRequestQuery when eager-loading is set.
+	Edges                  QRLoginRequestEdges `json:"edges"`
+	user_qr_login_requests *int
+	selectValues           sql.SelectValues
+}
+
+// QRLoginRequestEdges holds the relations/edges for other nodes in the graph.
+type QRLoginRequestEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QRLoginRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QRLoginRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case qrloginrequest.FieldID:
+			values[i] = new(sql.NullInt64)
+		case qrloginrequest.FieldRequestID, qrloginrequest.FieldBrowserSecretHash, qrloginrequest.FieldStatus, qrloginrequest.FieldUserAgent, qrloginrequest.FieldIPAddress, qrloginrequest.FieldLocation:
+			values[i] = new(sql.NullString)
+		case qrloginrequest.FieldCreateTime, qrloginrequest.FieldUpdateTime, qrloginrequest.FieldScannedAt, qrloginrequest.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case qrloginrequest.ForeignKeys[0]: // user_qr_login_requests
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QRLoginRequest fields.
+func (qlr *QRLoginRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case qrloginrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			qlr.ID = int(value.Int64)
+		case qrloginrequest.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				qlr.CreateTime = value.Time
+			}
+		case qrloginrequest.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				qlr.UpdateTime = value.Time
+			}
+		case qrloginrequest.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				qlr.RequestID = value.String
+			}
+		case qrloginrequest.FieldBrowserSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field browser_secret_hash", values[i])
+			} else if value.Valid {
+				qlr.BrowserSecretHash = value.String
+			}
+		case qrloginrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				qlr.Status = qrloginrequest.Status(value.String)
+			}
+		case qrloginrequest.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				qlr.UserAgent = value.String
+			}
+		case qrloginrequest.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				qlr.IPAddress = value.String
+			}
+		case qrloginrequest.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				qlr.Location = value.String
+			}
+		case qrloginrequest.FieldScannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_at", values[i])
+			} else if value.Valid {
+				qlr.ScannedAt = new(time.Time)
+				*qlr.ScannedAt = value.Time
+			}
+		case qrloginrequest.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				qlr.ExpiresAt = value.Time
+			}
+		case qrloginrequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_qr_login_requests", value)
+			} else if value.Valid {
+				qlr.user_qr_login_requests = new(int)
+				*qlr.user_qr_login_requests = int(value.Int64)
+			}
+		default:
+			qlr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QRLoginRequest.
+// This includes values selected through modifiers, order, etc.
+func (qlr *QRLoginRequest) Value(name string) (ent.Value, error) {
+	return qlr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the QRLoginRequest entity.
+func (qlr *QRLoginRequest) QueryUser() *UserQuery {
+	return NewQRLoginRequestClient(qlr.config).QueryUser(qlr)
+}
+
+// Update returns a builder for updating this QRLoginRequest.
+// Note that you need to call QRLoginRequest.Unwrap() before calling this method if this QRLoginRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qlr *QRLoginRequest) Update() *QRLoginRequestUpdateOne {
+	return NewQRLoginRequestClient(qlr.config).UpdateOne(qlr)
+}
+
+// Unwrap unwraps the QRLoginRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qlr *QRLoginRequest) Unwrap() *QRLoginRequest {
+	_tx, ok := qlr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QRLoginRequest is not a transactional entity")
+	}
+	qlr.config.driver = _tx.drv
+	return qlr
+}
+
+// String implements the fmt.Stringer.
+func (qlr *QRLoginRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("QRLoginRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qlr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(qlr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(qlr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(qlr.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("browser_secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", qlr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(qlr.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(qlr.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(qlr.Location)
+	builder.WriteString(", ")
+	if v := qlr.ScannedAt; v != nil {
+		builder.WriteString("scanned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(qlr.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QRLoginRequests is a parsable slice of QRLoginRequest.
+type QRLoginRequests []*QRLoginRequest
@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package qrloginrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldRequestID, v))
+}
+
+// BrowserSecretHash applies equality check predicate on the "browser_secret_hash" field. It's identical to BrowserSecretHashEQ.
+func BrowserSecretHash(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldBrowserSecretHash, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldLocation, v))
+}
+
+// ScannedAt applies equality check predicate on the "scanned_at" field. It's identical to ScannedAtEQ.
+func ScannedAt(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// BrowserSecretHashEQ applies the EQ predicate on the "browser_secret_hash" field.
+func BrowserSecretHashEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashNEQ applies the NEQ predicate on the "browser_secret_hash" field.
+func BrowserSecretHashNEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashIn applies the In predicate on the "browser_secret_hash" field.
+func BrowserSecretHashIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldBrowserSecretHash, vs...))
+}
+
+// BrowserSecretHashNotIn applies the NotIn predicate on the "browser_secret_hash" field.
+func BrowserSecretHashNotIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldBrowserSecretHash, vs...))
+}
+
+// BrowserSecretHashGT applies the GT predicate on the "browser_secret_hash" field.
+func BrowserSecretHashGT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashGTE applies the GTE predicate on the "browser_secret_hash" field.
+func BrowserSecretHashGTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashLT applies the LT predicate on the "browser_secret_hash" field.
+func BrowserSecretHashLT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashLTE applies the LTE predicate on the "browser_secret_hash" field.
+func BrowserSecretHashLTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashContains applies the Contains predicate on the "browser_secret_hash" field.
+func BrowserSecretHashContains(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContains(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashHasPrefix applies the HasPrefix predicate on the "browser_secret_hash" field.
+func BrowserSecretHashHasPrefix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasPrefix(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashHasSuffix applies the HasSuffix predicate on the "browser_secret_hash" field.
+func BrowserSecretHashHasSuffix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasSuffix(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashEqualFold applies the EqualFold predicate on the "browser_secret_hash" field.
+func BrowserSecretHashEqualFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEqualFold(FieldBrowserSecretHash, v))
+}
+
+// BrowserSecretHashContainsFold applies the ContainsFold predicate on the "browser_secret_hash" field.
+func BrowserSecretHashContainsFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContainsFold(FieldBrowserSecretHash, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressIsNil applies the IsNil predicate on the "ip_address" field.
+func IPAddressIsNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIsNull(FieldIPAddress))
+}
+
+// IPAddressNotNil applies the NotNil predicate on the "ip_address" field.
+func IPAddressNotNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotNull(FieldIPAddress))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// ScannedAtEQ applies the EQ predicate on the "scanned_at" field.
+func ScannedAtEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ScannedAtNEQ applies the NEQ predicate on the "scanned_at" field.
+func ScannedAtNEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldScannedAt, v))
+}
+
+// ScannedAtIn applies the In predicate on the "scanned_at" field.
+func ScannedAtIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtNotIn applies the NotIn predicate on the "scanned_at" field.
+func ScannedAtNotIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtGT applies the GT predicate on the "scanned_at" field.
+func ScannedAtGT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldScannedAt, v))
+}
+
+// ScannedAtGTE applies the GTE predicate on the "scanned_at" field.
+func ScannedAtGTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldScannedAt, v))
+}
+
+// ScannedAtLT applies the LT predicate on the "scanned_at" field.
+func ScannedAtLT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldScannedAt, v))
+}
+
+// ScannedAtLTE applies the LTE predicate on the "scanned_at" field.
+func ScannedAtLTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldScannedAt, v))
+}
+
+// ScannedAtIsNil applies the IsNil predicate on the "scanned_at" field.
+func ScannedAtIsNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIsNull(FieldScannedAt))
+}
+
+// ScannedAtNotNil applies the NotNil predicate on the "scanned_at" field.
+func ScannedAtNotNil() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotNull(FieldScannedAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QRLoginRequest) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QRLoginRequest) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QRLoginRequest) predicate.QRLoginRequest {
+	return predicate.QRLoginRequest(sql.NotPredicates(p))
+}
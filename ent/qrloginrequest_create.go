@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/user"
+)
+
+// QRLoginRequestCreate is the builder for creating a QRLoginRequest entity.
+type QRLoginRequestCreate struct {
+	config
+	mutation *QRLoginRequestMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (qlrc *QRLoginRequestCreate) SetCreateTime(t time.Time) *QRLoginRequestCreate {
+	qlrc.mutation.SetCreateTime(t)
+	return qlrc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableCreateTime(t *time.Time) *QRLoginRequestCreate {
+	if t != nil {
+		qlrc.SetCreateTime(*t)
+	}
+	return qlrc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (qlrc *QRLoginRequestCreate) SetUpdateTime(t time.Time) *QRLoginRequestCreate {
+	qlrc.mutation.SetUpdateTime(t)
+	return qlrc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableUpdateTime(t *time.Time) *QRLoginRequestCreate {
+	if t != nil {
+		qlrc.SetUpdateTime(*t)
+	}
+	return qlrc
+}
+
+// SetRequestID sets the "request_id" field.
+func (qlrc *QRLoginRequestCreate) SetRequestID(s string) *QRLoginRequestCreate {
+	qlrc.mutation.SetRequestID(s)
+	return qlrc
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableRequestID(s *string) *QRLoginRequestCreate {
+	if s != nil {
+		qlrc.SetRequestID(*s)
+	}
+	return qlrc
+}
+
+// SetBrowserSecretHash sets the "browser_secret_hash" field.
+func (qlrc *QRLoginRequestCreate) SetBrowserSecretHash(s string) *QRLoginRequestCreate {
+	qlrc.mutation.SetBrowserSecretHash(s)
+	return qlrc
+}
+
+// SetStatus sets the "status" field.
+func (qlrc *QRLoginRequestCreate) SetStatus(q qrloginrequest.Status) *QRLoginRequestCreate {
+	qlrc.mutation.SetStatus(q)
+	return qlrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableStatus(q *qrloginrequest.Status) *QRLoginRequestCreate {
+	if q != nil {
+		qlrc.SetStatus(*q)
+	}
+	return qlrc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (qlrc *QRLoginRequestCreate) SetUserAgent(s string) *QRLoginRequestCreate {
+	qlrc.mutation.SetUserAgent(s)
+	return qlrc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableUserAgent(s *string) *QRLoginRequestCreate {
+	if s != nil {
+		qlrc.SetUserAgent(*s)
+	}
+	return qlrc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (qlrc *QRLoginRequestCreate) SetIPAddress(s string) *QRLoginRequestCreate {
+	qlrc.mutation.SetIPAddress(s)
+	return qlrc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableIPAddress(s *string) *QRLoginRequestCreate {
+	if s != nil {
+		qlrc.SetIPAddress(*s)
+	}
+	return qlrc
+}
+
+// SetLocation sets the "location" field.
+func (qlrc *QRLoginRequestCreate) SetLocation(s string) *QRLoginRequestCreate {
+	qlrc.mutation.SetLocation(s)
+	return qlrc
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableLocation(s *string) *QRLoginRequestCreate {
+	if s != nil {
+		qlrc.SetLocation(*s)
+	}
+	return qlrc
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (qlrc *QRLoginRequestCreate) SetScannedAt(t time.Time) *QRLoginRequestCreate {
+	qlrc.mutation.SetScannedAt(t)
+	return qlrc
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableScannedAt(t *time.Time) *QRLoginRequestCreate {
+	if t != nil {
+		qlrc.SetScannedAt(*t)
+	}
+	return qlrc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qlrc *QRLoginRequestCreate) SetExpiresAt(t time.Time) *QRLoginRequestCreate {
+	qlrc.mutation.SetExpiresAt(t)
+	return qlrc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qlrc *QRLoginRequestCreate) SetUserID(id int) *QRLoginRequestCreate {
+	qlrc.mutation.SetUserID(id)
+	return qlrc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (qlrc *QRLoginRequestCreate) SetNillableUserID(id *int) *QRLoginRequestCreate {
+	if id != nil {
+		qlrc = qlrc.SetUserID(*id)
+	}
+	return qlrc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qlrc *QRLoginRequestCreate) SetUser(u *User) *QRLoginRequestCreate {
+	return qlrc.SetUserID(u.ID)
+}
+
+// Mutation returns the QRLoginRequestMutation object of the builder.
+func (qlrc *QRLoginRequestCreate) Mutation() *QRLoginRequestMutation {
+	return qlrc.mutation
+}
+
+// Save creates the QRLoginRequest in the database.
+func (qlrc *QRLoginRequestCreate) Save(ctx context.Context) (*QRLoginRequest, error) {
+	qlrc.defaults()
+	return withHooks(ctx, qlrc.sqlSave, qlrc.mutation, qlrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qlrc *QRLoginRequestCreate) SaveX(ctx context.Context) *QRLoginRequest {
+	v, err := qlrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qlrc *QRLoginRequestCreate) Exec(ctx context.Context) error {
+	_, err := qlrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlrc *QRLoginRequestCreate) ExecX(ctx context.Context) {
+	if err := qlrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qlrc *QRLoginRequestCreate) defaults() {
+	if _, ok := qlrc.mutation.CreateTime(); !ok {
+		v := qrloginrequest.DefaultCreateTime()
+		qlrc.mutation.SetCreateTime(v)
+	}
+	if _, ok := qlrc.mutation.UpdateTime(); !ok {
+		v := qrloginrequest.DefaultUpdateTime()
+		qlrc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := qlrc.mutation.RequestID(); !ok {
+		v := qrloginrequest.DefaultRequestID()
+		qlrc.mutation.SetRequestID(v)
+	}
+	if _, ok := qlrc.mutation.Status(); !ok {
+		v := qrloginrequest.DefaultStatus
+		qlrc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qlrc *QRLoginRequestCreate) check() error {
+	if _, ok := qlrc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "QRLoginRequest.create_time"`)}
+	}
+	if _, ok := qlrc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "QRLoginRequest.update_time"`)}
+	}
+	if _, ok := qlrc.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "QRLoginRequest.request_id"`)}
+	}
+	if v, ok := qlrc.mutation.RequestID(); ok {
+		if err := qrloginrequest.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "QRLoginRequest.request_id": %w`, err)}
+		}
+	}
+	if _, ok := qlrc.mutation.BrowserSecretHash(); !ok {
+		return &ValidationError{Name: "browser_secret_hash", err: errors.New(`ent: missing required field "QRLoginRequest.browser_secret_hash"`)}
+	}
+	if v, ok := qlrc.mutation.BrowserSecretHash(); ok {
+		if err := qrloginrequest.BrowserSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "browser_secret_hash", err: fmt.Errorf(`ent: validator failed for field "QRLoginRequest.browser_secret_hash": %w`, err)}
+		}
+	}
+	if _, ok := qlrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QRLoginRequest.status"`)}
+	}
+	if v, ok := qlrc.mutation.Status(); ok {
+		if err := qrloginrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QRLoginRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := qlrc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "QRLoginRequest.expires_at"`)}
+	}
+	return nil
+}
+
+func (qlrc *QRLoginRequestCreate) sqlSave(ctx context.Context) (*QRLoginRequest, error) {
+	if err := qlrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qlrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qlrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	qlrc.mutation.id = &_node.ID
+	qlrc.mutation.done = true
+	return _node, nil
+}
+
+func (qlrc *QRLoginRequestCreate) createSpec() (*QRLoginRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QRLoginRequest{config: qlrc.config}
+		_spec = sqlgraph.NewCreateSpec(qrloginrequest.Table, sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt))
+	)
+	if value, ok := qlrc.mutation.CreateTime(); ok {
+		_spec.SetField(qrloginrequest.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := qlrc.mutation.UpdateTime(); ok {
+		_spec.SetField(qrloginrequest.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := qlrc.mutation.RequestID(); ok {
+		_spec.SetField(qrloginrequest.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := qlrc.mutation.BrowserSecretHash(); ok {
+		_spec.SetField(qrloginrequest.FieldBrowserSecretHash, field.TypeString, value)
+		_node.BrowserSecretHash = value
+	}
+	if value, ok := qlrc.mutation.Status(); ok {
+		_spec.SetField(qrloginrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := qlrc.mutation.UserAgent(); ok {
+		_spec.SetField(qrloginrequest.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := qlrc.mutation.IPAddress(); ok {
+		_spec.SetField(qrloginrequest.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := qlrc.mutation.Location(); ok {
+		_spec.SetField(qrloginrequest.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := qlrc.mutation.ScannedAt(); ok {
+		_spec.SetField(qrloginrequest.FieldScannedAt, field.TypeTime, value)
+		_node.ScannedAt = &value
+	}
+	if value, ok := qlrc.mutation.ExpiresAt(); ok {
+		_spec.SetField(qrloginrequest.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := qlrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrloginrequest.UserTable,
+			Columns: []string{qrloginrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_qr_login_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QRLoginRequestCreateBulk is the builder for creating many QRLoginRequest entities in bulk.
+type QRLoginRequestCreateBulk struct {
+	config
+	err      error
+	builders []*QRLoginRequestCreate
+}
+
+// Save creates the QRLoginRequest entities in the database.
+func (qlrcb *QRLoginRequestCreateBulk) Save(ctx context.Context) ([]*QRLoginRequest, error) {
+	if qlrcb.err != nil {
+		return nil, qlrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qlrcb.builders))
+	nodes := make([]*QRLoginRequest, len(qlrcb.builders))
+	mutators := make([]Mutator, len(qlrcb.builders))
+	for i := range qlrcb.builders {
+		func(i int, root context.Context) {
+			builder := qlrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QRLoginRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qlrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qlrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qlrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qlrcb *QRLoginRequestCreateBulk) SaveX(ctx context.Context) []*QRLoginRequest {
+	v, err := qlrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qlrcb *QRLoginRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := qlrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlrcb *QRLoginRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := qlrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
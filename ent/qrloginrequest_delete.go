@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/qrloginrequest"
+)
+
+// QRLoginRequestDelete is the builder for deleting a QRLoginRequest entity.
+type QRLoginRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *QRLoginRequestMutation
+}
+
+// Where appends a list predicates to the QRLoginRequestDelete builder.
+func (qlrd *QRLoginRequestDelete) Where(ps ...predicate.QRLoginRequest) *QRLoginRequestDelete {
+	qlrd.mutation.Where(ps...)
+	return qlrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qlrd *QRLoginRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qlrd.sqlExec, qlrd.mutation, qlrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlrd *QRLoginRequestDelete) ExecX(ctx context.Context) int {
+	n, err := qlrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qlrd *QRLoginRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(qrloginrequest.Table, sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt))
+	if ps := qlrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qlrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qlrd.mutation.done = true
+	return affected, err
+}
+
+// QRLoginRequestDeleteOne is the builder for deleting a single QRLoginRequest entity.
+type QRLoginRequestDeleteOne struct {
+	qlrd *QRLoginRequestDelete
+}
+
+// Where appends a list predicates to the QRLoginRequestDelete builder.
+func (qlrdo *QRLoginRequestDeleteOne) Where(ps ...predicate.QRLoginRequest) *QRLoginRequestDeleteOne {
+	qlrdo.qlrd.mutation.Where(ps...)
+	return qlrdo
+}
+
+// Exec executes the deletion query.
+func (qlrdo *QRLoginRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := qlrdo.qlrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{qrloginrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlrdo *QRLoginRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := qlrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/user"
+)
+
+// QRLoginRequestQuery is the builder for querying QRLoginRequest entities.
+type QRLoginRequestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []qrloginrequest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QRLoginRequest
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QRLoginRequestQuery builder.
+func (qlrq *QRLoginRequestQuery) Where(ps ...predicate.QRLoginRequest) *QRLoginRequestQuery {
+	qlrq.predicates = append(qlrq.predicates, ps...)
+	return qlrq
+}
+
+// Limit the number of records to be returned by this query.
+func (qlrq *QRLoginRequestQuery) Limit(limit int) *QRLoginRequestQuery {
+	qlrq.ctx.Limit = &limit
+	return qlrq
+}
+
+// Offset to start from.
+func (qlrq *QRLoginRequestQuery) Offset(offset int) *QRLoginRequestQuery {
+	qlrq.ctx.Offset = &offset
+	return qlrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qlrq *QRLoginRequestQuery) Unique(unique bool) *QRLoginRequestQuery {
+	qlrq.ctx.Unique = &unique
+	return qlrq
+}
+
+// Order specifies how the records should be ordered.
+func (qlrq *QRLoginRequestQuery) Order(o ...qrloginrequest.OrderOption) *QRLoginRequestQuery {
+	qlrq.order = append(qlrq.order, o...)
+	return qlrq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (qlrq *QRLoginRequestQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: qlrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qlrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qlrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrloginrequest.Table, qrloginrequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, qrloginrequest.UserTable, qrloginrequest.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qlrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QRLoginRequest entity from the query.
+// Returns a *NotFoundError when no QRLoginRequest was found.
+func (qlrq *QRLoginRequestQuery) First(ctx context.Context) (*QRLoginRequest, error) {
+	nodes, err := qlrq.Limit(1).All(setContextOp(ctx, qlrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{qrloginrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) FirstX(ctx context.Context) *QRLoginRequest {
+	node, err := qlrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QRLoginRequest ID from the query.
+// Returns a *NotFoundError when no QRLoginRequest ID was found.
+func (qlrq *QRLoginRequestQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qlrq.Limit(1).IDs(setContextOp(ctx, qlrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{qrloginrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) FirstIDX(ctx context.Context) int {
+	id, err := qlrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QRLoginRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QRLoginRequest entity is found.
+// Returns a *NotFoundError when no QRLoginRequest entities are found.
+func (qlrq *QRLoginRequestQuery) Only(ctx context.Context) (*QRLoginRequest, error) {
+	nodes, err := qlrq.Limit(2).All(setContextOp(ctx, qlrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{qrloginrequest.Label}
+	default:
+		return nil, &NotSingularError{qrloginrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) OnlyX(ctx context.Context) *QRLoginRequest {
+	node, err := qlrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QRLoginRequest ID in the query.
+// Returns a *NotSingularError when more than one QRLoginRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qlrq *QRLoginRequestQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qlrq.Limit(2).IDs(setContextOp(ctx, qlrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{qrloginrequest.Label}
+	default:
+		err = &NotSingularError{qrloginrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qlrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QRLoginRequests.
+func (qlrq *QRLoginRequestQuery) All(ctx context.Context) ([]*QRLoginRequest, error) {
+	ctx = setContextOp(ctx, qlrq.ctx, ent.OpQueryAll)
+	if err := qlrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QRLoginRequest, *QRLoginRequestQuery]()
+	return withInterceptors[[]*QRLoginRequest](ctx, qlrq, qr, qlrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) AllX(ctx context.Context) []*QRLoginRequest {
+	nodes, err := qlrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QRLoginRequest IDs.
+func (qlrq *QRLoginRequestQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if qlrq.ctx.Unique == nil && qlrq.path != nil {
+		qlrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qlrq.ctx, ent.OpQueryIDs)
+	if err = qlrq.Select(qrloginrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) IDsX(ctx context.Context) []int {
+	ids, err := qlrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qlrq *QRLoginRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qlrq.ctx, ent.OpQueryCount)
+	if err := qlrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qlrq, querierCount[*QRLoginRequestQuery](), qlrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) CountX(ctx context.Context) int {
+	count, err := qlrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qlrq *QRLoginRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qlrq.ctx, ent.OpQueryExist)
+	switch _, err := qlrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qlrq *QRLoginRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := qlrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QRLoginRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qlrq *QRLoginRequestQuery) Clone() *QRLoginRequestQuery {
+	if qlrq == nil {
+		return nil
+	}
+	return &QRLoginRequestQuery{
+		config:     qlrq.config,
+		ctx:        qlrq.ctx.Clone(),
+		order:      append([]qrloginrequest.OrderOption{}, qlrq.order...),
+		inters:     append([]Interceptor{}, qlrq.inters...),
+		predicates: append([]predicate.QRLoginRequest{}, qlrq.predicates...),
+		withUser:   qlrq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       qlrq.sql.Clone(),
+		path:      qlrq.path,
+		modifiers: append([]func(*sql.Selector){}, qlrq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (qlrq *QRLoginRequestQuery) WithUser(opts ...func(*UserQuery)) *QRLoginRequestQuery {
+	query := (&UserClient{config: qlrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qlrq.withUser = query
+	return qlrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QRLoginRequest.Query().
+//		GroupBy(qrloginrequest.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qlrq *QRLoginRequestQuery) GroupBy(field string, fields ...string) *QRLoginRequestGroupBy {
+	qlrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QRLoginRequestGroupBy{build: qlrq}
+	grbuild.flds = &qlrq.ctx.Fields
+	grbuild.label = qrloginrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.QRLoginRequest.Query().
+//		Select(qrloginrequest.FieldCreateTime).
+//		Scan(ctx, &v)
+func (qlrq *QRLoginRequestQuery) Select(fields ...string) *QRLoginRequestSelect {
+	qlrq.ctx.Fields = append(qlrq.ctx.Fields, fields...)
+	sbuild := &QRLoginRequestSelect{QRLoginRequestQuery: qlrq}
+	sbuild.label = qrloginrequest.Label
+	sbuild.flds, sbuild.scan = &qlrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QRLoginRequestSelect configured with the given aggregations.
+func (qlrq *QRLoginRequestQuery) Aggregate(fns ...AggregateFunc) *QRLoginRequestSelect {
+	return qlrq.Select().Aggregate(fns...)
+}
+
+func (qlrq *QRLoginRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qlrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qlrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qlrq.ctx.Fields {
+		if !qrloginrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qlrq.path != nil {
+		prev, err := qlrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qlrq.sql = prev
+	}
+	return nil
+}
+
+func (qlrq *QRLoginRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QRLoginRequest, error) {
+	var (
+		nodes       = []*QRLoginRequest{}
+		withFKs     = qlrq.withFKs
+		_spec       = qlrq.querySpec()
+		loadedTypes = [1]bool{
+			qlrq.withUser != nil,
+		}
+	)
+	if qlrq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, qrloginrequest.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QRLoginRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QRLoginRequest{config: qlrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(qlrq.modifiers) > 0 {
+		_spec.Modifiers = qlrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qlrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := qlrq.withUser; query != nil {
+		if err := qlrq.loadUser(ctx, query, nodes, nil,
+			func(n *QRLoginRequest, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (qlrq *QRLoginRequestQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*QRLoginRequest, init func(*QRLoginRequest), assign func(*QRLoginRequest, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*QRLoginRequest)
+	for i := range nodes {
+		if nodes[i].user_qr_login_requests == nil {
+			continue
+		}
+		fk := *nodes[i].user_qr_login_requests
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_qr_login_requests" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (qlrq *QRLoginRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qlrq.querySpec()
+	if len(qlrq.modifiers) > 0 {
+		_spec.Modifiers = qlrq.modifiers
+	}
+	_spec.Node.Columns = qlrq.ctx.Fields
+	if len(qlrq.ctx.Fields) > 0 {
+		_spec.Unique = qlrq.ctx.Unique != nil && *qlrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qlrq.driver, _spec)
+}
+
+func (qlrq *QRLoginRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(qrloginrequest.Table, qrloginrequest.Columns, sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt))
+	_spec.From = qlrq.sql
+	if unique := qlrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qlrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qlrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrloginrequest.FieldID)
+		for i := range fields {
+			if fields[i] != qrloginrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qlrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qlrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qlrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qlrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qlrq *QRLoginRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qlrq.driver.Dialect())
+	t1 := builder.Table(qrloginrequest.Table)
+	columns := qlrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = qrloginrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qlrq.sql != nil {
+		selector = qlrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qlrq.ctx.Unique != nil && *qlrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range qlrq.modifiers {
+		m(selector)
+	}
+	for _, p := range qlrq.predicates {
+		p(selector)
+	}
+	for _, p := range qlrq.order {
+		p(selector)
+	}
+	if offset := qlrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qlrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qlrq *QRLoginRequestQuery) ForUpdate(opts ...sql.LockOption) *QRLoginRequestQuery {
+	if qlrq.driver.Dialect() == dialect.Postgres {
+		qlrq.Unique(false)
+	}
+	qlrq.modifiers = append(qlrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qlrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qlrq *QRLoginRequestQuery) ForShare(opts ...sql.LockOption) *QRLoginRequestQuery {
+	if qlrq.driver.Dialect() == dialect.Postgres {
+		qlrq.Unique(false)
+	}
+	qlrq.modifiers = append(qlrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qlrq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qlrq *QRLoginRequestQuery) Modify(modifiers ...func(s *sql.Selector)) *QRLoginRequestSelect {
+	qlrq.modifiers = append(qlrq.modifiers, modifiers...)
+	return qlrq.Select()
+}
+
+// QRLoginRequestGroupBy is the group-by builder for QRLoginRequest entities.
+type QRLoginRequestGroupBy struct {
+	selector
+	build *QRLoginRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qlrgb *QRLoginRequestGroupBy) Aggregate(fns ...AggregateFunc) *QRLoginRequestGroupBy {
+	qlrgb.fns = append(qlrgb.fns, fns...)
+	return qlrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qlrgb *QRLoginRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qlrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qlrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRLoginRequestQuery, *QRLoginRequestGroupBy](ctx, qlrgb.build, qlrgb, qlrgb.build.inters, v)
+}
+
+func (qlrgb *QRLoginRequestGroupBy) sqlScan(ctx context.Context, root *QRLoginRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qlrgb.fns))
+	for _, fn := range qlrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qlrgb.flds)+len(qlrgb.fns))
+		for _, f := range *qlrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qlrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qlrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QRLoginRequestSelect is the builder for selecting fields of QRLoginRequest entities.
+type QRLoginRequestSelect struct {
+	*QRLoginRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qlrs *QRLoginRequestSelect) Aggregate(fns ...AggregateFunc) *QRLoginRequestSelect {
+	qlrs.fns = append(qlrs.fns, fns...)
+	return qlrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qlrs *QRLoginRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qlrs.ctx, ent.OpQuerySelect)
+	if err := qlrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRLoginRequestQuery, *QRLoginRequestSelect](ctx, qlrs.QRLoginRequestQuery, qlrs, qlrs.inters, v)
+}
+
+func (qlrs *QRLoginRequestSelect) sqlScan(ctx context.Context, root *QRLoginRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qlrs.fns))
+	for _, fn := range qlrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qlrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qlrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qlrs *QRLoginRequestSelect) Modify(modifiers ...func(s *sql.Selector)) *QRLoginRequestSelect {
+	qlrs.modifiers = append(qlrs.modifiers, modifiers...)
+	return qlrs
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/user"
+)
+
+// QRLoginRequestUpdate is the builder for updating QRLoginRequest entities.
+type QRLoginRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QRLoginRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QRLoginRequestUpdate builder.
+func (qlru *QRLoginRequestUpdate) Where(ps ...predicate.QRLoginRequest) *QRLoginRequestUpdate {
+	qlru.mutation.Where(ps...)
+	return qlru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (qlru *QRLoginRequestUpdate) SetUpdateTime(t time.Time) *QRLoginRequestUpdate {
+	qlru.mutation.SetUpdateTime(t)
+	return qlru
+}
+
+// SetStatus sets the "status" field.
+func (qlru *QRLoginRequestUpdate) SetStatus(q qrloginrequest.Status) *QRLoginRequestUpdate {
+	qlru.mutation.SetStatus(q)
+	return qlru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qlru *QRLoginRequestUpdate) SetNillableStatus(q *qrloginrequest.Status) *QRLoginRequestUpdate {
+	if q != nil {
+		qlru.SetStatus(*q)
+	}
+	return qlru
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (qlru *QRLoginRequestUpdate) SetScannedAt(t time.Time) *QRLoginRequestUpdate {
+	qlru.mutation.SetScannedAt(t)
+	return qlru
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (qlru *QRLoginRequestUpdate) SetNillableScannedAt(t *time.Time) *QRLoginRequestUpdate {
+	if t != nil {
+		qlru.SetScannedAt(*t)
+	}
+	return qlru
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (qlru *QRLoginRequestUpdate) ClearScannedAt() *QRLoginRequestUpdate {
+	qlru.mutation.ClearScannedAt()
+	return qlru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qlru *QRLoginRequestUpdate) SetUserID(id int) *QRLoginRequestUpdate {
+	qlru.mutation.SetUserID(id)
+	return qlru
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (qlru *QRLoginRequestUpdate) SetNillableUserID(id *int) *QRLoginRequestUpdate {
+	if id != nil {
+		qlru = qlru.SetUserID(*id)
+	}
+	return qlru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qlru *QRLoginRequestUpdate) SetUser(u *User) *QRLoginRequestUpdate {
+	return qlru.SetUserID(u.ID)
+}
+
+// Mutation returns the QRLoginRequestMutation object of the builder.
+func (qlru *QRLoginRequestUpdate) Mutation() *QRLoginRequestMutation {
+	return qlru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (qlru *QRLoginRequestUpdate) ClearUser() *QRLoginRequestUpdate {
+	qlru.mutation.ClearUser()
+	return qlru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qlru *QRLoginRequestUpdate) Save(ctx context.Context) (int, error) {
+	qlru.defaults()
+	return withHooks(ctx, qlru.sqlSave, qlru.mutation, qlru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qlru *QRLoginRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := qlru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qlru *QRLoginRequestUpdate) Exec(ctx context.Context) error {
+	_, err := qlru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlru *QRLoginRequestUpdate) ExecX(ctx context.Context) {
+	if err := qlru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qlru *QRLoginRequestUpdate) defaults() {
+	if _, ok := qlru.mutation.UpdateTime(); !ok {
+		v := qrloginrequest.UpdateDefaultUpdateTime()
+		qlru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qlru *QRLoginRequestUpdate) check() error {
+	if v, ok := qlru.mutation.Status(); ok {
+		if err := qrloginrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QRLoginRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qlru *QRLoginRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QRLoginRequestUpdate {
+	qlru.modifiers = append(qlru.modifiers, modifiers...)
+	return qlru
+}
+
+func (qlru *QRLoginRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qlru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrloginrequest.Table, qrloginrequest.Columns, sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt))
+	if ps := qlru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qlru.mutation.UpdateTime(); ok {
+		_spec.SetField(qrloginrequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := qlru.mutation.Status(); ok {
+		_spec.SetField(qrloginrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if qlru.mutation.UserAgentCleared() {
+		_spec.ClearField(qrloginrequest.FieldUserAgent, field.TypeString)
+	}
+	if qlru.mutation.IPAddressCleared() {
+		_spec.ClearField(qrloginrequest.FieldIPAddress, field.TypeString)
+	}
+	if qlru.mutation.LocationCleared() {
+		_spec.ClearField(qrloginrequest.FieldLocation, field.TypeString)
+	}
+	if value, ok := qlru.mutation.ScannedAt(); ok {
+		_spec.SetField(qrloginrequest.FieldScannedAt, field.TypeTime, value)
+	}
+	if qlru.mutation.ScannedAtCleared() {
+		_spec.ClearField(qrloginrequest.FieldScannedAt, field.TypeTime)
+	}
+	if qlru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrloginrequest.UserTable,
+			Columns: []string{qrloginrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qlru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrloginrequest.UserTable,
+			Columns: []string{qrloginrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(qlru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, qlru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrloginrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qlru.mutation.done = true
+	return n, nil
+}
+
+// QRLoginRequestUpdateOne is the builder for updating a single QRLoginRequest entity.
+type QRLoginRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QRLoginRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (qlruo *QRLoginRequestUpdateOne) SetUpdateTime(t time.Time) *QRLoginRequestUpdateOne {
+	qlruo.mutation.SetUpdateTime(t)
+	return qlruo
+}
+
+// SetStatus sets the "status" field.
+func (qlruo *QRLoginRequestUpdateOne) SetStatus(q qrloginrequest.Status) *QRLoginRequestUpdateOne {
+	qlruo.mutation.SetStatus(q)
+	return qlruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qlruo *QRLoginRequestUpdateOne) SetNillableStatus(q *qrloginrequest.Status) *QRLoginRequestUpdateOne {
+	if q != nil {
+		qlruo.SetStatus(*q)
+	}
+	return qlruo
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (qlruo *QRLoginRequestUpdateOne) SetScannedAt(t time.Time) *QRLoginRequestUpdateOne {
+	qlruo.mutation.SetScannedAt(t)
+	return qlruo
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (qlruo *QRLoginRequestUpdateOne) SetNillableScannedAt(t *time.Time) *QRLoginRequestUpdateOne {
+	if t != nil {
+		qlruo.SetScannedAt(*t)
+	}
+	return qlruo
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (qlruo *QRLoginRequestUpdateOne) ClearScannedAt() *QRLoginRequestUpdateOne {
+	qlruo.mutation.ClearScannedAt()
+	return qlruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qlruo *QRLoginRequestUpdateOne) SetUserID(id int) *QRLoginRequestUpdateOne {
+	qlruo.mutation.SetUserID(id)
+	return qlruo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (qlruo *QRLoginRequestUpdateOne) SetNillableUserID(id *int) *QRLoginRequestUpdateOne {
+	if id != nil {
+		qlruo = qlruo.SetUserID(*id)
+	}
+	return qlruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qlruo *QRLoginRequestUpdateOne) SetUser(u *User) *QRLoginRequestUpdateOne {
+	return qlruo.SetUserID(u.ID)
+}
+
+// Mutation returns the QRLoginRequestMutation object of the builder.
+func (qlruo *QRLoginRequestUpdateOne) Mutation() *QRLoginRequestMutation {
+	return qlruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (qlruo *QRLoginRequestUpdateOne) ClearUser() *QRLoginRequestUpdateOne {
+	qlruo.mutation.ClearUser()
+	return qlruo
+}
+
+// Where appends a list predicates to the QRLoginRequestUpdate builder.
+func (qlruo *QRLoginRequestUpdateOne) Where(ps ...predicate.QRLoginRequest) *QRLoginRequestUpdateOne {
+	qlruo.mutation.Where(ps...)
+	return qlruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qlruo *QRLoginRequestUpdateOne) Select(field string, fields ...string) *QRLoginRequestUpdateOne {
+	qlruo.fields = append([]string{field}, fields...)
+	return qlruo
+}
+
+// Save executes the query and returns the updated QRLoginRequest entity.
+func (qlruo *QRLoginRequestUpdateOne) Save(ctx context.Context) (*QRLoginRequest, error) {
+	qlruo.defaults()
+	return withHooks(ctx, qlruo.sqlSave, qlruo.mutation, qlruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qlruo *QRLoginRequestUpdateOne) SaveX(ctx context.Context) *QRLoginRequest {
+	node, err := qlruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qlruo *QRLoginRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := qlruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlruo *QRLoginRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := qlruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qlruo *QRLoginRequestUpdateOne) defaults() {
+	if _, ok := qlruo.mutation.UpdateTime(); !ok {
+		v := qrloginrequest.UpdateDefaultUpdateTime()
+		qlruo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qlruo *QRLoginRequestUpdateOne) check() error {
+	if v, ok := qlruo.mutation.Status(); ok {
+		if err := qrloginrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QRLoginRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qlruo *QRLoginRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QRLoginRequestUpdateOne {
+	qlruo.modifiers = append(qlruo.modifiers, modifiers...)
+	return qlruo
+}
+
+func (qlruo *QRLoginRequestUpdateOne) sqlSave(ctx context.Context) (_node *QRLoginRequest, err error) {
+	if err := qlruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrloginrequest.Table, qrloginrequest.Columns, sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt))
+	id, ok := qlruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QRLoginRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qlruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrloginrequest.FieldID)
+		for _, f := range fields {
+			if !qrloginrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != qrloginrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qlruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qlruo.mutation.UpdateTime(); ok {
+		_spec.SetField(qrloginrequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := qlruo.mutation.Status(); ok {
+		_spec.SetField(qrloginrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if qlruo.mutation.UserAgentCleared() {
+		_spec.ClearField(qrloginrequest.FieldUserAgent, field.TypeString)
+	}
+	if qlruo.mutation.IPAddressCleared() {
+		_spec.ClearField(qrloginrequest.FieldIPAddress, field.TypeString)
+	}
+	if qlruo.mutation.LocationCleared() {
+		_spec.ClearField(qrloginrequest.FieldLocation, field.TypeString)
+	}
+	if value, ok := qlruo.mutation.ScannedAt(); ok {
+		_spec.SetField(qrloginrequest.FieldScannedAt, field.TypeTime, value)
+	}
+	if qlruo.mutation.ScannedAtCleared() {
+		_spec.ClearField(qrloginrequest.FieldScannedAt, field.TypeTime)
+	}
+	if qlruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrloginrequest.UserTable,
+			Columns: []string{qrloginrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qlruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrloginrequest.UserTable,
+			Columns: []string{qrloginrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(qlruo.modifiers...)
+	_node = &QRLoginRequest{config: qlruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qlruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrloginrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qlruo.mutation.done = true
+	return _node, nil
+}
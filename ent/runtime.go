@@ -5,16 +5,275 @@ package ent
 import (
 	"time"
 
+	"github.com/shinplay/ent/auditevent"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/impersonation"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
 	"github.com/shinplay/ent/otp"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/role"
 	"github.com/shinplay/ent/schema"
 	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditeventMixin := schema.AuditEvent{}.Mixin()
+	auditeventMixinFields0 := auditeventMixin[0].Fields()
+	_ = auditeventMixinFields0
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreateTime is the schema descriptor for create_time field.
+	auditeventDescCreateTime := auditeventMixinFields0[0].Descriptor()
+	// auditevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	auditevent.DefaultCreateTime = auditeventDescCreateTime.Default.(func() time.Time)
+	// auditeventDescEventType is the schema descriptor for event_type field.
+	auditeventDescEventType := auditeventFields[0].Descriptor()
+	// auditevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	auditevent.EventTypeValidator = auditeventDescEventType.Validators[0].(func(string) error)
+	blockMixin := schema.Block{}.Mixin()
+	blockMixinFields0 := blockMixin[0].Fields()
+	_ = blockMixinFields0
+	blockFields := schema.Block{}.Fields()
+	_ = blockFields
+	// blockDescCreateTime is the schema descriptor for create_time field.
+	blockDescCreateTime := blockMixinFields0[0].Descriptor()
+	// block.DefaultCreateTime holds the default value on creation for the create_time field.
+	block.DefaultCreateTime = blockDescCreateTime.Default.(func() time.Time)
+	dataexportMixin := schema.DataExport{}.Mixin()
+	dataexportMixinFields0 := dataexportMixin[0].Fields()
+	_ = dataexportMixinFields0
+	dataexportMixinFields1 := dataexportMixin[1].Fields()
+	_ = dataexportMixinFields1
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescCreateTime is the schema descriptor for create_time field.
+	dataexportDescCreateTime := dataexportMixinFields0[0].Descriptor()
+	// dataexport.DefaultCreateTime holds the default value on creation for the create_time field.
+	dataexport.DefaultCreateTime = dataexportDescCreateTime.Default.(func() time.Time)
+	// dataexportDescUpdateTime is the schema descriptor for update_time field.
+	dataexportDescUpdateTime := dataexportMixinFields1[0].Descriptor()
+	// dataexport.DefaultUpdateTime holds the default value on creation for the update_time field.
+	dataexport.DefaultUpdateTime = dataexportDescUpdateTime.Default.(func() time.Time)
+	// dataexport.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	dataexport.UpdateDefaultUpdateTime = dataexportDescUpdateTime.UpdateDefault.(func() time.Time)
+	// dataexportDescExportID is the schema descriptor for export_id field.
+	dataexportDescExportID := dataexportFields[0].Descriptor()
+	// dataexport.DefaultExportID holds the default value on creation for the export_id field.
+	dataexport.DefaultExportID = dataexportDescExportID.Default.(func() string)
+	// dataexport.ExportIDValidator is a validator for the "export_id" field. It is called by the builders before save.
+	dataexport.ExportIDValidator = func() func(string) error {
+		validators := dataexportDescExportID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(export_id string) error {
+			for _, fn := range fns {
+				if err := fn(export_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	deviceauthorizationMixin := schema.DeviceAuthorization{}.Mixin()
+	deviceauthorizationMixinFields0 := deviceauthorizationMixin[0].Fields()
+	_ = deviceauthorizationMixinFields0
+	deviceauthorizationMixinFields1 := deviceauthorizationMixin[1].Fields()
+	_ = deviceauthorizationMixinFields1
+	deviceauthorizationFields := schema.DeviceAuthorization{}.Fields()
+	_ = deviceauthorizationFields
+	// deviceauthorizationDescCreateTime is the schema descriptor for create_time field.
+	deviceauthorizationDescCreateTime := deviceauthorizationMixinFields0[0].Descriptor()
+	// deviceauthorization.DefaultCreateTime holds the default value on creation for the create_time field.
+	deviceauthorization.DefaultCreateTime = deviceauthorizationDescCreateTime.Default.(func() time.Time)
+	// deviceauthorizationDescUpdateTime is the schema descriptor for update_time field.
+	deviceauthorizationDescUpdateTime := deviceauthorizationMixinFields1[0].Descriptor()
+	// deviceauthorization.DefaultUpdateTime holds the default value on creation for the update_time field.
+	deviceauthorization.DefaultUpdateTime = deviceauthorizationDescUpdateTime.Default.(func() time.Time)
+	// deviceauthorization.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	deviceauthorization.UpdateDefaultUpdateTime = deviceauthorizationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// deviceauthorizationDescDeviceCodeHash is the schema descriptor for device_code_hash field.
+	deviceauthorizationDescDeviceCodeHash := deviceauthorizationFields[0].Descriptor()
+	// deviceauthorization.DeviceCodeHashValidator is a validator for the "device_code_hash" field. It is called by the builders before save.
+	deviceauthorization.DeviceCodeHashValidator = deviceauthorizationDescDeviceCodeHash.Validators[0].(func(string) error)
+	// deviceauthorizationDescUserCode is the schema descriptor for user_code field.
+	deviceauthorizationDescUserCode := deviceauthorizationFields[1].Descriptor()
+	// deviceauthorization.UserCodeValidator is a validator for the "user_code" field. It is called by the builders before save.
+	deviceauthorization.UserCodeValidator = deviceauthorizationDescUserCode.Validators[0].(func(string) error)
+	// deviceauthorizationDescInterval is the schema descriptor for interval field.
+	deviceauthorizationDescInterval := deviceauthorizationFields[5].Descriptor()
+	// deviceauthorization.IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	deviceauthorization.IntervalValidator = deviceauthorizationDescInterval.Validators[0].(func(int) error)
+	emailverificationMixin := schema.EmailVerification{}.Mixin()
+	emailverificationMixinFields0 := emailverificationMixin[0].Fields()
+	_ = emailverificationMixinFields0
+	emailverificationMixinFields1 := emailverificationMixin[1].Fields()
+	_ = emailverificationMixinFields1
+	emailverificationFields := schema.EmailVerification{}.Fields()
+	_ = emailverificationFields
+	// emailverificationDescCreateTime is the schema descriptor for create_time field.
+	emailverificationDescCreateTime := emailverificationMixinFields0[0].Descriptor()
+	// emailverification.DefaultCreateTime holds the default value on creation for the create_time field.
+	emailverification.DefaultCreateTime = emailverificationDescCreateTime.Default.(func() time.Time)
+	// emailverificationDescUpdateTime is the schema descriptor for update_time field.
+	emailverificationDescUpdateTime := emailverificationMixinFields1[0].Descriptor()
+	// emailverification.DefaultUpdateTime holds the default value on creation for the update_time field.
+	emailverification.DefaultUpdateTime = emailverificationDescUpdateTime.Default.(func() time.Time)
+	// emailverification.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	emailverification.UpdateDefaultUpdateTime = emailverificationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// emailverificationDescEmail is the schema descriptor for email field.
+	emailverificationDescEmail := emailverificationFields[0].Descriptor()
+	// emailverification.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailverification.EmailValidator = emailverificationDescEmail.Validators[0].(func(string) error)
+	// emailverificationDescCodeHash is the schema descriptor for code_hash field.
+	emailverificationDescCodeHash := emailverificationFields[1].Descriptor()
+	// emailverification.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	emailverification.CodeHashValidator = emailverificationDescCodeHash.Validators[0].(func(string) error)
+	// emailverificationDescTokenHash is the schema descriptor for token_hash field.
+	emailverificationDescTokenHash := emailverificationFields[2].Descriptor()
+	// emailverification.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	emailverification.TokenHashValidator = emailverificationDescTokenHash.Validators[0].(func(string) error)
+	// emailverificationDescAttempts is the schema descriptor for attempts field.
+	emailverificationDescAttempts := emailverificationFields[3].Descriptor()
+	// emailverification.DefaultAttempts holds the default value on creation for the attempts field.
+	emailverification.DefaultAttempts = emailverificationDescAttempts.Default.(int)
+	// emailverification.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	emailverification.AttemptsValidator = emailverificationDescAttempts.Validators[0].(func(int) error)
+	followMixin := schema.Follow{}.Mixin()
+	followMixinFields0 := followMixin[0].Fields()
+	_ = followMixinFields0
+	followFields := schema.Follow{}.Fields()
+	_ = followFields
+	// followDescCreateTime is the schema descriptor for create_time field.
+	followDescCreateTime := followMixinFields0[0].Descriptor()
+	// follow.DefaultCreateTime holds the default value on creation for the create_time field.
+	follow.DefaultCreateTime = followDescCreateTime.Default.(func() time.Time)
+	impersonationMixin := schema.Impersonation{}.Mixin()
+	impersonationMixinFields0 := impersonationMixin[0].Fields()
+	_ = impersonationMixinFields0
+	impersonationMixinFields1 := impersonationMixin[1].Fields()
+	_ = impersonationMixinFields1
+	impersonationFields := schema.Impersonation{}.Fields()
+	_ = impersonationFields
+	// impersonationDescCreateTime is the schema descriptor for create_time field.
+	impersonationDescCreateTime := impersonationMixinFields0[0].Descriptor()
+	// impersonation.DefaultCreateTime holds the default value on creation for the create_time field.
+	impersonation.DefaultCreateTime = impersonationDescCreateTime.Default.(func() time.Time)
+	// impersonationDescUpdateTime is the schema descriptor for update_time field.
+	impersonationDescUpdateTime := impersonationMixinFields1[0].Descriptor()
+	// impersonation.DefaultUpdateTime holds the default value on creation for the update_time field.
+	impersonation.DefaultUpdateTime = impersonationDescUpdateTime.Default.(func() time.Time)
+	// impersonation.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	impersonation.UpdateDefaultUpdateTime = impersonationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// impersonationDescImpersonationID is the schema descriptor for impersonation_id field.
+	impersonationDescImpersonationID := impersonationFields[0].Descriptor()
+	// impersonation.DefaultImpersonationID holds the default value on creation for the impersonation_id field.
+	impersonation.DefaultImpersonationID = impersonationDescImpersonationID.Default.(func() string)
+	// impersonation.ImpersonationIDValidator is a validator for the "impersonation_id" field. It is called by the builders before save.
+	impersonation.ImpersonationIDValidator = impersonationDescImpersonationID.Validators[0].(func(string) error)
+	// impersonationDescReason is the schema descriptor for reason field.
+	impersonationDescReason := impersonationFields[1].Descriptor()
+	// impersonation.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	impersonation.ReasonValidator = impersonationDescReason.Validators[0].(func(string) error)
+	muteMixin := schema.Mute{}.Mixin()
+	muteMixinFields0 := muteMixin[0].Fields()
+	_ = muteMixinFields0
+	muteFields := schema.Mute{}.Fields()
+	_ = muteFields
+	// muteDescCreateTime is the schema descriptor for create_time field.
+	muteDescCreateTime := muteMixinFields0[0].Descriptor()
+	// mute.DefaultCreateTime holds the default value on creation for the create_time field.
+	mute.DefaultCreateTime = muteDescCreateTime.Default.(func() time.Time)
+	oauthauthorizationcodeMixin := schema.OAuthAuthorizationCode{}.Mixin()
+	oauthauthorizationcodeMixinFields0 := oauthauthorizationcodeMixin[0].Fields()
+	_ = oauthauthorizationcodeMixinFields0
+	oauthauthorizationcodeFields := schema.OAuthAuthorizationCode{}.Fields()
+	_ = oauthauthorizationcodeFields
+	// oauthauthorizationcodeDescCreateTime is the schema descriptor for create_time field.
+	oauthauthorizationcodeDescCreateTime := oauthauthorizationcodeMixinFields0[0].Descriptor()
+	// oauthauthorizationcode.DefaultCreateTime holds the default value on creation for the create_time field.
+	oauthauthorizationcode.DefaultCreateTime = oauthauthorizationcodeDescCreateTime.Default.(func() time.Time)
+	// oauthauthorizationcodeDescCodeHash is the schema descriptor for code_hash field.
+	oauthauthorizationcodeDescCodeHash := oauthauthorizationcodeFields[0].Descriptor()
+	// oauthauthorizationcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeHashValidator = oauthauthorizationcodeDescCodeHash.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescCodeChallenge is the schema descriptor for code_challenge field.
+	oauthauthorizationcodeDescCodeChallenge := oauthauthorizationcodeFields[3].Descriptor()
+	// oauthauthorizationcode.CodeChallengeValidator is a validator for the "code_challenge" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeChallengeValidator = oauthauthorizationcodeDescCodeChallenge.Validators[0].(func(string) error)
+	oauthclientMixin := schema.OAuthClient{}.Mixin()
+	oauthclientMixinFields0 := oauthclientMixin[0].Fields()
+	_ = oauthclientMixinFields0
+	oauthclientMixinFields1 := oauthclientMixin[1].Fields()
+	_ = oauthclientMixinFields1
+	oauthclientFields := schema.OAuthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescCreateTime is the schema descriptor for create_time field.
+	oauthclientDescCreateTime := oauthclientMixinFields0[0].Descriptor()
+	// oauthclient.DefaultCreateTime holds the default value on creation for the create_time field.
+	oauthclient.DefaultCreateTime = oauthclientDescCreateTime.Default.(func() time.Time)
+	// oauthclientDescUpdateTime is the schema descriptor for update_time field.
+	oauthclientDescUpdateTime := oauthclientMixinFields1[0].Descriptor()
+	// oauthclient.DefaultUpdateTime holds the default value on creation for the update_time field.
+	oauthclient.DefaultUpdateTime = oauthclientDescUpdateTime.Default.(func() time.Time)
+	// oauthclient.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	oauthclient.UpdateDefaultUpdateTime = oauthclientDescUpdateTime.UpdateDefault.(func() time.Time)
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[0].Descriptor()
+	// oauthclient.DefaultClientID holds the default value on creation for the client_id field.
+	oauthclient.DefaultClientID = oauthclientDescClientID.Default.(func() string)
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = oauthclientDescClientID.Validators[0].(func(string) error)
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[2].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = func() func(string) error {
+		validators := oauthclientDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	oauthconsentMixin := schema.OAuthConsent{}.Mixin()
+	oauthconsentMixinFields0 := oauthconsentMixin[0].Fields()
+	_ = oauthconsentMixinFields0
+	oauthconsentMixinFields1 := oauthconsentMixin[1].Fields()
+	_ = oauthconsentMixinFields1
+	oauthconsentFields := schema.OAuthConsent{}.Fields()
+	_ = oauthconsentFields
+	// oauthconsentDescCreateTime is the schema descriptor for create_time field.
+	oauthconsentDescCreateTime := oauthconsentMixinFields0[0].Descriptor()
+	// oauthconsent.DefaultCreateTime holds the default value on creation for the create_time field.
+	oauthconsent.DefaultCreateTime = oauthconsentDescCreateTime.Default.(func() time.Time)
+	// oauthconsentDescUpdateTime is the schema descriptor for update_time field.
+	oauthconsentDescUpdateTime := oauthconsentMixinFields1[0].Descriptor()
+	// oauthconsent.DefaultUpdateTime holds the default value on creation for the update_time field.
+	oauthconsent.DefaultUpdateTime = oauthconsentDescUpdateTime.Default.(func() time.Time)
+	// oauthconsent.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	oauthconsent.UpdateDefaultUpdateTime = oauthconsentDescUpdateTime.UpdateDefault.(func() time.Time)
 	otpMixin := schema.OTP{}.Mixin()
 	otpMixinFields0 := otpMixin[0].Fields()
 	_ = otpMixinFields0
@@ -56,6 +315,156 @@ func init() {
 	otpDescExpiresAt := otpFields[1].Descriptor()
 	// otp.DefaultExpiresAt holds the default value on creation for the expires_at field.
 	otp.DefaultExpiresAt = otpDescExpiresAt.Default.(time.Time)
+	personalaccesstokenMixin := schema.PersonalAccessToken{}.Mixin()
+	personalaccesstokenMixinFields0 := personalaccesstokenMixin[0].Fields()
+	_ = personalaccesstokenMixinFields0
+	personalaccesstokenMixinFields1 := personalaccesstokenMixin[1].Fields()
+	_ = personalaccesstokenMixinFields1
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescCreateTime is the schema descriptor for create_time field.
+	personalaccesstokenDescCreateTime := personalaccesstokenMixinFields0[0].Descriptor()
+	// personalaccesstoken.DefaultCreateTime holds the default value on creation for the create_time field.
+	personalaccesstoken.DefaultCreateTime = personalaccesstokenDescCreateTime.Default.(func() time.Time)
+	// personalaccesstokenDescUpdateTime is the schema descriptor for update_time field.
+	personalaccesstokenDescUpdateTime := personalaccesstokenMixinFields1[0].Descriptor()
+	// personalaccesstoken.DefaultUpdateTime holds the default value on creation for the update_time field.
+	personalaccesstoken.DefaultUpdateTime = personalaccesstokenDescUpdateTime.Default.(func() time.Time)
+	// personalaccesstoken.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	personalaccesstoken.UpdateDefaultUpdateTime = personalaccesstokenDescUpdateTime.UpdateDefault.(func() time.Time)
+	// personalaccesstokenDescName is the schema descriptor for name field.
+	personalaccesstokenDescName := personalaccesstokenFields[0].Descriptor()
+	// personalaccesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	personalaccesstoken.NameValidator = func() func(string) error {
+		validators := personalaccesstokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	personalaccesstokenDescTokenPrefix := personalaccesstokenFields[1].Descriptor()
+	// personalaccesstoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	personalaccesstoken.TokenPrefixValidator = personalaccesstokenDescTokenPrefix.Validators[0].(func(string) error)
+	// personalaccesstokenDescTokenHash is the schema descriptor for token_hash field.
+	personalaccesstokenDescTokenHash := personalaccesstokenFields[2].Descriptor()
+	// personalaccesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personalaccesstoken.TokenHashValidator = personalaccesstokenDescTokenHash.Validators[0].(func(string) error)
+	phonechangerequestMixin := schema.PhoneChangeRequest{}.Mixin()
+	phonechangerequestMixinFields0 := phonechangerequestMixin[0].Fields()
+	_ = phonechangerequestMixinFields0
+	phonechangerequestMixinFields1 := phonechangerequestMixin[1].Fields()
+	_ = phonechangerequestMixinFields1
+	phonechangerequestFields := schema.PhoneChangeRequest{}.Fields()
+	_ = phonechangerequestFields
+	// phonechangerequestDescCreateTime is the schema descriptor for create_time field.
+	phonechangerequestDescCreateTime := phonechangerequestMixinFields0[0].Descriptor()
+	// phonechangerequest.DefaultCreateTime holds the default value on creation for the create_time field.
+	phonechangerequest.DefaultCreateTime = phonechangerequestDescCreateTime.Default.(func() time.Time)
+	// phonechangerequestDescUpdateTime is the schema descriptor for update_time field.
+	phonechangerequestDescUpdateTime := phonechangerequestMixinFields1[0].Descriptor()
+	// phonechangerequest.DefaultUpdateTime holds the default value on creation for the update_time field.
+	phonechangerequest.DefaultUpdateTime = phonechangerequestDescUpdateTime.Default.(func() time.Time)
+	// phonechangerequest.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	phonechangerequest.UpdateDefaultUpdateTime = phonechangerequestDescUpdateTime.UpdateDefault.(func() time.Time)
+	// phonechangerequestDescNewPhoneNumber is the schema descriptor for new_phone_number field.
+	phonechangerequestDescNewPhoneNumber := phonechangerequestFields[0].Descriptor()
+	// phonechangerequest.NewPhoneNumberValidator is a validator for the "new_phone_number" field. It is called by the builders before save.
+	phonechangerequest.NewPhoneNumberValidator = func() func(string) error {
+		validators := phonechangerequestDescNewPhoneNumber.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(new_phone_number string) error {
+			for _, fn := range fns {
+				if err := fn(new_phone_number); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// phonechangerequestDescCodeHash is the schema descriptor for code_hash field.
+	phonechangerequestDescCodeHash := phonechangerequestFields[1].Descriptor()
+	// phonechangerequest.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	phonechangerequest.CodeHashValidator = phonechangerequestDescCodeHash.Validators[0].(func(string) error)
+	// phonechangerequestDescAttempts is the schema descriptor for attempts field.
+	phonechangerequestDescAttempts := phonechangerequestFields[2].Descriptor()
+	// phonechangerequest.DefaultAttempts holds the default value on creation for the attempts field.
+	phonechangerequest.DefaultAttempts = phonechangerequestDescAttempts.Default.(int)
+	// phonechangerequest.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	phonechangerequest.AttemptsValidator = phonechangerequestDescAttempts.Validators[0].(func(int) error)
+	qrloginrequestMixin := schema.QRLoginRequest{}.Mixin()
+	qrloginrequestMixinFields0 := qrloginrequestMixin[0].Fields()
+	_ = qrloginrequestMixinFields0
+	qrloginrequestMixinFields1 := qrloginrequestMixin[1].Fields()
+	_ = qrloginrequestMixinFields1
+	qrloginrequestFields := schema.QRLoginRequest{}.Fields()
+	_ = qrloginrequestFields
+	// qrloginrequestDescCreateTime is the schema descriptor for create_time field.
+	qrloginrequestDescCreateTime := qrloginrequestMixinFields0[0].Descriptor()
+	// qrloginrequest.DefaultCreateTime holds the default value on creation for the create_time field.
+	qrloginrequest.DefaultCreateTime = qrloginrequestDescCreateTime.Default.(func() time.Time)
+	// qrloginrequestDescUpdateTime is the schema descriptor for update_time field.
+	qrloginrequestDescUpdateTime := qrloginrequestMixinFields1[0].Descriptor()
+	// qrloginrequest.DefaultUpdateTime holds the default value on creation for the update_time field.
+	qrloginrequest.DefaultUpdateTime = qrloginrequestDescUpdateTime.Default.(func() time.Time)
+	// qrloginrequest.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	qrloginrequest.UpdateDefaultUpdateTime = qrloginrequestDescUpdateTime.UpdateDefault.(func() time.Time)
+	// qrloginrequestDescRequestID is the schema descriptor for request_id field.
+	qrloginrequestDescRequestID := qrloginrequestFields[0].Descriptor()
+	// qrloginrequest.DefaultRequestID holds the default value on creation for the request_id field.
+	qrloginrequest.DefaultRequestID = qrloginrequestDescRequestID.Default.(func() string)
+	// qrloginrequest.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	qrloginrequest.RequestIDValidator = qrloginrequestDescRequestID.Validators[0].(func(string) error)
+	// qrloginrequestDescBrowserSecretHash is the schema descriptor for browser_secret_hash field.
+	qrloginrequestDescBrowserSecretHash := qrloginrequestFields[1].Descriptor()
+	// qrloginrequest.BrowserSecretHashValidator is a validator for the "browser_secret_hash" field. It is called by the builders before save.
+	qrloginrequest.BrowserSecretHashValidator = qrloginrequestDescBrowserSecretHash.Validators[0].(func(string) error)
+	roleMixin := schema.Role{}.Mixin()
+	roleMixinFields0 := roleMixin[0].Fields()
+	_ = roleMixinFields0
+	roleMixinFields1 := roleMixin[1].Fields()
+	_ = roleMixinFields1
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescCreateTime is the schema descriptor for create_time field.
+	roleDescCreateTime := roleMixinFields0[0].Descriptor()
+	// role.DefaultCreateTime holds the default value on creation for the create_time field.
+	role.DefaultCreateTime = roleDescCreateTime.Default.(func() time.Time)
+	// roleDescUpdateTime is the schema descriptor for update_time field.
+	roleDescUpdateTime := roleMixinFields1[0].Descriptor()
+	// role.DefaultUpdateTime holds the default value on creation for the update_time field.
+	role.DefaultUpdateTime = roleDescUpdateTime.Default.(func() time.Time)
+	// role.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	role.UpdateDefaultUpdateTime = roleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = func() func(string) error {
+		validators := roleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	sessionMixin := schema.Session{}.Mixin()
 	sessionMixinFields0 := sessionMixin[0].Fields()
 	_ = sessionMixinFields0
@@ -120,8 +529,12 @@ func init() {
 	userDescUsername := userFields[1].Descriptor()
 	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescUsernameNormalized is the schema descriptor for username_normalized field.
+	userDescUsernameNormalized := userFields[2].Descriptor()
+	// user.UsernameNormalizedValidator is a validator for the "username_normalized" field. It is called by the builders before save.
+	user.UsernameNormalizedValidator = userDescUsernameNormalized.Validators[0].(func(string) error)
 	// userDescPhoneNumber is the schema descriptor for phone_number field.
-	userDescPhoneNumber := userFields[3].Descriptor()
+	userDescPhoneNumber := userFields[6].Descriptor()
 	// user.PhoneNumberValidator is a validator for the "phone_number" field. It is called by the builders before save.
 	user.PhoneNumberValidator = func() func(string) error {
 		validators := userDescPhoneNumber.Validators
@@ -138,8 +551,61 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescDisplayName is the schema descriptor for display_name field.
+	userDescDisplayName := userFields[9].Descriptor()
+	// user.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	user.DisplayNameValidator = userDescDisplayName.Validators[0].(func(string) error)
+	// userDescBio is the schema descriptor for bio field.
+	userDescBio := userFields[10].Descriptor()
+	// user.BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	user.BioValidator = userDescBio.Validators[0].(func(string) error)
+	// userDescLocale is the schema descriptor for locale field.
+	userDescLocale := userFields[12].Descriptor()
+	// user.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	user.LocaleValidator = userDescLocale.Validators[0].(func(string) error)
+	// userDescPrivate is the schema descriptor for private field.
+	userDescPrivate := userFields[17].Descriptor()
+	// user.DefaultPrivate holds the default value on creation for the private field.
+	user.DefaultPrivate = userDescPrivate.Default.(bool)
+	// userDescFollowerCount is the schema descriptor for follower_count field.
+	userDescFollowerCount := userFields[18].Descriptor()
+	// user.DefaultFollowerCount holds the default value on creation for the follower_count field.
+	user.DefaultFollowerCount = userDescFollowerCount.Default.(int)
+	// user.FollowerCountValidator is a validator for the "follower_count" field. It is called by the builders before save.
+	user.FollowerCountValidator = userDescFollowerCount.Validators[0].(func(int) error)
+	// userDescFollowingCount is the schema descriptor for following_count field.
+	userDescFollowingCount := userFields[19].Descriptor()
+	// user.DefaultFollowingCount holds the default value on creation for the following_count field.
+	user.DefaultFollowingCount = userDescFollowingCount.Default.(int)
+	// user.FollowingCountValidator is a validator for the "following_count" field. It is called by the builders before save.
+	user.FollowingCountValidator = userDescFollowingCount.Validators[0].(func(int) error)
+	// userDescTimezone is the schema descriptor for timezone field.
+	userDescTimezone := userFields[20].Descriptor()
+	// user.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	user.TimezoneValidator = userDescTimezone.Validators[0].(func(string) error)
 	// userDescLoginCount is the schema descriptor for login_count field.
-	userDescLoginCount := userFields[6].Descriptor()
+	userDescLoginCount := userFields[21].Descriptor()
 	// user.DefaultLoginCount holds the default value on creation for the login_count field.
 	user.DefaultLoginCount = userDescLoginCount.Default.(int)
+	// userDescGuest is the schema descriptor for guest field.
+	userDescGuest := userFields[29].Descriptor()
+	// user.DefaultGuest holds the default value on creation for the guest field.
+	user.DefaultGuest = userDescGuest.Default.(bool)
+	usernamehistoryMixin := schema.UsernameHistory{}.Mixin()
+	usernamehistoryMixinFields0 := usernamehistoryMixin[0].Fields()
+	_ = usernamehistoryMixinFields0
+	usernamehistoryFields := schema.UsernameHistory{}.Fields()
+	_ = usernamehistoryFields
+	// usernamehistoryDescCreateTime is the schema descriptor for create_time field.
+	usernamehistoryDescCreateTime := usernamehistoryMixinFields0[0].Descriptor()
+	// usernamehistory.DefaultCreateTime holds the default value on creation for the create_time field.
+	usernamehistory.DefaultCreateTime = usernamehistoryDescCreateTime.Default.(func() time.Time)
+	// usernamehistoryDescUsername is the schema descriptor for username field.
+	usernamehistoryDescUsername := usernamehistoryFields[0].Descriptor()
+	// usernamehistory.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	usernamehistory.UsernameValidator = usernamehistoryDescUsername.Validators[0].(func(string) error)
+	// usernamehistoryDescUsernameNormalized is the schema descriptor for username_normalized field.
+	usernamehistoryDescUsernameNormalized := usernamehistoryFields[1].Descriptor()
+	// usernamehistory.UsernameNormalizedValidator is a validator for the "username_normalized" field. It is called by the builders before save.
+	usernamehistory.UsernameNormalizedValidator = usernamehistoryDescUsernameNormalized.Validators[0].(func(string) error)
 }
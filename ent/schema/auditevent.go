@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_type").NotEmpty().Immutable().Comment("e.g. auth.otp.verified or admin.user.banned"),
+		field.String("ip_address").Optional().Immutable(),
+		field.String("user_agent").Optional().Immutable(),
+		field.String("request_id").Optional().Immutable(),
+		field.JSON("metadata", map[string]any{}).Optional().Immutable(),
+	}
+}
+
+// Edges of the AuditEvent.
+func (AuditEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("actor", User.Type).
+			Unique().
+			Immutable(), // who performed the action, empty for anonymous requests
+		edge.To("subject", User.Type).
+			Unique().
+			Immutable(), // whose account the action was about
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("create_time"),
+		index.Fields("event_type", "create_time"),
+	}
+}
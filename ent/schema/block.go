@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Block holds the schema definition for the Block entity. Users who blocked
+// one another, in either direction, cannot see or follow each other.
+type Block struct {
+	ent.Schema
+}
+
+func (Block) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Fields of the Block.
+func (Block) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("blocker_id").Immutable(),
+		field.Int("blocked_id").Immutable(),
+	}
+}
+
+// Edges of the Block.
+func (Block) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blocker", User.Type).
+			Ref("blocks").
+			Field("blocker_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("blocked", User.Type).
+			Ref("blocked_by").
+			Field("blocked_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (Block) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("blocker_id", "blocked_id").Unique(),
+		// the other direction, looked up by every block check
+		index.Fields("blocked_id", "blocker_id"),
+		index.Fields("blocker_id", "create_time"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shinplay/pkg/publicid"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+type DataExport struct {
+	ent.Schema
+}
+
+func (DataExport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("export_id").DefaultFunc(publicid.Must).NotEmpty().Unique().MaxLen(24),
+		field.Enum("status").Values("pending", "ready", "failed").Default("pending"),
+		field.String("file_path").Optional().Comment("Location of the zip archive while it is available"),
+		field.Time("expires_at").Optional().Nillable().Comment("Archive and download link stop working after this time"),
+		field.String("error").Optional(),
+	}
+}
+
+// Edges of the DataExport.
+func (DataExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("data_exports").
+			Unique().
+			Required(), // export must belong to a user
+	}
+}
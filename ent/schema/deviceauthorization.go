@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// DeviceAuthorization holds the schema definition for the DeviceAuthorization
+// entity, a sign-in request from a TV or console waiting to be approved on a
+// phone (RFC 8628).
+type DeviceAuthorization struct {
+	ent.Schema
+}
+
+func (DeviceAuthorization) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the DeviceAuthorization.
+func (DeviceAuthorization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("device_code_hash").NotEmpty().Unique().Immutable().Sensitive().Comment("SHA-256 of the device code polled by the device"),
+		field.String("user_code").NotEmpty().Unique().Immutable().Comment("Short code typed on the phone"),
+		field.Enum("status").Values("pending", "approved", "denied", "consumed").Default("pending"),
+		field.String("user_agent").Optional().Immutable().Comment("User agent of the requesting device"),
+		field.String("ip_address").Optional().Immutable().Comment("IP address of the requesting device"),
+		field.Int("interval").Positive().Comment("Seconds the device must wait between polls"),
+		field.Time("last_polled_at").Optional().Nillable(),
+		field.Time("expires_at").Immutable(),
+	}
+}
+
+// Edges of the DeviceAuthorization.
+func (DeviceAuthorization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("device_authorizations").
+			Unique(), // set once someone approves or denies the request
+	}
+}
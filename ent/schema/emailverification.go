@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// EmailVerification holds the schema definition for the EmailVerification
+// entity, an address waiting to be confirmed before it is put on an account.
+type EmailVerification struct {
+	ent.Schema
+}
+
+func (EmailVerification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the EmailVerification.
+func (EmailVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").NotEmpty().Immutable(),
+		field.String("code_hash").NotEmpty().Immutable().Sensitive().Comment("SHA-256 of the code typed in the app"),
+		field.String("token_hash").NotEmpty().Unique().Immutable().Sensitive().Comment("SHA-256 of the token in the emailed link"),
+		field.Int("attempts").NonNegative().Default(0),
+		field.Time("expires_at").Immutable(),
+		field.Time("verified_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the EmailVerification.
+func (EmailVerification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("email_verifications").
+			Unique().
+			Required(),
+	}
+}
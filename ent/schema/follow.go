@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Follow holds the schema definition for the Follow entity, one user
+// following another. Following a private account starts out as a pending
+// request its owner has to accept.
+type Follow struct {
+	ent.Schema
+}
+
+func (Follow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Fields of the Follow.
+func (Follow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("follower_id").Immutable(),
+		field.Int("followee_id").Immutable(),
+		field.Enum("status").Values("pending", "accepted"),
+		field.Time("accepted_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Follow.
+func (Follow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("follower", User.Type).
+			Ref("following").
+			Field("follower_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("followee", User.Type).
+			Ref("followers").
+			Field("followee_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (Follow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("follower_id", "followee_id").Unique(),
+		// the followers, following and request lists page over these
+		index.Fields("followee_id", "status", "create_time"),
+		index.Fields("follower_id", "status", "create_time"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shinplay/pkg/publicid"
+)
+
+// Impersonation holds the schema definition for the Impersonation entity, a
+// time-boxed period during which a staff member acts as a user.
+type Impersonation struct {
+	ent.Schema
+}
+
+func (Impersonation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the Impersonation.
+func (Impersonation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("impersonation_id").NotEmpty().Unique().Immutable().DefaultFunc(func() string {
+			return publicid.Must()
+		}),
+		field.String("reason").NotEmpty().Immutable().Comment("Why support needed to act as the user, e.g. a ticket reference"),
+		field.Time("expires_at").Immutable(),
+		field.Time("ended_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Impersonation.
+func (Impersonation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("actor", User.Type).
+			Unique().
+			Required().
+			Immutable(), // the staff member acting as the user
+		edge.To("subject", User.Type).
+			Unique().
+			Required().
+			Immutable(), // the user being impersonated
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Mute holds the schema definition for the Mute entity. Muting hides what a
+// user posts from the muter only, the muted user is not told.
+type Mute struct {
+	ent.Schema
+}
+
+func (Mute) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Fields of the Mute.
+func (Mute) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("muter_id").Immutable(),
+		field.Int("muted_id").Immutable(),
+	}
+}
+
+// Edges of the Mute.
+func (Mute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("muter", User.Type).
+			Ref("mutes").
+			Field("muter_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("muted", User.Type).
+			Ref("muted_by").
+			Field("muted_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (Mute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("muter_id", "muted_id").Unique(),
+		index.Fields("muter_id", "create_time"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// OAuthAuthorizationCode holds the schema definition for the
+// OAuthAuthorizationCode entity, a short-lived code exchanged for tokens.
+type OAuthAuthorizationCode struct {
+	ent.Schema
+}
+
+func (OAuthAuthorizationCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "oauth_authorization_codes"},
+	}
+}
+
+func (OAuthAuthorizationCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Fields of the OAuthAuthorizationCode.
+func (OAuthAuthorizationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").NotEmpty().Unique().Immutable().Sensitive().Comment("SHA-256 of the code"),
+		field.String("redirect_uri").Immutable(),
+		field.Strings("scopes").Immutable(),
+		field.String("code_challenge").NotEmpty().Immutable().Comment("PKCE challenge, always S256"),
+		field.Time("expires_at").Immutable(),
+		field.Time("used_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the OAuthAuthorizationCode.
+func (OAuthAuthorizationCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("oauth_authorization_codes").
+			Unique().
+			Required(),
+		edge.From("client", OAuthClient.Type).
+			Ref("authorization_codes").
+			Unique().
+			Required(),
+	}
+}
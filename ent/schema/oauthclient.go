@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shinplay/pkg/publicid"
+)
+
+// OAuthClient holds the schema definition for the OAuthClient entity, a
+// third-party app allowed to use "Sign in with Shinplay".
+type OAuthClient struct {
+	ent.Schema
+}
+
+func (OAuthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "oauth_clients"},
+	}
+}
+
+func (OAuthClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the OAuthClient.
+func (OAuthClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("client_id").NotEmpty().Unique().Immutable().DefaultFunc(func() string {
+			return publicid.Must()
+		}),
+		field.String("client_secret_hash").Optional().Sensitive().Comment("SHA-256 of the secret, empty for public clients such as mobile apps"),
+		field.String("name").NotEmpty().MaxLen(100),
+		field.String("homepage_url").Optional(),
+		field.Strings("redirect_uris").Comment("Exact redirect URIs the client may use"),
+		field.Strings("scopes").Comment("Scopes the client may request"),
+		field.Time("disabled_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the OAuthClient.
+func (OAuthClient) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("consents", OAuthConsent.Type),
+		edge.To("authorization_codes", OAuthAuthorizationCode.Type),
+		edge.To("sessions", Session.Type),
+	}
+}
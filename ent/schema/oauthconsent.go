@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// OAuthConsent holds the schema definition for the OAuthConsent entity, the
+// scopes a user has allowed a client to use.
+type OAuthConsent struct {
+	ent.Schema
+}
+
+func (OAuthConsent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "oauth_consents"},
+	}
+}
+
+func (OAuthConsent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the OAuthConsent.
+func (OAuthConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Strings("scopes"),
+	}
+}
+
+// Edges of the OAuthConsent.
+func (OAuthConsent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("oauth_consents").
+			Unique().
+			Required(),
+		edge.From("client", OAuthClient.Type).
+			Ref("consents").
+			Unique().
+			Required(),
+	}
+}
+
+func (OAuthConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		// one consent per user and client
+		index.Edges("user", "client").Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+func (PersonalAccessToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().MaxLen(100),
+		field.String("token_prefix").NotEmpty().Immutable().Comment("First characters of the token, shown to help users recognise it"),
+		field.String("token_hash").NotEmpty().Unique().Immutable().Sensitive().Comment("SHA-256 of the token, the token itself is never stored"),
+		field.Strings("scopes").Optional(),
+		field.Time("expires_at"),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PersonalAccessToken.
+func (PersonalAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("personal_access_tokens").
+			Unique().
+			Required(), // token must belong to a user
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// PhoneChangeRequest holds the schema definition for the PhoneChangeRequest
+// entity, a pending move of an account to a new phone number.
+type PhoneChangeRequest struct {
+	ent.Schema
+}
+
+func (PhoneChangeRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the PhoneChangeRequest.
+func (PhoneChangeRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("new_phone_number").MaxLen(15).MinLen(7).Immutable(),
+		field.String("code_hash").NotEmpty().Immutable().Sensitive().Comment("SHA-256 of the code sent to the new number"),
+		field.Int("attempts").NonNegative().Default(0),
+		field.Time("expires_at").Immutable(),
+		field.Time("verified_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PhoneChangeRequest.
+func (PhoneChangeRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("phone_change_requests").
+			Unique().
+			Required(),
+	}
+}
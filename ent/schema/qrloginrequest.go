@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shinplay/pkg/publicid"
+)
+
+// QRLoginRequest holds the schema definition for the QRLoginRequest entity, a
+// browser waiting to be signed in by scanning a QR code with the app.
+type QRLoginRequest struct {
+	ent.Schema
+}
+
+func (QRLoginRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		mixin.UpdateTime{},
+	}
+}
+
+// Fields of the QRLoginRequest.
+func (QRLoginRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("request_id").NotEmpty().Unique().Immutable().DefaultFunc(func() string {
+			return publicid.MustWith(32, publicid.AlphaNumeric())
+		}).Comment("Encoded in the QR code"),
+		field.String("browser_secret_hash").NotEmpty().Immutable().Sensitive().Comment("SHA-256 of the secret kept in the requesting browser's cookie"),
+		field.Enum("status").Values("pending", "approved", "denied", "consumed").Default("pending"),
+		field.String("user_agent").Optional().Immutable().Comment("User agent of the requesting browser"),
+		field.String("ip_address").Optional().Immutable().Comment("IP address of the requesting browser"),
+		field.String("location").Optional().Immutable().Comment("Approximate location of the requesting browser"),
+		field.Time("scanned_at").Optional().Nillable(),
+		field.Time("expires_at").Immutable(),
+	}
+}
+
+// Edges of the QRLoginRequest.
+func (QRLoginRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("qr_login_requests").
+			Unique(), // set once someone approves or denies the request
+	}
+}
@@ -23,6 +23,7 @@ func (Session) Fields() []ent.Field {
 		field.Time("expires_at").Comment("Session expiration time"),
 		field.String("user_agent").Optional().Comment("User agent string of the session"),
 		field.String("ip_address").Optional().Comment("IP address of the user"),
+		field.Strings("scopes").Optional().Comment("Scopes granted to a third-party client, empty for first-party sessions"),
 	}
 }
 
@@ -40,5 +41,8 @@ func (Session) Edges() []ent.Edge {
 			Ref("sessions").
 			Unique().
 			Required(), // session must belong to a user
+		edge.From("client", OAuthClient.Type).
+			Ref("sessions").
+			Unique(), // set when the session was created for a third-party app
 	}
 }
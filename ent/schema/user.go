@@ -2,8 +2,12 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/shinplay/pkg/publicid"
 )
@@ -24,12 +28,36 @@ func (User) Mixin() []ent.Mixin {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("auth_id").DefaultFunc(publicid.Must).NotEmpty().Unique().MaxLen(24),
-		field.String("username").MaxLen(40).Optional().Unique(),
-		field.String("email").Optional().Unique(),
+		field.String("username").MaxLen(120).Optional().Unique().Comment("Up to 30 characters, the limit is in bytes to fit any script"),
+		field.String("username_normalized").MaxLen(120).Optional().Nillable().Unique().Comment("Lowercased, confusable-folded username that uniqueness is enforced on"),
+		field.Time("username_changed_at").Optional().Nillable(),
+		field.String("email").Optional().Comment("Unique only among verified addresses, see Indexes"),
+		field.Time("email_verified_at").Optional().Nillable(),
 		field.String("phone_number").Optional().Unique().MaxLen(15).MinLen(7),
 		field.String("first_name").Optional(),
 		field.String("last_name").Optional(),
+		field.String("display_name").MaxLen(50).Optional(),
+		field.String("bio").MaxLen(300).Optional(),
+		field.Time("birthday").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.String("locale").MaxLen(35).Optional().Comment("BCP 47 language tag, e.g. en-IN"),
+		field.String("avatar_key").Optional().Comment("Blob store prefix the avatar variants are stored under"),
+		field.JSON("avatar_urls", map[string]string{}).Optional().Comment("Avatar variant URLs keyed by size in pixels"),
+		field.JSON("profile_visibility", map[string]string{}).Optional().Comment("Who may see each public profile field, unset fields use the defaults"),
+		field.JSON("settings", map[string]any{}).Optional().Comment("Preferences the user changed from the defaults, keyed by setting name"),
+		field.Bool("private").Default(false).Comment("Follows of a private account need its approval"),
+		field.Int("follower_count").Default(0).NonNegative(),
+		field.Int("following_count").Default(0).NonNegative(),
+		field.String("timezone").MaxLen(64).Optional().Comment("IANA time zone name, e.g. Asia/Kolkata"),
 		field.Int("login_count").Default(0),
+		field.Enum("status").Values("active", "suspended", "banned", "deleted").Default("active"),
+		field.Time("suspended_until").Optional().Nillable().Comment("End of a temporary suspension"),
+		field.String("restriction_reason").Optional().Comment("Why the account was suspended or banned"),
+		field.String("restricted_by").Optional().Comment("Auth ID of the staff member who restricted the account"),
+		field.Time("restricted_at").Optional().Nillable(),
+		field.Time("deletion_requested_at").Optional().Nillable(),
+		field.Time("deletion_scheduled_at").Optional().Nillable().Comment("Account is purged after this time unless the user logs in again"),
+		field.Bool("guest").Default(false).Comment("Anonymous account without a verified phone number or email"),
+		field.String("guest_device_hash").Optional().Unique().Sensitive().Comment("SHA-256 of the device ID a guest signs back in with"),
 	}
 }
 
@@ -38,5 +66,48 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("sessions", Session.Type),
 		edge.To("otps", OTP.Type),
+		edge.To("roles", Role.Type),
+		edge.To("data_exports", DataExport.Type),
+		edge.To("personal_access_tokens", PersonalAccessToken.Type),
+		edge.To("oauth_consents", OAuthConsent.Type),
+		edge.To("oauth_authorization_codes", OAuthAuthorizationCode.Type),
+		edge.To("device_authorizations", DeviceAuthorization.Type),
+		edge.To("qr_login_requests", QRLoginRequest.Type),
+		edge.To("phone_change_requests", PhoneChangeRequest.Type),
+		edge.To("email_verifications", EmailVerification.Type),
+		edge.To("username_history", UsernameHistory.Type),
+		edge.To("following", Follow.Type),
+		edge.To("followers", Follow.Type),
+		edge.To("blocks", Block.Type),
+		edge.To("blocked_by", Block.Type),
+		edge.To("mutes", Mute.Type),
+		edge.To("muted_by", Mute.Type),
+	}
+}
+
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// anyone can type an address, only the person who verified it owns it
+		index.Fields("email").
+			Unique().
+			Annotations(entsql.IndexWhere("email_verified_at IS NOT NULL")),
+		// trigram indexes back the fuzzy user search, pg_trgm is created on startup
+		index.Fields("username_normalized").
+			Annotations(trigramIndex()...),
+		index.Fields("display_name").
+			Annotations(trigramIndex()...),
+		index.Fields("first_name").
+			Annotations(trigramIndex()...),
+		index.Fields("last_name").
+			Annotations(trigramIndex()...),
+	}
+}
+
+// trigramIndex makes an index a GIN trigram index on Postgres, other
+// dialects get a plain index.
+func trigramIndex() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"}),
+		entsql.OpClass("gin_trgm_ops"),
 	}
 }
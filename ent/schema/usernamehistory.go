@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// UsernameHistory holds the schema definition for the UsernameHistory entity,
+// a username a user gave up. Nobody else can claim it until hold_until.
+type UsernameHistory struct {
+	ent.Schema
+}
+
+func (UsernameHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{}, // when the username was released
+	}
+}
+
+// Fields of the UsernameHistory.
+func (UsernameHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").NotEmpty().Immutable(),
+		field.String("username_normalized").NotEmpty().Immutable(),
+		field.Time("hold_until").Immutable().Comment("Only the previous owner can claim the username before this time"),
+	}
+}
+
+// Edges of the UsernameHistory.
+func (UsernameHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("username_history").
+			Unique().
+			Required(),
+	}
+}
+
+func (UsernameHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username_normalized", "hold_until"),
+	}
+}
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/oauthclient"
 	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
 )
@@ -32,20 +34,25 @@ type Session struct {
 	UserAgent string `json:"user_agent,omitempty"`
 	// IP address of the user
 	IPAddress string `json:"ip_address,omitempty"`
+	// Scopes granted to a third-party client, empty for first-party sessions
+	Scopes []string `json:"scopes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SessionQuery when eager-loading is set.
-	Edges         SessionEdges `json:"edges"`
-	user_sessions *int
-	selectValues  sql.SelectValues
+	Edges                 SessionEdges `json:"edges"`
+	oauth_client_sessions *int
+	user_sessions         *int
+	selectValues          sql.SelectValues
 }
 
 // SessionEdges holds the relations/edges for other nodes in the graph.
 type SessionEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Client holds the value of the client edge.
+	Client *OAuthClient `json:"client,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -59,18 +66,33 @@ func (e SessionEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// ClientOrErr returns the Client value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SessionEdges) ClientOrErr() (*OAuthClient, error) {
+	if e.Client != nil {
+		return e.Client, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: oauthclient.Label}
+	}
+	return nil, &NotLoadedError{edge: "client"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Session) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case session.FieldScopes:
+			values[i] = new([]byte)
 		case session.FieldID:
 			values[i] = new(sql.NullInt64)
 		case session.FieldSessionID, session.FieldRefreshToken, session.FieldUserAgent, session.FieldIPAddress:
 			values[i] = new(sql.NullString)
 		case session.FieldCreateTime, session.FieldUpdateTime, session.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
-		case session.ForeignKeys[0]: // user_sessions
+		case session.ForeignKeys[0]: // oauth_client_sessions
+			values[i] = new(sql.NullInt64)
+		case session.ForeignKeys[1]: // user_sessions
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -135,7 +157,22 @@ func (s *Session) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.IPAddress = value.String
 			}
+		case session.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
 		case session.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field oauth_client_sessions", value)
+			} else if value.Valid {
+				s.oauth_client_sessions = new(int)
+				*s.oauth_client_sessions = int(value.Int64)
+			}
+		case session.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_sessions", value)
 			} else if value.Valid {
@@ -160,6 +197,11 @@ func (s *Session) QueryUser() *UserQuery {
 	return NewSessionClient(s.config).QueryUser(s)
 }
 
+// QueryClient queries the "client" edge of the Session entity.
+func (s *Session) QueryClient() *OAuthClientQuery {
+	return NewSessionClient(s.config).QueryClient(s)
+}
+
 // Update returns a builder for updating this Session.
 // Note that you need to call Session.Unwrap() before calling this method if this Session
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -203,6 +245,9 @@ func (s *Session) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("ip_address=")
 	builder.WriteString(s.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", s.Scopes))
 	builder.WriteByte(')')
 	return builder.String()
 }
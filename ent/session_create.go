@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthclient"
 	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
 )
@@ -103,6 +104,12 @@ func (sc *SessionCreate) SetNillableIPAddress(s *string) *SessionCreate {
 	return sc
 }
 
+// SetScopes sets the "scopes" field.
+func (sc *SessionCreate) SetScopes(s []string) *SessionCreate {
+	sc.mutation.SetScopes(s)
+	return sc
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (sc *SessionCreate) SetUserID(id int) *SessionCreate {
 	sc.mutation.SetUserID(id)
@@ -114,6 +121,25 @@ func (sc *SessionCreate) SetUser(u *User) *SessionCreate {
 	return sc.SetUserID(u.ID)
 }
 
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (sc *SessionCreate) SetClientID(id int) *SessionCreate {
+	sc.mutation.SetClientID(id)
+	return sc
+}
+
+// SetNillableClientID sets the "client" edge to the OAuthClient entity by ID if the given value is not nil.
+func (sc *SessionCreate) SetNillableClientID(id *int) *SessionCreate {
+	if id != nil {
+		sc = sc.SetClientID(*id)
+	}
+	return sc
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (sc *SessionCreate) SetClient(o *OAuthClient) *SessionCreate {
+	return sc.SetClientID(o.ID)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (sc *SessionCreate) Mutation() *SessionMutation {
 	return sc.mutation
@@ -242,6 +268,10 @@ func (sc *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
 		_node.IPAddress = value
 	}
+	if value, ok := sc.mutation.Scopes(); ok {
+		_spec.SetField(session.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
 	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -259,6 +289,23 @@ func (sc *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_node.user_sessions = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.ClientTable,
+			Columns: []string{session.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.oauth_client_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
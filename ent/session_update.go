@@ -10,7 +10,9 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/oauthclient"
 	"github.com/shinplay/ent/predicate"
 	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
@@ -19,8 +21,9 @@ import (
 // SessionUpdate is the builder for updating Session entities.
 type SessionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SessionMutation
+	hooks     []Hook
+	mutation  *SessionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SessionUpdate builder.
@@ -117,6 +120,24 @@ func (su *SessionUpdate) ClearIPAddress() *SessionUpdate {
 	return su
 }
 
+// SetScopes sets the "scopes" field.
+func (su *SessionUpdate) SetScopes(s []string) *SessionUpdate {
+	su.mutation.SetScopes(s)
+	return su
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (su *SessionUpdate) AppendScopes(s []string) *SessionUpdate {
+	su.mutation.AppendScopes(s)
+	return su
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (su *SessionUpdate) ClearScopes() *SessionUpdate {
+	su.mutation.ClearScopes()
+	return su
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (su *SessionUpdate) SetUserID(id int) *SessionUpdate {
 	su.mutation.SetUserID(id)
@@ -128,6 +149,25 @@ func (su *SessionUpdate) SetUser(u *User) *SessionUpdate {
 	return su.SetUserID(u.ID)
 }
 
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (su *SessionUpdate) SetClientID(id int) *SessionUpdate {
+	su.mutation.SetClientID(id)
+	return su
+}
+
+// SetNillableClientID sets the "client" edge to the OAuthClient entity by ID if the given value is not nil.
+func (su *SessionUpdate) SetNillableClientID(id *int) *SessionUpdate {
+	if id != nil {
+		su = su.SetClientID(*id)
+	}
+	return su
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (su *SessionUpdate) SetClient(o *OAuthClient) *SessionUpdate {
+	return su.SetClientID(o.ID)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (su *SessionUpdate) Mutation() *SessionMutation {
 	return su.mutation
@@ -139,6 +179,12 @@ func (su *SessionUpdate) ClearUser() *SessionUpdate {
 	return su
 }
 
+// ClearClient clears the "client" edge to the OAuthClient entity.
+func (su *SessionUpdate) ClearClient() *SessionUpdate {
+	su.mutation.ClearClient()
+	return su
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SessionUpdate) Save(ctx context.Context) (int, error) {
 	su.defaults()
@@ -188,6 +234,12 @@ func (su *SessionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *SessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
 func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := su.check(); err != nil {
 		return n, err
@@ -224,6 +276,17 @@ func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.IPAddressCleared() {
 		_spec.ClearField(session.FieldIPAddress, field.TypeString)
 	}
+	if value, ok := su.mutation.Scopes(); ok {
+		_spec.SetField(session.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, session.FieldScopes, value)
+		})
+	}
+	if su.mutation.ScopesCleared() {
+		_spec.ClearField(session.FieldScopes, field.TypeJSON)
+	}
 	if su.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -253,6 +316,36 @@ func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.ClientTable,
+			Columns: []string{session.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.ClientTable,
+			Columns: []string{session.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(su.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{session.Label}
@@ -268,9 +361,10 @@ func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // SessionUpdateOne is the builder for updating a single Session entity.
 type SessionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SessionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SessionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -361,6 +455,24 @@ func (suo *SessionUpdateOne) ClearIPAddress() *SessionUpdateOne {
 	return suo
 }
 
+// SetScopes sets the "scopes" field.
+func (suo *SessionUpdateOne) SetScopes(s []string) *SessionUpdateOne {
+	suo.mutation.SetScopes(s)
+	return suo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (suo *SessionUpdateOne) AppendScopes(s []string) *SessionUpdateOne {
+	suo.mutation.AppendScopes(s)
+	return suo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (suo *SessionUpdateOne) ClearScopes() *SessionUpdateOne {
+	suo.mutation.ClearScopes()
+	return suo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (suo *SessionUpdateOne) SetUserID(id int) *SessionUpdateOne {
 	suo.mutation.SetUserID(id)
@@ -372,6 +484,25 @@ func (suo *SessionUpdateOne) SetUser(u *User) *SessionUpdateOne {
 	return suo.SetUserID(u.ID)
 }
 
+// SetClientID sets the "client" edge to the OAuthClient entity by ID.
+func (suo *SessionUpdateOne) SetClientID(id int) *SessionUpdateOne {
+	suo.mutation.SetClientID(id)
+	return suo
+}
+
+// SetNillableClientID sets the "client" edge to the OAuthClient entity by ID if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableClientID(id *int) *SessionUpdateOne {
+	if id != nil {
+		suo = suo.SetClientID(*id)
+	}
+	return suo
+}
+
+// SetClient sets the "client" edge to the OAuthClient entity.
+func (suo *SessionUpdateOne) SetClient(o *OAuthClient) *SessionUpdateOne {
+	return suo.SetClientID(o.ID)
+}
+
 // Mutation returns the SessionMutation object of the builder.
 func (suo *SessionUpdateOne) Mutation() *SessionMutation {
 	return suo.mutation
@@ -383,6 +514,12 @@ func (suo *SessionUpdateOne) ClearUser() *SessionUpdateOne {
 	return suo
 }
 
+// ClearClient clears the "client" edge to the OAuthClient entity.
+func (suo *SessionUpdateOne) ClearClient() *SessionUpdateOne {
+	suo.mutation.ClearClient()
+	return suo
+}
+
 // Where appends a list predicates to the SessionUpdate builder.
 func (suo *SessionUpdateOne) Where(ps ...predicate.Session) *SessionUpdateOne {
 	suo.mutation.Where(ps...)
@@ -445,6 +582,12 @@ func (suo *SessionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *SessionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
 func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err error) {
 	if err := suo.check(); err != nil {
 		return _node, err
@@ -498,6 +641,17 @@ func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err e
 	if suo.mutation.IPAddressCleared() {
 		_spec.ClearField(session.FieldIPAddress, field.TypeString)
 	}
+	if value, ok := suo.mutation.Scopes(); ok {
+		_spec.SetField(session.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, session.FieldScopes, value)
+		})
+	}
+	if suo.mutation.ScopesCleared() {
+		_spec.ClearField(session.FieldScopes, field.TypeJSON)
+	}
 	if suo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -527,6 +681,36 @@ func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.ClientTable,
+			Columns: []string{session.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.ClientTable,
+			Columns: []string{session.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(suo.modifiers...)
 	_node = &Session{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,18 +24,66 @@ type User struct {
 	UpdateTime time.Time `json:"update_time,omitempty"`
 	// AuthID holds the value of the "auth_id" field.
 	AuthID string `json:"auth_id,omitempty"`
-	// Username holds the value of the "username" field.
+	// Up to 30 characters, the limit is in bytes to fit any script
 	Username string `json:"username,omitempty"`
-	// Email holds the value of the "email" field.
+	// Lowercased, confusable-folded username that uniqueness is enforced on
+	UsernameNormalized *string `json:"username_normalized,omitempty"`
+	// UsernameChangedAt holds the value of the "username_changed_at" field.
+	UsernameChangedAt *time.Time `json:"username_changed_at,omitempty"`
+	// Unique only among verified addresses, see Indexes
 	Email string `json:"email,omitempty"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
 	// PhoneNumber holds the value of the "phone_number" field.
 	PhoneNumber string `json:"phone_number,omitempty"`
 	// FirstName holds the value of the "first_name" field.
 	FirstName string `json:"first_name,omitempty"`
 	// LastName holds the value of the "last_name" field.
 	LastName string `json:"last_name,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// Bio holds the value of the "bio" field.
+	Bio string `json:"bio,omitempty"`
+	// Birthday holds the value of the "birthday" field.
+	Birthday *time.Time `json:"birthday,omitempty"`
+	// BCP 47 language tag, e.g. en-IN
+	Locale string `json:"locale,omitempty"`
+	// Blob store prefix the avatar variants are stored under
+	AvatarKey string `json:"avatar_key,omitempty"`
+	// Avatar variant URLs keyed by size in pixels
+	AvatarUrls map[string]string `json:"avatar_urls,omitempty"`
+	// Who may see each public profile field, unset fields use the defaults
+	ProfileVisibility map[string]string `json:"profile_visibility,omitempty"`
+	// Preferences the user changed from the defaults, keyed by setting name
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	// Follows of a private account need its approval
+	Private bool `json:"private,omitempty"`
+	// FollowerCount holds the value of the "follower_count" field.
+	FollowerCount int `json:"follower_count,omitempty"`
+	// FollowingCount holds the value of the "following_count" field.
+	FollowingCount int `json:"following_count,omitempty"`
+	// IANA time zone name, e.g. Asia/Kolkata
+	Timezone string `json:"timezone,omitempty"`
 	// LoginCount holds the value of the "login_count" field.
 	LoginCount int `json:"login_count,omitempty"`
+	// Status holds the value of the "status" field.
+	Status user.Status `json:"status,omitempty"`
+	// End of a temporary suspension
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	// Why the account was suspended or banned
+	RestrictionReason string `json:"restriction_reason,omitempty"`
+	// Auth ID of the staff member who restricted the account
+	RestrictedBy string `json:"restricted_by,omitempty"`
+	// RestrictedAt holds the value of the "restricted_at" field.
+	RestrictedAt *time.Time `json:"restricted_at,omitempty"`
+	// DeletionRequestedAt holds the value of the "deletion_requested_at" field.
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	// Account is purged after this time unless the user logs in again
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	// Anonymous account without a verified phone number or email
+	Guest bool `json:"guest,omitempty"`
+	// SHA-256 of the device ID a guest signs back in with
+	GuestDeviceHash string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -47,9 +96,41 @@ type UserEdges struct {
 	Sessions []*Session `json:"sessions,omitempty"`
 	// Otps holds the value of the otps edge.
 	Otps []*OTP `json:"otps,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// DataExports holds the value of the data_exports edge.
+	DataExports []*DataExport `json:"data_exports,omitempty"`
+	// PersonalAccessTokens holds the value of the personal_access_tokens edge.
+	PersonalAccessTokens []*PersonalAccessToken `json:"personal_access_tokens,omitempty"`
+	// OauthConsents holds the value of the oauth_consents edge.
+	OauthConsents []*OAuthConsent `json:"oauth_consents,omitempty"`
+	// OauthAuthorizationCodes holds the value of the oauth_authorization_codes edge.
+	OauthAuthorizationCodes []*OAuthAuthorizationCode `json:"oauth_authorization_codes,omitempty"`
+	// DeviceAuthorizations holds the value of the device_authorizations edge.
+	DeviceAuthorizations []*DeviceAuthorization `json:"device_authorizations,omitempty"`
+	// QrLoginRequests holds the value of the qr_login_requests edge.
+	QrLoginRequests []*QRLoginRequest `json:"qr_login_requests,omitempty"`
+	// PhoneChangeRequests holds the value of the phone_change_requests edge.
+	PhoneChangeRequests []*PhoneChangeRequest `json:"phone_change_requests,omitempty"`
+	// EmailVerifications holds the value of the email_verifications edge.
+	EmailVerifications []*EmailVerification `json:"email_verifications,omitempty"`
+	// UsernameHistory holds the value of the username_history edge.
+	UsernameHistory []*UsernameHistory `json:"username_history,omitempty"`
+	// Following holds the value of the following edge.
+	Following []*Follow `json:"following,omitempty"`
+	// Followers holds the value of the followers edge.
+	Followers []*Follow `json:"followers,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*Block `json:"blocks,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*Block `json:"blocked_by,omitempty"`
+	// Mutes holds the value of the mutes edge.
+	Mutes []*Mute `json:"mutes,omitempty"`
+	// MutedBy holds the value of the muted_by edge.
+	MutedBy []*Mute `json:"muted_by,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [18]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -70,16 +151,164 @@ func (e UserEdges) OtpsOrErr() ([]*OTP, error) {
 	return nil, &NotLoadedError{edge: "otps"}
 }
 
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[2] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// DataExportsOrErr returns the DataExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DataExportsOrErr() ([]*DataExport, error) {
+	if e.loadedTypes[3] {
+		return e.DataExports, nil
+	}
+	return nil, &NotLoadedError{edge: "data_exports"}
+}
+
+// PersonalAccessTokensOrErr returns the PersonalAccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PersonalAccessTokensOrErr() ([]*PersonalAccessToken, error) {
+	if e.loadedTypes[4] {
+		return e.PersonalAccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "personal_access_tokens"}
+}
+
+// OauthConsentsOrErr returns the OauthConsents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthConsentsOrErr() ([]*OAuthConsent, error) {
+	if e.loadedTypes[5] {
+		return e.OauthConsents, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_consents"}
+}
+
+// OauthAuthorizationCodesOrErr returns the OauthAuthorizationCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthAuthorizationCodesOrErr() ([]*OAuthAuthorizationCode, error) {
+	if e.loadedTypes[6] {
+		return e.OauthAuthorizationCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_authorization_codes"}
+}
+
+// DeviceAuthorizationsOrErr returns the DeviceAuthorizations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DeviceAuthorizationsOrErr() ([]*DeviceAuthorization, error) {
+	if e.loadedTypes[7] {
+		return e.DeviceAuthorizations, nil
+	}
+	return nil, &NotLoadedError{edge: "device_authorizations"}
+}
+
+// QrLoginRequestsOrErr returns the QrLoginRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) QrLoginRequestsOrErr() ([]*QRLoginRequest, error) {
+	if e.loadedTypes[8] {
+		return e.QrLoginRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "qr_login_requests"}
+}
+
+// PhoneChangeRequestsOrErr returns the PhoneChangeRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PhoneChangeRequestsOrErr() ([]*PhoneChangeRequest, error) {
+	if e.loadedTypes[9] {
+		return e.PhoneChangeRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "phone_change_requests"}
+}
+
+// EmailVerificationsOrErr returns the EmailVerifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailVerificationsOrErr() ([]*EmailVerification, error) {
+	if e.loadedTypes[10] {
+		return e.EmailVerifications, nil
+	}
+	return nil, &NotLoadedError{edge: "email_verifications"}
+}
+
+// UsernameHistoryOrErr returns the UsernameHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UsernameHistoryOrErr() ([]*UsernameHistory, error) {
+	if e.loadedTypes[11] {
+		return e.UsernameHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "username_history"}
+}
+
+// FollowingOrErr returns the Following value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowingOrErr() ([]*Follow, error) {
+	if e.loadedTypes[12] {
+		return e.Following, nil
+	}
+	return nil, &NotLoadedError{edge: "following"}
+}
+
+// FollowersOrErr returns the Followers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowersOrErr() ([]*Follow, error) {
+	if e.loadedTypes[13] {
+		return e.Followers, nil
+	}
+	return nil, &NotLoadedError{edge: "followers"}
+}
+
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlocksOrErr() ([]*Block, error) {
+	if e.loadedTypes[14] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedByOrErr() ([]*Block, error) {
+	if e.loadedTypes[15] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// MutesOrErr returns the Mutes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutesOrErr() ([]*Mute, error) {
+	if e.loadedTypes[16] {
+		return e.Mutes, nil
+	}
+	return nil, &NotLoadedError{edge: "mutes"}
+}
+
+// MutedByOrErr returns the MutedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutedByOrErr() ([]*Mute, error) {
+	if e.loadedTypes[17] {
+		return e.MutedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "muted_by"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldLoginCount:
+		case user.FieldAvatarUrls, user.FieldProfileVisibility, user.FieldSettings:
+			values[i] = new([]byte)
+		case user.FieldPrivate, user.FieldGuest:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldFollowerCount, user.FieldFollowingCount, user.FieldLoginCount:
 			values[i] = new(sql.NullInt64)
-		case user.FieldAuthID, user.FieldUsername, user.FieldEmail, user.FieldPhoneNumber, user.FieldFirstName, user.FieldLastName:
+		case user.FieldAuthID, user.FieldUsername, user.FieldUsernameNormalized, user.FieldEmail, user.FieldPhoneNumber, user.FieldFirstName, user.FieldLastName, user.FieldDisplayName, user.FieldBio, user.FieldLocale, user.FieldAvatarKey, user.FieldTimezone, user.FieldStatus, user.FieldRestrictionReason, user.FieldRestrictedBy, user.FieldGuestDeviceHash:
 			values[i] = new(sql.NullString)
-		case user.FieldCreateTime, user.FieldUpdateTime:
+		case user.FieldCreateTime, user.FieldUpdateTime, user.FieldUsernameChangedAt, user.FieldEmailVerifiedAt, user.FieldBirthday, user.FieldSuspendedUntil, user.FieldRestrictedAt, user.FieldDeletionRequestedAt, user.FieldDeletionScheduledAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -126,12 +355,33 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Username = value.String
 			}
+		case user.FieldUsernameNormalized:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username_normalized", values[i])
+			} else if value.Valid {
+				u.UsernameNormalized = new(string)
+				*u.UsernameNormalized = value.String
+			}
+		case user.FieldUsernameChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field username_changed_at", values[i])
+			} else if value.Valid {
+				u.UsernameChangedAt = new(time.Time)
+				*u.UsernameChangedAt = value.Time
+			}
 		case user.FieldEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field email", values[i])
 			} else if value.Valid {
 				u.Email = value.String
 			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				u.EmailVerifiedAt = new(time.Time)
+				*u.EmailVerifiedAt = value.Time
+			}
 		case user.FieldPhoneNumber:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field phone_number", values[i])
@@ -150,12 +400,149 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.LastName = value.String
 			}
+		case user.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				u.DisplayName = value.String
+			}
+		case user.FieldBio:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bio", values[i])
+			} else if value.Valid {
+				u.Bio = value.String
+			}
+		case user.FieldBirthday:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field birthday", values[i])
+			} else if value.Valid {
+				u.Birthday = new(time.Time)
+				*u.Birthday = value.Time
+			}
+		case user.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				u.Locale = value.String
+			}
+		case user.FieldAvatarKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_key", values[i])
+			} else if value.Valid {
+				u.AvatarKey = value.String
+			}
+		case user.FieldAvatarUrls:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_urls", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.AvatarUrls); err != nil {
+					return fmt.Errorf("unmarshal field avatar_urls: %w", err)
+				}
+			}
+		case user.FieldProfileVisibility:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_visibility", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.ProfileVisibility); err != nil {
+					return fmt.Errorf("unmarshal field profile_visibility: %w", err)
+				}
+			}
+		case user.FieldSettings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field settings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Settings); err != nil {
+					return fmt.Errorf("unmarshal field settings: %w", err)
+				}
+			}
+		case user.FieldPrivate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field private", values[i])
+			} else if value.Valid {
+				u.Private = value.Bool
+			}
+		case user.FieldFollowerCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field follower_count", values[i])
+			} else if value.Valid {
+				u.FollowerCount = int(value.Int64)
+			}
+		case user.FieldFollowingCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field following_count", values[i])
+			} else if value.Valid {
+				u.FollowingCount = int(value.Int64)
+			}
+		case user.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				u.Timezone = value.String
+			}
 		case user.FieldLoginCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field login_count", values[i])
 			} else if value.Valid {
 				u.LoginCount = int(value.Int64)
 			}
+		case user.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				u.Status = user.Status(value.String)
+			}
+		case user.FieldSuspendedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_until", values[i])
+			} else if value.Valid {
+				u.SuspendedUntil = new(time.Time)
+				*u.SuspendedUntil = value.Time
+			}
+		case user.FieldRestrictionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field restriction_reason", values[i])
+			} else if value.Valid {
+				u.RestrictionReason = value.String
+			}
+		case user.FieldRestrictedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field restricted_by", values[i])
+			} else if value.Valid {
+				u.RestrictedBy = value.String
+			}
+		case user.FieldRestrictedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field restricted_at", values[i])
+			} else if value.Valid {
+				u.RestrictedAt = new(time.Time)
+				*u.RestrictedAt = value.Time
+			}
+		case user.FieldDeletionRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_requested_at", values[i])
+			} else if value.Valid {
+				u.DeletionRequestedAt = new(time.Time)
+				*u.DeletionRequestedAt = value.Time
+			}
+		case user.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				u.DeletionScheduledAt = new(time.Time)
+				*u.DeletionScheduledAt = value.Time
+			}
+		case user.FieldGuest:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field guest", values[i])
+			} else if value.Valid {
+				u.Guest = value.Bool
+			}
+		case user.FieldGuestDeviceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_device_hash", values[i])
+			} else if value.Valid {
+				u.GuestDeviceHash = value.String
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -179,6 +566,86 @@ func (u *User) QueryOtps() *OTPQuery {
 	return NewUserClient(u.config).QueryOtps(u)
 }
 
+// QueryRoles queries the "roles" edge of the User entity.
+func (u *User) QueryRoles() *RoleQuery {
+	return NewUserClient(u.config).QueryRoles(u)
+}
+
+// QueryDataExports queries the "data_exports" edge of the User entity.
+func (u *User) QueryDataExports() *DataExportQuery {
+	return NewUserClient(u.config).QueryDataExports(u)
+}
+
+// QueryPersonalAccessTokens queries the "personal_access_tokens" edge of the User entity.
+func (u *User) QueryPersonalAccessTokens() *PersonalAccessTokenQuery {
+	return NewUserClient(u.config).QueryPersonalAccessTokens(u)
+}
+
+// QueryOauthConsents queries the "oauth_consents" edge of the User entity.
+func (u *User) QueryOauthConsents() *OAuthConsentQuery {
+	return NewUserClient(u.config).QueryOauthConsents(u)
+}
+
+// QueryOauthAuthorizationCodes queries the "oauth_authorization_codes" edge of the User entity.
+func (u *User) QueryOauthAuthorizationCodes() *OAuthAuthorizationCodeQuery {
+	return NewUserClient(u.config).QueryOauthAuthorizationCodes(u)
+}
+
+// QueryDeviceAuthorizations queries the "device_authorizations" edge of the User entity.
+func (u *User) QueryDeviceAuthorizations() *DeviceAuthorizationQuery {
+	return NewUserClient(u.config).QueryDeviceAuthorizations(u)
+}
+
+// QueryQrLoginRequests queries the "qr_login_requests" edge of the User entity.
+func (u *User) QueryQrLoginRequests() *QRLoginRequestQuery {
+	return NewUserClient(u.config).QueryQrLoginRequests(u)
+}
+
+// QueryPhoneChangeRequests queries the "phone_change_requests" edge of the User entity.
+func (u *User) QueryPhoneChangeRequests() *PhoneChangeRequestQuery {
+	return NewUserClient(u.config).QueryPhoneChangeRequests(u)
+}
+
+// QueryEmailVerifications queries the "email_verifications" edge of the User entity.
+func (u *User) QueryEmailVerifications() *EmailVerificationQuery {
+	return NewUserClient(u.config).QueryEmailVerifications(u)
+}
+
+// QueryUsernameHistory queries the "username_history" edge of the User entity.
+func (u *User) QueryUsernameHistory() *UsernameHistoryQuery {
+	return NewUserClient(u.config).QueryUsernameHistory(u)
+}
+
+// QueryFollowing queries the "following" edge of the User entity.
+func (u *User) QueryFollowing() *FollowQuery {
+	return NewUserClient(u.config).QueryFollowing(u)
+}
+
+// QueryFollowers queries the "followers" edge of the User entity.
+func (u *User) QueryFollowers() *FollowQuery {
+	return NewUserClient(u.config).QueryFollowers(u)
+}
+
+// QueryBlocks queries the "blocks" edge of the User entity.
+func (u *User) QueryBlocks() *BlockQuery {
+	return NewUserClient(u.config).QueryBlocks(u)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the User entity.
+func (u *User) QueryBlockedBy() *BlockQuery {
+	return NewUserClient(u.config).QueryBlockedBy(u)
+}
+
+// QueryMutes queries the "mutes" edge of the User entity.
+func (u *User) QueryMutes() *MuteQuery {
+	return NewUserClient(u.config).QueryMutes(u)
+}
+
+// QueryMutedBy queries the "muted_by" edge of the User entity.
+func (u *User) QueryMutedBy() *MuteQuery {
+	return NewUserClient(u.config).QueryMutedBy(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -214,9 +681,24 @@ func (u *User) String() string {
 	builder.WriteString("username=")
 	builder.WriteString(u.Username)
 	builder.WriteString(", ")
+	if v := u.UsernameNormalized; v != nil {
+		builder.WriteString("username_normalized=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.UsernameChangedAt; v != nil {
+		builder.WriteString("username_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
+	if v := u.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("phone_number=")
 	builder.WriteString(u.PhoneNumber)
 	builder.WriteString(", ")
@@ -226,8 +708,80 @@ func (u *User) String() string {
 	builder.WriteString("last_name=")
 	builder.WriteString(u.LastName)
 	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(u.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("bio=")
+	builder.WriteString(u.Bio)
+	builder.WriteString(", ")
+	if v := u.Birthday; v != nil {
+		builder.WriteString("birthday=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(u.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("avatar_key=")
+	builder.WriteString(u.AvatarKey)
+	builder.WriteString(", ")
+	builder.WriteString("avatar_urls=")
+	builder.WriteString(fmt.Sprintf("%v", u.AvatarUrls))
+	builder.WriteString(", ")
+	builder.WriteString("profile_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", u.ProfileVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("settings=")
+	builder.WriteString(fmt.Sprintf("%v", u.Settings))
+	builder.WriteString(", ")
+	builder.WriteString("private=")
+	builder.WriteString(fmt.Sprintf("%v", u.Private))
+	builder.WriteString(", ")
+	builder.WriteString("follower_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.FollowerCount))
+	builder.WriteString(", ")
+	builder.WriteString("following_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.FollowingCount))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(u.Timezone)
+	builder.WriteString(", ")
 	builder.WriteString("login_count=")
 	builder.WriteString(fmt.Sprintf("%v", u.LoginCount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", u.Status))
+	builder.WriteString(", ")
+	if v := u.SuspendedUntil; v != nil {
+		builder.WriteString("suspended_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("restriction_reason=")
+	builder.WriteString(u.RestrictionReason)
+	builder.WriteString(", ")
+	builder.WriteString("restricted_by=")
+	builder.WriteString(u.RestrictedBy)
+	builder.WriteString(", ")
+	if v := u.RestrictedAt; v != nil {
+		builder.WriteString("restricted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletionRequestedAt; v != nil {
+		builder.WriteString("deletion_requested_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("guest=")
+	builder.WriteString(fmt.Sprintf("%v", u.Guest))
+	builder.WriteString(", ")
+	builder.WriteString("guest_device_hash=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
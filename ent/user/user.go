@@ -3,6 +3,7 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -22,20 +23,100 @@ const (
 	FieldAuthID = "auth_id"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldUsernameNormalized holds the string denoting the username_normalized field in the database.
+	FieldUsernameNormalized = "username_normalized"
+	// FieldUsernameChangedAt holds the string denoting the username_changed_at field in the database.
+	FieldUsernameChangedAt = "username_changed_at"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
 	// FieldPhoneNumber holds the string denoting the phone_number field in the database.
 	FieldPhoneNumber = "phone_number"
 	// FieldFirstName holds the string denoting the first_name field in the database.
 	FieldFirstName = "first_name"
 	// FieldLastName holds the string denoting the last_name field in the database.
 	FieldLastName = "last_name"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldBio holds the string denoting the bio field in the database.
+	FieldBio = "bio"
+	// FieldBirthday holds the string denoting the birthday field in the database.
+	FieldBirthday = "birthday"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldAvatarKey holds the string denoting the avatar_key field in the database.
+	FieldAvatarKey = "avatar_key"
+	// FieldAvatarUrls holds the string denoting the avatar_urls field in the database.
+	FieldAvatarUrls = "avatar_urls"
+	// FieldProfileVisibility holds the string denoting the profile_visibility field in the database.
+	FieldProfileVisibility = "profile_visibility"
+	// FieldSettings holds the string denoting the settings field in the database.
+	FieldSettings = "settings"
+	// FieldPrivate holds the string denoting the private field in the database.
+	FieldPrivate = "private"
+	// FieldFollowerCount holds the string denoting the follower_count field in the database.
+	FieldFollowerCount = "follower_count"
+	// FieldFollowingCount holds the string denoting the following_count field in the database.
+	FieldFollowingCount = "following_count"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldLoginCount holds the string denoting the login_count field in the database.
 	FieldLoginCount = "login_count"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSuspendedUntil holds the string denoting the suspended_until field in the database.
+	FieldSuspendedUntil = "suspended_until"
+	// FieldRestrictionReason holds the string denoting the restriction_reason field in the database.
+	FieldRestrictionReason = "restriction_reason"
+	// FieldRestrictedBy holds the string denoting the restricted_by field in the database.
+	FieldRestrictedBy = "restricted_by"
+	// FieldRestrictedAt holds the string denoting the restricted_at field in the database.
+	FieldRestrictedAt = "restricted_at"
+	// FieldDeletionRequestedAt holds the string denoting the deletion_requested_at field in the database.
+	FieldDeletionRequestedAt = "deletion_requested_at"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
+	// FieldGuest holds the string denoting the guest field in the database.
+	FieldGuest = "guest"
+	// FieldGuestDeviceHash holds the string denoting the guest_device_hash field in the database.
+	FieldGuestDeviceHash = "guest_device_hash"
 	// EdgeSessions holds the string denoting the sessions edge name in mutations.
 	EdgeSessions = "sessions"
 	// EdgeOtps holds the string denoting the otps edge name in mutations.
 	EdgeOtps = "otps"
+	// EdgeRoles holds the string denoting the roles edge name in mutations.
+	EdgeRoles = "roles"
+	// EdgeDataExports holds the string denoting the data_exports edge name in mutations.
+	EdgeDataExports = "data_exports"
+	// EdgePersonalAccessTokens holds the string denoting the personal_access_tokens edge name in mutations.
+	EdgePersonalAccessTokens = "personal_access_tokens"
+	// EdgeOauthConsents holds the string denoting the oauth_consents edge name in mutations.
+	EdgeOauthConsents = "oauth_consents"
+	// EdgeOauthAuthorizationCodes holds the string denoting the oauth_authorization_codes edge name in mutations.
+	EdgeOauthAuthorizationCodes = "oauth_authorization_codes"
+	// EdgeDeviceAuthorizations holds the string denoting the device_authorizations edge name in mutations.
+	EdgeDeviceAuthorizations = "device_authorizations"
+	// EdgeQrLoginRequests holds the string denoting the qr_login_requests edge name in mutations.
+	EdgeQrLoginRequests = "qr_login_requests"
+	// EdgePhoneChangeRequests holds the string denoting the phone_change_requests edge name in mutations.
+	EdgePhoneChangeRequests = "phone_change_requests"
+	// EdgeEmailVerifications holds the string denoting the email_verifications edge name in mutations.
+	EdgeEmailVerifications = "email_verifications"
+	// EdgeUsernameHistory holds the string denoting the username_history edge name in mutations.
+	EdgeUsernameHistory = "username_history"
+	// EdgeFollowing holds the string denoting the following edge name in mutations.
+	EdgeFollowing = "following"
+	// EdgeFollowers holds the string denoting the followers edge name in mutations.
+	EdgeFollowers = "followers"
+	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
+	EdgeBlocks = "blocks"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgeMutes holds the string denoting the mutes edge name in mutations.
+	EdgeMutes = "mutes"
+	// EdgeMutedBy holds the string denoting the muted_by edge name in mutations.
+	EdgeMutedBy = "muted_by"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SessionsTable is the table that holds the sessions relation/edge.
@@ -52,6 +133,116 @@ const (
 	OtpsInverseTable = "otps"
 	// OtpsColumn is the table column denoting the otps relation/edge.
 	OtpsColumn = "user_otps"
+	// RolesTable is the table that holds the roles relation/edge. The primary key declared below.
+	RolesTable = "user_roles"
+	// RolesInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RolesInverseTable = "roles"
+	// DataExportsTable is the table that holds the data_exports relation/edge.
+	DataExportsTable = "data_exports"
+	// DataExportsInverseTable is the table name for the DataExport entity.
+	// It exists in this package in order to avoid circular dependency with the "dataexport" package.
+	DataExportsInverseTable = "data_exports"
+	// DataExportsColumn is the table column denoting the data_exports relation/edge.
+	DataExportsColumn = "user_data_exports"
+	// PersonalAccessTokensTable is the table that holds the personal_access_tokens relation/edge.
+	PersonalAccessTokensTable = "personal_access_tokens"
+	// PersonalAccessTokensInverseTable is the table name for the PersonalAccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "personalaccesstoken" package.
+	PersonalAccessTokensInverseTable = "personal_access_tokens"
+	// PersonalAccessTokensColumn is the table column denoting the personal_access_tokens relation/edge.
+	PersonalAccessTokensColumn = "user_personal_access_tokens"
+	// OauthConsentsTable is the table that holds the oauth_consents relation/edge.
+	OauthConsentsTable = "oauth_consents"
+	// OauthConsentsInverseTable is the table name for the OAuthConsent entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthconsent" package.
+	OauthConsentsInverseTable = "oauth_consents"
+	// OauthConsentsColumn is the table column denoting the oauth_consents relation/edge.
+	OauthConsentsColumn = "user_oauth_consents"
+	// OauthAuthorizationCodesTable is the table that holds the oauth_authorization_codes relation/edge.
+	OauthAuthorizationCodesTable = "oauth_authorization_codes"
+	// OauthAuthorizationCodesInverseTable is the table name for the OAuthAuthorizationCode entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthauthorizationcode" package.
+	OauthAuthorizationCodesInverseTable = "oauth_authorization_codes"
+	// OauthAuthorizationCodesColumn is the table column denoting the oauth_authorization_codes relation/edge.
+	OauthAuthorizationCodesColumn = "user_oauth_authorization_codes"
+	// DeviceAuthorizationsTable is the table that holds the device_authorizations relation/edge.
+	DeviceAuthorizationsTable = "device_authorizations"
+	// DeviceAuthorizationsInverseTable is the table name for the DeviceAuthorization entity.
+	// It exists in this package in order to avoid circular dependency with the "deviceauthorization" package.
+	DeviceAuthorizationsInverseTable = "device_authorizations"
+	// DeviceAuthorizationsColumn is the table column denoting the device_authorizations relation/edge.
+	DeviceAuthorizationsColumn = "user_device_authorizations"
+	// QrLoginRequestsTable is the table that holds the qr_login_requests relation/edge.
+	QrLoginRequestsTable = "qr_login_requests"
+	// QrLoginRequestsInverseTable is the table name for the QRLoginRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "qrloginrequest" package.
+	QrLoginRequestsInverseTable = "qr_login_requests"
+	// QrLoginRequestsColumn is the table column denoting the qr_login_requests relation/edge.
+	QrLoginRequestsColumn = "user_qr_login_requests"
+	// PhoneChangeRequestsTable is the table that holds the phone_change_requests relation/edge.
+	PhoneChangeRequestsTable = "phone_change_requests"
+	// PhoneChangeRequestsInverseTable is the table name for the PhoneChangeRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "phonechangerequest" package.
+	PhoneChangeRequestsInverseTable = "phone_change_requests"
+	// PhoneChangeRequestsColumn is the table column denoting the phone_change_requests relation/edge.
+	PhoneChangeRequestsColumn = "user_phone_change_requests"
+	// EmailVerificationsTable is the table that holds the email_verifications relation/edge.
+	EmailVerificationsTable = "email_verifications"
+	// EmailVerificationsInverseTable is the table name for the EmailVerification entity.
+	// It exists in this package in order to avoid circular dependency with the "emailverification" package.
+	EmailVerificationsInverseTable = "email_verifications"
+	// EmailVerificationsColumn is the table column denoting the email_verifications relation/edge.
+	EmailVerificationsColumn = "user_email_verifications"
+	// UsernameHistoryTable is the table that holds the username_history relation/edge.
+	UsernameHistoryTable = "username_histories"
+	// UsernameHistoryInverseTable is the table name for the UsernameHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "usernamehistory" package.
+	UsernameHistoryInverseTable = "username_histories"
+	// UsernameHistoryColumn is the table column denoting the username_history relation/edge.
+	UsernameHistoryColumn = "user_username_history"
+	// FollowingTable is the table that holds the following relation/edge.
+	FollowingTable = "follows"
+	// FollowingInverseTable is the table name for the Follow entity.
+	// It exists in this package in order to avoid circular dependency with the "follow" package.
+	FollowingInverseTable = "follows"
+	// FollowingColumn is the table column denoting the following relation/edge.
+	FollowingColumn = "follower_id"
+	// FollowersTable is the table that holds the followers relation/edge.
+	FollowersTable = "follows"
+	// FollowersInverseTable is the table name for the Follow entity.
+	// It exists in this package in order to avoid circular dependency with the "follow" package.
+	FollowersInverseTable = "follows"
+	// FollowersColumn is the table column denoting the followers relation/edge.
+	FollowersColumn = "followee_id"
+	// BlocksTable is the table that holds the blocks relation/edge.
+	BlocksTable = "blocks"
+	// BlocksInverseTable is the table name for the Block entity.
+	// It exists in this package in order to avoid circular dependency with the "block" package.
+	BlocksInverseTable = "blocks"
+	// BlocksColumn is the table column denoting the blocks relation/edge.
+	BlocksColumn = "blocker_id"
+	// BlockedByTable is the table that holds the blocked_by relation/edge.
+	BlockedByTable = "blocks"
+	// BlockedByInverseTable is the table name for the Block entity.
+	// It exists in this package in order to avoid circular dependency with the "block" package.
+	BlockedByInverseTable = "blocks"
+	// BlockedByColumn is the table column denoting the blocked_by relation/edge.
+	BlockedByColumn = "blocked_id"
+	// MutesTable is the table that holds the mutes relation/edge.
+	MutesTable = "mutes"
+	// MutesInverseTable is the table name for the Mute entity.
+	// It exists in this package in order to avoid circular dependency with the "mute" package.
+	MutesInverseTable = "mutes"
+	// MutesColumn is the table column denoting the mutes relation/edge.
+	MutesColumn = "muter_id"
+	// MutedByTable is the table that holds the muted_by relation/edge.
+	MutedByTable = "mutes"
+	// MutedByInverseTable is the table name for the Mute entity.
+	// It exists in this package in order to avoid circular dependency with the "mute" package.
+	MutedByInverseTable = "mutes"
+	// MutedByColumn is the table column denoting the muted_by relation/edge.
+	MutedByColumn = "muted_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -61,13 +252,43 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldAuthID,
 	FieldUsername,
+	FieldUsernameNormalized,
+	FieldUsernameChangedAt,
 	FieldEmail,
+	FieldEmailVerifiedAt,
 	FieldPhoneNumber,
 	FieldFirstName,
 	FieldLastName,
+	FieldDisplayName,
+	FieldBio,
+	FieldBirthday,
+	FieldLocale,
+	FieldAvatarKey,
+	FieldAvatarUrls,
+	FieldProfileVisibility,
+	FieldSettings,
+	FieldPrivate,
+	FieldFollowerCount,
+	FieldFollowingCount,
+	FieldTimezone,
 	FieldLoginCount,
+	FieldStatus,
+	FieldSuspendedUntil,
+	FieldRestrictionReason,
+	FieldRestrictedBy,
+	FieldRestrictedAt,
+	FieldDeletionRequestedAt,
+	FieldDeletionScheduledAt,
+	FieldGuest,
+	FieldGuestDeviceHash,
 }
 
+var (
+	// RolesPrimaryKey and RolesColumn2 are the table columns denoting the
+	// primary key for the roles relation (M2M).
+	RolesPrimaryKey = []string{"user_id", "role_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -91,12 +312,62 @@ var (
 	AuthIDValidator func(string) error
 	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	UsernameValidator func(string) error
+	// UsernameNormalizedValidator is a validator for the "username_normalized" field. It is called by the builders before save.
+	UsernameNormalizedValidator func(string) error
 	// PhoneNumberValidator is a validator for the "phone_number" field. It is called by the builders before save.
 	PhoneNumberValidator func(string) error
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	BioValidator func(string) error
+	// LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	LocaleValidator func(string) error
+	// DefaultPrivate holds the default value on creation for the "private" field.
+	DefaultPrivate bool
+	// DefaultFollowerCount holds the default value on creation for the "follower_count" field.
+	DefaultFollowerCount int
+	// FollowerCountValidator is a validator for the "follower_count" field. It is called by the builders before save.
+	FollowerCountValidator func(int) error
+	// DefaultFollowingCount holds the default value on creation for the "following_count" field.
+	DefaultFollowingCount int
+	// FollowingCountValidator is a validator for the "following_count" field. It is called by the builders before save.
+	FollowingCountValidator func(int) error
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
 	// DefaultLoginCount holds the default value on creation for the "login_count" field.
 	DefaultLoginCount int
+	// DefaultGuest holds the default value on creation for the "guest" field.
+	DefaultGuest bool
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive    Status = "active"
+	StatusSuspended Status = "suspended"
+	StatusBanned    Status = "banned"
+	StatusDeleted   Status = "deleted"
 )
 
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusSuspended, StatusBanned, StatusDeleted:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -125,11 +396,26 @@ func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
 
+// ByUsernameNormalized orders the results by the username_normalized field.
+func ByUsernameNormalized(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsernameNormalized, opts...).ToFunc()
+}
+
+// ByUsernameChangedAt orders the results by the username_changed_at field.
+func ByUsernameChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsernameChangedAt, opts...).ToFunc()
+}
+
 // ByEmail orders the results by the email field.
 func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
 // ByPhoneNumber orders the results by the phone_number field.
 func ByPhoneNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPhoneNumber, opts...).ToFunc()
@@ -145,11 +431,101 @@ func ByLastName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastName, opts...).ToFunc()
 }
 
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByBio orders the results by the bio field.
+func ByBio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBio, opts...).ToFunc()
+}
+
+// ByBirthday orders the results by the birthday field.
+func ByBirthday(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBirthday, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByAvatarKey orders the results by the avatar_key field.
+func ByAvatarKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarKey, opts...).ToFunc()
+}
+
+// ByPrivate orders the results by the private field.
+func ByPrivate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrivate, opts...).ToFunc()
+}
+
+// ByFollowerCount orders the results by the follower_count field.
+func ByFollowerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowerCount, opts...).ToFunc()
+}
+
+// ByFollowingCountField orders the results by the following_count field.
+func ByFollowingCountField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowingCount, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByLoginCount orders the results by the login_count field.
 func ByLoginCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLoginCount, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySuspendedUntil orders the results by the suspended_until field.
+func BySuspendedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedUntil, opts...).ToFunc()
+}
+
+// ByRestrictionReason orders the results by the restriction_reason field.
+func ByRestrictionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestrictionReason, opts...).ToFunc()
+}
+
+// ByRestrictedBy orders the results by the restricted_by field.
+func ByRestrictedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestrictedBy, opts...).ToFunc()
+}
+
+// ByRestrictedAt orders the results by the restricted_at field.
+func ByRestrictedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestrictedAt, opts...).ToFunc()
+}
+
+// ByDeletionRequestedAt orders the results by the deletion_requested_at field.
+func ByDeletionRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionRequestedAt, opts...).ToFunc()
+}
+
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
+// ByGuest orders the results by the guest field.
+func ByGuest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuest, opts...).ToFunc()
+}
+
+// ByGuestDeviceHash orders the results by the guest_device_hash field.
+func ByGuestDeviceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestDeviceHash, opts...).ToFunc()
+}
+
 // BySessionsCount orders the results by sessions count.
 func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -177,6 +553,230 @@ func ByOtps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOtpsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRolesCount orders the results by roles count.
+func ByRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRolesStep(), opts...)
+	}
+}
+
+// ByRoles orders the results by roles terms.
+func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDataExportsCount orders the results by data_exports count.
+func ByDataExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDataExportsStep(), opts...)
+	}
+}
+
+// ByDataExports orders the results by data_exports terms.
+func ByDataExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDataExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPersonalAccessTokensCount orders the results by personal_access_tokens count.
+func ByPersonalAccessTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPersonalAccessTokensStep(), opts...)
+	}
+}
+
+// ByPersonalAccessTokens orders the results by personal_access_tokens terms.
+func ByPersonalAccessTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonalAccessTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthConsentsCount orders the results by oauth_consents count.
+func ByOauthConsentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthConsentsStep(), opts...)
+	}
+}
+
+// ByOauthConsents orders the results by oauth_consents terms.
+func ByOauthConsents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthConsentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthAuthorizationCodesCount orders the results by oauth_authorization_codes count.
+func ByOauthAuthorizationCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthAuthorizationCodesStep(), opts...)
+	}
+}
+
+// ByOauthAuthorizationCodes orders the results by oauth_authorization_codes terms.
+func ByOauthAuthorizationCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthAuthorizationCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeviceAuthorizationsCount orders the results by device_authorizations count.
+func ByDeviceAuthorizationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeviceAuthorizationsStep(), opts...)
+	}
+}
+
+// ByDeviceAuthorizations orders the results by device_authorizations terms.
+func ByDeviceAuthorizations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceAuthorizationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQrLoginRequestsCount orders the results by qr_login_requests count.
+func ByQrLoginRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQrLoginRequestsStep(), opts...)
+	}
+}
+
+// ByQrLoginRequests orders the results by qr_login_requests terms.
+func ByQrLoginRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQrLoginRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPhoneChangeRequestsCount orders the results by phone_change_requests count.
+func ByPhoneChangeRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPhoneChangeRequestsStep(), opts...)
+	}
+}
+
+// ByPhoneChangeRequests orders the results by phone_change_requests terms.
+func ByPhoneChangeRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPhoneChangeRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailVerificationsCount orders the results by email_verifications count.
+func ByEmailVerificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailVerificationsStep(), opts...)
+	}
+}
+
+// ByEmailVerifications orders the results by email_verifications terms.
+func ByEmailVerifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailVerificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUsernameHistoryCount orders the results by username_history count.
+func ByUsernameHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsernameHistoryStep(), opts...)
+	}
+}
+
+// ByUsernameHistory orders the results by username_history terms.
+func ByUsernameHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsernameHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowingCount orders the results by following count.
+func ByFollowingCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowingStep(), opts...)
+	}
+}
+
+// ByFollowing orders the results by following terms.
+func ByFollowing(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowingStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowersCount orders the results by followers count.
+func ByFollowersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowersStep(), opts...)
+	}
+}
+
+// ByFollowers orders the results by followers terms.
+func ByFollowers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlocksCount orders the results by blocks count.
+func ByBlocksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlocksStep(), opts...)
+	}
+}
+
+// ByBlocks orders the results by blocks terms.
+func ByBlocks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlocksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutesCount orders the results by mutes count.
+func ByMutesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutesStep(), opts...)
+	}
+}
+
+// ByMutes orders the results by mutes terms.
+func ByMutes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutedByCount orders the results by muted_by count.
+func ByMutedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutedByStep(), opts...)
+	}
+}
+
+// ByMutedBy orders the results by muted_by terms.
+func ByMutedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSessionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -191,3 +791,115 @@ func newOtpsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OtpsTable, OtpsColumn),
 	)
 }
+func newRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, RolesTable, RolesPrimaryKey...),
+	)
+}
+func newDataExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DataExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DataExportsTable, DataExportsColumn),
+	)
+}
+func newPersonalAccessTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonalAccessTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PersonalAccessTokensTable, PersonalAccessTokensColumn),
+	)
+}
+func newOauthConsentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthConsentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthConsentsTable, OauthConsentsColumn),
+	)
+}
+func newOauthAuthorizationCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthAuthorizationCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthAuthorizationCodesTable, OauthAuthorizationCodesColumn),
+	)
+}
+func newDeviceAuthorizationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceAuthorizationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeviceAuthorizationsTable, DeviceAuthorizationsColumn),
+	)
+}
+func newQrLoginRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QrLoginRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QrLoginRequestsTable, QrLoginRequestsColumn),
+	)
+}
+func newPhoneChangeRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PhoneChangeRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PhoneChangeRequestsTable, PhoneChangeRequestsColumn),
+	)
+}
+func newEmailVerificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailVerificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailVerificationsTable, EmailVerificationsColumn),
+	)
+}
+func newUsernameHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsernameHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsernameHistoryTable, UsernameHistoryColumn),
+	)
+}
+func newFollowingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowingTable, FollowingColumn),
+	)
+}
+func newFollowersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowersTable, FollowersColumn),
+	)
+}
+func newBlocksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlocksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockedByTable, BlockedByColumn),
+	)
+}
+func newMutesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MutesTable, MutesColumn),
+	)
+}
+func newMutedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MutedByTable, MutedByColumn),
+	)
+}
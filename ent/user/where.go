@@ -75,11 +75,26 @@ func Username(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUsername, v))
 }
 
+// UsernameNormalized applies equality check predicate on the "username_normalized" field. It's identical to UsernameNormalizedEQ.
+func UsernameNormalized(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUsernameNormalized, v))
+}
+
+// UsernameChangedAt applies equality check predicate on the "username_changed_at" field. It's identical to UsernameChangedAtEQ.
+func UsernameChangedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUsernameChangedAt, v))
+}
+
 // Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
 func Email(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
 }
 
+// EmailVerifiedAt applies equality check predicate on the "email_verified_at" field. It's identical to EmailVerifiedAtEQ.
+func EmailVerifiedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
 // PhoneNumber applies equality check predicate on the "phone_number" field. It's identical to PhoneNumberEQ.
 func PhoneNumber(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPhoneNumber, v))
@@ -95,11 +110,96 @@ func LastName(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLastName, v))
 }
 
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// Bio applies equality check predicate on the "bio" field. It's identical to BioEQ.
+func Bio(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBio, v))
+}
+
+// Birthday applies equality check predicate on the "birthday" field. It's identical to BirthdayEQ.
+func Birthday(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBirthday, v))
+}
+
+// Locale applies equality check predicate on the "locale" field. It's identical to LocaleEQ.
+func Locale(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLocale, v))
+}
+
+// AvatarKey applies equality check predicate on the "avatar_key" field. It's identical to AvatarKeyEQ.
+func AvatarKey(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAvatarKey, v))
+}
+
+// Private applies equality check predicate on the "private" field. It's identical to PrivateEQ.
+func Private(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPrivate, v))
+}
+
+// FollowerCount applies equality check predicate on the "follower_count" field. It's identical to FollowerCountEQ.
+func FollowerCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowerCount, v))
+}
+
+// FollowingCount applies equality check predicate on the "following_count" field. It's identical to FollowingCountEQ.
+func FollowingCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowingCount, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTimezone, v))
+}
+
 // LoginCount applies equality check predicate on the "login_count" field. It's identical to LoginCountEQ.
 func LoginCount(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLoginCount, v))
 }
 
+// SuspendedUntil applies equality check predicate on the "suspended_until" field. It's identical to SuspendedUntilEQ.
+func SuspendedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSuspendedUntil, v))
+}
+
+// RestrictionReason applies equality check predicate on the "restriction_reason" field. It's identical to RestrictionReasonEQ.
+func RestrictionReason(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRestrictionReason, v))
+}
+
+// RestrictedBy applies equality check predicate on the "restricted_by" field. It's identical to RestrictedByEQ.
+func RestrictedBy(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRestrictedBy, v))
+}
+
+// RestrictedAt applies equality check predicate on the "restricted_at" field. It's identical to RestrictedAtEQ.
+func RestrictedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRestrictedAt, v))
+}
+
+// DeletionRequestedAt applies equality check predicate on the "deletion_requested_at" field. It's identical to DeletionRequestedAtEQ.
+func DeletionRequestedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionRequestedAt, v))
+}
+
+// DeletionScheduledAt applies equality check predicate on the "deletion_scheduled_at" field. It's identical to DeletionScheduledAtEQ.
+func DeletionScheduledAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionScheduledAt, v))
+}
+
+// Guest applies equality check predicate on the "guest" field. It's identical to GuestEQ.
+func Guest(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuest, v))
+}
+
+// GuestDeviceHash applies equality check predicate on the "guest_device_hash" field. It's identical to GuestDeviceHashEQ.
+func GuestDeviceHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuestDeviceHash, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreateTime, v))
@@ -320,6 +420,131 @@ func UsernameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldUsername, v))
 }
 
+// UsernameNormalizedEQ applies the EQ predicate on the "username_normalized" field.
+func UsernameNormalizedEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedNEQ applies the NEQ predicate on the "username_normalized" field.
+func UsernameNormalizedNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedIn applies the In predicate on the "username_normalized" field.
+func UsernameNormalizedIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUsernameNormalized, vs...))
+}
+
+// UsernameNormalizedNotIn applies the NotIn predicate on the "username_normalized" field.
+func UsernameNormalizedNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUsernameNormalized, vs...))
+}
+
+// UsernameNormalizedGT applies the GT predicate on the "username_normalized" field.
+func UsernameNormalizedGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedGTE applies the GTE predicate on the "username_normalized" field.
+func UsernameNormalizedGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedLT applies the LT predicate on the "username_normalized" field.
+func UsernameNormalizedLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedLTE applies the LTE predicate on the "username_normalized" field.
+func UsernameNormalizedLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedContains applies the Contains predicate on the "username_normalized" field.
+func UsernameNormalizedContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedHasPrefix applies the HasPrefix predicate on the "username_normalized" field.
+func UsernameNormalizedHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedHasSuffix applies the HasSuffix predicate on the "username_normalized" field.
+func UsernameNormalizedHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedIsNil applies the IsNil predicate on the "username_normalized" field.
+func UsernameNormalizedIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUsernameNormalized))
+}
+
+// UsernameNormalizedNotNil applies the NotNil predicate on the "username_normalized" field.
+func UsernameNormalizedNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUsernameNormalized))
+}
+
+// UsernameNormalizedEqualFold applies the EqualFold predicate on the "username_normalized" field.
+func UsernameNormalizedEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedContainsFold applies the ContainsFold predicate on the "username_normalized" field.
+func UsernameNormalizedContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldUsernameNormalized, v))
+}
+
+// UsernameChangedAtEQ applies the EQ predicate on the "username_changed_at" field.
+func UsernameChangedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtNEQ applies the NEQ predicate on the "username_changed_at" field.
+func UsernameChangedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtIn applies the In predicate on the "username_changed_at" field.
+func UsernameChangedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUsernameChangedAt, vs...))
+}
+
+// UsernameChangedAtNotIn applies the NotIn predicate on the "username_changed_at" field.
+func UsernameChangedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUsernameChangedAt, vs...))
+}
+
+// UsernameChangedAtGT applies the GT predicate on the "username_changed_at" field.
+func UsernameChangedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtGTE applies the GTE predicate on the "username_changed_at" field.
+func UsernameChangedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtLT applies the LT predicate on the "username_changed_at" field.
+func UsernameChangedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtLTE applies the LTE predicate on the "username_changed_at" field.
+func UsernameChangedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtIsNil applies the IsNil predicate on the "username_changed_at" field.
+func UsernameChangedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUsernameChangedAt))
+}
+
+// UsernameChangedAtNotNil applies the NotNil predicate on the "username_changed_at" field.
+func UsernameChangedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUsernameChangedAt))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -395,6 +620,56 @@ func EmailContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// EmailVerifiedAtEQ applies the EQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtNEQ applies the NEQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIn applies the In predicate on the "email_verified_at" field.
+func EmailVerifiedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtNotIn applies the NotIn predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtGT applies the GT predicate on the "email_verified_at" field.
+func EmailVerifiedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtGTE applies the GTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLT applies the LT predicate on the "email_verified_at" field.
+func EmailVerifiedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLTE applies the LTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIsNil applies the IsNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailVerifiedAt))
+}
+
+// EmailVerifiedAtNotNil applies the NotNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailVerifiedAt))
+}
+
 // PhoneNumberEQ applies the EQ predicate on the "phone_number" field.
 func PhoneNumberEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPhoneNumber, v))
@@ -620,84 +895,1452 @@ func LastNameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldLastName, v))
 }
 
-// LoginCountEQ applies the EQ predicate on the "login_count" field.
-func LoginCountEQ(v int) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldLoginCount, v))
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDisplayName, v))
 }
 
-// LoginCountNEQ applies the NEQ predicate on the "login_count" field.
-func LoginCountNEQ(v int) predicate.User {
-	return predicate.User(sql.FieldNEQ(FieldLoginCount, v))
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDisplayName, v))
 }
 
-// LoginCountIn applies the In predicate on the "login_count" field.
-func LoginCountIn(vs ...int) predicate.User {
-	return predicate.User(sql.FieldIn(FieldLoginCount, vs...))
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDisplayName, vs...))
 }
 
-// LoginCountNotIn applies the NotIn predicate on the "login_count" field.
-func LoginCountNotIn(vs ...int) predicate.User {
-	return predicate.User(sql.FieldNotIn(FieldLoginCount, vs...))
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDisplayName, vs...))
 }
 
-// LoginCountGT applies the GT predicate on the "login_count" field.
-func LoginCountGT(v int) predicate.User {
-	return predicate.User(sql.FieldGT(FieldLoginCount, v))
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDisplayName, v))
 }
 
-// LoginCountGTE applies the GTE predicate on the "login_count" field.
-func LoginCountGTE(v int) predicate.User {
-	return predicate.User(sql.FieldGTE(FieldLoginCount, v))
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDisplayName, v))
 }
 
-// LoginCountLT applies the LT predicate on the "login_count" field.
-func LoginCountLT(v int) predicate.User {
-	return predicate.User(sql.FieldLT(FieldLoginCount, v))
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDisplayName, v))
 }
 
-// LoginCountLTE applies the LTE predicate on the "login_count" field.
-func LoginCountLTE(v int) predicate.User {
-	return predicate.User(sql.FieldLTE(FieldLoginCount, v))
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDisplayName, v))
 }
 
-// HasSessions applies the HasEdge predicate on the "sessions" edge.
-func HasSessions() predicate.User {
-	return predicate.User(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldDisplayName, v))
 }
 
-// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
-func HasSessionsWith(preds ...predicate.Session) predicate.User {
-	return predicate.User(func(s *sql.Selector) {
-		step := newSessionsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldDisplayName, v))
 }
 
-// HasOtps applies the HasEdge predicate on the "otps" edge.
-func HasOtps() predicate.User {
-	return predicate.User(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, OtpsTable, OtpsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldDisplayName, v))
 }
 
-// HasOtpsWith applies the HasEdge predicate on the "otps" edge with a given conditions (other predicates).
-func HasOtpsWith(preds ...predicate.OTP) predicate.User {
-	return predicate.User(func(s *sql.Selector) {
-		step := newOtpsStep()
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// BioEQ applies the EQ predicate on the "bio" field.
+func BioEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBio, v))
+}
+
+// BioNEQ applies the NEQ predicate on the "bio" field.
+func BioNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBio, v))
+}
+
+// BioIn applies the In predicate on the "bio" field.
+func BioIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBio, vs...))
+}
+
+// BioNotIn applies the NotIn predicate on the "bio" field.
+func BioNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBio, vs...))
+}
+
+// BioGT applies the GT predicate on the "bio" field.
+func BioGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBio, v))
+}
+
+// BioGTE applies the GTE predicate on the "bio" field.
+func BioGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBio, v))
+}
+
+// BioLT applies the LT predicate on the "bio" field.
+func BioLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBio, v))
+}
+
+// BioLTE applies the LTE predicate on the "bio" field.
+func BioLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBio, v))
+}
+
+// BioContains applies the Contains predicate on the "bio" field.
+func BioContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldBio, v))
+}
+
+// BioHasPrefix applies the HasPrefix predicate on the "bio" field.
+func BioHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldBio, v))
+}
+
+// BioHasSuffix applies the HasSuffix predicate on the "bio" field.
+func BioHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldBio, v))
+}
+
+// BioIsNil applies the IsNil predicate on the "bio" field.
+func BioIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBio))
+}
+
+// BioNotNil applies the NotNil predicate on the "bio" field.
+func BioNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBio))
+}
+
+// BioEqualFold applies the EqualFold predicate on the "bio" field.
+func BioEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldBio, v))
+}
+
+// BioContainsFold applies the ContainsFold predicate on the "bio" field.
+func BioContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldBio, v))
+}
+
+// BirthdayEQ applies the EQ predicate on the "birthday" field.
+func BirthdayEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBirthday, v))
+}
+
+// BirthdayNEQ applies the NEQ predicate on the "birthday" field.
+func BirthdayNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBirthday, v))
+}
+
+// BirthdayIn applies the In predicate on the "birthday" field.
+func BirthdayIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBirthday, vs...))
+}
+
+// BirthdayNotIn applies the NotIn predicate on the "birthday" field.
+func BirthdayNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBirthday, vs...))
+}
+
+// BirthdayGT applies the GT predicate on the "birthday" field.
+func BirthdayGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBirthday, v))
+}
+
+// BirthdayGTE applies the GTE predicate on the "birthday" field.
+func BirthdayGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBirthday, v))
+}
+
+// BirthdayLT applies the LT predicate on the "birthday" field.
+func BirthdayLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBirthday, v))
+}
+
+// BirthdayLTE applies the LTE predicate on the "birthday" field.
+func BirthdayLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBirthday, v))
+}
+
+// BirthdayIsNil applies the IsNil predicate on the "birthday" field.
+func BirthdayIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBirthday))
+}
+
+// BirthdayNotNil applies the NotNil predicate on the "birthday" field.
+func BirthdayNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBirthday))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// LocaleGT applies the GT predicate on the "locale" field.
+func LocaleGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLocale, v))
+}
+
+// LocaleGTE applies the GTE predicate on the "locale" field.
+func LocaleGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLocale, v))
+}
+
+// LocaleLT applies the LT predicate on the "locale" field.
+func LocaleLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLocale, v))
+}
+
+// LocaleLTE applies the LTE predicate on the "locale" field.
+func LocaleLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLocale, v))
+}
+
+// LocaleContains applies the Contains predicate on the "locale" field.
+func LocaleContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldLocale, v))
+}
+
+// LocaleHasPrefix applies the HasPrefix predicate on the "locale" field.
+func LocaleHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldLocale, v))
+}
+
+// LocaleHasSuffix applies the HasSuffix predicate on the "locale" field.
+func LocaleHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldLocale, v))
+}
+
+// LocaleIsNil applies the IsNil predicate on the "locale" field.
+func LocaleIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLocale))
+}
+
+// LocaleNotNil applies the NotNil predicate on the "locale" field.
+func LocaleNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLocale))
+}
+
+// LocaleEqualFold applies the EqualFold predicate on the "locale" field.
+func LocaleEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldLocale, v))
+}
+
+// LocaleContainsFold applies the ContainsFold predicate on the "locale" field.
+func LocaleContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldLocale, v))
+}
+
+// AvatarKeyEQ applies the EQ predicate on the "avatar_key" field.
+func AvatarKeyEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAvatarKey, v))
+}
+
+// AvatarKeyNEQ applies the NEQ predicate on the "avatar_key" field.
+func AvatarKeyNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAvatarKey, v))
+}
+
+// AvatarKeyIn applies the In predicate on the "avatar_key" field.
+func AvatarKeyIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldAvatarKey, vs...))
+}
+
+// AvatarKeyNotIn applies the NotIn predicate on the "avatar_key" field.
+func AvatarKeyNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldAvatarKey, vs...))
+}
+
+// AvatarKeyGT applies the GT predicate on the "avatar_key" field.
+func AvatarKeyGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldAvatarKey, v))
+}
+
+// AvatarKeyGTE applies the GTE predicate on the "avatar_key" field.
+func AvatarKeyGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldAvatarKey, v))
+}
+
+// AvatarKeyLT applies the LT predicate on the "avatar_key" field.
+func AvatarKeyLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldAvatarKey, v))
+}
+
+// AvatarKeyLTE applies the LTE predicate on the "avatar_key" field.
+func AvatarKeyLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldAvatarKey, v))
+}
+
+// AvatarKeyContains applies the Contains predicate on the "avatar_key" field.
+func AvatarKeyContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldAvatarKey, v))
+}
+
+// AvatarKeyHasPrefix applies the HasPrefix predicate on the "avatar_key" field.
+func AvatarKeyHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldAvatarKey, v))
+}
+
+// AvatarKeyHasSuffix applies the HasSuffix predicate on the "avatar_key" field.
+func AvatarKeyHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldAvatarKey, v))
+}
+
+// AvatarKeyIsNil applies the IsNil predicate on the "avatar_key" field.
+func AvatarKeyIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAvatarKey))
+}
+
+// AvatarKeyNotNil applies the NotNil predicate on the "avatar_key" field.
+func AvatarKeyNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAvatarKey))
+}
+
+// AvatarKeyEqualFold applies the EqualFold predicate on the "avatar_key" field.
+func AvatarKeyEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldAvatarKey, v))
+}
+
+// AvatarKeyContainsFold applies the ContainsFold predicate on the "avatar_key" field.
+func AvatarKeyContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldAvatarKey, v))
+}
+
+// AvatarUrlsIsNil applies the IsNil predicate on the "avatar_urls" field.
+func AvatarUrlsIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAvatarUrls))
+}
+
+// AvatarUrlsNotNil applies the NotNil predicate on the "avatar_urls" field.
+func AvatarUrlsNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAvatarUrls))
+}
+
+// ProfileVisibilityIsNil applies the IsNil predicate on the "profile_visibility" field.
+func ProfileVisibilityIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldProfileVisibility))
+}
+
+// ProfileVisibilityNotNil applies the NotNil predicate on the "profile_visibility" field.
+func ProfileVisibilityNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldProfileVisibility))
+}
+
+// SettingsIsNil applies the IsNil predicate on the "settings" field.
+func SettingsIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSettings))
+}
+
+// SettingsNotNil applies the NotNil predicate on the "settings" field.
+func SettingsNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSettings))
+}
+
+// PrivateEQ applies the EQ predicate on the "private" field.
+func PrivateEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPrivate, v))
+}
+
+// PrivateNEQ applies the NEQ predicate on the "private" field.
+func PrivateNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPrivate, v))
+}
+
+// FollowerCountEQ applies the EQ predicate on the "follower_count" field.
+func FollowerCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowerCount, v))
+}
+
+// FollowerCountNEQ applies the NEQ predicate on the "follower_count" field.
+func FollowerCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFollowerCount, v))
+}
+
+// FollowerCountIn applies the In predicate on the "follower_count" field.
+func FollowerCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFollowerCount, vs...))
+}
+
+// FollowerCountNotIn applies the NotIn predicate on the "follower_count" field.
+func FollowerCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFollowerCount, vs...))
+}
+
+// FollowerCountGT applies the GT predicate on the "follower_count" field.
+func FollowerCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFollowerCount, v))
+}
+
+// FollowerCountGTE applies the GTE predicate on the "follower_count" field.
+func FollowerCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFollowerCount, v))
+}
+
+// FollowerCountLT applies the LT predicate on the "follower_count" field.
+func FollowerCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFollowerCount, v))
+}
+
+// FollowerCountLTE applies the LTE predicate on the "follower_count" field.
+func FollowerCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFollowerCount, v))
+}
+
+// FollowingCountEQ applies the EQ predicate on the "following_count" field.
+func FollowingCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowingCount, v))
+}
+
+// FollowingCountNEQ applies the NEQ predicate on the "following_count" field.
+func FollowingCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFollowingCount, v))
+}
+
+// FollowingCountIn applies the In predicate on the "following_count" field.
+func FollowingCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFollowingCount, vs...))
+}
+
+// FollowingCountNotIn applies the NotIn predicate on the "following_count" field.
+func FollowingCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFollowingCount, vs...))
+}
+
+// FollowingCountGT applies the GT predicate on the "following_count" field.
+func FollowingCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFollowingCount, v))
+}
+
+// FollowingCountGTE applies the GTE predicate on the "following_count" field.
+func FollowingCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFollowingCount, v))
+}
+
+// FollowingCountLT applies the LT predicate on the "following_count" field.
+func FollowingCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFollowingCount, v))
+}
+
+// FollowingCountLTE applies the LTE predicate on the "following_count" field.
+func FollowingCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFollowingCount, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// LoginCountEQ applies the EQ predicate on the "login_count" field.
+func LoginCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLoginCount, v))
+}
+
+// LoginCountNEQ applies the NEQ predicate on the "login_count" field.
+func LoginCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLoginCount, v))
+}
+
+// LoginCountIn applies the In predicate on the "login_count" field.
+func LoginCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLoginCount, vs...))
+}
+
+// LoginCountNotIn applies the NotIn predicate on the "login_count" field.
+func LoginCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLoginCount, vs...))
+}
+
+// LoginCountGT applies the GT predicate on the "login_count" field.
+func LoginCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLoginCount, v))
+}
+
+// LoginCountGTE applies the GTE predicate on the "login_count" field.
+func LoginCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLoginCount, v))
+}
+
+// LoginCountLT applies the LT predicate on the "login_count" field.
+func LoginCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLoginCount, v))
+}
+
+// LoginCountLTE applies the LTE predicate on the "login_count" field.
+func LoginCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLoginCount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// SuspendedUntilEQ applies the EQ predicate on the "suspended_until" field.
+func SuspendedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilNEQ applies the NEQ predicate on the "suspended_until" field.
+func SuspendedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilIn applies the In predicate on the "suspended_until" field.
+func SuspendedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSuspendedUntil, vs...))
+}
+
+// SuspendedUntilNotIn applies the NotIn predicate on the "suspended_until" field.
+func SuspendedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSuspendedUntil, vs...))
+}
+
+// SuspendedUntilGT applies the GT predicate on the "suspended_until" field.
+func SuspendedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilGTE applies the GTE predicate on the "suspended_until" field.
+func SuspendedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilLT applies the LT predicate on the "suspended_until" field.
+func SuspendedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilLTE applies the LTE predicate on the "suspended_until" field.
+func SuspendedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilIsNil applies the IsNil predicate on the "suspended_until" field.
+func SuspendedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSuspendedUntil))
+}
+
+// SuspendedUntilNotNil applies the NotNil predicate on the "suspended_until" field.
+func SuspendedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSuspendedUntil))
+}
+
+// RestrictionReasonEQ applies the EQ predicate on the "restriction_reason" field.
+func RestrictionReasonEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonNEQ applies the NEQ predicate on the "restriction_reason" field.
+func RestrictionReasonNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonIn applies the In predicate on the "restriction_reason" field.
+func RestrictionReasonIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRestrictionReason, vs...))
+}
+
+// RestrictionReasonNotIn applies the NotIn predicate on the "restriction_reason" field.
+func RestrictionReasonNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRestrictionReason, vs...))
+}
+
+// RestrictionReasonGT applies the GT predicate on the "restriction_reason" field.
+func RestrictionReasonGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonGTE applies the GTE predicate on the "restriction_reason" field.
+func RestrictionReasonGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonLT applies the LT predicate on the "restriction_reason" field.
+func RestrictionReasonLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonLTE applies the LTE predicate on the "restriction_reason" field.
+func RestrictionReasonLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonContains applies the Contains predicate on the "restriction_reason" field.
+func RestrictionReasonContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonHasPrefix applies the HasPrefix predicate on the "restriction_reason" field.
+func RestrictionReasonHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonHasSuffix applies the HasSuffix predicate on the "restriction_reason" field.
+func RestrictionReasonHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonIsNil applies the IsNil predicate on the "restriction_reason" field.
+func RestrictionReasonIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRestrictionReason))
+}
+
+// RestrictionReasonNotNil applies the NotNil predicate on the "restriction_reason" field.
+func RestrictionReasonNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRestrictionReason))
+}
+
+// RestrictionReasonEqualFold applies the EqualFold predicate on the "restriction_reason" field.
+func RestrictionReasonEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldRestrictionReason, v))
+}
+
+// RestrictionReasonContainsFold applies the ContainsFold predicate on the "restriction_reason" field.
+func RestrictionReasonContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldRestrictionReason, v))
+}
+
+// RestrictedByEQ applies the EQ predicate on the "restricted_by" field.
+func RestrictedByEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRestrictedBy, v))
+}
+
+// RestrictedByNEQ applies the NEQ predicate on the "restricted_by" field.
+func RestrictedByNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRestrictedBy, v))
+}
+
+// RestrictedByIn applies the In predicate on the "restricted_by" field.
+func RestrictedByIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRestrictedBy, vs...))
+}
+
+// RestrictedByNotIn applies the NotIn predicate on the "restricted_by" field.
+func RestrictedByNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRestrictedBy, vs...))
+}
+
+// RestrictedByGT applies the GT predicate on the "restricted_by" field.
+func RestrictedByGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRestrictedBy, v))
+}
+
+// RestrictedByGTE applies the GTE predicate on the "restricted_by" field.
+func RestrictedByGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRestrictedBy, v))
+}
+
+// RestrictedByLT applies the LT predicate on the "restricted_by" field.
+func RestrictedByLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRestrictedBy, v))
+}
+
+// RestrictedByLTE applies the LTE predicate on the "restricted_by" field.
+func RestrictedByLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRestrictedBy, v))
+}
+
+// RestrictedByContains applies the Contains predicate on the "restricted_by" field.
+func RestrictedByContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldRestrictedBy, v))
+}
+
+// RestrictedByHasPrefix applies the HasPrefix predicate on the "restricted_by" field.
+func RestrictedByHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldRestrictedBy, v))
+}
+
+// RestrictedByHasSuffix applies the HasSuffix predicate on the "restricted_by" field.
+func RestrictedByHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldRestrictedBy, v))
+}
+
+// RestrictedByIsNil applies the IsNil predicate on the "restricted_by" field.
+func RestrictedByIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRestrictedBy))
+}
+
+// RestrictedByNotNil applies the NotNil predicate on the "restricted_by" field.
+func RestrictedByNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRestrictedBy))
+}
+
+// RestrictedByEqualFold applies the EqualFold predicate on the "restricted_by" field.
+func RestrictedByEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldRestrictedBy, v))
+}
+
+// RestrictedByContainsFold applies the ContainsFold predicate on the "restricted_by" field.
+func RestrictedByContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldRestrictedBy, v))
+}
+
+// RestrictedAtEQ applies the EQ predicate on the "restricted_at" field.
+func RestrictedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRestrictedAt, v))
+}
+
+// RestrictedAtNEQ applies the NEQ predicate on the "restricted_at" field.
+func RestrictedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRestrictedAt, v))
+}
+
+// RestrictedAtIn applies the In predicate on the "restricted_at" field.
+func RestrictedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRestrictedAt, vs...))
+}
+
+// RestrictedAtNotIn applies the NotIn predicate on the "restricted_at" field.
+func RestrictedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRestrictedAt, vs...))
+}
+
+// RestrictedAtGT applies the GT predicate on the "restricted_at" field.
+func RestrictedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRestrictedAt, v))
+}
+
+// RestrictedAtGTE applies the GTE predicate on the "restricted_at" field.
+func RestrictedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRestrictedAt, v))
+}
+
+// RestrictedAtLT applies the LT predicate on the "restricted_at" field.
+func RestrictedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRestrictedAt, v))
+}
+
+// RestrictedAtLTE applies the LTE predicate on the "restricted_at" field.
+func RestrictedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRestrictedAt, v))
+}
+
+// RestrictedAtIsNil applies the IsNil predicate on the "restricted_at" field.
+func RestrictedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRestrictedAt))
+}
+
+// RestrictedAtNotNil applies the NotNil predicate on the "restricted_at" field.
+func RestrictedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRestrictedAt))
+}
+
+// DeletionRequestedAtEQ applies the EQ predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtNEQ applies the NEQ predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtIn applies the In predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletionRequestedAt, vs...))
+}
+
+// DeletionRequestedAtNotIn applies the NotIn predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletionRequestedAt, vs...))
+}
+
+// DeletionRequestedAtGT applies the GT predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtGTE applies the GTE predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtLT applies the LT predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtLTE applies the LTE predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtIsNil applies the IsNil predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletionRequestedAt))
+}
+
+// DeletionRequestedAtNotNil applies the NotNil predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletionRequestedAt))
+}
+
+// DeletionScheduledAtEQ applies the EQ predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtNEQ applies the NEQ predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtIn applies the In predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletionScheduledAt, vs...))
+}
+
+// DeletionScheduledAtNotIn applies the NotIn predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletionScheduledAt, vs...))
+}
+
+// DeletionScheduledAtGT applies the GT predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtGTE applies the GTE predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtLT applies the LT predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtLTE applies the LTE predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtIsNil applies the IsNil predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletionScheduledAt))
+}
+
+// DeletionScheduledAtNotNil applies the NotNil predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletionScheduledAt))
+}
+
+// GuestEQ applies the EQ predicate on the "guest" field.
+func GuestEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuest, v))
+}
+
+// GuestNEQ applies the NEQ predicate on the "guest" field.
+func GuestNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldGuest, v))
+}
+
+// GuestDeviceHashEQ applies the EQ predicate on the "guest_device_hash" field.
+func GuestDeviceHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashNEQ applies the NEQ predicate on the "guest_device_hash" field.
+func GuestDeviceHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashIn applies the In predicate on the "guest_device_hash" field.
+func GuestDeviceHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldGuestDeviceHash, vs...))
+}
+
+// GuestDeviceHashNotIn applies the NotIn predicate on the "guest_device_hash" field.
+func GuestDeviceHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldGuestDeviceHash, vs...))
+}
+
+// GuestDeviceHashGT applies the GT predicate on the "guest_device_hash" field.
+func GuestDeviceHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashGTE applies the GTE predicate on the "guest_device_hash" field.
+func GuestDeviceHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashLT applies the LT predicate on the "guest_device_hash" field.
+func GuestDeviceHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashLTE applies the LTE predicate on the "guest_device_hash" field.
+func GuestDeviceHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashContains applies the Contains predicate on the "guest_device_hash" field.
+func GuestDeviceHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashHasPrefix applies the HasPrefix predicate on the "guest_device_hash" field.
+func GuestDeviceHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashHasSuffix applies the HasSuffix predicate on the "guest_device_hash" field.
+func GuestDeviceHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashIsNil applies the IsNil predicate on the "guest_device_hash" field.
+func GuestDeviceHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldGuestDeviceHash))
+}
+
+// GuestDeviceHashNotNil applies the NotNil predicate on the "guest_device_hash" field.
+func GuestDeviceHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldGuestDeviceHash))
+}
+
+// GuestDeviceHashEqualFold applies the EqualFold predicate on the "guest_device_hash" field.
+func GuestDeviceHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldGuestDeviceHash, v))
+}
+
+// GuestDeviceHashContainsFold applies the ContainsFold predicate on the "guest_device_hash" field.
+func GuestDeviceHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldGuestDeviceHash, v))
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOtps applies the HasEdge predicate on the "otps" edge.
+func HasOtps() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OtpsTable, OtpsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOtpsWith applies the HasEdge predicate on the "otps" edge with a given conditions (other predicates).
+func HasOtpsWith(preds ...predicate.OTP) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newOtpsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, RolesTable, RolesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.Role) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDataExports applies the HasEdge predicate on the "data_exports" edge.
+func HasDataExports() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DataExportsTable, DataExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDataExportsWith applies the HasEdge predicate on the "data_exports" edge with a given conditions (other predicates).
+func HasDataExportsWith(preds ...predicate.DataExport) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newDataExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPersonalAccessTokens applies the HasEdge predicate on the "personal_access_tokens" edge.
+func HasPersonalAccessTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PersonalAccessTokensTable, PersonalAccessTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPersonalAccessTokensWith applies the HasEdge predicate on the "personal_access_tokens" edge with a given conditions (other predicates).
+func HasPersonalAccessTokensWith(preds ...predicate.PersonalAccessToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPersonalAccessTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOauthConsents applies the HasEdge predicate on the "oauth_consents" edge.
+func HasOauthConsents() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthConsentsTable, OauthConsentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthConsentsWith applies the HasEdge predicate on the "oauth_consents" edge with a given conditions (other predicates).
+func HasOauthConsentsWith(preds ...predicate.OAuthConsent) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newOauthConsentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOauthAuthorizationCodes applies the HasEdge predicate on the "oauth_authorization_codes" edge.
+func HasOauthAuthorizationCodes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthAuthorizationCodesTable, OauthAuthorizationCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthAuthorizationCodesWith applies the HasEdge predicate on the "oauth_authorization_codes" edge with a given conditions (other predicates).
+func HasOauthAuthorizationCodesWith(preds ...predicate.OAuthAuthorizationCode) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newOauthAuthorizationCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeviceAuthorizations applies the HasEdge predicate on the "device_authorizations" edge.
+func HasDeviceAuthorizations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeviceAuthorizationsTable, DeviceAuthorizationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceAuthorizationsWith applies the HasEdge predicate on the "device_authorizations" edge with a given conditions (other predicates).
+func HasDeviceAuthorizationsWith(preds ...predicate.DeviceAuthorization) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newDeviceAuthorizationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQrLoginRequests applies the HasEdge predicate on the "qr_login_requests" edge.
+func HasQrLoginRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QrLoginRequestsTable, QrLoginRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQrLoginRequestsWith applies the HasEdge predicate on the "qr_login_requests" edge with a given conditions (other predicates).
+func HasQrLoginRequestsWith(preds ...predicate.QRLoginRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newQrLoginRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPhoneChangeRequests applies the HasEdge predicate on the "phone_change_requests" edge.
+func HasPhoneChangeRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PhoneChangeRequestsTable, PhoneChangeRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPhoneChangeRequestsWith applies the HasEdge predicate on the "phone_change_requests" edge with a given conditions (other predicates).
+func HasPhoneChangeRequestsWith(preds ...predicate.PhoneChangeRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPhoneChangeRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmailVerifications applies the HasEdge predicate on the "email_verifications" edge.
+func HasEmailVerifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmailVerificationsTable, EmailVerificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmailVerificationsWith applies the HasEdge predicate on the "email_verifications" edge with a given conditions (other predicates).
+func HasEmailVerificationsWith(preds ...predicate.EmailVerification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEmailVerificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUsernameHistory applies the HasEdge predicate on the "username_history" edge.
+func HasUsernameHistory() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsernameHistoryTable, UsernameHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsernameHistoryWith applies the HasEdge predicate on the "username_history" edge with a given conditions (other predicates).
+func HasUsernameHistoryWith(preds ...predicate.UsernameHistory) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newUsernameHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowing applies the HasEdge predicate on the "following" edge.
+func HasFollowing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FollowingTable, FollowingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowingWith applies the HasEdge predicate on the "following" edge with a given conditions (other predicates).
+func HasFollowingWith(preds ...predicate.Follow) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFollowingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowers applies the HasEdge predicate on the "followers" edge.
+func HasFollowers() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FollowersTable, FollowersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowersWith applies the HasEdge predicate on the "followers" edge with a given conditions (other predicates).
+func HasFollowersWith(preds ...predicate.Follow) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFollowersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocks applies the HasEdge predicate on the "blocks" edge.
+func HasBlocks() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlocksWith applies the HasEdge predicate on the "blocks" edge with a given conditions (other predicates).
+func HasBlocksWith(preds ...predicate.Block) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBlocksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlockedByTable, BlockedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.Block) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBlockedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMutes applies the HasEdge predicate on the "mutes" edge.
+func HasMutes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MutesTable, MutesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutesWith applies the HasEdge predicate on the "mutes" edge with a given conditions (other predicates).
+func HasMutesWith(preds ...predicate.Mute) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMutesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMutedBy applies the HasEdge predicate on the "muted_by" edge.
+func HasMutedBy() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MutedByTable, MutedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutedByWith applies the HasEdge predicate on the "muted_by" edge with a given conditions (other predicates).
+func HasMutedByWith(preds ...predicate.Mute) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMutedByStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -10,9 +10,22 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthconsent"
 	"github.com/shinplay/ent/otp"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/role"
 	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -78,6 +91,34 @@ func (uc *UserCreate) SetNillableUsername(s *string) *UserCreate {
 	return uc
 }
 
+// SetUsernameNormalized sets the "username_normalized" field.
+func (uc *UserCreate) SetUsernameNormalized(s string) *UserCreate {
+	uc.mutation.SetUsernameNormalized(s)
+	return uc
+}
+
+// SetNillableUsernameNormalized sets the "username_normalized" field if the given value is not nil.
+func (uc *UserCreate) SetNillableUsernameNormalized(s *string) *UserCreate {
+	if s != nil {
+		uc.SetUsernameNormalized(*s)
+	}
+	return uc
+}
+
+// SetUsernameChangedAt sets the "username_changed_at" field.
+func (uc *UserCreate) SetUsernameChangedAt(t time.Time) *UserCreate {
+	uc.mutation.SetUsernameChangedAt(t)
+	return uc
+}
+
+// SetNillableUsernameChangedAt sets the "username_changed_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableUsernameChangedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetUsernameChangedAt(*t)
+	}
+	return uc
+}
+
 // SetEmail sets the "email" field.
 func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	uc.mutation.SetEmail(s)
@@ -92,6 +133,20 @@ func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
 	return uc
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (uc *UserCreate) SetEmailVerifiedAt(t time.Time) *UserCreate {
+	uc.mutation.SetEmailVerifiedAt(t)
+	return uc
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailVerifiedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetEmailVerifiedAt(*t)
+	}
+	return uc
+}
+
 // SetPhoneNumber sets the "phone_number" field.
 func (uc *UserCreate) SetPhoneNumber(s string) *UserCreate {
 	uc.mutation.SetPhoneNumber(s)
@@ -134,6 +189,150 @@ func (uc *UserCreate) SetNillableLastName(s *string) *UserCreate {
 	return uc
 }
 
+// SetDisplayName sets the "display_name" field.
+func (uc *UserCreate) SetDisplayName(s string) *UserCreate {
+	uc.mutation.SetDisplayName(s)
+	return uc
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDisplayName(s *string) *UserCreate {
+	if s != nil {
+		uc.SetDisplayName(*s)
+	}
+	return uc
+}
+
+// SetBio sets the "bio" field.
+func (uc *UserCreate) SetBio(s string) *UserCreate {
+	uc.mutation.SetBio(s)
+	return uc
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBio(s *string) *UserCreate {
+	if s != nil {
+		uc.SetBio(*s)
+	}
+	return uc
+}
+
+// SetBirthday sets the "birthday" field.
+func (uc *UserCreate) SetBirthday(t time.Time) *UserCreate {
+	uc.mutation.SetBirthday(t)
+	return uc
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBirthday(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetBirthday(*t)
+	}
+	return uc
+}
+
+// SetLocale sets the "locale" field.
+func (uc *UserCreate) SetLocale(s string) *UserCreate {
+	uc.mutation.SetLocale(s)
+	return uc
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLocale(s *string) *UserCreate {
+	if s != nil {
+		uc.SetLocale(*s)
+	}
+	return uc
+}
+
+// SetAvatarKey sets the "avatar_key" field.
+func (uc *UserCreate) SetAvatarKey(s string) *UserCreate {
+	uc.mutation.SetAvatarKey(s)
+	return uc
+}
+
+// SetNillableAvatarKey sets the "avatar_key" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAvatarKey(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAvatarKey(*s)
+	}
+	return uc
+}
+
+// SetAvatarUrls sets the "avatar_urls" field.
+func (uc *UserCreate) SetAvatarUrls(m map[string]string) *UserCreate {
+	uc.mutation.SetAvatarUrls(m)
+	return uc
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (uc *UserCreate) SetProfileVisibility(m map[string]string) *UserCreate {
+	uc.mutation.SetProfileVisibility(m)
+	return uc
+}
+
+// SetSettings sets the "settings" field.
+func (uc *UserCreate) SetSettings(m map[string]interface{}) *UserCreate {
+	uc.mutation.SetSettings(m)
+	return uc
+}
+
+// SetPrivate sets the "private" field.
+func (uc *UserCreate) SetPrivate(b bool) *UserCreate {
+	uc.mutation.SetPrivate(b)
+	return uc
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePrivate(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetPrivate(*b)
+	}
+	return uc
+}
+
+// SetFollowerCount sets the "follower_count" field.
+func (uc *UserCreate) SetFollowerCount(i int) *UserCreate {
+	uc.mutation.SetFollowerCount(i)
+	return uc
+}
+
+// SetNillableFollowerCount sets the "follower_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFollowerCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFollowerCount(*i)
+	}
+	return uc
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (uc *UserCreate) SetFollowingCount(i int) *UserCreate {
+	uc.mutation.SetFollowingCount(i)
+	return uc
+}
+
+// SetNillableFollowingCount sets the "following_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFollowingCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFollowingCount(*i)
+	}
+	return uc
+}
+
+// SetTimezone sets the "timezone" field.
+func (uc *UserCreate) SetTimezone(s string) *UserCreate {
+	uc.mutation.SetTimezone(s)
+	return uc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTimezone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTimezone(*s)
+	}
+	return uc
+}
+
 // SetLoginCount sets the "login_count" field.
 func (uc *UserCreate) SetLoginCount(i int) *UserCreate {
 	uc.mutation.SetLoginCount(i)
@@ -148,34 +347,400 @@ func (uc *UserCreate) SetNillableLoginCount(i *int) *UserCreate {
 	return uc
 }
 
+// SetStatus sets the "status" field.
+func (uc *UserCreate) SetStatus(u user.Status) *UserCreate {
+	uc.mutation.SetStatus(u)
+	return uc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStatus(u *user.Status) *UserCreate {
+	if u != nil {
+		uc.SetStatus(*u)
+	}
+	return uc
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (uc *UserCreate) SetSuspendedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetSuspendedUntil(t)
+	return uc
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSuspendedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetSuspendedUntil(*t)
+	}
+	return uc
+}
+
+// SetRestrictionReason sets the "restriction_reason" field.
+func (uc *UserCreate) SetRestrictionReason(s string) *UserCreate {
+	uc.mutation.SetRestrictionReason(s)
+	return uc
+}
+
+// SetNillableRestrictionReason sets the "restriction_reason" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRestrictionReason(s *string) *UserCreate {
+	if s != nil {
+		uc.SetRestrictionReason(*s)
+	}
+	return uc
+}
+
+// SetRestrictedBy sets the "restricted_by" field.
+func (uc *UserCreate) SetRestrictedBy(s string) *UserCreate {
+	uc.mutation.SetRestrictedBy(s)
+	return uc
+}
+
+// SetNillableRestrictedBy sets the "restricted_by" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRestrictedBy(s *string) *UserCreate {
+	if s != nil {
+		uc.SetRestrictedBy(*s)
+	}
+	return uc
+}
+
+// SetRestrictedAt sets the "restricted_at" field.
+func (uc *UserCreate) SetRestrictedAt(t time.Time) *UserCreate {
+	uc.mutation.SetRestrictedAt(t)
+	return uc
+}
+
+// SetNillableRestrictedAt sets the "restricted_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRestrictedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetRestrictedAt(*t)
+	}
+	return uc
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (uc *UserCreate) SetDeletionRequestedAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletionRequestedAt(t)
+	return uc
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletionRequestedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletionRequestedAt(*t)
+	}
+	return uc
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uc *UserCreate) SetDeletionScheduledAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletionScheduledAt(t)
+	return uc
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletionScheduledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletionScheduledAt(*t)
+	}
+	return uc
+}
+
+// SetGuest sets the "guest" field.
+func (uc *UserCreate) SetGuest(b bool) *UserCreate {
+	uc.mutation.SetGuest(b)
+	return uc
+}
+
+// SetNillableGuest sets the "guest" field if the given value is not nil.
+func (uc *UserCreate) SetNillableGuest(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetGuest(*b)
+	}
+	return uc
+}
+
+// SetGuestDeviceHash sets the "guest_device_hash" field.
+func (uc *UserCreate) SetGuestDeviceHash(s string) *UserCreate {
+	uc.mutation.SetGuestDeviceHash(s)
+	return uc
+}
+
+// SetNillableGuestDeviceHash sets the "guest_device_hash" field if the given value is not nil.
+func (uc *UserCreate) SetNillableGuestDeviceHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetGuestDeviceHash(*s)
+	}
+	return uc
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (uc *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
 	uc.mutation.AddSessionIDs(ids...)
 	return uc
 }
 
-// AddSessions adds the "sessions" edges to the Session entity.
-func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddOtpIDs adds the "otps" edge to the OTP entity by IDs.
+func (uc *UserCreate) AddOtpIDs(ids ...int) *UserCreate {
+	uc.mutation.AddOtpIDs(ids...)
+	return uc
+}
+
+// AddOtps adds the "otps" edges to the OTP entity.
+func (uc *UserCreate) AddOtps(o ...*OTP) *UserCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOtpIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uc *UserCreate) AddRoleIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRoleIDs(ids...)
+	return uc
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uc *UserCreate) AddRoles(r ...*Role) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRoleIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (uc *UserCreate) AddDataExportIDs(ids ...int) *UserCreate {
+	uc.mutation.AddDataExportIDs(ids...)
+	return uc
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (uc *UserCreate) AddDataExports(d ...*DataExport) *UserCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddDataExportIDs(ids...)
+}
+
+// AddPersonalAccessTokenIDs adds the "personal_access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (uc *UserCreate) AddPersonalAccessTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddPersonalAccessTokenIDs(ids...)
+	return uc
+}
+
+// AddPersonalAccessTokens adds the "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uc *UserCreate) AddPersonalAccessTokens(p ...*PersonalAccessToken) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPersonalAccessTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (uc *UserCreate) AddOauthConsentIDs(ids ...int) *UserCreate {
+	uc.mutation.AddOauthConsentIDs(ids...)
+	return uc
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (uc *UserCreate) AddOauthConsents(o ...*OAuthConsent) *UserCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (uc *UserCreate) AddOauthAuthorizationCodeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return uc
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (uc *UserCreate) AddOauthAuthorizationCodes(o ...*OAuthAuthorizationCode) *UserCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddDeviceAuthorizationIDs adds the "device_authorizations" edge to the DeviceAuthorization entity by IDs.
+func (uc *UserCreate) AddDeviceAuthorizationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddDeviceAuthorizationIDs(ids...)
+	return uc
+}
+
+// AddDeviceAuthorizations adds the "device_authorizations" edges to the DeviceAuthorization entity.
+func (uc *UserCreate) AddDeviceAuthorizations(d ...*DeviceAuthorization) *UserCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddDeviceAuthorizationIDs(ids...)
+}
+
+// AddQrLoginRequestIDs adds the "qr_login_requests" edge to the QRLoginRequest entity by IDs.
+func (uc *UserCreate) AddQrLoginRequestIDs(ids ...int) *UserCreate {
+	uc.mutation.AddQrLoginRequestIDs(ids...)
+	return uc
+}
+
+// AddQrLoginRequests adds the "qr_login_requests" edges to the QRLoginRequest entity.
+func (uc *UserCreate) AddQrLoginRequests(q ...*QRLoginRequest) *UserCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uc.AddQrLoginRequestIDs(ids...)
+}
+
+// AddPhoneChangeRequestIDs adds the "phone_change_requests" edge to the PhoneChangeRequest entity by IDs.
+func (uc *UserCreate) AddPhoneChangeRequestIDs(ids ...int) *UserCreate {
+	uc.mutation.AddPhoneChangeRequestIDs(ids...)
+	return uc
+}
+
+// AddPhoneChangeRequests adds the "phone_change_requests" edges to the PhoneChangeRequest entity.
+func (uc *UserCreate) AddPhoneChangeRequests(p ...*PhoneChangeRequest) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPhoneChangeRequestIDs(ids...)
+}
+
+// AddEmailVerificationIDs adds the "email_verifications" edge to the EmailVerification entity by IDs.
+func (uc *UserCreate) AddEmailVerificationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddEmailVerificationIDs(ids...)
+	return uc
+}
+
+// AddEmailVerifications adds the "email_verifications" edges to the EmailVerification entity.
+func (uc *UserCreate) AddEmailVerifications(e ...*EmailVerification) *UserCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uc.AddEmailVerificationIDs(ids...)
+}
+
+// AddUsernameHistoryIDs adds the "username_history" edge to the UsernameHistory entity by IDs.
+func (uc *UserCreate) AddUsernameHistoryIDs(ids ...int) *UserCreate {
+	uc.mutation.AddUsernameHistoryIDs(ids...)
+	return uc
+}
+
+// AddUsernameHistory adds the "username_history" edges to the UsernameHistory entity.
+func (uc *UserCreate) AddUsernameHistory(u ...*UsernameHistory) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddUsernameHistoryIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by IDs.
+func (uc *UserCreate) AddFollowingIDs(ids ...int) *UserCreate {
+	uc.mutation.AddFollowingIDs(ids...)
+	return uc
+}
+
+// AddFollowing adds the "following" edges to the Follow entity.
+func (uc *UserCreate) AddFollowing(f ...*Follow) *UserCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFollowingIDs(ids...)
+}
+
+// AddFollowerIDs adds the "followers" edge to the Follow entity by IDs.
+func (uc *UserCreate) AddFollowerIDs(ids ...int) *UserCreate {
+	uc.mutation.AddFollowerIDs(ids...)
+	return uc
+}
+
+// AddFollowers adds the "followers" edges to the Follow entity.
+func (uc *UserCreate) AddFollowers(f ...*Follow) *UserCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFollowerIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Block entity by IDs.
+func (uc *UserCreate) AddBlockIDs(ids ...int) *UserCreate {
+	uc.mutation.AddBlockIDs(ids...)
+	return uc
+}
+
+// AddBlocks adds the "blocks" edges to the Block entity.
+func (uc *UserCreate) AddBlocks(b ...*Block) *UserCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Block entity by IDs.
+func (uc *UserCreate) AddBlockedByIDs(ids ...int) *UserCreate {
+	uc.mutation.AddBlockedByIDs(ids...)
+	return uc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Block entity.
+func (uc *UserCreate) AddBlockedBy(b ...*Block) *UserCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBlockedByIDs(ids...)
+}
+
+// AddMuteIDs adds the "mutes" edge to the Mute entity by IDs.
+func (uc *UserCreate) AddMuteIDs(ids ...int) *UserCreate {
+	uc.mutation.AddMuteIDs(ids...)
+	return uc
+}
+
+// AddMutes adds the "mutes" edges to the Mute entity.
+func (uc *UserCreate) AddMutes(m ...*Mute) *UserCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uc.AddSessionIDs(ids...)
+	return uc.AddMuteIDs(ids...)
 }
 
-// AddOtpIDs adds the "otps" edge to the OTP entity by IDs.
-func (uc *UserCreate) AddOtpIDs(ids ...int) *UserCreate {
-	uc.mutation.AddOtpIDs(ids...)
+// AddMutedByIDs adds the "muted_by" edge to the Mute entity by IDs.
+func (uc *UserCreate) AddMutedByIDs(ids ...int) *UserCreate {
+	uc.mutation.AddMutedByIDs(ids...)
 	return uc
 }
 
-// AddOtps adds the "otps" edges to the OTP entity.
-func (uc *UserCreate) AddOtps(o ...*OTP) *UserCreate {
-	ids := make([]int, len(o))
-	for i := range o {
-		ids[i] = o[i].ID
+// AddMutedBy adds the "muted_by" edges to the Mute entity.
+func (uc *UserCreate) AddMutedBy(m ...*Mute) *UserCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uc.AddOtpIDs(ids...)
+	return uc.AddMutedByIDs(ids...)
 }
 
 // Mutation returns the UserMutation object of the builder.
@@ -225,10 +790,30 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultAuthID()
 		uc.mutation.SetAuthID(v)
 	}
+	if _, ok := uc.mutation.Private(); !ok {
+		v := user.DefaultPrivate
+		uc.mutation.SetPrivate(v)
+	}
+	if _, ok := uc.mutation.FollowerCount(); !ok {
+		v := user.DefaultFollowerCount
+		uc.mutation.SetFollowerCount(v)
+	}
+	if _, ok := uc.mutation.FollowingCount(); !ok {
+		v := user.DefaultFollowingCount
+		uc.mutation.SetFollowingCount(v)
+	}
 	if _, ok := uc.mutation.LoginCount(); !ok {
 		v := user.DefaultLoginCount
 		uc.mutation.SetLoginCount(v)
 	}
+	if _, ok := uc.mutation.Status(); !ok {
+		v := user.DefaultStatus
+		uc.mutation.SetStatus(v)
+	}
+	if _, ok := uc.mutation.Guest(); !ok {
+		v := user.DefaultGuest
+		uc.mutation.SetGuest(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -252,14 +837,69 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
 		}
 	}
+	if v, ok := uc.mutation.UsernameNormalized(); ok {
+		if err := user.UsernameNormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "username_normalized", err: fmt.Errorf(`ent: validator failed for field "User.username_normalized": %w`, err)}
+		}
+	}
 	if v, ok := uc.mutation.PhoneNumber(); ok {
 		if err := user.PhoneNumberValidator(v); err != nil {
 			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "User.phone_number": %w`, err)}
 		}
 	}
+	if v, ok := uc.mutation.DisplayName(); ok {
+		if err := user.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uc.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	if v, ok := uc.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "User.locale": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.Private(); !ok {
+		return &ValidationError{Name: "private", err: errors.New(`ent: missing required field "User.private"`)}
+	}
+	if _, ok := uc.mutation.FollowerCount(); !ok {
+		return &ValidationError{Name: "follower_count", err: errors.New(`ent: missing required field "User.follower_count"`)}
+	}
+	if v, ok := uc.mutation.FollowerCount(); ok {
+		if err := user.FollowerCountValidator(v); err != nil {
+			return &ValidationError{Name: "follower_count", err: fmt.Errorf(`ent: validator failed for field "User.follower_count": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.FollowingCount(); !ok {
+		return &ValidationError{Name: "following_count", err: errors.New(`ent: missing required field "User.following_count"`)}
+	}
+	if v, ok := uc.mutation.FollowingCount(); ok {
+		if err := user.FollowingCountValidator(v); err != nil {
+			return &ValidationError{Name: "following_count", err: fmt.Errorf(`ent: validator failed for field "User.following_count": %w`, err)}
+		}
+	}
+	if v, ok := uc.mutation.Timezone(); ok {
+		if err := user.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "User.timezone": %w`, err)}
+		}
+	}
 	if _, ok := uc.mutation.LoginCount(); !ok {
 		return &ValidationError{Name: "login_count", err: errors.New(`ent: missing required field "User.login_count"`)}
 	}
+	if _, ok := uc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
+	}
+	if v, ok := uc.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.Guest(); !ok {
+		return &ValidationError{Name: "guest", err: errors.New(`ent: missing required field "User.guest"`)}
+	}
 	return nil
 }
 
@@ -302,10 +942,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 		_node.Username = value
 	}
+	if value, ok := uc.mutation.UsernameNormalized(); ok {
+		_spec.SetField(user.FieldUsernameNormalized, field.TypeString, value)
+		_node.UsernameNormalized = &value
+	}
+	if value, ok := uc.mutation.UsernameChangedAt(); ok {
+		_spec.SetField(user.FieldUsernameChangedAt, field.TypeTime, value)
+		_node.UsernameChangedAt = &value
+	}
 	if value, ok := uc.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+		_node.EmailVerifiedAt = &value
+	}
 	if value, ok := uc.mutation.PhoneNumber(); ok {
 		_spec.SetField(user.FieldPhoneNumber, field.TypeString, value)
 		_node.PhoneNumber = value
@@ -318,10 +970,94 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldLastName, field.TypeString, value)
 		_node.LastName = value
 	}
+	if value, ok := uc.mutation.DisplayName(); ok {
+		_spec.SetField(user.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := uc.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+		_node.Bio = value
+	}
+	if value, ok := uc.mutation.Birthday(); ok {
+		_spec.SetField(user.FieldBirthday, field.TypeTime, value)
+		_node.Birthday = &value
+	}
+	if value, ok := uc.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := uc.mutation.AvatarKey(); ok {
+		_spec.SetField(user.FieldAvatarKey, field.TypeString, value)
+		_node.AvatarKey = value
+	}
+	if value, ok := uc.mutation.AvatarUrls(); ok {
+		_spec.SetField(user.FieldAvatarUrls, field.TypeJSON, value)
+		_node.AvatarUrls = value
+	}
+	if value, ok := uc.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeJSON, value)
+		_node.ProfileVisibility = value
+	}
+	if value, ok := uc.mutation.Settings(); ok {
+		_spec.SetField(user.FieldSettings, field.TypeJSON, value)
+		_node.Settings = value
+	}
+	if value, ok := uc.mutation.Private(); ok {
+		_spec.SetField(user.FieldPrivate, field.TypeBool, value)
+		_node.Private = value
+	}
+	if value, ok := uc.mutation.FollowerCount(); ok {
+		_spec.SetField(user.FieldFollowerCount, field.TypeInt, value)
+		_node.FollowerCount = value
+	}
+	if value, ok := uc.mutation.FollowingCount(); ok {
+		_spec.SetField(user.FieldFollowingCount, field.TypeInt, value)
+		_node.FollowingCount = value
+	}
+	if value, ok := uc.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
 	if value, ok := uc.mutation.LoginCount(); ok {
 		_spec.SetField(user.FieldLoginCount, field.TypeInt, value)
 		_node.LoginCount = value
 	}
+	if value, ok := uc.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := uc.mutation.SuspendedUntil(); ok {
+		_spec.SetField(user.FieldSuspendedUntil, field.TypeTime, value)
+		_node.SuspendedUntil = &value
+	}
+	if value, ok := uc.mutation.RestrictionReason(); ok {
+		_spec.SetField(user.FieldRestrictionReason, field.TypeString, value)
+		_node.RestrictionReason = value
+	}
+	if value, ok := uc.mutation.RestrictedBy(); ok {
+		_spec.SetField(user.FieldRestrictedBy, field.TypeString, value)
+		_node.RestrictedBy = value
+	}
+	if value, ok := uc.mutation.RestrictedAt(); ok {
+		_spec.SetField(user.FieldRestrictedAt, field.TypeTime, value)
+		_node.RestrictedAt = &value
+	}
+	if value, ok := uc.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(user.FieldDeletionRequestedAt, field.TypeTime, value)
+		_node.DeletionRequestedAt = &value
+	}
+	if value, ok := uc.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+		_node.DeletionScheduledAt = &value
+	}
+	if value, ok := uc.mutation.Guest(); ok {
+		_spec.SetField(user.FieldGuest, field.TypeBool, value)
+		_node.Guest = value
+	}
+	if value, ok := uc.mutation.GuestDeviceHash(); ok {
+		_spec.SetField(user.FieldGuestDeviceHash, field.TypeString, value)
+		_node.GuestDeviceHash = value
+	}
 	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -354,6 +1090,262 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DeviceAuthorizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceAuthorizationsTable,
+			Columns: []string{user.DeviceAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.QrLoginRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QrLoginRequestsTable,
+			Columns: []string{user.QrLoginRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PhoneChangeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhoneChangeRequestsTable,
+			Columns: []string{user.PhoneChangeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.EmailVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationsTable,
+			Columns: []string{user.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.UsernameHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MutesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutesTable,
+			Columns: []string{user.MutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,17 +11,31 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthconsent"
 	"github.com/shinplay/ent/otp"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/phonechangerequest"
 	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/role"
 	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
 )
 
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -70,6 +84,46 @@ func (uu *UserUpdate) ClearUsername() *UserUpdate {
 	return uu
 }
 
+// SetUsernameNormalized sets the "username_normalized" field.
+func (uu *UserUpdate) SetUsernameNormalized(s string) *UserUpdate {
+	uu.mutation.SetUsernameNormalized(s)
+	return uu
+}
+
+// SetNillableUsernameNormalized sets the "username_normalized" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableUsernameNormalized(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetUsernameNormalized(*s)
+	}
+	return uu
+}
+
+// ClearUsernameNormalized clears the value of the "username_normalized" field.
+func (uu *UserUpdate) ClearUsernameNormalized() *UserUpdate {
+	uu.mutation.ClearUsernameNormalized()
+	return uu
+}
+
+// SetUsernameChangedAt sets the "username_changed_at" field.
+func (uu *UserUpdate) SetUsernameChangedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetUsernameChangedAt(t)
+	return uu
+}
+
+// SetNillableUsernameChangedAt sets the "username_changed_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableUsernameChangedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetUsernameChangedAt(*t)
+	}
+	return uu
+}
+
+// ClearUsernameChangedAt clears the value of the "username_changed_at" field.
+func (uu *UserUpdate) ClearUsernameChangedAt() *UserUpdate {
+	uu.mutation.ClearUsernameChangedAt()
+	return uu
+}
+
 // SetEmail sets the "email" field.
 func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
 	uu.mutation.SetEmail(s)
@@ -90,6 +144,26 @@ func (uu *UserUpdate) ClearEmail() *UserUpdate {
 	return uu
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (uu *UserUpdate) SetEmailVerifiedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetEmailVerifiedAt(t)
+	return uu
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailVerifiedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetEmailVerifiedAt(*t)
+	}
+	return uu
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (uu *UserUpdate) ClearEmailVerifiedAt() *UserUpdate {
+	uu.mutation.ClearEmailVerifiedAt()
+	return uu
+}
+
 // SetPhoneNumber sets the "phone_number" field.
 func (uu *UserUpdate) SetPhoneNumber(s string) *UserUpdate {
 	uu.mutation.SetPhoneNumber(s)
@@ -150,6 +224,218 @@ func (uu *UserUpdate) ClearLastName() *UserUpdate {
 	return uu
 }
 
+// SetDisplayName sets the "display_name" field.
+func (uu *UserUpdate) SetDisplayName(s string) *UserUpdate {
+	uu.mutation.SetDisplayName(s)
+	return uu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDisplayName(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetDisplayName(*s)
+	}
+	return uu
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (uu *UserUpdate) ClearDisplayName() *UserUpdate {
+	uu.mutation.ClearDisplayName()
+	return uu
+}
+
+// SetBio sets the "bio" field.
+func (uu *UserUpdate) SetBio(s string) *UserUpdate {
+	uu.mutation.SetBio(s)
+	return uu
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBio(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetBio(*s)
+	}
+	return uu
+}
+
+// ClearBio clears the value of the "bio" field.
+func (uu *UserUpdate) ClearBio() *UserUpdate {
+	uu.mutation.ClearBio()
+	return uu
+}
+
+// SetBirthday sets the "birthday" field.
+func (uu *UserUpdate) SetBirthday(t time.Time) *UserUpdate {
+	uu.mutation.SetBirthday(t)
+	return uu
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBirthday(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetBirthday(*t)
+	}
+	return uu
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (uu *UserUpdate) ClearBirthday() *UserUpdate {
+	uu.mutation.ClearBirthday()
+	return uu
+}
+
+// SetLocale sets the "locale" field.
+func (uu *UserUpdate) SetLocale(s string) *UserUpdate {
+	uu.mutation.SetLocale(s)
+	return uu
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLocale(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetLocale(*s)
+	}
+	return uu
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (uu *UserUpdate) ClearLocale() *UserUpdate {
+	uu.mutation.ClearLocale()
+	return uu
+}
+
+// SetAvatarKey sets the "avatar_key" field.
+func (uu *UserUpdate) SetAvatarKey(s string) *UserUpdate {
+	uu.mutation.SetAvatarKey(s)
+	return uu
+}
+
+// SetNillableAvatarKey sets the "avatar_key" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAvatarKey(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetAvatarKey(*s)
+	}
+	return uu
+}
+
+// ClearAvatarKey clears the value of the "avatar_key" field.
+func (uu *UserUpdate) ClearAvatarKey() *UserUpdate {
+	uu.mutation.ClearAvatarKey()
+	return uu
+}
+
+// SetAvatarUrls sets the "avatar_urls" field.
+func (uu *UserUpdate) SetAvatarUrls(m map[string]string) *UserUpdate {
+	uu.mutation.SetAvatarUrls(m)
+	return uu
+}
+
+// ClearAvatarUrls clears the value of the "avatar_urls" field.
+func (uu *UserUpdate) ClearAvatarUrls() *UserUpdate {
+	uu.mutation.ClearAvatarUrls()
+	return uu
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (uu *UserUpdate) SetProfileVisibility(m map[string]string) *UserUpdate {
+	uu.mutation.SetProfileVisibility(m)
+	return uu
+}
+
+// ClearProfileVisibility clears the value of the "profile_visibility" field.
+func (uu *UserUpdate) ClearProfileVisibility() *UserUpdate {
+	uu.mutation.ClearProfileVisibility()
+	return uu
+}
+
+// SetSettings sets the "settings" field.
+func (uu *UserUpdate) SetSettings(m map[string]interface{}) *UserUpdate {
+	uu.mutation.SetSettings(m)
+	return uu
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (uu *UserUpdate) ClearSettings() *UserUpdate {
+	uu.mutation.ClearSettings()
+	return uu
+}
+
+// SetPrivate sets the "private" field.
+func (uu *UserUpdate) SetPrivate(b bool) *UserUpdate {
+	uu.mutation.SetPrivate(b)
+	return uu
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePrivate(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetPrivate(*b)
+	}
+	return uu
+}
+
+// SetFollowerCount sets the "follower_count" field.
+func (uu *UserUpdate) SetFollowerCount(i int) *UserUpdate {
+	uu.mutation.ResetFollowerCount()
+	uu.mutation.SetFollowerCount(i)
+	return uu
+}
+
+// SetNillableFollowerCount sets the "follower_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFollowerCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetFollowerCount(*i)
+	}
+	return uu
+}
+
+// AddFollowerCount adds i to the "follower_count" field.
+func (uu *UserUpdate) AddFollowerCount(i int) *UserUpdate {
+	uu.mutation.AddFollowerCount(i)
+	return uu
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (uu *UserUpdate) SetFollowingCount(i int) *UserUpdate {
+	uu.mutation.ResetFollowingCount()
+	uu.mutation.SetFollowingCount(i)
+	return uu
+}
+
+// SetNillableFollowingCount sets the "following_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFollowingCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetFollowingCount(*i)
+	}
+	return uu
+}
+
+// AddFollowingCount adds i to the "following_count" field.
+func (uu *UserUpdate) AddFollowingCount(i int) *UserUpdate {
+	uu.mutation.AddFollowingCount(i)
+	return uu
+}
+
+// SetTimezone sets the "timezone" field.
+func (uu *UserUpdate) SetTimezone(s string) *UserUpdate {
+	uu.mutation.SetTimezone(s)
+	return uu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTimezone(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTimezone(*s)
+	}
+	return uu
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (uu *UserUpdate) ClearTimezone() *UserUpdate {
+	uu.mutation.ClearTimezone()
+	return uu
+}
+
 // SetLoginCount sets the "login_count" field.
 func (uu *UserUpdate) SetLoginCount(i int) *UserUpdate {
 	uu.mutation.ResetLoginCount()
@@ -171,683 +457,4247 @@ func (uu *UserUpdate) AddLoginCount(i int) *UserUpdate {
 	return uu
 }
 
-// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
-func (uu *UserUpdate) AddSessionIDs(ids ...int) *UserUpdate {
-	uu.mutation.AddSessionIDs(ids...)
+// SetStatus sets the "status" field.
+func (uu *UserUpdate) SetStatus(u user.Status) *UserUpdate {
+	uu.mutation.SetStatus(u)
 	return uu
 }
 
-// AddSessions adds the "sessions" edges to the Session entity.
-func (uu *UserUpdate) AddSessions(s ...*Session) *UserUpdate {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStatus(u *user.Status) *UserUpdate {
+	if u != nil {
+		uu.SetStatus(*u)
 	}
-	return uu.AddSessionIDs(ids...)
+	return uu
 }
 
-// AddOtpIDs adds the "otps" edge to the OTP entity by IDs.
-func (uu *UserUpdate) AddOtpIDs(ids ...int) *UserUpdate {
-	uu.mutation.AddOtpIDs(ids...)
+// SetSuspendedUntil sets the "suspended_until" field.
+func (uu *UserUpdate) SetSuspendedUntil(t time.Time) *UserUpdate {
+	uu.mutation.SetSuspendedUntil(t)
 	return uu
 }
 
-// AddOtps adds the "otps" edges to the OTP entity.
-func (uu *UserUpdate) AddOtps(o ...*OTP) *UserUpdate {
-	ids := make([]int, len(o))
-	for i := range o {
-		ids[i] = o[i].ID
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSuspendedUntil(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetSuspendedUntil(*t)
 	}
-	return uu.AddOtpIDs(ids...)
+	return uu
 }
 
-// Mutation returns the UserMutation object of the builder.
-func (uu *UserUpdate) Mutation() *UserMutation {
-	return uu.mutation
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (uu *UserUpdate) ClearSuspendedUntil() *UserUpdate {
+	uu.mutation.ClearSuspendedUntil()
+	return uu
 }
 
-// ClearSessions clears all "sessions" edges to the Session entity.
-func (uu *UserUpdate) ClearSessions() *UserUpdate {
-	uu.mutation.ClearSessions()
+// SetRestrictionReason sets the "restriction_reason" field.
+func (uu *UserUpdate) SetRestrictionReason(s string) *UserUpdate {
+	uu.mutation.SetRestrictionReason(s)
 	return uu
 }
 
-// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
-func (uu *UserUpdate) RemoveSessionIDs(ids ...int) *UserUpdate {
-	uu.mutation.RemoveSessionIDs(ids...)
+// SetNillableRestrictionReason sets the "restriction_reason" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRestrictionReason(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetRestrictionReason(*s)
+	}
 	return uu
 }
 
-// RemoveSessions removes "sessions" edges to Session entities.
-func (uu *UserUpdate) RemoveSessions(s ...*Session) *UserUpdate {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// ClearRestrictionReason clears the value of the "restriction_reason" field.
+func (uu *UserUpdate) ClearRestrictionReason() *UserUpdate {
+	uu.mutation.ClearRestrictionReason()
+	return uu
+}
+
+// SetRestrictedBy sets the "restricted_by" field.
+func (uu *UserUpdate) SetRestrictedBy(s string) *UserUpdate {
+	uu.mutation.SetRestrictedBy(s)
+	return uu
+}
+
+// SetNillableRestrictedBy sets the "restricted_by" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRestrictedBy(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetRestrictedBy(*s)
 	}
-	return uu.RemoveSessionIDs(ids...)
+	return uu
 }
 
-// ClearOtps clears all "otps" edges to the OTP entity.
-func (uu *UserUpdate) ClearOtps() *UserUpdate {
-	uu.mutation.ClearOtps()
+// ClearRestrictedBy clears the value of the "restricted_by" field.
+func (uu *UserUpdate) ClearRestrictedBy() *UserUpdate {
+	uu.mutation.ClearRestrictedBy()
 	return uu
 }
 
-// RemoveOtpIDs removes the "otps" edge to OTP entities by IDs.
-func (uu *UserUpdate) RemoveOtpIDs(ids ...int) *UserUpdate {
-	uu.mutation.RemoveOtpIDs(ids...)
+// SetRestrictedAt sets the "restricted_at" field.
+func (uu *UserUpdate) SetRestrictedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetRestrictedAt(t)
 	return uu
 }
 
-// RemoveOtps removes "otps" edges to OTP entities.
-func (uu *UserUpdate) RemoveOtps(o ...*OTP) *UserUpdate {
-	ids := make([]int, len(o))
-	for i := range o {
-		ids[i] = o[i].ID
+// SetNillableRestrictedAt sets the "restricted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRestrictedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetRestrictedAt(*t)
 	}
-	return uu.RemoveOtpIDs(ids...)
+	return uu
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	uu.defaults()
-	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+// ClearRestrictedAt clears the value of the "restricted_at" field.
+func (uu *UserUpdate) ClearRestrictedAt() *UserUpdate {
+	uu.mutation.ClearRestrictedAt()
+	return uu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (uu *UserUpdate) SetDeletionRequestedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletionRequestedAt(t)
+	return uu
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletionRequestedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletionRequestedAt(*t)
 	}
-	return affected
+	return uu
 }
 
-// Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
-	return err
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (uu *UserUpdate) ClearDeletionRequestedAt() *UserUpdate {
+	uu.mutation.ClearDeletionRequestedAt()
+	return uu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uu *UserUpdate) SetDeletionScheduledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletionScheduledAt(t)
+	return uu
 }
 
-// defaults sets the default values of the builder before save.
-func (uu *UserUpdate) defaults() {
-	if _, ok := uu.mutation.UpdateTime(); !ok {
-		v := user.UpdateDefaultUpdateTime()
-		uu.mutation.SetUpdateTime(v)
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletionScheduledAt(*t)
 	}
+	return uu
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (uu *UserUpdate) check() error {
-	if v, ok := uu.mutation.AuthID(); ok {
-		if err := user.AuthIDValidator(v); err != nil {
-			return &ValidationError{Name: "auth_id", err: fmt.Errorf(`ent: validator failed for field "User.auth_id": %w`, err)}
-		}
-	}
-	if v, ok := uu.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
-		}
-	}
-	if v, ok := uu.mutation.PhoneNumber(); ok {
-		if err := user.PhoneNumberValidator(v); err != nil {
-			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "User.phone_number": %w`, err)}
-		}
-	}
-	return nil
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uu *UserUpdate) ClearDeletionScheduledAt() *UserUpdate {
+	uu.mutation.ClearDeletionScheduledAt()
+	return uu
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := uu.check(); err != nil {
-		return n, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	if ps := uu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := uu.mutation.UpdateTime(); ok {
-		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
-	}
-	if value, ok := uu.mutation.AuthID(); ok {
-		_spec.SetField(user.FieldAuthID, field.TypeString, value)
-	}
-	if value, ok := uu.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
-	}
-	if uu.mutation.UsernameCleared() {
-		_spec.ClearField(user.FieldUsername, field.TypeString)
-	}
-	if value, ok := uu.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
-	}
-	if uu.mutation.EmailCleared() {
-		_spec.ClearField(user.FieldEmail, field.TypeString)
-	}
-	if value, ok := uu.mutation.PhoneNumber(); ok {
-		_spec.SetField(user.FieldPhoneNumber, field.TypeString, value)
-	}
-	if uu.mutation.PhoneNumberCleared() {
-		_spec.ClearField(user.FieldPhoneNumber, field.TypeString)
-	}
-	if value, ok := uu.mutation.FirstName(); ok {
-		_spec.SetField(user.FieldFirstName, field.TypeString, value)
-	}
-	if uu.mutation.FirstNameCleared() {
-		_spec.ClearField(user.FieldFirstName, field.TypeString)
-	}
-	if value, ok := uu.mutation.LastName(); ok {
-		_spec.SetField(user.FieldLastName, field.TypeString, value)
-	}
-	if uu.mutation.LastNameCleared() {
-		_spec.ClearField(user.FieldLastName, field.TypeString)
-	}
-	if value, ok := uu.mutation.LoginCount(); ok {
-		_spec.SetField(user.FieldLoginCount, field.TypeInt, value)
-	}
-	if value, ok := uu.mutation.AddedLoginCount(); ok {
-		_spec.AddField(user.FieldLoginCount, field.TypeInt, value)
-	}
-	if uu.mutation.SessionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := uu.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uu.mutation.SessionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := uu.mutation.SessionsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if uu.mutation.OtpsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.OtpsTable,
-			Columns: []string{user.OtpsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := uu.mutation.RemovedOtpsIDs(); len(nodes) > 0 && !uu.mutation.OtpsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.OtpsTable,
-			Columns: []string{user.OtpsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := uu.mutation.OtpsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.OtpsTable,
-			Columns: []string{user.OtpsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+// SetGuest sets the "guest" field.
+func (uu *UserUpdate) SetGuest(b bool) *UserUpdate {
+	uu.mutation.SetGuest(b)
+	return uu
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (uuo *UserUpdateOne) SetUpdateTime(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdateTime(t)
-	return uuo
+// SetNillableGuest sets the "guest" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableGuest(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetGuest(*b)
+	}
+	return uu
 }
 
-// SetAuthID sets the "auth_id" field.
-func (uuo *UserUpdateOne) SetAuthID(s string) *UserUpdateOne {
-	uuo.mutation.SetAuthID(s)
-	return uuo
+// SetGuestDeviceHash sets the "guest_device_hash" field.
+func (uu *UserUpdate) SetGuestDeviceHash(s string) *UserUpdate {
+	uu.mutation.SetGuestDeviceHash(s)
+	return uu
 }
 
-// SetNillableAuthID sets the "auth_id" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAuthID(s *string) *UserUpdateOne {
+// SetNillableGuestDeviceHash sets the "guest_device_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableGuestDeviceHash(s *string) *UserUpdate {
 	if s != nil {
-		uuo.SetAuthID(*s)
+		uu.SetGuestDeviceHash(*s)
 	}
-	return uuo
+	return uu
 }
 
-// SetUsername sets the "username" field.
-func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
-	uuo.mutation.SetUsername(s)
-	return uuo
+// ClearGuestDeviceHash clears the value of the "guest_device_hash" field.
+func (uu *UserUpdate) ClearGuestDeviceHash() *UserUpdate {
+	uu.mutation.ClearGuestDeviceHash()
+	return uu
 }
 
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetUsername(*s)
-	}
-	return uuo
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uu *UserUpdate) AddSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSessionIDs(ids...)
+	return uu
 }
 
-// ClearUsername clears the value of the "username" field.
-func (uuo *UserUpdateOne) ClearUsername() *UserUpdateOne {
-	uuo.mutation.ClearUsername()
-	return uuo
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uu *UserUpdate) AddSessions(s ...*Session) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSessionIDs(ids...)
 }
 
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
+// AddOtpIDs adds the "otps" edge to the OTP entity by IDs.
+func (uu *UserUpdate) AddOtpIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddOtpIDs(ids...)
+	return uu
 }
 
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+// AddOtps adds the "otps" edges to the OTP entity.
+func (uu *UserUpdate) AddOtps(o ...*OTP) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return uuo
+	return uu.AddOtpIDs(ids...)
 }
 
-// ClearEmail clears the value of the "email" field.
-func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
-	uuo.mutation.ClearEmail()
-	return uuo
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uu *UserUpdate) AddRoleIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRoleIDs(ids...)
+	return uu
 }
 
-// SetPhoneNumber sets the "phone_number" field.
-func (uuo *UserUpdateOne) SetPhoneNumber(s string) *UserUpdateOne {
-	uuo.mutation.SetPhoneNumber(s)
-	return uuo
+// AddRoles adds the "roles" edges to the Role entity.
+func (uu *UserUpdate) AddRoles(r ...*Role) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRoleIDs(ids...)
 }
 
-// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePhoneNumber(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPhoneNumber(*s)
-	}
-	return uuo
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (uu *UserUpdate) AddDataExportIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDataExportIDs(ids...)
+	return uu
 }
 
-// ClearPhoneNumber clears the value of the "phone_number" field.
-func (uuo *UserUpdateOne) ClearPhoneNumber() *UserUpdateOne {
-	uuo.mutation.ClearPhoneNumber()
-	return uuo
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (uu *UserUpdate) AddDataExports(d ...*DataExport) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDataExportIDs(ids...)
 }
 
-// SetFirstName sets the "first_name" field.
-func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
-	uuo.mutation.SetFirstName(s)
-	return uuo
+// AddPersonalAccessTokenIDs adds the "personal_access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (uu *UserUpdate) AddPersonalAccessTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddPersonalAccessTokenIDs(ids...)
+	return uu
 }
 
-// SetNillableFirstName sets the "first_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableFirstName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetFirstName(*s)
+// AddPersonalAccessTokens adds the "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uu *UserUpdate) AddPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return uuo
+	return uu.AddPersonalAccessTokenIDs(ids...)
 }
 
-// ClearFirstName clears the value of the "first_name" field.
-func (uuo *UserUpdateOne) ClearFirstName() *UserUpdateOne {
-	uuo.mutation.ClearFirstName()
-	return uuo
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (uu *UserUpdate) AddOauthConsentIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddOauthConsentIDs(ids...)
+	return uu
 }
 
-// SetLastName sets the "last_name" field.
-func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
-	uuo.mutation.SetLastName(s)
-	return uuo
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (uu *UserUpdate) AddOauthConsents(o ...*OAuthConsent) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.AddOauthConsentIDs(ids...)
 }
 
-// SetNillableLastName sets the "last_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableLastName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetLastName(*s)
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (uu *UserUpdate) AddOauthAuthorizationCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return uu
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (uu *UserUpdate) AddOauthAuthorizationCodes(o ...*OAuthAuthorizationCode) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return uuo
+	return uu.AddOauthAuthorizationCodeIDs(ids...)
 }
 
-// ClearLastName clears the value of the "last_name" field.
-func (uuo *UserUpdateOne) ClearLastName() *UserUpdateOne {
-	uuo.mutation.ClearLastName()
-	return uuo
+// AddDeviceAuthorizationIDs adds the "device_authorizations" edge to the DeviceAuthorization entity by IDs.
+func (uu *UserUpdate) AddDeviceAuthorizationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDeviceAuthorizationIDs(ids...)
+	return uu
 }
 
-// SetLoginCount sets the "login_count" field.
-func (uuo *UserUpdateOne) SetLoginCount(i int) *UserUpdateOne {
-	uuo.mutation.ResetLoginCount()
-	uuo.mutation.SetLoginCount(i)
-	return uuo
+// AddDeviceAuthorizations adds the "device_authorizations" edges to the DeviceAuthorization entity.
+func (uu *UserUpdate) AddDeviceAuthorizations(d ...*DeviceAuthorization) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDeviceAuthorizationIDs(ids...)
 }
 
-// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableLoginCount(i *int) *UserUpdateOne {
-	if i != nil {
-		uuo.SetLoginCount(*i)
+// AddQrLoginRequestIDs adds the "qr_login_requests" edge to the QRLoginRequest entity by IDs.
+func (uu *UserUpdate) AddQrLoginRequestIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddQrLoginRequestIDs(ids...)
+	return uu
+}
+
+// AddQrLoginRequests adds the "qr_login_requests" edges to the QRLoginRequest entity.
+func (uu *UserUpdate) AddQrLoginRequests(q ...*QRLoginRequest) *UserUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
 	}
-	return uuo
+	return uu.AddQrLoginRequestIDs(ids...)
 }
 
-// AddLoginCount adds i to the "login_count" field.
-func (uuo *UserUpdateOne) AddLoginCount(i int) *UserUpdateOne {
-	uuo.mutation.AddLoginCount(i)
-	return uuo
+// AddPhoneChangeRequestIDs adds the "phone_change_requests" edge to the PhoneChangeRequest entity by IDs.
+func (uu *UserUpdate) AddPhoneChangeRequestIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddPhoneChangeRequestIDs(ids...)
+	return uu
 }
 
-// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
-func (uuo *UserUpdateOne) AddSessionIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddSessionIDs(ids...)
-	return uuo
+// AddPhoneChangeRequests adds the "phone_change_requests" edges to the PhoneChangeRequest entity.
+func (uu *UserUpdate) AddPhoneChangeRequests(p ...*PhoneChangeRequest) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPhoneChangeRequestIDs(ids...)
 }
 
-// AddSessions adds the "sessions" edges to the Session entity.
-func (uuo *UserUpdateOne) AddSessions(s ...*Session) *UserUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// AddEmailVerificationIDs adds the "email_verifications" edge to the EmailVerification entity by IDs.
+func (uu *UserUpdate) AddEmailVerificationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddEmailVerificationIDs(ids...)
+	return uu
+}
+
+// AddEmailVerifications adds the "email_verifications" edges to the EmailVerification entity.
+func (uu *UserUpdate) AddEmailVerifications(e ...*EmailVerification) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
 	}
-	return uuo.AddSessionIDs(ids...)
+	return uu.AddEmailVerificationIDs(ids...)
 }
 
-// AddOtpIDs adds the "otps" edge to the OTP entity by IDs.
-func (uuo *UserUpdateOne) AddOtpIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddOtpIDs(ids...)
-	return uuo
+// AddUsernameHistoryIDs adds the "username_history" edge to the UsernameHistory entity by IDs.
+func (uu *UserUpdate) AddUsernameHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddUsernameHistoryIDs(ids...)
+	return uu
 }
 
-// AddOtps adds the "otps" edges to the OTP entity.
-func (uuo *UserUpdateOne) AddOtps(o ...*OTP) *UserUpdateOne {
-	ids := make([]int, len(o))
-	for i := range o {
-		ids[i] = o[i].ID
+// AddUsernameHistory adds the "username_history" edges to the UsernameHistory entity.
+func (uu *UserUpdate) AddUsernameHistory(u ...*UsernameHistory) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
-	return uuo.AddOtpIDs(ids...)
+	return uu.AddUsernameHistoryIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by IDs.
+func (uu *UserUpdate) AddFollowingIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddFollowingIDs(ids...)
+	return uu
+}
+
+// AddFollowing adds the "following" edges to the Follow entity.
+func (uu *UserUpdate) AddFollowing(f ...*Follow) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFollowingIDs(ids...)
+}
+
+// AddFollowerIDs adds the "followers" edge to the Follow entity by IDs.
+func (uu *UserUpdate) AddFollowerIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddFollowerIDs(ids...)
+	return uu
+}
+
+// AddFollowers adds the "followers" edges to the Follow entity.
+func (uu *UserUpdate) AddFollowers(f ...*Follow) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFollowerIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Block entity by IDs.
+func (uu *UserUpdate) AddBlockIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddBlockIDs(ids...)
+	return uu
+}
+
+// AddBlocks adds the "blocks" edges to the Block entity.
+func (uu *UserUpdate) AddBlocks(b ...*Block) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Block entity by IDs.
+func (uu *UserUpdate) AddBlockedByIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddBlockedByIDs(ids...)
+	return uu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Block entity.
+func (uu *UserUpdate) AddBlockedBy(b ...*Block) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBlockedByIDs(ids...)
+}
+
+// AddMuteIDs adds the "mutes" edge to the Mute entity by IDs.
+func (uu *UserUpdate) AddMuteIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddMuteIDs(ids...)
+	return uu
+}
+
+// AddMutes adds the "mutes" edges to the Mute entity.
+func (uu *UserUpdate) AddMutes(m ...*Mute) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMuteIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the Mute entity by IDs.
+func (uu *UserUpdate) AddMutedByIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddMutedByIDs(ids...)
+	return uu
+}
+
+// AddMutedBy adds the "muted_by" edges to the Mute entity.
+func (uu *UserUpdate) AddMutedBy(m ...*Mute) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMutedByIDs(ids...)
 }
 
 // Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return uu.mutation
 }
 
 // ClearSessions clears all "sessions" edges to the Session entity.
-func (uuo *UserUpdateOne) ClearSessions() *UserUpdateOne {
-	uuo.mutation.ClearSessions()
-	return uuo
+func (uu *UserUpdate) ClearSessions() *UserUpdate {
+	uu.mutation.ClearSessions()
+	return uu
 }
 
 // RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
-func (uuo *UserUpdateOne) RemoveSessionIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveSessionIDs(ids...)
-	return uuo
+func (uu *UserUpdate) RemoveSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSessionIDs(ids...)
+	return uu
 }
 
 // RemoveSessions removes "sessions" edges to Session entities.
-func (uuo *UserUpdateOne) RemoveSessions(s ...*Session) *UserUpdateOne {
+func (uu *UserUpdate) RemoveSessions(s ...*Session) *UserUpdate {
 	ids := make([]int, len(s))
 	for i := range s {
 		ids[i] = s[i].ID
 	}
-	return uuo.RemoveSessionIDs(ids...)
+	return uu.RemoveSessionIDs(ids...)
 }
 
 // ClearOtps clears all "otps" edges to the OTP entity.
-func (uuo *UserUpdateOne) ClearOtps() *UserUpdateOne {
-	uuo.mutation.ClearOtps()
-	return uuo
+func (uu *UserUpdate) ClearOtps() *UserUpdate {
+	uu.mutation.ClearOtps()
+	return uu
 }
 
 // RemoveOtpIDs removes the "otps" edge to OTP entities by IDs.
-func (uuo *UserUpdateOne) RemoveOtpIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveOtpIDs(ids...)
-	return uuo
+func (uu *UserUpdate) RemoveOtpIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveOtpIDs(ids...)
+	return uu
 }
 
 // RemoveOtps removes "otps" edges to OTP entities.
-func (uuo *UserUpdateOne) RemoveOtps(o ...*OTP) *UserUpdateOne {
+func (uu *UserUpdate) RemoveOtps(o ...*OTP) *UserUpdate {
 	ids := make([]int, len(o))
 	for i := range o {
 		ids[i] = o[i].ID
 	}
-	return uuo.RemoveOtpIDs(ids...)
+	return uu.RemoveOtpIDs(ids...)
 }
 
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
+// ClearRoles clears all "roles" edges to the Role entity.
+func (uu *UserUpdate) ClearRoles() *UserUpdate {
+	uu.mutation.ClearRoles()
+	return uu
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (uu *UserUpdate) RemoveRoleIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRoleIDs(ids...)
+	return uu
 }
 
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	uuo.defaults()
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+// RemoveRoles removes "roles" edges to Role entities.
+func (uu *UserUpdate) RemoveRoles(r ...*Role) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRoleIDs(ids...)
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (uu *UserUpdate) ClearDataExports() *UserUpdate {
+	uu.mutation.ClearDataExports()
+	return uu
+}
+
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (uu *UserUpdate) RemoveDataExportIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDataExportIDs(ids...)
+	return uu
+}
+
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (uu *UserUpdate) RemoveDataExports(d ...*DataExport) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
 	}
-	return node
+	return uu.RemoveDataExportIDs(ids...)
 }
 
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
+// ClearPersonalAccessTokens clears all "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uu *UserUpdate) ClearPersonalAccessTokens() *UserUpdate {
+	uu.mutation.ClearPersonalAccessTokens()
+	return uu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+// RemovePersonalAccessTokenIDs removes the "personal_access_tokens" edge to PersonalAccessToken entities by IDs.
+func (uu *UserUpdate) RemovePersonalAccessTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemovePersonalAccessTokenIDs(ids...)
+	return uu
+}
+
+// RemovePersonalAccessTokens removes "personal_access_tokens" edges to PersonalAccessToken entities.
+func (uu *UserUpdate) RemovePersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return uu.RemovePersonalAccessTokenIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() {
-	if _, ok := uuo.mutation.UpdateTime(); !ok {
-		v := user.UpdateDefaultUpdateTime()
-		uuo.mutation.SetUpdateTime(v)
+// ClearOauthConsents clears all "oauth_consents" edges to the OAuthConsent entity.
+func (uu *UserUpdate) ClearOauthConsents() *UserUpdate {
+	uu.mutation.ClearOauthConsents()
+	return uu
+}
+
+// RemoveOauthConsentIDs removes the "oauth_consents" edge to OAuthConsent entities by IDs.
+func (uu *UserUpdate) RemoveOauthConsentIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveOauthConsentIDs(ids...)
+	return uu
+}
+
+// RemoveOauthConsents removes "oauth_consents" edges to OAuthConsent entities.
+func (uu *UserUpdate) RemoveOauthConsents(o ...*OAuthConsent) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
+	return uu.RemoveOauthConsentIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.AuthID(); ok {
-		if err := user.AuthIDValidator(v); err != nil {
-			return &ValidationError{Name: "auth_id", err: fmt.Errorf(`ent: validator failed for field "User.auth_id": %w`, err)}
+// ClearOauthAuthorizationCodes clears all "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (uu *UserUpdate) ClearOauthAuthorizationCodes() *UserUpdate {
+	uu.mutation.ClearOauthAuthorizationCodes()
+	return uu
+}
+
+// RemoveOauthAuthorizationCodeIDs removes the "oauth_authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (uu *UserUpdate) RemoveOauthAuthorizationCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveOauthAuthorizationCodeIDs(ids...)
+	return uu
+}
+
+// RemoveOauthAuthorizationCodes removes "oauth_authorization_codes" edges to OAuthAuthorizationCode entities.
+func (uu *UserUpdate) RemoveOauthAuthorizationCodes(o ...*OAuthAuthorizationCode) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.RemoveOauthAuthorizationCodeIDs(ids...)
+}
+
+// ClearDeviceAuthorizations clears all "device_authorizations" edges to the DeviceAuthorization entity.
+func (uu *UserUpdate) ClearDeviceAuthorizations() *UserUpdate {
+	uu.mutation.ClearDeviceAuthorizations()
+	return uu
+}
+
+// RemoveDeviceAuthorizationIDs removes the "device_authorizations" edge to DeviceAuthorization entities by IDs.
+func (uu *UserUpdate) RemoveDeviceAuthorizationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDeviceAuthorizationIDs(ids...)
+	return uu
+}
+
+// RemoveDeviceAuthorizations removes "device_authorizations" edges to DeviceAuthorization entities.
+func (uu *UserUpdate) RemoveDeviceAuthorizations(d ...*DeviceAuthorization) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDeviceAuthorizationIDs(ids...)
+}
+
+// ClearQrLoginRequests clears all "qr_login_requests" edges to the QRLoginRequest entity.
+func (uu *UserUpdate) ClearQrLoginRequests() *UserUpdate {
+	uu.mutation.ClearQrLoginRequests()
+	return uu
+}
+
+// RemoveQrLoginRequestIDs removes the "qr_login_requests" edge to QRLoginRequest entities by IDs.
+func (uu *UserUpdate) RemoveQrLoginRequestIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveQrLoginRequestIDs(ids...)
+	return uu
+}
+
+// RemoveQrLoginRequests removes "qr_login_requests" edges to QRLoginRequest entities.
+func (uu *UserUpdate) RemoveQrLoginRequests(q ...*QRLoginRequest) *UserUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uu.RemoveQrLoginRequestIDs(ids...)
+}
+
+// ClearPhoneChangeRequests clears all "phone_change_requests" edges to the PhoneChangeRequest entity.
+func (uu *UserUpdate) ClearPhoneChangeRequests() *UserUpdate {
+	uu.mutation.ClearPhoneChangeRequests()
+	return uu
+}
+
+// RemovePhoneChangeRequestIDs removes the "phone_change_requests" edge to PhoneChangeRequest entities by IDs.
+func (uu *UserUpdate) RemovePhoneChangeRequestIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemovePhoneChangeRequestIDs(ids...)
+	return uu
+}
+
+// RemovePhoneChangeRequests removes "phone_change_requests" edges to PhoneChangeRequest entities.
+func (uu *UserUpdate) RemovePhoneChangeRequests(p ...*PhoneChangeRequest) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePhoneChangeRequestIDs(ids...)
+}
+
+// ClearEmailVerifications clears all "email_verifications" edges to the EmailVerification entity.
+func (uu *UserUpdate) ClearEmailVerifications() *UserUpdate {
+	uu.mutation.ClearEmailVerifications()
+	return uu
+}
+
+// RemoveEmailVerificationIDs removes the "email_verifications" edge to EmailVerification entities by IDs.
+func (uu *UserUpdate) RemoveEmailVerificationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveEmailVerificationIDs(ids...)
+	return uu
+}
+
+// RemoveEmailVerifications removes "email_verifications" edges to EmailVerification entities.
+func (uu *UserUpdate) RemoveEmailVerifications(e ...*EmailVerification) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.RemoveEmailVerificationIDs(ids...)
+}
+
+// ClearUsernameHistory clears all "username_history" edges to the UsernameHistory entity.
+func (uu *UserUpdate) ClearUsernameHistory() *UserUpdate {
+	uu.mutation.ClearUsernameHistory()
+	return uu
+}
+
+// RemoveUsernameHistoryIDs removes the "username_history" edge to UsernameHistory entities by IDs.
+func (uu *UserUpdate) RemoveUsernameHistoryIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveUsernameHistoryIDs(ids...)
+	return uu
+}
+
+// RemoveUsernameHistory removes "username_history" edges to UsernameHistory entities.
+func (uu *UserUpdate) RemoveUsernameHistory(u ...*UsernameHistory) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveUsernameHistoryIDs(ids...)
+}
+
+// ClearFollowing clears all "following" edges to the Follow entity.
+func (uu *UserUpdate) ClearFollowing() *UserUpdate {
+	uu.mutation.ClearFollowing()
+	return uu
+}
+
+// RemoveFollowingIDs removes the "following" edge to Follow entities by IDs.
+func (uu *UserUpdate) RemoveFollowingIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveFollowingIDs(ids...)
+	return uu
+}
+
+// RemoveFollowing removes "following" edges to Follow entities.
+func (uu *UserUpdate) RemoveFollowing(f ...*Follow) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFollowingIDs(ids...)
+}
+
+// ClearFollowers clears all "followers" edges to the Follow entity.
+func (uu *UserUpdate) ClearFollowers() *UserUpdate {
+	uu.mutation.ClearFollowers()
+	return uu
+}
+
+// RemoveFollowerIDs removes the "followers" edge to Follow entities by IDs.
+func (uu *UserUpdate) RemoveFollowerIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveFollowerIDs(ids...)
+	return uu
+}
+
+// RemoveFollowers removes "followers" edges to Follow entities.
+func (uu *UserUpdate) RemoveFollowers(f ...*Follow) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFollowerIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the Block entity.
+func (uu *UserUpdate) ClearBlocks() *UserUpdate {
+	uu.mutation.ClearBlocks()
+	return uu
+}
+
+// RemoveBlockIDs removes the "blocks" edge to Block entities by IDs.
+func (uu *UserUpdate) RemoveBlockIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveBlockIDs(ids...)
+	return uu
+}
+
+// RemoveBlocks removes "blocks" edges to Block entities.
+func (uu *UserUpdate) RemoveBlocks(b ...*Block) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Block entity.
+func (uu *UserUpdate) ClearBlockedBy() *UserUpdate {
+	uu.mutation.ClearBlockedBy()
+	return uu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Block entities by IDs.
+func (uu *UserUpdate) RemoveBlockedByIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveBlockedByIDs(ids...)
+	return uu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Block entities.
+func (uu *UserUpdate) RemoveBlockedBy(b ...*Block) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBlockedByIDs(ids...)
+}
+
+// ClearMutes clears all "mutes" edges to the Mute entity.
+func (uu *UserUpdate) ClearMutes() *UserUpdate {
+	uu.mutation.ClearMutes()
+	return uu
+}
+
+// RemoveMuteIDs removes the "mutes" edge to Mute entities by IDs.
+func (uu *UserUpdate) RemoveMuteIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveMuteIDs(ids...)
+	return uu
+}
+
+// RemoveMutes removes "mutes" edges to Mute entities.
+func (uu *UserUpdate) RemoveMutes(m ...*Mute) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMuteIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the Mute entity.
+func (uu *UserUpdate) ClearMutedBy() *UserUpdate {
+	uu.mutation.ClearMutedBy()
+	return uu
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to Mute entities by IDs.
+func (uu *UserUpdate) RemoveMutedByIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveMutedByIDs(ids...)
+	return uu
+}
+
+// RemoveMutedBy removes "muted_by" edges to Mute entities.
+func (uu *UserUpdate) RemoveMutedBy(m ...*Mute) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMutedByIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	uu.defaults()
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UserUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UserUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uu *UserUpdate) defaults() {
+	if _, ok := uu.mutation.UpdateTime(); !ok {
+		v := user.UpdateDefaultUpdateTime()
+		uu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.AuthID(); ok {
+		if err := user.AuthIDValidator(v); err != nil {
+			return &ValidationError{Name: "auth_id", err: fmt.Errorf(`ent: validator failed for field "User.auth_id": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.UsernameNormalized(); ok {
+		if err := user.UsernameNormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "username_normalized", err: fmt.Errorf(`ent: validator failed for field "User.username_normalized": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.PhoneNumber(); ok {
+		if err := user.PhoneNumberValidator(v); err != nil {
+			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "User.phone_number": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.DisplayName(); ok {
+		if err := user.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "User.locale": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.FollowerCount(); ok {
+		if err := user.FollowerCountValidator(v); err != nil {
+			return &ValidationError{Name: "follower_count", err: fmt.Errorf(`ent: validator failed for field "User.follower_count": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.FollowingCount(); ok {
+		if err := user.FollowingCountValidator(v); err != nil {
+			return &ValidationError{Name: "following_count", err: fmt.Errorf(`ent: validator failed for field "User.following_count": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Timezone(); ok {
+		if err := user.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "User.timezone": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uu *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	uu.modifiers = append(uu.modifiers, modifiers...)
+	return uu
+}
+
+func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uu.mutation.UpdateTime(); ok {
+		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := uu.mutation.AuthID(); ok {
+		_spec.SetField(user.FieldAuthID, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if uu.mutation.UsernameCleared() {
+		_spec.ClearField(user.FieldUsername, field.TypeString)
+	}
+	if value, ok := uu.mutation.UsernameNormalized(); ok {
+		_spec.SetField(user.FieldUsernameNormalized, field.TypeString, value)
+	}
+	if uu.mutation.UsernameNormalizedCleared() {
+		_spec.ClearField(user.FieldUsernameNormalized, field.TypeString)
+	}
+	if value, ok := uu.mutation.UsernameChangedAt(); ok {
+		_spec.SetField(user.FieldUsernameChangedAt, field.TypeTime, value)
+	}
+	if uu.mutation.UsernameChangedAtCleared() {
+		_spec.ClearField(user.FieldUsernameChangedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if uu.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := uu.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if uu.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(user.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.PhoneNumber(); ok {
+		_spec.SetField(user.FieldPhoneNumber, field.TypeString, value)
+	}
+	if uu.mutation.PhoneNumberCleared() {
+		_spec.ClearField(user.FieldPhoneNumber, field.TypeString)
+	}
+	if value, ok := uu.mutation.FirstName(); ok {
+		_spec.SetField(user.FieldFirstName, field.TypeString, value)
+	}
+	if uu.mutation.FirstNameCleared() {
+		_spec.ClearField(user.FieldFirstName, field.TypeString)
+	}
+	if value, ok := uu.mutation.LastName(); ok {
+		_spec.SetField(user.FieldLastName, field.TypeString, value)
+	}
+	if uu.mutation.LastNameCleared() {
+		_spec.ClearField(user.FieldLastName, field.TypeString)
+	}
+	if value, ok := uu.mutation.DisplayName(); ok {
+		_spec.SetField(user.FieldDisplayName, field.TypeString, value)
+	}
+	if uu.mutation.DisplayNameCleared() {
+		_spec.ClearField(user.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := uu.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if uu.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := uu.mutation.Birthday(); ok {
+		_spec.SetField(user.FieldBirthday, field.TypeTime, value)
+	}
+	if uu.mutation.BirthdayCleared() {
+		_spec.ClearField(user.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if uu.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
+	if value, ok := uu.mutation.AvatarKey(); ok {
+		_spec.SetField(user.FieldAvatarKey, field.TypeString, value)
+	}
+	if uu.mutation.AvatarKeyCleared() {
+		_spec.ClearField(user.FieldAvatarKey, field.TypeString)
+	}
+	if value, ok := uu.mutation.AvatarUrls(); ok {
+		_spec.SetField(user.FieldAvatarUrls, field.TypeJSON, value)
+	}
+	if uu.mutation.AvatarUrlsCleared() {
+		_spec.ClearField(user.FieldAvatarUrls, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeJSON, value)
+	}
+	if uu.mutation.ProfileVisibilityCleared() {
+		_spec.ClearField(user.FieldProfileVisibility, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.Settings(); ok {
+		_spec.SetField(user.FieldSettings, field.TypeJSON, value)
+	}
+	if uu.mutation.SettingsCleared() {
+		_spec.ClearField(user.FieldSettings, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.Private(); ok {
+		_spec.SetField(user.FieldPrivate, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.FollowerCount(); ok {
+		_spec.SetField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedFollowerCount(); ok {
+		_spec.AddField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.FollowingCount(); ok {
+		_spec.SetField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedFollowingCount(); ok {
+		_spec.AddField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if uu.mutation.TimezoneCleared() {
+		_spec.ClearField(user.FieldTimezone, field.TypeString)
+	}
+	if value, ok := uu.mutation.LoginCount(); ok {
+		_spec.SetField(user.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedLoginCount(); ok {
+		_spec.AddField(user.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.SuspendedUntil(); ok {
+		_spec.SetField(user.FieldSuspendedUntil, field.TypeTime, value)
+	}
+	if uu.mutation.SuspendedUntilCleared() {
+		_spec.ClearField(user.FieldSuspendedUntil, field.TypeTime)
+	}
+	if value, ok := uu.mutation.RestrictionReason(); ok {
+		_spec.SetField(user.FieldRestrictionReason, field.TypeString, value)
+	}
+	if uu.mutation.RestrictionReasonCleared() {
+		_spec.ClearField(user.FieldRestrictionReason, field.TypeString)
+	}
+	if value, ok := uu.mutation.RestrictedBy(); ok {
+		_spec.SetField(user.FieldRestrictedBy, field.TypeString, value)
+	}
+	if uu.mutation.RestrictedByCleared() {
+		_spec.ClearField(user.FieldRestrictedBy, field.TypeString)
+	}
+	if value, ok := uu.mutation.RestrictedAt(); ok {
+		_spec.SetField(user.FieldRestrictedAt, field.TypeTime, value)
+	}
+	if uu.mutation.RestrictedAtCleared() {
+		_spec.ClearField(user.FieldRestrictedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(user.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(user.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Guest(); ok {
+		_spec.SetField(user.FieldGuest, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.GuestDeviceHash(); ok {
+		_spec.SetField(user.FieldGuestDeviceHash, field.TypeString, value)
+	}
+	if uu.mutation.GuestDeviceHashCleared() {
+		_spec.ClearField(user.FieldGuestDeviceHash, field.TypeString)
+	}
+	if uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.OtpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OtpsTable,
+			Columns: []string{user.OtpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedOtpsIDs(); len(nodes) > 0 && !uu.mutation.OtpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OtpsTable,
+			Columns: []string{user.OtpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OtpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OtpsTable,
+			Columns: []string{user.OtpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRolesIDs(); len(nodes) > 0 && !uu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !uu.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPersonalAccessTokensIDs(); len(nodes) > 0 && !uu.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedOauthConsentsIDs(); len(nodes) > 0 && !uu.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedOauthAuthorizationCodesIDs(); len(nodes) > 0 && !uu.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DeviceAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceAuthorizationsTable,
+			Columns: []string{user.DeviceAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDeviceAuthorizationsIDs(); len(nodes) > 0 && !uu.mutation.DeviceAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceAuthorizationsTable,
+			Columns: []string{user.DeviceAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DeviceAuthorizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceAuthorizationsTable,
+			Columns: []string{user.DeviceAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.QrLoginRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QrLoginRequestsTable,
+			Columns: []string{user.QrLoginRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedQrLoginRequestsIDs(); len(nodes) > 0 && !uu.mutation.QrLoginRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QrLoginRequestsTable,
+			Columns: []string{user.QrLoginRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.QrLoginRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QrLoginRequestsTable,
+			Columns: []string{user.QrLoginRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.PhoneChangeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhoneChangeRequestsTable,
+			Columns: []string{user.PhoneChangeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPhoneChangeRequestsIDs(); len(nodes) > 0 && !uu.mutation.PhoneChangeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhoneChangeRequestsTable,
+			Columns: []string{user.PhoneChangeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PhoneChangeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhoneChangeRequestsTable,
+			Columns: []string{user.PhoneChangeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationsTable,
+			Columns: []string{user.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedEmailVerificationsIDs(); len(nodes) > 0 && !uu.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationsTable,
+			Columns: []string{user.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.EmailVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationsTable,
+			Columns: []string{user.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUsernameHistoryIDs(); len(nodes) > 0 && !uu.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UsernameHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !uu.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !uu.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !uu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MutesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutesTable,
+			Columns: []string{user.MutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMutesIDs(); len(nodes) > 0 && !uu.mutation.MutesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutesTable,
+			Columns: []string{user.MutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MutesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutesTable,
+			Columns: []string{user.MutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (uuo *UserUpdateOne) SetUpdateTime(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdateTime(t)
+	return uuo
+}
+
+// SetAuthID sets the "auth_id" field.
+func (uuo *UserUpdateOne) SetAuthID(s string) *UserUpdateOne {
+	uuo.mutation.SetAuthID(s)
+	return uuo
+}
+
+// SetNillableAuthID sets the "auth_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAuthID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAuthID(*s)
+	}
+	return uuo
+}
+
+// SetUsername sets the "username" field.
+func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
+	uuo.mutation.SetUsername(s)
+	return uuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUsername(*s)
+	}
+	return uuo
+}
+
+// ClearUsername clears the value of the "username" field.
+func (uuo *UserUpdateOne) ClearUsername() *UserUpdateOne {
+	uuo.mutation.ClearUsername()
+	return uuo
+}
+
+// SetUsernameNormalized sets the "username_normalized" field.
+func (uuo *UserUpdateOne) SetUsernameNormalized(s string) *UserUpdateOne {
+	uuo.mutation.SetUsernameNormalized(s)
+	return uuo
+}
+
+// SetNillableUsernameNormalized sets the "username_normalized" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsernameNormalized(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUsernameNormalized(*s)
+	}
+	return uuo
+}
+
+// ClearUsernameNormalized clears the value of the "username_normalized" field.
+func (uuo *UserUpdateOne) ClearUsernameNormalized() *UserUpdateOne {
+	uuo.mutation.ClearUsernameNormalized()
+	return uuo
+}
+
+// SetUsernameChangedAt sets the "username_changed_at" field.
+func (uuo *UserUpdateOne) SetUsernameChangedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUsernameChangedAt(t)
+	return uuo
+}
+
+// SetNillableUsernameChangedAt sets the "username_changed_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsernameChangedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetUsernameChangedAt(*t)
+	}
+	return uuo
+}
+
+// ClearUsernameChangedAt clears the value of the "username_changed_at" field.
+func (uuo *UserUpdateOne) ClearUsernameChangedAt() *UserUpdateOne {
+	uuo.mutation.ClearUsernameChangedAt()
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	uuo.mutation.ClearEmail()
+	return uuo
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (uuo *UserUpdateOne) SetEmailVerifiedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetEmailVerifiedAt(t)
+	return uuo
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailVerifiedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetEmailVerifiedAt(*t)
+	}
+	return uuo
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (uuo *UserUpdateOne) ClearEmailVerifiedAt() *UserUpdateOne {
+	uuo.mutation.ClearEmailVerifiedAt()
+	return uuo
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (uuo *UserUpdateOne) SetPhoneNumber(s string) *UserUpdateOne {
+	uuo.mutation.SetPhoneNumber(s)
+	return uuo
+}
+
+// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhoneNumber(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPhoneNumber(*s)
+	}
+	return uuo
+}
+
+// ClearPhoneNumber clears the value of the "phone_number" field.
+func (uuo *UserUpdateOne) ClearPhoneNumber() *UserUpdateOne {
+	uuo.mutation.ClearPhoneNumber()
+	return uuo
+}
+
+// SetFirstName sets the "first_name" field.
+func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
+	uuo.mutation.SetFirstName(s)
+	return uuo
+}
+
+// SetNillableFirstName sets the "first_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFirstName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetFirstName(*s)
+	}
+	return uuo
+}
+
+// ClearFirstName clears the value of the "first_name" field.
+func (uuo *UserUpdateOne) ClearFirstName() *UserUpdateOne {
+	uuo.mutation.ClearFirstName()
+	return uuo
+}
+
+// SetLastName sets the "last_name" field.
+func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
+	uuo.mutation.SetLastName(s)
+	return uuo
+}
+
+// SetNillableLastName sets the "last_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLastName(*s)
+	}
+	return uuo
+}
+
+// ClearLastName clears the value of the "last_name" field.
+func (uuo *UserUpdateOne) ClearLastName() *UserUpdateOne {
+	uuo.mutation.ClearLastName()
+	return uuo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (uuo *UserUpdateOne) SetDisplayName(s string) *UserUpdateOne {
+	uuo.mutation.SetDisplayName(s)
+	return uuo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisplayName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetDisplayName(*s)
+	}
+	return uuo
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (uuo *UserUpdateOne) ClearDisplayName() *UserUpdateOne {
+	uuo.mutation.ClearDisplayName()
+	return uuo
+}
+
+// SetBio sets the "bio" field.
+func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
+	uuo.mutation.SetBio(s)
+	return uuo
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBio(*s)
+	}
+	return uuo
+}
+
+// ClearBio clears the value of the "bio" field.
+func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
+	uuo.mutation.ClearBio()
+	return uuo
+}
+
+// SetBirthday sets the "birthday" field.
+func (uuo *UserUpdateOne) SetBirthday(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetBirthday(t)
+	return uuo
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBirthday(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetBirthday(*t)
+	}
+	return uuo
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (uuo *UserUpdateOne) ClearBirthday() *UserUpdateOne {
+	uuo.mutation.ClearBirthday()
+	return uuo
+}
+
+// SetLocale sets the "locale" field.
+func (uuo *UserUpdateOne) SetLocale(s string) *UserUpdateOne {
+	uuo.mutation.SetLocale(s)
+	return uuo
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLocale(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLocale(*s)
+	}
+	return uuo
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (uuo *UserUpdateOne) ClearLocale() *UserUpdateOne {
+	uuo.mutation.ClearLocale()
+	return uuo
+}
+
+// SetAvatarKey sets the "avatar_key" field.
+func (uuo *UserUpdateOne) SetAvatarKey(s string) *UserUpdateOne {
+	uuo.mutation.SetAvatarKey(s)
+	return uuo
+}
+
+// SetNillableAvatarKey sets the "avatar_key" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvatarKey(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAvatarKey(*s)
+	}
+	return uuo
+}
+
+// ClearAvatarKey clears the value of the "avatar_key" field.
+func (uuo *UserUpdateOne) ClearAvatarKey() *UserUpdateOne {
+	uuo.mutation.ClearAvatarKey()
+	return uuo
+}
+
+// SetAvatarUrls sets the "avatar_urls" field.
+func (uuo *UserUpdateOne) SetAvatarUrls(m map[string]string) *UserUpdateOne {
+	uuo.mutation.SetAvatarUrls(m)
+	return uuo
+}
+
+// ClearAvatarUrls clears the value of the "avatar_urls" field.
+func (uuo *UserUpdateOne) ClearAvatarUrls() *UserUpdateOne {
+	uuo.mutation.ClearAvatarUrls()
+	return uuo
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (uuo *UserUpdateOne) SetProfileVisibility(m map[string]string) *UserUpdateOne {
+	uuo.mutation.SetProfileVisibility(m)
+	return uuo
+}
+
+// ClearProfileVisibility clears the value of the "profile_visibility" field.
+func (uuo *UserUpdateOne) ClearProfileVisibility() *UserUpdateOne {
+	uuo.mutation.ClearProfileVisibility()
+	return uuo
+}
+
+// SetSettings sets the "settings" field.
+func (uuo *UserUpdateOne) SetSettings(m map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.SetSettings(m)
+	return uuo
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (uuo *UserUpdateOne) ClearSettings() *UserUpdateOne {
+	uuo.mutation.ClearSettings()
+	return uuo
+}
+
+// SetPrivate sets the "private" field.
+func (uuo *UserUpdateOne) SetPrivate(b bool) *UserUpdateOne {
+	uuo.mutation.SetPrivate(b)
+	return uuo
+}
+
+// SetNillablePrivate sets the "private" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePrivate(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetPrivate(*b)
+	}
+	return uuo
+}
+
+// SetFollowerCount sets the "follower_count" field.
+func (uuo *UserUpdateOne) SetFollowerCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetFollowerCount()
+	uuo.mutation.SetFollowerCount(i)
+	return uuo
+}
+
+// SetNillableFollowerCount sets the "follower_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFollowerCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetFollowerCount(*i)
+	}
+	return uuo
+}
+
+// AddFollowerCount adds i to the "follower_count" field.
+func (uuo *UserUpdateOne) AddFollowerCount(i int) *UserUpdateOne {
+	uuo.mutation.AddFollowerCount(i)
+	return uuo
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (uuo *UserUpdateOne) SetFollowingCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetFollowingCount()
+	uuo.mutation.SetFollowingCount(i)
+	return uuo
+}
+
+// SetNillableFollowingCount sets the "following_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFollowingCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetFollowingCount(*i)
+	}
+	return uuo
+}
+
+// AddFollowingCount adds i to the "following_count" field.
+func (uuo *UserUpdateOne) AddFollowingCount(i int) *UserUpdateOne {
+	uuo.mutation.AddFollowingCount(i)
+	return uuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (uuo *UserUpdateOne) SetTimezone(s string) *UserUpdateOne {
+	uuo.mutation.SetTimezone(s)
+	return uuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTimezone(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTimezone(*s)
+	}
+	return uuo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (uuo *UserUpdateOne) ClearTimezone() *UserUpdateOne {
+	uuo.mutation.ClearTimezone()
+	return uuo
+}
+
+// SetLoginCount sets the "login_count" field.
+func (uuo *UserUpdateOne) SetLoginCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetLoginCount()
+	uuo.mutation.SetLoginCount(i)
+	return uuo
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLoginCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetLoginCount(*i)
+	}
+	return uuo
+}
+
+// AddLoginCount adds i to the "login_count" field.
+func (uuo *UserUpdateOne) AddLoginCount(i int) *UserUpdateOne {
+	uuo.mutation.AddLoginCount(i)
+	return uuo
+}
+
+// SetStatus sets the "status" field.
+func (uuo *UserUpdateOne) SetStatus(u user.Status) *UserUpdateOne {
+	uuo.mutation.SetStatus(u)
+	return uuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStatus(u *user.Status) *UserUpdateOne {
+	if u != nil {
+		uuo.SetStatus(*u)
+	}
+	return uuo
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (uuo *UserUpdateOne) SetSuspendedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetSuspendedUntil(t)
+	return uuo
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSuspendedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetSuspendedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (uuo *UserUpdateOne) ClearSuspendedUntil() *UserUpdateOne {
+	uuo.mutation.ClearSuspendedUntil()
+	return uuo
+}
+
+// SetRestrictionReason sets the "restriction_reason" field.
+func (uuo *UserUpdateOne) SetRestrictionReason(s string) *UserUpdateOne {
+	uuo.mutation.SetRestrictionReason(s)
+	return uuo
+}
+
+// SetNillableRestrictionReason sets the "restriction_reason" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRestrictionReason(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetRestrictionReason(*s)
+	}
+	return uuo
+}
+
+// ClearRestrictionReason clears the value of the "restriction_reason" field.
+func (uuo *UserUpdateOne) ClearRestrictionReason() *UserUpdateOne {
+	uuo.mutation.ClearRestrictionReason()
+	return uuo
+}
+
+// SetRestrictedBy sets the "restricted_by" field.
+func (uuo *UserUpdateOne) SetRestrictedBy(s string) *UserUpdateOne {
+	uuo.mutation.SetRestrictedBy(s)
+	return uuo
+}
+
+// SetNillableRestrictedBy sets the "restricted_by" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRestrictedBy(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetRestrictedBy(*s)
+	}
+	return uuo
+}
+
+// ClearRestrictedBy clears the value of the "restricted_by" field.
+func (uuo *UserUpdateOne) ClearRestrictedBy() *UserUpdateOne {
+	uuo.mutation.ClearRestrictedBy()
+	return uuo
+}
+
+// SetRestrictedAt sets the "restricted_at" field.
+func (uuo *UserUpdateOne) SetRestrictedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetRestrictedAt(t)
+	return uuo
+}
+
+// SetNillableRestrictedAt sets the "restricted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRestrictedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetRestrictedAt(*t)
+	}
+	return uuo
+}
+
+// ClearRestrictedAt clears the value of the "restricted_at" field.
+func (uuo *UserUpdateOne) ClearRestrictedAt() *UserUpdateOne {
+	uuo.mutation.ClearRestrictedAt()
+	return uuo
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (uuo *UserUpdateOne) SetDeletionRequestedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletionRequestedAt(t)
+	return uuo
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletionRequestedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletionRequestedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (uuo *UserUpdateOne) ClearDeletionRequestedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletionRequestedAt()
+	return uuo
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) SetDeletionScheduledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletionScheduledAt(t)
+	return uuo
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletionScheduledAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) ClearDeletionScheduledAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletionScheduledAt()
+	return uuo
+}
+
+// SetGuest sets the "guest" field.
+func (uuo *UserUpdateOne) SetGuest(b bool) *UserUpdateOne {
+	uuo.mutation.SetGuest(b)
+	return uuo
+}
+
+// SetNillableGuest sets the "guest" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGuest(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetGuest(*b)
+	}
+	return uuo
+}
+
+// SetGuestDeviceHash sets the "guest_device_hash" field.
+func (uuo *UserUpdateOne) SetGuestDeviceHash(s string) *UserUpdateOne {
+	uuo.mutation.SetGuestDeviceHash(s)
+	return uuo
+}
+
+// SetNillableGuestDeviceHash sets the "guest_device_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGuestDeviceHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetGuestDeviceHash(*s)
+	}
+	return uuo
+}
+
+// ClearGuestDeviceHash clears the value of the "guest_device_hash" field.
+func (uuo *UserUpdateOne) ClearGuestDeviceHash() *UserUpdateOne {
+	uuo.mutation.ClearGuestDeviceHash()
+	return uuo
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uuo *UserUpdateOne) AddSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSessionIDs(ids...)
+	return uuo
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) AddSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSessionIDs(ids...)
+}
+
+// AddOtpIDs adds the "otps" edge to the OTP entity by IDs.
+func (uuo *UserUpdateOne) AddOtpIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddOtpIDs(ids...)
+	return uuo
+}
+
+// AddOtps adds the "otps" edges to the OTP entity.
+func (uuo *UserUpdateOne) AddOtps(o ...*OTP) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddOtpIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uuo *UserUpdateOne) AddRoleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRoleIDs(ids...)
+	return uuo
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uuo *UserUpdateOne) AddRoles(r ...*Role) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRoleIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (uuo *UserUpdateOne) AddDataExportIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDataExportIDs(ids...)
+	return uuo
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (uuo *UserUpdateOne) AddDataExports(d ...*DataExport) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDataExportIDs(ids...)
+}
+
+// AddPersonalAccessTokenIDs adds the "personal_access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (uuo *UserUpdateOne) AddPersonalAccessTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPersonalAccessTokenIDs(ids...)
+	return uuo
+}
+
+// AddPersonalAccessTokens adds the "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uuo *UserUpdateOne) AddPersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPersonalAccessTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (uuo *UserUpdateOne) AddOauthConsentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddOauthConsentIDs(ids...)
+	return uuo
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (uuo *UserUpdateOne) AddOauthConsents(o ...*OAuthConsent) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (uuo *UserUpdateOne) AddOauthAuthorizationCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return uuo
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (uuo *UserUpdateOne) AddOauthAuthorizationCodes(o ...*OAuthAuthorizationCode) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddDeviceAuthorizationIDs adds the "device_authorizations" edge to the DeviceAuthorization entity by IDs.
+func (uuo *UserUpdateOne) AddDeviceAuthorizationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDeviceAuthorizationIDs(ids...)
+	return uuo
+}
+
+// AddDeviceAuthorizations adds the "device_authorizations" edges to the DeviceAuthorization entity.
+func (uuo *UserUpdateOne) AddDeviceAuthorizations(d ...*DeviceAuthorization) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDeviceAuthorizationIDs(ids...)
+}
+
+// AddQrLoginRequestIDs adds the "qr_login_requests" edge to the QRLoginRequest entity by IDs.
+func (uuo *UserUpdateOne) AddQrLoginRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddQrLoginRequestIDs(ids...)
+	return uuo
+}
+
+// AddQrLoginRequests adds the "qr_login_requests" edges to the QRLoginRequest entity.
+func (uuo *UserUpdateOne) AddQrLoginRequests(q ...*QRLoginRequest) *UserUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.AddQrLoginRequestIDs(ids...)
+}
+
+// AddPhoneChangeRequestIDs adds the "phone_change_requests" edge to the PhoneChangeRequest entity by IDs.
+func (uuo *UserUpdateOne) AddPhoneChangeRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPhoneChangeRequestIDs(ids...)
+	return uuo
+}
+
+// AddPhoneChangeRequests adds the "phone_change_requests" edges to the PhoneChangeRequest entity.
+func (uuo *UserUpdateOne) AddPhoneChangeRequests(p ...*PhoneChangeRequest) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPhoneChangeRequestIDs(ids...)
+}
+
+// AddEmailVerificationIDs adds the "email_verifications" edge to the EmailVerification entity by IDs.
+func (uuo *UserUpdateOne) AddEmailVerificationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddEmailVerificationIDs(ids...)
+	return uuo
+}
+
+// AddEmailVerifications adds the "email_verifications" edges to the EmailVerification entity.
+func (uuo *UserUpdateOne) AddEmailVerifications(e ...*EmailVerification) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddEmailVerificationIDs(ids...)
+}
+
+// AddUsernameHistoryIDs adds the "username_history" edge to the UsernameHistory entity by IDs.
+func (uuo *UserUpdateOne) AddUsernameHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddUsernameHistoryIDs(ids...)
+	return uuo
+}
+
+// AddUsernameHistory adds the "username_history" edges to the UsernameHistory entity.
+func (uuo *UserUpdateOne) AddUsernameHistory(u ...*UsernameHistory) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddUsernameHistoryIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by IDs.
+func (uuo *UserUpdateOne) AddFollowingIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFollowingIDs(ids...)
+	return uuo
+}
+
+// AddFollowing adds the "following" edges to the Follow entity.
+func (uuo *UserUpdateOne) AddFollowing(f ...*Follow) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFollowingIDs(ids...)
+}
+
+// AddFollowerIDs adds the "followers" edge to the Follow entity by IDs.
+func (uuo *UserUpdateOne) AddFollowerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFollowerIDs(ids...)
+	return uuo
+}
+
+// AddFollowers adds the "followers" edges to the Follow entity.
+func (uuo *UserUpdateOne) AddFollowers(f ...*Follow) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFollowerIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Block entity by IDs.
+func (uuo *UserUpdateOne) AddBlockIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddBlockIDs(ids...)
+	return uuo
+}
+
+// AddBlocks adds the "blocks" edges to the Block entity.
+func (uuo *UserUpdateOne) AddBlocks(b ...*Block) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Block entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedByIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddBlockedByIDs(ids...)
+	return uuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Block entity.
+func (uuo *UserUpdateOne) AddBlockedBy(b ...*Block) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockedByIDs(ids...)
+}
+
+// AddMuteIDs adds the "mutes" edge to the Mute entity by IDs.
+func (uuo *UserUpdateOne) AddMuteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMuteIDs(ids...)
+	return uuo
+}
+
+// AddMutes adds the "mutes" edges to the Mute entity.
+func (uuo *UserUpdateOne) AddMutes(m ...*Mute) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMuteIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the Mute entity by IDs.
+func (uuo *UserUpdateOne) AddMutedByIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMutedByIDs(ids...)
+	return uuo
+}
+
+// AddMutedBy adds the "muted_by" edges to the Mute entity.
+func (uuo *UserUpdateOne) AddMutedBy(m ...*Mute) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMutedByIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	uuo.mutation.ClearSessions()
+	return uuo
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uuo *UserUpdateOne) RemoveSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSessionIDs(ids...)
+	return uuo
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uuo *UserUpdateOne) RemoveSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSessionIDs(ids...)
+}
+
+// ClearOtps clears all "otps" edges to the OTP entity.
+func (uuo *UserUpdateOne) ClearOtps() *UserUpdateOne {
+	uuo.mutation.ClearOtps()
+	return uuo
+}
+
+// RemoveOtpIDs removes the "otps" edge to OTP entities by IDs.
+func (uuo *UserUpdateOne) RemoveOtpIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveOtpIDs(ids...)
+	return uuo
+}
+
+// RemoveOtps removes "otps" edges to OTP entities.
+func (uuo *UserUpdateOne) RemoveOtps(o ...*OTP) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.RemoveOtpIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (uuo *UserUpdateOne) ClearRoles() *UserUpdateOne {
+	uuo.mutation.ClearRoles()
+	return uuo
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (uuo *UserUpdateOne) RemoveRoleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRoleIDs(ids...)
+	return uuo
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (uuo *UserUpdateOne) RemoveRoles(r ...*Role) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRoleIDs(ids...)
+}
+
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (uuo *UserUpdateOne) ClearDataExports() *UserUpdateOne {
+	uuo.mutation.ClearDataExports()
+	return uuo
+}
+
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (uuo *UserUpdateOne) RemoveDataExportIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDataExportIDs(ids...)
+	return uuo
+}
+
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (uuo *UserUpdateOne) RemoveDataExports(d ...*DataExport) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDataExportIDs(ids...)
+}
+
+// ClearPersonalAccessTokens clears all "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (uuo *UserUpdateOne) ClearPersonalAccessTokens() *UserUpdateOne {
+	uuo.mutation.ClearPersonalAccessTokens()
+	return uuo
+}
+
+// RemovePersonalAccessTokenIDs removes the "personal_access_tokens" edge to PersonalAccessToken entities by IDs.
+func (uuo *UserUpdateOne) RemovePersonalAccessTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePersonalAccessTokenIDs(ids...)
+	return uuo
+}
+
+// RemovePersonalAccessTokens removes "personal_access_tokens" edges to PersonalAccessToken entities.
+func (uuo *UserUpdateOne) RemovePersonalAccessTokens(p ...*PersonalAccessToken) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePersonalAccessTokenIDs(ids...)
+}
+
+// ClearOauthConsents clears all "oauth_consents" edges to the OAuthConsent entity.
+func (uuo *UserUpdateOne) ClearOauthConsents() *UserUpdateOne {
+	uuo.mutation.ClearOauthConsents()
+	return uuo
+}
+
+// RemoveOauthConsentIDs removes the "oauth_consents" edge to OAuthConsent entities by IDs.
+func (uuo *UserUpdateOne) RemoveOauthConsentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveOauthConsentIDs(ids...)
+	return uuo
+}
+
+// RemoveOauthConsents removes "oauth_consents" edges to OAuthConsent entities.
+func (uuo *UserUpdateOne) RemoveOauthConsents(o ...*OAuthConsent) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.RemoveOauthConsentIDs(ids...)
+}
+
+// ClearOauthAuthorizationCodes clears all "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (uuo *UserUpdateOne) ClearOauthAuthorizationCodes() *UserUpdateOne {
+	uuo.mutation.ClearOauthAuthorizationCodes()
+	return uuo
+}
+
+// RemoveOauthAuthorizationCodeIDs removes the "oauth_authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (uuo *UserUpdateOne) RemoveOauthAuthorizationCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveOauthAuthorizationCodeIDs(ids...)
+	return uuo
+}
+
+// RemoveOauthAuthorizationCodes removes "oauth_authorization_codes" edges to OAuthAuthorizationCode entities.
+func (uuo *UserUpdateOne) RemoveOauthAuthorizationCodes(o ...*OAuthAuthorizationCode) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.RemoveOauthAuthorizationCodeIDs(ids...)
+}
+
+// ClearDeviceAuthorizations clears all "device_authorizations" edges to the DeviceAuthorization entity.
+func (uuo *UserUpdateOne) ClearDeviceAuthorizations() *UserUpdateOne {
+	uuo.mutation.ClearDeviceAuthorizations()
+	return uuo
+}
+
+// RemoveDeviceAuthorizationIDs removes the "device_authorizations" edge to DeviceAuthorization entities by IDs.
+func (uuo *UserUpdateOne) RemoveDeviceAuthorizationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDeviceAuthorizationIDs(ids...)
+	return uuo
+}
+
+// RemoveDeviceAuthorizations removes "device_authorizations" edges to DeviceAuthorization entities.
+func (uuo *UserUpdateOne) RemoveDeviceAuthorizations(d ...*DeviceAuthorization) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDeviceAuthorizationIDs(ids...)
+}
+
+// ClearQrLoginRequests clears all "qr_login_requests" edges to the QRLoginRequest entity.
+func (uuo *UserUpdateOne) ClearQrLoginRequests() *UserUpdateOne {
+	uuo.mutation.ClearQrLoginRequests()
+	return uuo
+}
+
+// RemoveQrLoginRequestIDs removes the "qr_login_requests" edge to QRLoginRequest entities by IDs.
+func (uuo *UserUpdateOne) RemoveQrLoginRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveQrLoginRequestIDs(ids...)
+	return uuo
+}
+
+// RemoveQrLoginRequests removes "qr_login_requests" edges to QRLoginRequest entities.
+func (uuo *UserUpdateOne) RemoveQrLoginRequests(q ...*QRLoginRequest) *UserUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.RemoveQrLoginRequestIDs(ids...)
+}
+
+// ClearPhoneChangeRequests clears all "phone_change_requests" edges to the PhoneChangeRequest entity.
+func (uuo *UserUpdateOne) ClearPhoneChangeRequests() *UserUpdateOne {
+	uuo.mutation.ClearPhoneChangeRequests()
+	return uuo
+}
+
+// RemovePhoneChangeRequestIDs removes the "phone_change_requests" edge to PhoneChangeRequest entities by IDs.
+func (uuo *UserUpdateOne) RemovePhoneChangeRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePhoneChangeRequestIDs(ids...)
+	return uuo
+}
+
+// RemovePhoneChangeRequests removes "phone_change_requests" edges to PhoneChangeRequest entities.
+func (uuo *UserUpdateOne) RemovePhoneChangeRequests(p ...*PhoneChangeRequest) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePhoneChangeRequestIDs(ids...)
+}
+
+// ClearEmailVerifications clears all "email_verifications" edges to the EmailVerification entity.
+func (uuo *UserUpdateOne) ClearEmailVerifications() *UserUpdateOne {
+	uuo.mutation.ClearEmailVerifications()
+	return uuo
+}
+
+// RemoveEmailVerificationIDs removes the "email_verifications" edge to EmailVerification entities by IDs.
+func (uuo *UserUpdateOne) RemoveEmailVerificationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveEmailVerificationIDs(ids...)
+	return uuo
+}
+
+// RemoveEmailVerifications removes "email_verifications" edges to EmailVerification entities.
+func (uuo *UserUpdateOne) RemoveEmailVerifications(e ...*EmailVerification) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.RemoveEmailVerificationIDs(ids...)
+}
+
+// ClearUsernameHistory clears all "username_history" edges to the UsernameHistory entity.
+func (uuo *UserUpdateOne) ClearUsernameHistory() *UserUpdateOne {
+	uuo.mutation.ClearUsernameHistory()
+	return uuo
+}
+
+// RemoveUsernameHistoryIDs removes the "username_history" edge to UsernameHistory entities by IDs.
+func (uuo *UserUpdateOne) RemoveUsernameHistoryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveUsernameHistoryIDs(ids...)
+	return uuo
+}
+
+// RemoveUsernameHistory removes "username_history" edges to UsernameHistory entities.
+func (uuo *UserUpdateOne) RemoveUsernameHistory(u ...*UsernameHistory) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveUsernameHistoryIDs(ids...)
+}
+
+// ClearFollowing clears all "following" edges to the Follow entity.
+func (uuo *UserUpdateOne) ClearFollowing() *UserUpdateOne {
+	uuo.mutation.ClearFollowing()
+	return uuo
+}
+
+// RemoveFollowingIDs removes the "following" edge to Follow entities by IDs.
+func (uuo *UserUpdateOne) RemoveFollowingIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFollowingIDs(ids...)
+	return uuo
+}
+
+// RemoveFollowing removes "following" edges to Follow entities.
+func (uuo *UserUpdateOne) RemoveFollowing(f ...*Follow) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFollowingIDs(ids...)
+}
+
+// ClearFollowers clears all "followers" edges to the Follow entity.
+func (uuo *UserUpdateOne) ClearFollowers() *UserUpdateOne {
+	uuo.mutation.ClearFollowers()
+	return uuo
+}
+
+// RemoveFollowerIDs removes the "followers" edge to Follow entities by IDs.
+func (uuo *UserUpdateOne) RemoveFollowerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFollowerIDs(ids...)
+	return uuo
+}
+
+// RemoveFollowers removes "followers" edges to Follow entities.
+func (uuo *UserUpdateOne) RemoveFollowers(f ...*Follow) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFollowerIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the Block entity.
+func (uuo *UserUpdateOne) ClearBlocks() *UserUpdateOne {
+	uuo.mutation.ClearBlocks()
+	return uuo
+}
+
+// RemoveBlockIDs removes the "blocks" edge to Block entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveBlockIDs(ids...)
+	return uuo
+}
+
+// RemoveBlocks removes "blocks" edges to Block entities.
+func (uuo *UserUpdateOne) RemoveBlocks(b ...*Block) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Block entity.
+func (uuo *UserUpdateOne) ClearBlockedBy() *UserUpdateOne {
+	uuo.mutation.ClearBlockedBy()
+	return uuo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Block entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedByIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedByIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Block entities.
+func (uuo *UserUpdateOne) RemoveBlockedBy(b ...*Block) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockedByIDs(ids...)
+}
+
+// ClearMutes clears all "mutes" edges to the Mute entity.
+func (uuo *UserUpdateOne) ClearMutes() *UserUpdateOne {
+	uuo.mutation.ClearMutes()
+	return uuo
+}
+
+// RemoveMuteIDs removes the "mutes" edge to Mute entities by IDs.
+func (uuo *UserUpdateOne) RemoveMuteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMuteIDs(ids...)
+	return uuo
+}
+
+// RemoveMutes removes "mutes" edges to Mute entities.
+func (uuo *UserUpdateOne) RemoveMutes(m ...*Mute) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMuteIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the Mute entity.
+func (uuo *UserUpdateOne) ClearMutedBy() *UserUpdateOne {
+	uuo.mutation.ClearMutedBy()
+	return uuo
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to Mute entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutedByIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMutedByIDs(ids...)
+	return uuo
+}
+
+// RemoveMutedBy removes "muted_by" edges to Mute entities.
+func (uuo *UserUpdateOne) RemoveMutedBy(m ...*Mute) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMutedByIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	uuo.defaults()
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdateTime(); !ok {
+		v := user.UpdateDefaultUpdateTime()
+		uuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.AuthID(); ok {
+		if err := user.AuthIDValidator(v); err != nil {
+			return &ValidationError{Name: "auth_id", err: fmt.Errorf(`ent: validator failed for field "User.auth_id": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.UsernameNormalized(); ok {
+		if err := user.UsernameNormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "username_normalized", err: fmt.Errorf(`ent: validator failed for field "User.username_normalized": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.PhoneNumber(); ok {
+		if err := user.PhoneNumberValidator(v); err != nil {
+			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "User.phone_number": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.DisplayName(); ok {
+		if err := user.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "User.locale": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.FollowerCount(); ok {
+		if err := user.FollowerCountValidator(v); err != nil {
+			return &ValidationError{Name: "follower_count", err: fmt.Errorf(`ent: validator failed for field "User.follower_count": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.FollowingCount(); ok {
+		if err := user.FollowingCountValidator(v); err != nil {
+			return &ValidationError{Name: "following_count", err: fmt.Errorf(`ent: validator failed for field "User.following_count": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Timezone(); ok {
+		if err := user.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "User.timezone": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uuo *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	uuo.modifiers = append(uuo.modifiers, modifiers...)
+	return uuo
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.UpdateTime(); ok {
+		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.AuthID(); ok {
+		_spec.SetField(user.FieldAuthID, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if uuo.mutation.UsernameCleared() {
+		_spec.ClearField(user.FieldUsername, field.TypeString)
+	}
+	if value, ok := uuo.mutation.UsernameNormalized(); ok {
+		_spec.SetField(user.FieldUsernameNormalized, field.TypeString, value)
+	}
+	if uuo.mutation.UsernameNormalizedCleared() {
+		_spec.ClearField(user.FieldUsernameNormalized, field.TypeString)
+	}
+	if value, ok := uuo.mutation.UsernameChangedAt(); ok {
+		_spec.SetField(user.FieldUsernameChangedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.UsernameChangedAtCleared() {
+		_spec.ClearField(user.FieldUsernameChangedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if uuo.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := uuo.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(user.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.PhoneNumber(); ok {
+		_spec.SetField(user.FieldPhoneNumber, field.TypeString, value)
+	}
+	if uuo.mutation.PhoneNumberCleared() {
+		_spec.ClearField(user.FieldPhoneNumber, field.TypeString)
+	}
+	if value, ok := uuo.mutation.FirstName(); ok {
+		_spec.SetField(user.FieldFirstName, field.TypeString, value)
+	}
+	if uuo.mutation.FirstNameCleared() {
+		_spec.ClearField(user.FieldFirstName, field.TypeString)
+	}
+	if value, ok := uuo.mutation.LastName(); ok {
+		_spec.SetField(user.FieldLastName, field.TypeString, value)
+	}
+	if uuo.mutation.LastNameCleared() {
+		_spec.ClearField(user.FieldLastName, field.TypeString)
+	}
+	if value, ok := uuo.mutation.DisplayName(); ok {
+		_spec.SetField(user.FieldDisplayName, field.TypeString, value)
+	}
+	if uuo.mutation.DisplayNameCleared() {
+		_spec.ClearField(user.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if uuo.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Birthday(); ok {
+		_spec.SetField(user.FieldBirthday, field.TypeTime, value)
+	}
+	if uuo.mutation.BirthdayCleared() {
+		_spec.ClearField(user.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if uuo.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
+	if value, ok := uuo.mutation.AvatarKey(); ok {
+		_spec.SetField(user.FieldAvatarKey, field.TypeString, value)
+	}
+	if uuo.mutation.AvatarKeyCleared() {
+		_spec.ClearField(user.FieldAvatarKey, field.TypeString)
+	}
+	if value, ok := uuo.mutation.AvatarUrls(); ok {
+		_spec.SetField(user.FieldAvatarUrls, field.TypeJSON, value)
+	}
+	if uuo.mutation.AvatarUrlsCleared() {
+		_spec.ClearField(user.FieldAvatarUrls, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeJSON, value)
+	}
+	if uuo.mutation.ProfileVisibilityCleared() {
+		_spec.ClearField(user.FieldProfileVisibility, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.Settings(); ok {
+		_spec.SetField(user.FieldSettings, field.TypeJSON, value)
+	}
+	if uuo.mutation.SettingsCleared() {
+		_spec.ClearField(user.FieldSettings, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.Private(); ok {
+		_spec.SetField(user.FieldPrivate, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.FollowerCount(); ok {
+		_spec.SetField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedFollowerCount(); ok {
+		_spec.AddField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.FollowingCount(); ok {
+		_spec.SetField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedFollowingCount(); ok {
+		_spec.AddField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if uuo.mutation.TimezoneCleared() {
+		_spec.ClearField(user.FieldTimezone, field.TypeString)
+	}
+	if value, ok := uuo.mutation.LoginCount(); ok {
+		_spec.SetField(user.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedLoginCount(); ok {
+		_spec.AddField(user.FieldLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.SuspendedUntil(); ok {
+		_spec.SetField(user.FieldSuspendedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.SuspendedUntilCleared() {
+		_spec.ClearField(user.FieldSuspendedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.RestrictionReason(); ok {
+		_spec.SetField(user.FieldRestrictionReason, field.TypeString, value)
+	}
+	if uuo.mutation.RestrictionReasonCleared() {
+		_spec.ClearField(user.FieldRestrictionReason, field.TypeString)
+	}
+	if value, ok := uuo.mutation.RestrictedBy(); ok {
+		_spec.SetField(user.FieldRestrictedBy, field.TypeString, value)
+	}
+	if uuo.mutation.RestrictedByCleared() {
+		_spec.ClearField(user.FieldRestrictedBy, field.TypeString)
+	}
+	if value, ok := uuo.mutation.RestrictedAt(); ok {
+		_spec.SetField(user.FieldRestrictedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.RestrictedAtCleared() {
+		_spec.ClearField(user.FieldRestrictedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(user.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(user.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Guest(); ok {
+		_spec.SetField(user.FieldGuest, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.GuestDeviceHash(); ok {
+		_spec.SetField(user.FieldGuestDeviceHash, field.TypeString, value)
+	}
+	if uuo.mutation.GuestDeviceHashCleared() {
+		_spec.ClearField(user.FieldGuestDeviceHash, field.TypeString)
+	}
+	if uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OtpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OtpsTable,
+			Columns: []string{user.OtpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOtpsIDs(); len(nodes) > 0 && !uuo.mutation.OtpsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OtpsTable,
+			Columns: []string{user.OtpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OtpsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OtpsTable,
+			Columns: []string{user.OtpsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRolesIDs(); len(nodes) > 0 && !uuo.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !uuo.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPersonalAccessTokensIDs(); len(nodes) > 0 && !uuo.mutation.PersonalAccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOauthConsentsIDs(); len(nodes) > 0 && !uuo.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOauthAuthorizationCodesIDs(); len(nodes) > 0 && !uuo.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DeviceAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceAuthorizationsTable,
+			Columns: []string{user.DeviceAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDeviceAuthorizationsIDs(); len(nodes) > 0 && !uuo.mutation.DeviceAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceAuthorizationsTable,
+			Columns: []string{user.DeviceAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DeviceAuthorizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceAuthorizationsTable,
+			Columns: []string{user.DeviceAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.QrLoginRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QrLoginRequestsTable,
+			Columns: []string{user.QrLoginRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedQrLoginRequestsIDs(); len(nodes) > 0 && !uuo.mutation.QrLoginRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QrLoginRequestsTable,
+			Columns: []string{user.QrLoginRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.QrLoginRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QrLoginRequestsTable,
+			Columns: []string{user.QrLoginRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrloginrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.PhoneChangeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhoneChangeRequestsTable,
+			Columns: []string{user.PhoneChangeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPhoneChangeRequestsIDs(); len(nodes) > 0 && !uuo.mutation.PhoneChangeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhoneChangeRequestsTable,
+			Columns: []string{user.PhoneChangeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PhoneChangeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PhoneChangeRequestsTable,
+			Columns: []string{user.PhoneChangeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(phonechangerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationsTable,
+			Columns: []string{user.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+	if nodes := uuo.mutation.RemovedEmailVerificationsIDs(); len(nodes) > 0 && !uuo.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationsTable,
+			Columns: []string{user.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
 		}
-	}
-	if v, ok := uuo.mutation.PhoneNumber(); ok {
-		if err := user.PhoneNumberValidator(v); err != nil {
-			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "User.phone_number": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := uuo.mutation.EmailVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationsTable,
+			Columns: []string{user.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if uuo.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.UpdateTime(); ok {
-		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
+	if nodes := uuo.mutation.RemovedUsernameHistoryIDs(); len(nodes) > 0 && !uuo.mutation.UsernameHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AuthID(); ok {
-		_spec.SetField(user.FieldAuthID, field.TypeString, value)
+	if nodes := uuo.mutation.UsernameHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsernameHistoryTable,
+			Columns: []string{user.UsernameHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	if uuo.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.UsernameCleared() {
-		_spec.ClearField(user.FieldUsername, field.TypeString)
+	if nodes := uuo.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !uuo.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if nodes := uuo.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.EmailCleared() {
-		_spec.ClearField(user.FieldEmail, field.TypeString)
+	if uuo.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.PhoneNumber(); ok {
-		_spec.SetField(user.FieldPhoneNumber, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !uuo.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.PhoneNumberCleared() {
-		_spec.ClearField(user.FieldPhoneNumber, field.TypeString)
+	if nodes := uuo.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.FirstName(); ok {
-		_spec.SetField(user.FieldFirstName, field.TypeString, value)
+	if uuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.FirstNameCleared() {
-		_spec.ClearField(user.FieldFirstName, field.TypeString)
+	if nodes := uuo.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !uuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.LastName(); ok {
-		_spec.SetField(user.FieldLastName, field.TypeString, value)
+	if nodes := uuo.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlocksTable,
+			Columns: []string{user.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.LastNameCleared() {
-		_spec.ClearField(user.FieldLastName, field.TypeString)
+	if uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.LoginCount(); ok {
-		_spec.SetField(user.FieldLoginCount, field.TypeInt, value)
+	if nodes := uuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AddedLoginCount(); ok {
-		_spec.AddField(user.FieldLoginCount, field.TypeInt, value)
+	if nodes := uuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SessionsCleared() {
+	if uuo.mutation.MutesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Table:   user.MutesTable,
+			Columns: []string{user.MutesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uuo.mutation.SessionsCleared() {
+	if nodes := uuo.mutation.RemovedMutesIDs(); len(nodes) > 0 && !uuo.mutation.MutesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Table:   user.MutesTable,
+			Columns: []string{user.MutesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -855,15 +4705,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.SessionsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MutesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Table:   user.MutesTable,
+			Columns: []string{user.MutesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -871,28 +4721,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.OtpsCleared() {
+	if uuo.mutation.MutedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OtpsTable,
-			Columns: []string{user.OtpsColumn},
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedOtpsIDs(); len(nodes) > 0 && !uuo.mutation.OtpsCleared() {
+	if nodes := uuo.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uuo.mutation.MutedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OtpsTable,
-			Columns: []string{user.OtpsColumn},
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -900,15 +4750,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.OtpsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MutedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OtpsTable,
-			Columns: []string{user.OtpsColumn},
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(otp.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -916,6 +4766,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
+)
+
+// UsernameHistory is the model entity for the UsernameHistory schema.
+type UsernameHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// UsernameNormalized holds the value of the "username_normalized" field.
+	UsernameNormalized string `json:"username_normalized,omitempty"`
+	// Only the previous owner can claim the username before this time
+	HoldUntil time.Time `json:"hold_until,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UsernameHistoryQuery when eager-loading is set.
+	Edges                 UsernameHistoryEdges `json:"edges"`
+	user_username_history *int
+	selectValues          sql.SelectValues
+}
+
+// UsernameHistoryEdges holds the relations/edges for other nodes in the graph.
+type UsernameHistoryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UsernameHistoryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsernameHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usernamehistory.FieldID:
+			values[i] = new(sql.NullInt64)
+		case usernamehistory.FieldUsername, usernamehistory.FieldUsernameNormalized:
+			values[i] = new(sql.NullString)
+		case usernamehistory.FieldCreateTime, usernamehistory.FieldHoldUntil:
+			values[i] = new(sql.NullTime)
+		case usernamehistory.ForeignKeys[0]: // user_username_history
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsernameHistory fields.
+func (uh *UsernameHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usernamehistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uh.ID = int(value.Int64)
+		case usernamehistory.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				uh.CreateTime = value.Time
+			}
+		case usernamehistory.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				uh.Username = value.String
+			}
+		case usernamehistory.FieldUsernameNormalized:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username_normalized", values[i])
+			} else if value.Valid {
+				uh.UsernameNormalized = value.String
+			}
+		case usernamehistory.FieldHoldUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_until", values[i])
+			} else if value.Valid {
+				uh.HoldUntil = value.Time
+			}
+		case usernamehistory.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_username_history", value)
+			} else if value.Valid {
+				uh.user_username_history = new(int)
+				*uh.user_username_history = int(value.Int64)
+			}
+		default:
+			uh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsernameHistory.
+// This includes values selected through modifiers, order, etc.
+func (uh *UsernameHistory) Value(name string) (ent.Value, error) {
+	return uh.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UsernameHistory entity.
+func (uh *UsernameHistory) QueryUser() *UserQuery {
+	return NewUsernameHistoryClient(uh.config).QueryUser(uh)
+}
+
+// Update returns a builder for updating this UsernameHistory.
+// Note that you need to call UsernameHistory.Unwrap() before calling this method if this UsernameHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uh *UsernameHistory) Update() *UsernameHistoryUpdateOne {
+	return NewUsernameHistoryClient(uh.config).UpdateOne(uh)
+}
+
+// Unwrap unwraps the UsernameHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uh *UsernameHistory) Unwrap() *UsernameHistory {
+	_tx, ok := uh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsernameHistory is not a transactional entity")
+	}
+	uh.config.driver = _tx.drv
+	return uh
+}
+
+// String implements the fmt.Stringer.
+func (uh *UsernameHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsernameHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uh.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(uh.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(uh.Username)
+	builder.WriteString(", ")
+	builder.WriteString("username_normalized=")
+	builder.WriteString(uh.UsernameNormalized)
+	builder.WriteString(", ")
+	builder.WriteString("hold_until=")
+	builder.WriteString(uh.HoldUntil.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsernameHistories is a parsable slice of UsernameHistory.
+type UsernameHistories []*UsernameHistory
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usernamehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shinplay/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNormalized applies equality check predicate on the "username_normalized" field. It's identical to UsernameNormalizedEQ.
+func UsernameNormalized(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldUsernameNormalized, v))
+}
+
+// HoldUntil applies equality check predicate on the "hold_until" field. It's identical to HoldUntilEQ.
+func HoldUntil(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldHoldUntil, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// UsernameNormalizedEQ applies the EQ predicate on the "username_normalized" field.
+func UsernameNormalizedEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedNEQ applies the NEQ predicate on the "username_normalized" field.
+func UsernameNormalizedNEQ(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedIn applies the In predicate on the "username_normalized" field.
+func UsernameNormalizedIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldUsernameNormalized, vs...))
+}
+
+// UsernameNormalizedNotIn applies the NotIn predicate on the "username_normalized" field.
+func UsernameNormalizedNotIn(vs ...string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldUsernameNormalized, vs...))
+}
+
+// UsernameNormalizedGT applies the GT predicate on the "username_normalized" field.
+func UsernameNormalizedGT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedGTE applies the GTE predicate on the "username_normalized" field.
+func UsernameNormalizedGTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedLT applies the LT predicate on the "username_normalized" field.
+func UsernameNormalizedLT(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedLTE applies the LTE predicate on the "username_normalized" field.
+func UsernameNormalizedLTE(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedContains applies the Contains predicate on the "username_normalized" field.
+func UsernameNormalizedContains(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContains(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedHasPrefix applies the HasPrefix predicate on the "username_normalized" field.
+func UsernameNormalizedHasPrefix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasPrefix(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedHasSuffix applies the HasSuffix predicate on the "username_normalized" field.
+func UsernameNormalizedHasSuffix(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldHasSuffix(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedEqualFold applies the EqualFold predicate on the "username_normalized" field.
+func UsernameNormalizedEqualFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEqualFold(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedContainsFold applies the ContainsFold predicate on the "username_normalized" field.
+func UsernameNormalizedContainsFold(v string) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldContainsFold(FieldUsernameNormalized, v))
+}
+
+// HoldUntilEQ applies the EQ predicate on the "hold_until" field.
+func HoldUntilEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldEQ(FieldHoldUntil, v))
+}
+
+// HoldUntilNEQ applies the NEQ predicate on the "hold_until" field.
+func HoldUntilNEQ(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNEQ(FieldHoldUntil, v))
+}
+
+// HoldUntilIn applies the In predicate on the "hold_until" field.
+func HoldUntilIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldIn(FieldHoldUntil, vs...))
+}
+
+// HoldUntilNotIn applies the NotIn predicate on the "hold_until" field.
+func HoldUntilNotIn(vs ...time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldNotIn(FieldHoldUntil, vs...))
+}
+
+// HoldUntilGT applies the GT predicate on the "hold_until" field.
+func HoldUntilGT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGT(FieldHoldUntil, v))
+}
+
+// HoldUntilGTE applies the GTE predicate on the "hold_until" field.
+func HoldUntilGTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldGTE(FieldHoldUntil, v))
+}
+
+// HoldUntilLT applies the LT predicate on the "hold_until" field.
+func HoldUntilLT(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLT(FieldHoldUntil, v))
+}
+
+// HoldUntilLTE applies the LTE predicate on the "hold_until" field.
+func HoldUntilLTE(v time.Time) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.FieldLTE(FieldHoldUntil, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UsernameHistory {
+	return predicate.UsernameHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UsernameHistory {
+	return predicate.UsernameHistory(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsernameHistory) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsernameHistory) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsernameHistory) predicate.UsernameHistory {
+	return predicate.UsernameHistory(sql.NotPredicates(p))
+}
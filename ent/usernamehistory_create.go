@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
+)
+
+// UsernameHistoryCreate is the builder for creating a UsernameHistory entity.
+type UsernameHistoryCreate struct {
+	config
+	mutation *UsernameHistoryMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (uhc *UsernameHistoryCreate) SetCreateTime(t time.Time) *UsernameHistoryCreate {
+	uhc.mutation.SetCreateTime(t)
+	return uhc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (uhc *UsernameHistoryCreate) SetNillableCreateTime(t *time.Time) *UsernameHistoryCreate {
+	if t != nil {
+		uhc.SetCreateTime(*t)
+	}
+	return uhc
+}
+
+// SetUsername sets the "username" field.
+func (uhc *UsernameHistoryCreate) SetUsername(s string) *UsernameHistoryCreate {
+	uhc.mutation.SetUsername(s)
+	return uhc
+}
+
+// SetUsernameNormalized sets the "username_normalized" field.
+func (uhc *UsernameHistoryCreate) SetUsernameNormalized(s string) *UsernameHistoryCreate {
+	uhc.mutation.SetUsernameNormalized(s)
+	return uhc
+}
+
+// SetHoldUntil sets the "hold_until" field.
+func (uhc *UsernameHistoryCreate) SetHoldUntil(t time.Time) *UsernameHistoryCreate {
+	uhc.mutation.SetHoldUntil(t)
+	return uhc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (uhc *UsernameHistoryCreate) SetUserID(id int) *UsernameHistoryCreate {
+	uhc.mutation.SetUserID(id)
+	return uhc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uhc *UsernameHistoryCreate) SetUser(u *User) *UsernameHistoryCreate {
+	return uhc.SetUserID(u.ID)
+}
+
+// Mutation returns the UsernameHistoryMutation object of the builder.
+func (uhc *UsernameHistoryCreate) Mutation() *UsernameHistoryMutation {
+	return uhc.mutation
+}
+
+// Save creates the UsernameHistory in the database.
+func (uhc *UsernameHistoryCreate) Save(ctx context.Context) (*UsernameHistory, error) {
+	uhc.defaults()
+	return withHooks(ctx, uhc.sqlSave, uhc.mutation, uhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uhc *UsernameHistoryCreate) SaveX(ctx context.Context) *UsernameHistory {
+	v, err := uhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uhc *UsernameHistoryCreate) Exec(ctx context.Context) error {
+	_, err := uhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhc *UsernameHistoryCreate) ExecX(ctx context.Context) {
+	if err := uhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uhc *UsernameHistoryCreate) defaults() {
+	if _, ok := uhc.mutation.CreateTime(); !ok {
+		v := usernamehistory.DefaultCreateTime()
+		uhc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uhc *UsernameHistoryCreate) check() error {
+	if _, ok := uhc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "UsernameHistory.create_time"`)}
+	}
+	if _, ok := uhc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "UsernameHistory.username"`)}
+	}
+	if v, ok := uhc.mutation.Username(); ok {
+		if err := usernamehistory.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsernameHistory.username": %w`, err)}
+		}
+	}
+	if _, ok := uhc.mutation.UsernameNormalized(); !ok {
+		return &ValidationError{Name: "username_normalized", err: errors.New(`ent: missing required field "UsernameHistory.username_normalized"`)}
+	}
+	if v, ok := uhc.mutation.UsernameNormalized(); ok {
+		if err := usernamehistory.UsernameNormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "username_normalized", err: fmt.Errorf(`ent: validator failed for field "UsernameHistory.username_normalized": %w`, err)}
+		}
+	}
+	if _, ok := uhc.mutation.HoldUntil(); !ok {
+		return &ValidationError{Name: "hold_until", err: errors.New(`ent: missing required field "UsernameHistory.hold_until"`)}
+	}
+	if len(uhc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UsernameHistory.user"`)}
+	}
+	return nil
+}
+
+func (uhc *UsernameHistoryCreate) sqlSave(ctx context.Context) (*UsernameHistory, error) {
+	if err := uhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	uhc.mutation.id = &_node.ID
+	uhc.mutation.done = true
+	return _node, nil
+}
+
+func (uhc *UsernameHistoryCreate) createSpec() (*UsernameHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsernameHistory{config: uhc.config}
+		_spec = sqlgraph.NewCreateSpec(usernamehistory.Table, sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt))
+	)
+	if value, ok := uhc.mutation.CreateTime(); ok {
+		_spec.SetField(usernamehistory.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := uhc.mutation.Username(); ok {
+		_spec.SetField(usernamehistory.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := uhc.mutation.UsernameNormalized(); ok {
+		_spec.SetField(usernamehistory.FieldUsernameNormalized, field.TypeString, value)
+		_node.UsernameNormalized = value
+	}
+	if value, ok := uhc.mutation.HoldUntil(); ok {
+		_spec.SetField(usernamehistory.FieldHoldUntil, field.TypeTime, value)
+		_node.HoldUntil = value
+	}
+	if nodes := uhc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernamehistory.UserTable,
+			Columns: []string{usernamehistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_username_history = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UsernameHistoryCreateBulk is the builder for creating many UsernameHistory entities in bulk.
+type UsernameHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*UsernameHistoryCreate
+}
+
+// Save creates the UsernameHistory entities in the database.
+func (uhcb *UsernameHistoryCreateBulk) Save(ctx context.Context) ([]*UsernameHistory, error) {
+	if uhcb.err != nil {
+		return nil, uhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uhcb.builders))
+	nodes := make([]*UsernameHistory, len(uhcb.builders))
+	mutators := make([]Mutator, len(uhcb.builders))
+	for i := range uhcb.builders {
+		func(i int, root context.Context) {
+			builder := uhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsernameHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uhcb *UsernameHistoryCreateBulk) SaveX(ctx context.Context) []*UsernameHistory {
+	v, err := uhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uhcb *UsernameHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := uhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhcb *UsernameHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := uhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
+)
+
+// UsernameHistoryQuery is the builder for querying UsernameHistory entities.
+type UsernameHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []usernamehistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UsernameHistory
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UsernameHistoryQuery builder.
+func (uhq *UsernameHistoryQuery) Where(ps ...predicate.UsernameHistory) *UsernameHistoryQuery {
+	uhq.predicates = append(uhq.predicates, ps...)
+	return uhq
+}
+
+// Limit the number of records to be returned by this query.
+func (uhq *UsernameHistoryQuery) Limit(limit int) *UsernameHistoryQuery {
+	uhq.ctx.Limit = &limit
+	return uhq
+}
+
+// Offset to start from.
+func (uhq *UsernameHistoryQuery) Offset(offset int) *UsernameHistoryQuery {
+	uhq.ctx.Offset = &offset
+	return uhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (uhq *UsernameHistoryQuery) Unique(unique bool) *UsernameHistoryQuery {
+	uhq.ctx.Unique = &unique
+	return uhq
+}
+
+// Order specifies how the records should be ordered.
+func (uhq *UsernameHistoryQuery) Order(o ...usernamehistory.OrderOption) *UsernameHistoryQuery {
+	uhq.order = append(uhq.order, o...)
+	return uhq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (uhq *UsernameHistoryQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: uhq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uhq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uhq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usernamehistory.Table, usernamehistory.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usernamehistory.UserTable, usernamehistory.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uhq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UsernameHistory entity from the query.
+// Returns a *NotFoundError when no UsernameHistory was found.
+func (uhq *UsernameHistoryQuery) First(ctx context.Context) (*UsernameHistory, error) {
+	nodes, err := uhq.Limit(1).All(setContextOp(ctx, uhq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usernamehistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) FirstX(ctx context.Context) *UsernameHistory {
+	node, err := uhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UsernameHistory ID from the query.
+// Returns a *NotFoundError when no UsernameHistory ID was found.
+func (uhq *UsernameHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uhq.Limit(1).IDs(setContextOp(ctx, uhq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usernamehistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := uhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UsernameHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UsernameHistory entity is found.
+// Returns a *NotFoundError when no UsernameHistory entities are found.
+func (uhq *UsernameHistoryQuery) Only(ctx context.Context) (*UsernameHistory, error) {
+	nodes, err := uhq.Limit(2).All(setContextOp(ctx, uhq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usernamehistory.Label}
+	default:
+		return nil, &NotSingularError{usernamehistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) OnlyX(ctx context.Context) *UsernameHistory {
+	node, err := uhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UsernameHistory ID in the query.
+// Returns a *NotSingularError when more than one UsernameHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uhq *UsernameHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uhq.Limit(2).IDs(setContextOp(ctx, uhq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usernamehistory.Label}
+	default:
+		err = &NotSingularError{usernamehistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := uhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UsernameHistories.
+func (uhq *UsernameHistoryQuery) All(ctx context.Context) ([]*UsernameHistory, error) {
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryAll)
+	if err := uhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UsernameHistory, *UsernameHistoryQuery]()
+	return withInterceptors[[]*UsernameHistory](ctx, uhq, qr, uhq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) AllX(ctx context.Context) []*UsernameHistory {
+	nodes, err := uhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UsernameHistory IDs.
+func (uhq *UsernameHistoryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if uhq.ctx.Unique == nil && uhq.path != nil {
+		uhq.Unique(true)
+	}
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryIDs)
+	if err = uhq.Select(usernamehistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := uhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (uhq *UsernameHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryCount)
+	if err := uhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, uhq, querierCount[*UsernameHistoryQuery](), uhq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) CountX(ctx context.Context) int {
+	count, err := uhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (uhq *UsernameHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryExist)
+	switch _, err := uhq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (uhq *UsernameHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := uhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UsernameHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (uhq *UsernameHistoryQuery) Clone() *UsernameHistoryQuery {
+	if uhq == nil {
+		return nil
+	}
+	return &UsernameHistoryQuery{
+		config:     uhq.config,
+		ctx:        uhq.ctx.Clone(),
+		order:      append([]usernamehistory.OrderOption{}, uhq.order...),
+		inters:     append([]Interceptor{}, uhq.inters...),
+		predicates: append([]predicate.UsernameHistory{}, uhq.predicates...),
+		withUser:   uhq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       uhq.sql.Clone(),
+		path:      uhq.path,
+		modifiers: append([]func(*sql.Selector){}, uhq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (uhq *UsernameHistoryQuery) WithUser(opts ...func(*UserQuery)) *UsernameHistoryQuery {
+	query := (&UserClient{config: uhq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uhq.withUser = query
+	return uhq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UsernameHistory.Query().
+//		GroupBy(usernamehistory.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uhq *UsernameHistoryQuery) GroupBy(field string, fields ...string) *UsernameHistoryGroupBy {
+	uhq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UsernameHistoryGroupBy{build: uhq}
+	grbuild.flds = &uhq.ctx.Fields
+	grbuild.label = usernamehistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.UsernameHistory.Query().
+//		Select(usernamehistory.FieldCreateTime).
+//		Scan(ctx, &v)
+func (uhq *UsernameHistoryQuery) Select(fields ...string) *UsernameHistorySelect {
+	uhq.ctx.Fields = append(uhq.ctx.Fields, fields...)
+	sbuild := &UsernameHistorySelect{UsernameHistoryQuery: uhq}
+	sbuild.label = usernamehistory.Label
+	sbuild.flds, sbuild.scan = &uhq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UsernameHistorySelect configured with the given aggregations.
+func (uhq *UsernameHistoryQuery) Aggregate(fns ...AggregateFunc) *UsernameHistorySelect {
+	return uhq.Select().Aggregate(fns...)
+}
+
+func (uhq *UsernameHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range uhq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uhq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range uhq.ctx.Fields {
+		if !usernamehistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if uhq.path != nil {
+		prev, err := uhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		uhq.sql = prev
+	}
+	return nil
+}
+
+func (uhq *UsernameHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UsernameHistory, error) {
+	var (
+		nodes       = []*UsernameHistory{}
+		withFKs     = uhq.withFKs
+		_spec       = uhq.querySpec()
+		loadedTypes = [1]bool{
+			uhq.withUser != nil,
+		}
+	)
+	if uhq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, usernamehistory.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UsernameHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UsernameHistory{config: uhq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(uhq.modifiers) > 0 {
+		_spec.Modifiers = uhq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, uhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := uhq.withUser; query != nil {
+		if err := uhq.loadUser(ctx, query, nodes, nil,
+			func(n *UsernameHistory, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (uhq *UsernameHistoryQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UsernameHistory, init func(*UsernameHistory), assign func(*UsernameHistory, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UsernameHistory)
+	for i := range nodes {
+		if nodes[i].user_username_history == nil {
+			continue
+		}
+		fk := *nodes[i].user_username_history
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_username_history" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (uhq *UsernameHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := uhq.querySpec()
+	if len(uhq.modifiers) > 0 {
+		_spec.Modifiers = uhq.modifiers
+	}
+	_spec.Node.Columns = uhq.ctx.Fields
+	if len(uhq.ctx.Fields) > 0 {
+		_spec.Unique = uhq.ctx.Unique != nil && *uhq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, uhq.driver, _spec)
+}
+
+func (uhq *UsernameHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usernamehistory.Table, usernamehistory.Columns, sqlgraph.NewFieldSpec(usernamehistory.FieldID, field.TypeInt))
+	_spec.From = uhq.sql
+	if unique := uhq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if uhq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := uhq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernamehistory.FieldID)
+		for i := range fields {
+			if fields[i] != usernamehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := uhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := uhq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := uhq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := uhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (uhq *UsernameHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(uhq.driver.Dialect())
+	t1 := builder.Table(usernamehistory.Table)
+	columns := uhq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usernamehistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if uhq.sql != nil {
+		selector = uhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uhq.ctx.Unique != nil && *uhq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range uhq.modifiers {
+		m(selector)
+	}
+	for _, p := range uhq.predicates {
+		p(selector)
+	}
+	for _, p := range uhq.order {
+		p(selector)
+	}
+	if offset := uhq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := uhq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uhq *UsernameHistoryQuery) ForUpdate(opts ...sql.LockOption) *UsernameHistoryQuery {
+	if uhq.driver.Dialect() == dialect.Postgres {
+		uhq.Unique(false)
+	}
+	uhq.modifiers = append(uhq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uhq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uhq *UsernameHistoryQuery) ForShare(opts ...sql.LockOption) *UsernameHistoryQuery {
+	if uhq.driver.Dialect() == dialect.Postgres {
+		uhq.Unique(false)
+	}
+	uhq.modifiers = append(uhq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uhq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uhq *UsernameHistoryQuery) Modify(modifiers ...func(s *sql.Selector)) *UsernameHistorySelect {
+	uhq.modifiers = append(uhq.modifiers, modifiers...)
+	return uhq.Select()
+}
+
+// UsernameHistoryGroupBy is the group-by builder for UsernameHistory entities.
+type UsernameHistoryGroupBy struct {
+	selector
+	build *UsernameHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uhgb *UsernameHistoryGroupBy) Aggregate(fns ...AggregateFunc) *UsernameHistoryGroupBy {
+	uhgb.fns = append(uhgb.fns, fns...)
+	return uhgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uhgb *UsernameHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uhgb.build.ctx, ent.OpQueryGroupBy)
+	if err := uhgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsernameHistoryQuery, *UsernameHistoryGroupBy](ctx, uhgb.build, uhgb, uhgb.build.inters, v)
+}
+
+func (uhgb *UsernameHistoryGroupBy) sqlScan(ctx context.Context, root *UsernameHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(uhgb.fns))
+	for _, fn := range uhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*uhgb.flds)+len(uhgb.fns))
+		for _, f := range *uhgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*uhgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uhgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UsernameHistorySelect is the builder for selecting fields of UsernameHistory entities.
+type UsernameHistorySelect struct {
+	*UsernameHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uhs *UsernameHistorySelect) Aggregate(fns ...AggregateFunc) *UsernameHistorySelect {
+	uhs.fns = append(uhs.fns, fns...)
+	return uhs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uhs *UsernameHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uhs.ctx, ent.OpQuerySelect)
+	if err := uhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsernameHistoryQuery, *UsernameHistorySelect](ctx, uhs.UsernameHistoryQuery, uhs, uhs.inters, v)
+}
+
+func (uhs *UsernameHistorySelect) sqlScan(ctx context.Context, root *UsernameHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uhs.fns))
+	for _, fn := range uhs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uhs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uhs *UsernameHistorySelect) Modify(modifiers ...func(s *sql.Selector)) *UsernameHistorySelect {
+	uhs.modifiers = append(uhs.modifiers, modifiers...)
+	return uhs
+}
@@ -0,0 +1,374 @@
+package admin
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+type AdminHandlerIntr interface {
+	SearchUsers(ctx *fiber.Ctx) error
+	GetUser(ctx *fiber.Ctx) error
+	GetUserSessions(ctx *fiber.Ctx) error
+	GetUserOTPs(ctx *fiber.Ctx) error
+	UpdateUser(ctx *fiber.Ctx) error
+	RevokeUserSessions(ctx *fiber.Ctx) error
+	SuspendUser(ctx *fiber.Ctx) error
+	BanUser(ctx *fiber.Ctx) error
+	LiftRestriction(ctx *fiber.Ctx) error
+}
+
+type AdminHandler struct {
+	adminService *AdminService
+	auditService *audit.AuditService
+	config       *config.Config
+}
+
+func NewAdminHandler(adminService *AdminService, auditService *audit.AuditService, config *config.Config) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+// UserDetail is the admin view of a user, it keeps zero values such as an
+// untouched login_count visible.
+type UserDetail struct {
+	AuthID          string             `json:"auth_id"`
+	PhoneNumber     string             `json:"phone_number"`
+	UserName        string             `json:"username"`
+	Email           string             `json:"email"`
+	EmailVerifiedAt *time.Time         `json:"email_verified_at"`
+	FirstName       string             `json:"first_name"`
+	LastName        string             `json:"last_name"`
+	LoginCount      int                `json:"login_count"`
+	Status          string             `json:"status"`
+	Restriction     *RestrictionDetail `json:"restriction,omitempty"`
+	CreateTime      time.Time          `json:"create_time"`
+	UpdateTime      time.Time          `json:"update_time"`
+}
+
+type RestrictionDetail struct {
+	Reason       string     `json:"reason"`
+	Until        *time.Time `json:"until"`
+	RestrictedBy string     `json:"restricted_by"`
+	RestrictedAt *time.Time `json:"restricted_at"`
+}
+
+func NewUserDetail(u *ent.User) UserDetail {
+	detail := UserDetail{
+		AuthID:          u.AuthID,
+		PhoneNumber:     u.PhoneNumber,
+		UserName:        u.Username,
+		Email:           u.Email,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		FirstName:       u.FirstName,
+		LastName:        u.LastName,
+		LoginCount:      u.LoginCount,
+		Status:          u.Status.String(),
+		CreateTime:      u.CreateTime,
+		UpdateTime:      u.UpdateTime,
+	}
+
+	if u.RestrictedAt != nil {
+		detail.Restriction = &RestrictionDetail{
+			Reason:       u.RestrictionReason,
+			Until:        u.SuspendedUntil,
+			RestrictedBy: u.RestrictedBy,
+			RestrictedAt: u.RestrictedAt,
+		}
+	}
+
+	return detail
+}
+
+// SessionDetail never exposes the session cookie value or the refresh token.
+type SessionDetail struct {
+	ID         int       `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreateTime time.Time `json:"create_time"`
+	UpdateTime time.Time `json:"update_time"`
+}
+
+// OTPDetail never exposes the OTP code.
+type OTPDetail struct {
+	ID         int       `json:"id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreateTime time.Time `json:"create_time"`
+}
+
+type SearchQuery struct {
+	PhoneNumber string `query:"phone_number"`
+	Email       string `query:"email"`
+	Username    string `query:"username"`
+	AuthID      string `query:"auth_id"`
+}
+
+func (h *AdminHandler) SearchUsers(ctx *fiber.Ctx) error {
+	query := new(SearchQuery)
+	if err := ctx.QueryParser(query); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid search query",
+		})
+	}
+
+	filter := user.SearchFilter(*query)
+	if filter == (user.SearchFilter{}) {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide phone_number, email, username or auth_id",
+		})
+	}
+
+	users, err := h.adminService.SearchUsers(filter)
+	if err != nil {
+		return h.internalError(ctx, err)
+	}
+
+	results := make([]UserDetail, 0, len(users))
+	for _, u := range users {
+		results = append(results, NewUserDetail(u))
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   results,
+	})
+}
+
+func (h *AdminHandler) GetUser(ctx *fiber.Ctx) error {
+	u, err := h.adminService.GetUser(ctx.Params("authId"))
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   NewUserDetail(u),
+	})
+}
+
+func (h *AdminHandler) GetUserSessions(ctx *fiber.Ctx) error {
+	sessions, err := h.adminService.GetUserSessions(ctx.Params("authId"))
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	results := make([]SessionDetail, 0, len(sessions))
+	for _, s := range sessions {
+		results = append(results, SessionDetail{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			ExpiresAt:  s.ExpiresAt,
+			CreateTime: s.CreateTime,
+			UpdateTime: s.UpdateTime,
+		})
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   results,
+	})
+}
+
+func (h *AdminHandler) GetUserOTPs(ctx *fiber.Ctx) error {
+	otps, err := h.adminService.GetUserOTPs(ctx.Params("authId"))
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	results := make([]OTPDetail, 0, len(otps))
+	for _, o := range otps {
+		results = append(results, OTPDetail{
+			ID:         o.ID,
+			ExpiresAt:  o.ExpiresAt,
+			CreateTime: o.CreateTime,
+		})
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   results,
+	})
+}
+
+func (h *AdminHandler) UpdateUser(ctx *fiber.Ctx) error {
+	changes := new(user.UserChanges)
+	if err := ctx.BodyParser(changes); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body",
+		})
+	}
+
+	u, err := h.adminService.UpdateUser(ctx.Params("authId"), *changes)
+	if err != nil {
+		if code, message, ok := user.UsernameErrorOf(err); ok {
+			status := fiber.StatusConflict
+			if code == "invalid_username" {
+				status = fiber.StatusBadRequest
+			}
+			return ctx.Status(status).JSON(fiber.Map{
+				"status":  "error",
+				"code":    code,
+				"message": message,
+			})
+		}
+		if ent.IsConstraintError(err) {
+			return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Username, email or phone number already in use",
+			})
+		}
+		if ent.IsValidationError(err) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"status":  "error",
+				"message": err.Error(),
+			})
+		}
+		return h.lookupError(ctx, err)
+	}
+
+	h.recordAdminAction(ctx, audit.EventAdminUserUpdated, u, map[string]any{"changes": changes})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "User updated successfully",
+		"data":    NewUserDetail(u),
+	})
+}
+
+func (h *AdminHandler) RevokeUserSessions(ctx *fiber.Ctx) error {
+	u, revoked, err := h.adminService.RevokeUserSessions(ctx.Params("authId"))
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	h.recordAdminAction(ctx, audit.EventAdminSessionsRevoked, u, map[string]any{"revoked": revoked})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "User logged out of all sessions",
+		"data": fiber.Map{
+			"revoked": revoked,
+		},
+	})
+}
+
+type RestrictBody struct {
+	Reason string    `json:"reason" xml:"reason" form:"reason"`
+	Until  time.Time `json:"until" xml:"until" form:"until"`
+}
+
+func (h *AdminHandler) SuspendUser(ctx *fiber.Ctx) error {
+	body := new(RestrictBody)
+	if err := ctx.BodyParser(body); err != nil || body.Reason == "" || !body.Until.After(time.Now()) {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a reason and a future until time",
+		})
+	}
+
+	actor := ctx.Locals("user").(*ent.User)
+	u, err := h.adminService.SuspendUser(ctx.Params("authId"), actor.AuthID, body.Reason, body.Until)
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	h.recordAdminAction(ctx, audit.EventAdminUserSuspended, u, map[string]any{"reason": body.Reason, "until": body.Until})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "User suspended successfully",
+		"data":    NewUserDetail(u),
+	})
+}
+
+func (h *AdminHandler) BanUser(ctx *fiber.Ctx) error {
+	body := new(RestrictBody)
+	if err := ctx.BodyParser(body); err != nil || body.Reason == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a reason",
+		})
+	}
+
+	actor := ctx.Locals("user").(*ent.User)
+	u, err := h.adminService.BanUser(ctx.Params("authId"), actor.AuthID, body.Reason)
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	h.recordAdminAction(ctx, audit.EventAdminUserBanned, u, map[string]any{"reason": body.Reason})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "User banned successfully",
+		"data":    NewUserDetail(u),
+	})
+}
+
+func (h *AdminHandler) LiftRestriction(ctx *fiber.Ctx) error {
+	u, err := h.adminService.LiftRestriction(ctx.Params("authId"))
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	h.recordAdminAction(ctx, audit.EventAdminRestrictionLifted, u, nil)
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "User restriction lifted successfully",
+		"data":    NewUserDetail(u),
+	})
+}
+
+// recordAdminAction writes an audit event attributed to the staff member
+// making the request.
+func (h *AdminHandler) recordAdminAction(ctx *fiber.Ctx, eventType string, subject *ent.User, metadata map[string]any) {
+	actor, _ := ctx.Locals("user").(*ent.User)
+	h.auditService.Record(ctx, audit.Event{
+		Type:     eventType,
+		Actor:    actor,
+		Subject:  subject,
+		Metadata: metadata,
+	})
+}
+
+func (h *AdminHandler) lookupError(ctx *fiber.Ctx, err error) error {
+	if ent.IsNotFound(err) {
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "User not found",
+		})
+	}
+
+	if errors.Is(err, ErrAccountDeleted) {
+		return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "account_deleted",
+			"message": "The account has been deleted",
+		})
+	}
+
+	return h.internalError(ctx, err)
+}
+
+func (h *AdminHandler) internalError(ctx *fiber.Ctx, err error) error {
+	h.config.Logger.Error("Admin request failed", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Something went wrong, please try again later",
+	})
+}
@@ -0,0 +1,195 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shinplay/ent"
+	entuser "github.com/shinplay/ent/user"
+	"github.com/shinplay/internal/auth/otp"
+	"github.com/shinplay/internal/auth/session"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+const searchLimit = 50
+
+// ErrAccountDeleted is returned for changes that would bring an erased
+// account back.
+var ErrAccountDeleted = errors.New("account has been deleted")
+
+type AdminServiceIntr interface {
+	SearchUsers(filter user.SearchFilter) ([]*ent.User, error)
+	GetUser(authID string) (*ent.User, error)
+	GetUserSessions(authID string) ([]*ent.Session, error)
+	GetUserOTPs(authID string) ([]*ent.OTP, error)
+	UpdateUser(authID string, changes user.UserChanges) (*ent.User, error)
+	RevokeUserSessions(authID string) (*ent.User, int, error)
+	SuspendUser(authID string, actorAuthID string, reason string, until time.Time) (*ent.User, error)
+	BanUser(authID string, actorAuthID string, reason string) (*ent.User, error)
+	LiftRestriction(authID string) (*ent.User, error)
+}
+
+// AdminService backs the support tooling used to inspect and manage users.
+type AdminService struct {
+	userRepository    *user.UserRepository
+	userService       *user.UserService
+	sessionRepository *session.SessionRepository
+	otpRepository     *otp.OTPRepository
+	config            *config.Config
+	ctx               context.Context
+}
+
+// NewAdminService creates a new AdminService instance.
+func NewAdminService(userRepository *user.UserRepository, userService *user.UserService, sessionRepository *session.SessionRepository, otpRepository *otp.OTPRepository, config *config.Config, ctx context.Context) *AdminService {
+	return &AdminService{
+		userRepository:    userRepository,
+		userService:       userService,
+		sessionRepository: sessionRepository,
+		otpRepository:     otpRepository,
+		config:            config,
+		ctx:               ctx,
+	}
+}
+
+func (s *AdminService) SearchUsers(filter user.SearchFilter) ([]*ent.User, error) {
+	users, err := s.userRepository.Search(s.ctx, filter, searchLimit)
+	if err != nil {
+		s.config.Logger.Error("Failed to search users", zap.Any("filter", filter), zap.Error(err))
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (s *AdminService) GetUser(authID string) (*ent.User, error) {
+	user, err := s.userRepository.FindByAuthID(s.ctx, authID)
+	if err != nil {
+		s.config.Logger.Info("Failed to find user by auth ID", zap.String("authID", authID), zap.Error(err))
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *AdminService) GetUserSessions(authID string) ([]*ent.Session, error) {
+	user, err := s.GetUser(authID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sessionRepository.FindSessionsByUser(s.ctx, user.ID)
+}
+
+func (s *AdminService) GetUserOTPs(authID string) ([]*ent.OTP, error) {
+	user, err := s.GetUser(authID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.otpRepository.FindOTPsByUser(s.ctx, user.ID)
+}
+
+// UpdateUser applies changes made by support staff. A new username goes
+// through the same policy as a user's own change, without the cooldown.
+func (s *AdminService) UpdateUser(authID string, changes user.UserChanges) (*ent.User, error) {
+	u, err := s.GetUser(authID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Status == entuser.StatusDeleted {
+		return nil, ErrAccountDeleted
+	}
+
+	if changes.Username != nil {
+		u, err = s.userService.SetUsername(u, *changes.Username)
+		if err != nil {
+			s.config.Logger.Info("Failed to change username", zap.String("authID", authID), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	updated, err := s.userRepository.Update(s.ctx, u, changes)
+	if err != nil {
+		s.config.Logger.Error("Failed to update user", zap.String("authID", authID), zap.Error(err))
+		return nil, err
+	}
+
+	s.config.Logger.Info("User updated by admin", zap.String("authID", authID))
+	return updated, nil
+}
+
+// RevokeUserSessions logs the user out of every device.
+func (s *AdminService) RevokeUserSessions(authID string) (*ent.User, int, error) {
+	user, err := s.GetUser(authID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	revoked, err := s.sessionRepository.DeleteSessionsByUser(s.ctx, user.ID)
+	if err != nil {
+		s.config.Logger.Error("Failed to revoke user sessions", zap.String("authID", authID), zap.Error(err))
+		return nil, 0, err
+	}
+
+	s.config.Logger.Info("User sessions revoked by admin", zap.String("authID", authID), zap.Int("revoked", revoked))
+	return user, revoked, nil
+}
+
+// SuspendUser blocks the user until the given time and logs them out everywhere.
+func (s *AdminService) SuspendUser(authID string, actorAuthID string, reason string, until time.Time) (*ent.User, error) {
+	return s.restrict(authID, actorAuthID, entuser.StatusSuspended, &until, reason)
+}
+
+// BanUser blocks the user indefinitely and logs them out everywhere.
+func (s *AdminService) BanUser(authID string, actorAuthID string, reason string) (*ent.User, error) {
+	return s.restrict(authID, actorAuthID, entuser.StatusBanned, nil, reason)
+}
+
+func (s *AdminService) restrict(authID string, actorAuthID string, status entuser.Status, until *time.Time, reason string) (*ent.User, error) {
+	u, err := s.GetUser(authID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Status == entuser.StatusDeleted {
+		return nil, ErrAccountDeleted
+	}
+
+	restricted, err := s.userRepository.SetRestriction(s.ctx, u, status, until, reason, actorAuthID)
+	if err != nil {
+		s.config.Logger.Error("Failed to restrict user", zap.String("authID", authID), zap.Error(err))
+		return nil, err
+	}
+
+	if _, err := s.sessionRepository.DeleteSessionsByUser(s.ctx, u.ID); err != nil {
+		s.config.Logger.Error("Failed to revoke sessions of restricted user", zap.String("authID", authID), zap.Error(err))
+		return nil, err
+	}
+
+	s.config.Logger.Info("User restricted", zap.String("authID", authID), zap.String("status", status.String()), zap.String("actor", actorAuthID))
+	return restricted, nil
+}
+
+func (s *AdminService) LiftRestriction(authID string) (*ent.User, error) {
+	u, err := s.GetUser(authID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Status == entuser.StatusDeleted {
+		return nil, ErrAccountDeleted
+	}
+
+	lifted, err := s.userRepository.ClearRestriction(s.ctx, u)
+	if err != nil {
+		s.config.Logger.Error("Failed to lift restriction", zap.String("authID", authID), zap.Error(err))
+		return nil, err
+	}
+
+	s.config.Logger.Info("User restriction lifted", zap.String("authID", authID))
+	return lifted, nil
+}
@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/shinplay/ent"
+)
+
+// Event types recorded in the audit log.
+const (
+	EventOTPSent            = "auth.otp.sent"
+	EventOTPSendFailed      = "auth.otp.send_failed"
+	EventOTPVerified        = "auth.otp.verified"
+	EventOTPVerifyFailed    = "auth.otp.verify_failed"
+	EventGoogleSignIn       = "auth.google.signed_in"
+	EventGoogleSignInFailed = "auth.google.sign_in_failed"
+	EventTokenRefreshed     = "auth.token.refreshed"
+	EventTokenRefreshFailed = "auth.token.refresh_failed"
+	EventLogout             = "auth.logout"
+	EventDeviceApproved     = "auth.device.approved"
+	EventDeviceDenied       = "auth.device.denied"
+	EventDeviceSignIn       = "auth.device.signed_in"
+	EventQRLoginApproved    = "auth.qr.approved"
+	EventQRLoginDenied      = "auth.qr.denied"
+	EventQRLoginSignIn      = "auth.qr.signed_in"
+	EventGuestSignIn        = "auth.guest.signed_in"
+	EventGuestUpgraded      = "auth.guest.upgraded"
+
+	EventUsernameChanged          = "user.username.changed"
+	EventProfileUpdated           = "user.profile.updated"
+	EventAvatarUpdated            = "user.avatar.updated"
+	EventAvatarRemoved            = "user.avatar.removed"
+	EventSettingsUpdated          = "user.settings.updated"
+	EventAccountDeletionRequested = "user.deletion.requested"
+	EventPhoneChangeRequested     = "user.phone.change_requested"
+	EventPhoneChanged             = "user.phone.changed"
+	EventEmailChangeRequested     = "user.email.change_requested"
+	EventEmailChanged             = "user.email.changed"
+	EventDataExportRequested      = "user.export.requested"
+	EventAccessTokenCreated       = "user.access_token.created"
+	EventAccessTokenRevoked       = "user.access_token.revoked"
+	EventOAuthAuthorized          = "user.oauth.authorized"
+	EventOAuthConsentRevoked      = "user.oauth.consent_revoked"
+	EventUserBlocked              = "user.blocked"
+	EventUserUnblocked            = "user.unblocked"
+	EventOAuthTokenIssued         = "oauth.token.issued"
+
+	EventAdminUserUpdated           = "admin.user.updated"
+	EventAdminSessionsRevoked       = "admin.user.sessions_revoked"
+	EventAdminUserSuspended         = "admin.user.suspended"
+	EventAdminUserBanned            = "admin.user.banned"
+	EventAdminRestrictionLifted     = "admin.user.restriction_lifted"
+	EventAdminRoleAssigned          = "admin.role.assigned"
+	EventAdminRoleRevoked           = "admin.role.revoked"
+	EventAdminImpersonationStarted  = "admin.impersonation.started"
+	EventAdminImpersonationStopped  = "admin.impersonation.stopped"
+	EventAdminImpersonationRequest  = "admin.impersonation.request"
+	EventAdminRiskUnblocked         = "admin.risk.unblocked"
+	EventAdminOAuthClientRegistered = "admin.oauth_client.registered"
+)
+
+// Event describes something that happened to an account.
+type Event struct {
+	Type     string
+	Actor    *ent.User
+	Subject  *ent.User
+	Metadata map[string]any
+}
+
+// RequestMeta is the request information stored alongside an event.
+type RequestMeta struct {
+	IPAddress string
+	UserAgent string
+	RequestID string
+}
+
+// RequestMetaFrom copies the request information out of the fiber context so
+// it can still be used once the handler has returned. Values fiber reads from
+// headers point into buffers it reuses for the next request, so each one is
+// copied.
+func RequestMetaFrom(ctx *fiber.Ctx) RequestMeta {
+	requestID, _ := ctx.Locals("requestid").(string)
+
+	return RequestMeta{
+		IPAddress: utils.CopyString(ctx.IP()),
+		UserAgent: utils.CopyString(ctx.Get("User-Agent")),
+		RequestID: utils.CopyString(requestID),
+	}
+}
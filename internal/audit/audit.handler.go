@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type AuditHandlerIntr interface {
+	ListEvents(ctx *fiber.Ctx) error
+}
+
+type AuditHandler struct {
+	auditService *AuditService
+	config       *config.Config
+}
+
+func NewAuditHandler(auditService *AuditService, config *config.Config) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+type EventQuery struct {
+	User  string `query:"user"`
+	Actor string `query:"actor"`
+	Type  string `query:"type"`
+	From  string `query:"from"`
+	To    string `query:"to"`
+	Limit int    `query:"limit"`
+}
+
+type EventDetail struct {
+	ID        int            `json:"id"`
+	EventType string         `json:"event_type"`
+	Actor     string         `json:"actor,omitempty"`
+	Subject   string         `json:"subject,omitempty"`
+	IPAddress string         `json:"ip_address"`
+	UserAgent string         `json:"user_agent"`
+	RequestID string         `json:"request_id"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+func NewEventDetail(event *ent.AuditEvent) EventDetail {
+	detail := EventDetail{
+		ID:        event.ID,
+		EventType: event.EventType,
+		IPAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+		RequestID: event.RequestID,
+		Metadata:  event.Metadata,
+		CreatedAt: event.CreateTime,
+	}
+
+	if event.Edges.Actor != nil {
+		detail.Actor = event.Edges.Actor.AuthID
+	}
+	if event.Edges.Subject != nil {
+		detail.Subject = event.Edges.Subject.AuthID
+	}
+
+	return detail
+}
+
+// ListEvents returns the audit trail, filterable by subject or actor auth ID,
+// event type and a [from, to) time range given in RFC 3339.
+func (h *AuditHandler) ListEvents(ctx *fiber.Ctx) error {
+	query := new(EventQuery)
+	if err := ctx.QueryParser(query); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid query",
+		})
+	}
+
+	filter := Filter{
+		SubjectAuthID: query.User,
+		ActorAuthID:   query.Actor,
+		EventType:     query.Type,
+		Limit:         query.Limit,
+	}
+
+	var err error
+	if filter.From, err = parseTime(query.From); err == nil {
+		filter.To, err = parseTime(query.To)
+	}
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "from and to must be RFC 3339 timestamps",
+		})
+	}
+
+	events, err := h.auditService.Find(filter)
+	if err != nil {
+		h.config.Logger.Error("Failed to list audit events", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to list audit events, please try again later",
+		})
+	}
+
+	results := make([]EventDetail, 0, len(events))
+	for _, event := range events {
+		results = append(results, NewEventDetail(event))
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   results,
+	})
+}
+
+// parseTime parses an optional RFC 3339 timestamp.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/auditevent"
+	"github.com/shinplay/ent/user"
+)
+
+type AuditRepositoryIntr interface {
+	Create(ctx context.Context, meta RequestMeta, event Event) (*ent.AuditEvent, error)
+	Find(ctx context.Context, filter Filter) ([]*ent.AuditEvent, error)
+}
+
+// Filter narrows down an audit log query, zero values are ignored.
+type Filter struct {
+	SubjectID     int
+	SubjectAuthID string
+	ActorAuthID   string
+	EventType     string
+	From          time.Time
+	To            time.Time
+	Limit         int
+}
+
+type AuditRepository struct {
+	client *ent.Client
+}
+
+func NewAuditRepository(client *ent.Client) *AuditRepository {
+	return &AuditRepository{client: client}
+}
+
+func (r *AuditRepository) Create(ctx context.Context, meta RequestMeta, event Event) (*ent.AuditEvent, error) {
+	create := r.client.AuditEvent.Create().
+		SetEventType(event.Type).
+		SetIPAddress(meta.IPAddress).
+		SetUserAgent(meta.UserAgent).
+		SetRequestID(meta.RequestID)
+
+	if event.Metadata != nil {
+		create.SetMetadata(event.Metadata)
+	}
+	if event.Actor != nil {
+		create.SetActor(event.Actor)
+	}
+	if event.Subject != nil {
+		create.SetSubject(event.Subject)
+	}
+
+	return create.Save(ctx)
+}
+
+func (r *AuditRepository) Find(ctx context.Context, filter Filter) ([]*ent.AuditEvent, error) {
+	query := r.client.AuditEvent.Query().
+		WithActor().
+		WithSubject()
+
+	if filter.SubjectID != 0 {
+		query = query.Where(auditevent.HasSubjectWith(user.IDEQ(filter.SubjectID)))
+	}
+	if filter.SubjectAuthID != "" {
+		query = query.Where(auditevent.HasSubjectWith(user.AuthIDEQ(filter.SubjectAuthID)))
+	}
+	if filter.ActorAuthID != "" {
+		query = query.Where(auditevent.HasActorWith(user.AuthIDEQ(filter.ActorAuthID)))
+	}
+	if filter.EventType != "" {
+		query = query.Where(auditevent.EventTypeEQ(filter.EventType))
+	}
+	if !filter.From.IsZero() {
+		query = query.Where(auditevent.CreateTimeGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		query = query.Where(auditevent.CreateTimeLT(filter.To))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	return query.
+		Order(ent.Desc(auditevent.FieldCreateTime), ent.Desc(auditevent.FieldID)).
+		All(ctx)
+}
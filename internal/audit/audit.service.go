@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+const maxLimit = 500
+
+type AuditServiceIntr interface {
+	Record(ctx *fiber.Ctx, event Event)
+	RecordWithMeta(meta RequestMeta, event Event)
+	Find(filter Filter) ([]*ent.AuditEvent, error)
+}
+
+// AuditService persists security relevant events so they outlive log rotation.
+type AuditService struct {
+	auditRepository *AuditRepository
+	config          *config.Config
+	ctx             context.Context
+}
+
+// NewAuditService creates a new AuditService instance.
+func NewAuditService(auditRepository *AuditRepository, config *config.Config, ctx context.Context) *AuditService {
+	return &AuditService{
+		auditRepository: auditRepository,
+		config:          config,
+		ctx:             ctx,
+	}
+}
+
+// Record stores the event together with the request that caused it.
+func (s *AuditService) Record(ctx *fiber.Ctx, event Event) {
+	s.RecordWithMeta(RequestMetaFrom(ctx), event)
+}
+
+// RecordWithMeta stores the event. Failures are logged and never interrupt
+// the request being audited.
+func (s *AuditService) RecordWithMeta(meta RequestMeta, event Event) {
+	if _, err := s.auditRepository.Create(s.ctx, meta, event); err != nil {
+		s.config.Logger.Error("Failed to record audit event", zap.String("eventType", event.Type), zap.Error(err))
+	}
+}
+
+func (s *AuditService) Find(filter Filter) ([]*ent.AuditEvent, error) {
+	if filter.Limit <= 0 || filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+
+	events, err := s.auditRepository.Find(s.ctx, filter)
+	if err != nil {
+		s.config.Logger.Error("Failed to query audit events", zap.Error(err))
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -1,10 +1,18 @@
 package auth
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/auth/pat"
 	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/rbac"
+	"github.com/shinplay/internal/risk"
+	"github.com/shinplay/internal/user"
 	"go.uber.org/zap"
 )
 
@@ -13,19 +21,28 @@ type AuthHandlerIntr interface {
 	VerifyWhatsAppOTP(ctx *fiber.Ctx) error
 	GoogleOauthSignin(ctx *fiber.Ctx) error
 	AuthenticateUser(ctx *fiber.Ctx) error
+	OptionalAuthentication(ctx *fiber.Ctx) error
 	RefreshAccessToken(ctx *fiber.Ctx) error
 	Logout(ctx *fiber.Ctx) error
 }
 
 type AuthHandler struct {
-	authService *AuthService
-	config      *config.Config
+	authService  *AuthService
+	patService   *pat.PATService
+	rbacService  *rbac.RBACService
+	auditService *audit.AuditService
+	riskEngine   *risk.RiskEngine
+	config       *config.Config
 }
 
-func NewAuthHandler(authService *AuthService, config *config.Config) *AuthHandler {
+func NewAuthHandler(authService *AuthService, patService *pat.PATService, rbacService *rbac.RBACService, auditService *audit.AuditService, riskEngine *risk.RiskEngine, config *config.Config) *AuthHandler {
 	return &AuthHandler{
-		authService: authService,
-		config:      config,
+		authService:  authService,
+		patService:   patService,
+		rbacService:  rbacService,
+		auditService: auditService,
+		riskEngine:   riskEngine,
+		config:       config,
 	}
 }
 
@@ -43,7 +60,27 @@ func (h *AuthHandler) SendWhatsAppOTP(ctx *fiber.Ctx) error {
 		})
 	}
 
-	go h.authService.SendWhatsAppOTP(body.PhoneNumber) // Example OTP
+	if err := h.authService.CheckPhoneRestriction(body.PhoneNumber); err != nil {
+		if restriction, ok := restrictionOf(err); ok {
+			return restriction.Respond(ctx)
+		}
+	}
+
+	h.riskEngine.RecordOTPSend(body.PhoneNumber, ctx.IP())
+
+	meta := audit.RequestMetaFrom(ctx)
+	phoneNumber := strings.Clone(body.PhoneNumber)
+
+	go func() {
+		event := audit.Event{Type: audit.EventOTPSent, Metadata: map[string]any{"phone_number": phoneNumber}}
+		if err := h.authService.SendWhatsAppOTP(phoneNumber); err != nil {
+			event.Type = audit.EventOTPSendFailed
+			event.Metadata["error"] = err.Error()
+		}
+
+		event.Subject, _ = h.authService.FindUserByPhone(phoneNumber)
+		h.auditService.RecordWithMeta(meta, event)
+	}()
 
 	return ctx.
 		Status(fiber.StatusOK).
@@ -73,6 +110,12 @@ func (h *AuthHandler) VerifyWhatsAppOTP(ctx *fiber.Ctx) error {
 
 	if !isValid {
 		h.config.Logger.Info("Failed to verify WhatsApp OTP", zap.String("phone_number", body.PhoneNumber))
+		h.riskEngine.RecordFailure(body.PhoneNumber, ctx.IP())
+		h.auditService.Record(ctx, audit.Event{
+			Type:     audit.EventOTPVerifyFailed,
+			Subject:  user,
+			Metadata: map[string]any{"phone_number": body.PhoneNumber},
+		})
 		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"status":  "error",
 			"message": "Invalid OTP or OTP expired",
@@ -80,10 +123,16 @@ func (h *AuthHandler) VerifyWhatsAppOTP(ctx *fiber.Ctx) error {
 	}
 
 	h.config.Logger.Info("User verified successfully", zap.Any("user_id", user))
+	h.auditService.Record(ctx, audit.Event{Type: audit.EventOTPVerified, Actor: user, Subject: user})
+	h.riskEngine.RecordSuccess(body.PhoneNumber, ctx.IP())
 
 	tokens, userInfo, sessionId, err := h.authService.LoginUser(user, ctx.IP(), ctx.Get("User-Agent"))
 
 	if err != nil {
+		if restriction, ok := restrictionOf(err); ok {
+			return restriction.Respond(ctx)
+		}
+
 		h.config.Logger.Error("Failed to create session", zap.Error(err))
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"status":  "error",
@@ -124,14 +173,25 @@ func (h *AuthHandler) GoogleOauthSignin(ctx *fiber.Ctx) error {
 
 	if err != nil {
 		h.config.Logger.Error("Failed to sign in with Google", zap.Error(err))
+		h.riskEngine.RecordFailure("", ctx.IP())
+		h.auditService.Record(ctx, audit.Event{
+			Type:     audit.EventGoogleSignInFailed,
+			Metadata: map[string]any{"error": err.Error()},
+		})
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"status":  "error",
 			"message": "Failed to sign in with Google, please try again later",
 		})
 	}
 
+	h.auditService.Record(ctx, audit.Event{Type: audit.EventGoogleSignIn, Actor: user, Subject: user})
+
 	tokens, userInfo, sessionId, err := h.authService.LoginUser(user, ctx.IP(), ctx.Get("User-Agent"))
 	if err != nil {
+		if restriction, ok := restrictionOf(err); ok {
+			return restriction.Respond(ctx)
+		}
+
 		h.config.Logger.Error("Failed to create session", zap.Error(err))
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"status":  "error",
@@ -158,6 +218,11 @@ func (h *AuthHandler) GoogleOauthSignin(ctx *fiber.Ctx) error {
 }
 
 func (h *AuthHandler) AuthenticateUser(ctx *fiber.Ctx) error {
+	// already authenticated earlier in the chain, by OptionalAuthentication
+	if _, ok := ctx.Locals("user").(*ent.User); ok {
+		return ctx.Next()
+	}
+
 	h.config.Logger.Info("Authenticating user")
 
 	header := ctx.Get("Authorization")
@@ -170,7 +235,31 @@ func (h *AuthHandler) AuthenticateUser(ctx *fiber.Ctx) error {
 		})
 	}
 
-	isValid, user := h.authService.ValidateToken(token)
+	// Personal access tokens and tokens issued to third-party apps carry
+	// scopes, JWTs issued for a first-party session do not.
+	var (
+		isValid bool
+		user    *ent.User
+		scopes  []string
+	)
+	if pat.IsPersonalAccessToken(token) {
+		accessToken, owner, err := h.patService.Authenticate(token)
+		if err == nil {
+			isValid, user = true, owner
+			scopes = append([]string{}, accessToken.Scopes...)
+		} else if !errors.Is(err, pat.ErrInvalidToken) {
+			h.config.Logger.Error("Failed to authenticate personal access token", zap.Error(err))
+		}
+	} else {
+		var claims jwt.MapClaims
+		isValid, user, scopes, claims = h.authService.ValidateScopedToken(token)
+
+		// checked against the database by the impersonation middleware
+		if actor, ok := ActorOf(claims); ok {
+			ctx.Locals("actor", actor)
+		}
+	}
+
 	if !isValid {
 		h.config.Logger.Info("Invalid or expired token")
 		return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
@@ -179,12 +268,60 @@ func (h *AuthHandler) AuthenticateUser(ctx *fiber.Ctx) error {
 		})
 	}
 
+	if err := h.authService.CheckRestriction(user); err != nil {
+		if restriction, ok := restrictionOf(err); ok {
+			return restriction.Respond(ctx)
+		}
+	}
+
+	// tokens issued before the deletion request stop working, signing in
+	// again cancels it
+	if user.DeletionScheduledAt != nil {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "account_deletion_scheduled",
+			"message": "The account is scheduled for deletion, sign in again to keep it",
+		})
+	}
+
+	roles, permissions, err := h.rbacService.PermissionsForUser(user)
+	if err != nil {
+		h.config.Logger.Error("Failed to load user permissions", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to authenticate, please try again later",
+		})
+	}
+
+	// guests only reach the profile routes until they upgrade
+	if user.Guest && scopes == nil {
+		scopes = append([]string{}, rbac.GuestScopes...)
+	}
+
+	if scopes != nil {
+		permissions = permissions.Restrict(scopes)
+		ctx.Locals("scopes", scopes)
+	}
+
 	ctx.Locals("user", user)
 	ctx.Locals("accessToken", token)
+	ctx.Locals("roles", roles)
+	ctx.Locals("permissions", permissions)
 
 	return ctx.Next()
 }
 
+// OptionalAuthentication is AuthenticateUser for routes anyone may call. A
+// request without a token goes through anonymously, one with a bad token is
+// still rejected.
+func (h *AuthHandler) OptionalAuthentication(ctx *fiber.Ctx) error {
+	if ctx.Get("Authorization") == "" {
+		return ctx.Next()
+	}
+
+	return h.AuthenticateUser(ctx)
+}
+
 func (h *AuthHandler) RefreshAccessToken(ctx *fiber.Ctx) error {
 	sessionID := ctx.Cookies("session_id")
 	if sessionID == "" {
@@ -196,8 +333,18 @@ func (h *AuthHandler) RefreshAccessToken(ctx *fiber.Ctx) error {
 
 	h.config.Logger.Info("existing accessToken", zap.String("session_id", sessionID))
 
-	tokens, err := h.authService.RefreshAccessToken(sessionID)
+	tokens, user, err := h.authService.RefreshAccessToken(sessionID)
 	if err != nil {
+		h.auditService.Record(ctx, audit.Event{
+			Type:     audit.EventTokenRefreshFailed,
+			Subject:  user,
+			Metadata: map[string]any{"error": err.Error()},
+		})
+
+		if restriction, ok := restrictionOf(err); ok {
+			return restriction.Respond(ctx)
+		}
+
 		h.config.Logger.Warn("Failed to refresh access token", zap.Error(err))
 		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"status":  "error",
@@ -205,6 +352,8 @@ func (h *AuthHandler) RefreshAccessToken(ctx *fiber.Ctx) error {
 		})
 	}
 
+	h.auditService.Record(ctx, audit.Event{Type: audit.EventTokenRefreshed, Actor: user, Subject: user})
+
 	return ctx.JSON(fiber.Map{
 		"status":  "success",
 		"message": "Access token refreshed successfully",
@@ -223,7 +372,7 @@ func (h *AuthHandler) Logout(ctx *fiber.Ctx) error {
 		})
 	}
 
-	err := h.authService.Logout(sessionID)
+	user, err := h.authService.Logout(sessionID)
 	if err != nil {
 		h.config.Logger.Error("Failed to logout", zap.Error(err))
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -232,6 +381,8 @@ func (h *AuthHandler) Logout(ctx *fiber.Ctx) error {
 		})
 	}
 
+	h.auditService.Record(ctx, audit.Event{Type: audit.EventLogout, Actor: user, Subject: user})
+
 	ctx.ClearCookie("session_id")
 
 	return ctx.JSON(fiber.Map{
@@ -239,3 +390,8 @@ func (h *AuthHandler) Logout(ctx *fiber.Ctx) error {
 		"message": "Logged out successfully",
 	})
 }
+
+// restrictionOf unwraps a suspension or ban from err.
+func restrictionOf(err error) (*user.RestrictionError, bool) {
+	return user.AsRestriction(err)
+}
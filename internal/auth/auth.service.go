@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/auth/credentials/idtoken"
@@ -18,6 +20,16 @@ import (
 	"go.uber.org/zap"
 )
 
+// AccessTokenTTL is how long access tokens stay valid.
+const AccessTokenTTL = 1 * time.Hour
+
+// Token types carried in the "typ" claim, so a refresh token is never
+// accepted where an access token is expected.
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 type Token struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
@@ -25,16 +37,25 @@ type Token struct {
 
 type AuthServiceIntr interface {
 	SendWhatsAppOTP(phoneNumber string) error
+	SendOTPMessage(phoneNumber string, otp string) error
 	GenerateOTP(phoneNumber string) (otp string, err error)
 	GoogleOauthSignIn(idToken string, ipAddress string, userAgent string) (token Token, userInfo UserInfo, sessionID string, err error)
 	VerifyWhatsAppOTP(phoneNumber, otp string) (bool, error)
+	VerifyGoogleIdentity(idToken string) (GoogleIdentity, error)
+	ImportGooglePicture(user *ent.User, identity GoogleIdentity)
 	GenerateAuthTokens(user *ent.User) (token Token, err error)
-	generateAccessToken(user *ent.User) (string, error)
-	generateRefreshToken(user *ent.User) (string, error)
+	GenerateAuthTokensWithClaims(user *ent.User, extra jwt.MapClaims) (token Token, err error)
+	generateAccessToken(user *ent.User, extra jwt.MapClaims) (string, error)
+	generateRefreshToken(user *ent.User, extra jwt.MapClaims) (string, error)
 	LoginUser(user *ent.User) (token Token, err error)
 	ValidateToken(token string) bool
-	RefreshAccessToken(sessionID string) (Token, error)
-	Logout(sessionID string) error
+	ValidateScopedToken(token string) (bool, *ent.User, []string, jwt.MapClaims)
+	ValidateTokenClaims(token string) (bool, *ent.User, jwt.MapClaims)
+	CheckRestriction(user *ent.User) error
+	FindUserByPhone(phoneNumber string) (*ent.User, error)
+	CheckPhoneRestriction(phoneNumber string) error
+	RefreshAccessToken(sessionID string) (Token, *ent.User, error)
+	Logout(sessionID string) (*ent.User, error)
 }
 
 type AuthService struct {
@@ -45,14 +66,8 @@ type AuthService struct {
 	ctx               context.Context
 }
 
-type UserInfo struct {
-	AuthID      string `json:"auth_id"`
-	PhoneNumber string `json:"phone_number"`
-	UserName    string `json:"username"`
-	Email       string `json:"email"`
-	FirstName   string `json:"first_name"`
-	LastName    string `json:"last_name"`
-}
+// UserInfo is kept here for callers that predate its move to the user package.
+type UserInfo = user.UserInfo
 
 func NewAuthService(userService *user.UserService, otpService *otp.OTPService, sessionRepository *session.SessionRepository, config *config.Config, ctx context.Context) *AuthService {
 	return &AuthService{
@@ -64,28 +79,25 @@ func NewAuthService(userService *user.UserService, otpService *otp.OTPService, s
 	}
 }
 
-func (s *AuthService) LoginUser(user *ent.User, ipAddress string, userAgent string) (Token, UserInfo, string, error) {
+func (s *AuthService) LoginUser(u *ent.User, ipAddress string, userAgent string) (Token, UserInfo, string, error) {
+	if err := s.userService.CheckRestriction(u); err != nil {
+		return Token{}, UserInfo{}, "", err
+	}
+
 	// Create a new session for the user
-	tokens, err := s.GenerateAuthTokens(user)
+	tokens, err := s.GenerateAuthTokens(u)
 	if err != nil {
 		s.config.Logger.Error("Failed to generate auth tokens", zap.Error(err))
 		return Token{}, UserInfo{}, "", err
 	}
 
-	userInfo := UserInfo{
-		AuthID:      user.AuthID,
-		PhoneNumber: user.PhoneNumber,
-		UserName:    user.Username,
-		Email:       user.Email,
-		FirstName:   user.FirstName,
-		LastName:    user.LastName,
-	}
+	userInfo := user.NewUserInfo(u)
 
 	s.config.Logger.Info("Creating Session", zap.Any("id", ipAddress), zap.Any("userAgent", userAgent))
 
 	session, err := s.sessionRepository.CreateNewSession(
 		context.Background(),
-		user,
+		u,
 		tokens.RefreshToken,
 		time.Now().Add(30*24*time.Hour), // 30 days expiration
 		userAgent,
@@ -97,33 +109,86 @@ func (s *AuthService) LoginUser(user *ent.User, ipAddress string, userAgent stri
 		return Token{}, UserInfo{}, "", err
 	}
 
+	// login succeeded even if the counter could not be bumped
+	_ = s.userService.RecordLogin(u)
+
+	// logging in during the grace period cancels a pending account deletion
+	if err := s.userService.CancelDeletion(u); err != nil {
+		return Token{}, UserInfo{}, "", err
+	}
+
 	return tokens, userInfo, session.SessionID, nil
 }
 
 func (s *AuthService) GoogleOauthSignIn(idToken string, ipAddress string, userAgent string) (user *ent.User, err error) {
-	// Validate the ID token
-	payload, err := idtoken.Validate(context.Background(), idToken, s.config.Google.ClientID)
-
+	identity, err := s.VerifyGoogleIdentity(idToken)
 	if err != nil {
-		s.config.Logger.Error("Failed to validate ID token", zap.Error(err))
-		return nil, fmt.Errorf("failed to validate ID token: %w", err)
+		return nil, err
 	}
 
-	// Find user by email
-	email := payload.Claims["email"].(string)
-	user, err = s.userService.FindOrCreateByEmail(email)
+	user, err = s.userService.FindOrCreateByEmail(identity.Email)
 
 	if err != nil {
 		s.config.Logger.Error("Failed to find or create user by email", zap.Error(err))
 		return nil, fmt.Errorf("failed to find or create user: %w", err)
 	}
 
+	s.ImportGooglePicture(user, identity)
+
 	return user, nil
 }
 
+// GoogleIdentity is what a verified Google ID token says about its holder.
+type GoogleIdentity struct {
+	Email   string
+	Picture string
+}
+
+// VerifyGoogleIdentity validates a Google ID token and returns its verified
+// email address and profile picture. Only an address Google has verified may
+// sign in to an account.
+func (s *AuthService) VerifyGoogleIdentity(idToken string) (GoogleIdentity, error) {
+	payload, err := idtoken.Validate(context.Background(), idToken, s.config.Google.ClientID)
+
+	if err != nil {
+		s.config.Logger.Error("Failed to validate ID token", zap.Error(err))
+		return GoogleIdentity{}, fmt.Errorf("failed to validate ID token: %w", err)
+	}
+
+	email, _ := payload.Claims["email"].(string)
+	if verified, _ := payload.Claims["email_verified"].(bool); email == "" || !verified {
+		return GoogleIdentity{}, fmt.Errorf("google account email is not verified")
+	}
+
+	picture, _ := payload.Claims["picture"].(string)
+
+	return GoogleIdentity{Email: strings.ToLower(email), Picture: picture}, nil
+}
+
+// ImportGooglePicture copies the Google profile picture as the avatar of a
+// user who has none, when enabled. It runs in the background so a slow
+// download never holds up the sign in.
+func (s *AuthService) ImportGooglePicture(user *ent.User, identity GoogleIdentity) {
+	if !s.config.Google.ImportPicture || identity.Picture == "" || user.AvatarKey != "" {
+		return
+	}
+
+	go func() {
+		if err := s.userService.ImportAvatar(user, identity.Picture); err != nil {
+			s.config.Logger.Warn("Failed to import Google picture", zap.String("authID", user.AuthID), zap.Error(err))
+		}
+	}()
+}
+
 func (s *AuthService) GenerateAuthTokens(user *ent.User) (token Token, err error) {
-	accessToken, _ := s.generateAccessToken(user)
-	refreshToken, err := s.generateRefreshToken(user)
+	return s.GenerateAuthTokensWithClaims(user, nil)
+}
+
+// GenerateAuthTokensWithClaims mints tokens carrying extra claims, such as the
+// scope and client of a third-party app, on top of the standard ones.
+func (s *AuthService) GenerateAuthTokensWithClaims(user *ent.User, extra jwt.MapClaims) (token Token, err error) {
+	accessToken, _ := s.generateAccessToken(user, extra)
+	refreshToken, err := s.generateRefreshToken(user, extra)
 
 	token = Token{
 		AccessToken:  accessToken,
@@ -133,35 +198,46 @@ func (s *AuthService) GenerateAuthTokens(user *ent.User) (token Token, err error
 	return token, err
 }
 
-func (s *AuthService) generateAccessToken(user *ent.User) (string, error) {
+func (s *AuthService) generateAccessToken(user *ent.User, extra jwt.MapClaims) (string, error) {
 	claims := jwt.MapClaims{
 		"sub": user.AuthID,
-		"exp": time.Now().Add(1 * time.Hour).Unix(),
+		"exp": time.Now().Add(AccessTokenTTL).Unix(),
 		"iat": time.Now().Unix(),
 	}
+	maps.Copy(claims, extra)
+	claims["typ"] = TokenTypeAccess
+
 	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.config.JWTSecret))
 }
 
-func (s *AuthService) generateRefreshToken(user *ent.User) (string, error) {
+func (s *AuthService) generateRefreshToken(user *ent.User, extra jwt.MapClaims) (string, error) {
 	// valid for 30 days
 	claims := jwt.MapClaims{
 		"sub": user.AuthID,
 		"exp": time.Now().Add(30 * 24 * time.Hour).Unix(),
 		"iat": time.Now().Unix(),
 	}
+	maps.Copy(claims, extra)
+	claims["typ"] = TokenTypeRefresh
 
 	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.config.JWTSecret))
 }
 
 func (s *AuthService) SendWhatsAppOTP(phoneNumber string) error {
-	url := "https://graph.facebook.com/v22.0/" + s.config.WhatsApp.PhoneId + "/messages"
-	token := s.config.WhatsApp.Token
 	otp, err := s.GenerateOTP(phoneNumber)
 
 	if err != nil {
 		return fmt.Errorf("error generating OTP: %w", err)
 	}
 
+	return s.SendOTPMessage(phoneNumber, otp)
+}
+
+// SendOTPMessage delivers a one-time code through the WhatsApp OTP template.
+func (s *AuthService) SendOTPMessage(phoneNumber string, otp string) error {
+	url := "https://graph.facebook.com/v22.0/" + s.config.WhatsApp.PhoneId + "/messages"
+	token := s.config.WhatsApp.Token
+
 	// Payload
 	payload := map[string]any{
 		"messaging_product": "whatsapp",
@@ -251,6 +327,8 @@ func (s *AuthService) GenerateOTP(phoneNumber string) (string, error) {
 	return otp.Otp, nil
 }
 
+// VerifyWhatsAppOTP reports whether the OTP is valid. The user is returned
+// whenever the phone number is known so failed attempts can be attributed.
 func (s *AuthService) VerifyWhatsAppOTP(phoneNumber string, otp string) (bool, *ent.User) {
 	// Find user by phone number
 	user, err := s.userService.FindByPhone(phoneNumber)
@@ -263,7 +341,7 @@ func (s *AuthService) VerifyWhatsAppOTP(phoneNumber string, otp string) (bool, *
 	is_valid, err := s.otpService.IsOTPValid(otp, user)
 	if err != nil {
 		s.config.Logger.Info("Failed to validate OTP", zap.Error(err))
-		return false, nil
+		return false, user
 	}
 
 	// Expire the OTP
@@ -272,7 +350,84 @@ func (s *AuthService) VerifyWhatsAppOTP(phoneNumber string, otp string) (bool, *
 	return is_valid, user
 }
 
+// CheckRestriction returns a *user.RestrictionError for suspended or banned users.
+func (s *AuthService) CheckRestriction(user *ent.User) error {
+	return s.userService.CheckRestriction(user)
+}
+
+// FindUserByPhone returns the user registered with the phone number.
+func (s *AuthService) FindUserByPhone(phoneNumber string) (*ent.User, error) {
+	return s.userService.FindByPhone(phoneNumber)
+}
+
+// CheckPhoneRestriction rejects OTP requests for suspended or banned numbers.
+func (s *AuthService) CheckPhoneRestriction(phoneNumber string) error {
+	user, err := s.userService.FindByPhone(phoneNumber)
+	if err != nil {
+		// unknown numbers are not restricted
+		return nil
+	}
+
+	return s.userService.CheckRestriction(user)
+}
+
 func (s *AuthService) ValidateToken(token string) (bool, *ent.User) {
+	isValid, user, _ := s.ValidateTokenClaims(token)
+	return isValid, user
+}
+
+// ValidateScopedToken validates token like ValidateToken and also returns the
+// scopes it was limited to, along with its claims. Scopes are nil for
+// first-party session tokens. Only access tokens are accepted.
+func (s *AuthService) ValidateScopedToken(token string) (bool, *ent.User, []string, jwt.MapClaims) {
+	isValid, user, claims := s.ValidateTokenClaims(token)
+	if !isValid {
+		return false, nil, nil, nil
+	}
+
+	if typ, _ := claims["typ"].(string); typ != TokenTypeAccess {
+		s.config.Logger.Info("Rejected token that is not an access token", zap.String("typ", typ))
+		return false, nil, nil, nil
+	}
+
+	scope, ok := claims["scope"].(string)
+	if !ok {
+		return true, user, nil, claims
+	}
+
+	// tokens issued to third-party apps die with their session, so revoking
+	// consent takes effect immediately
+	if sessionID, ok := claims["sid"].(string); ok {
+		exists, err := s.sessionRepository.ClientSessionExists(s.ctx, sessionID)
+		if err != nil || !exists {
+			return false, nil, nil, nil
+		}
+	}
+
+	return true, user, strings.Fields(scope), claims
+}
+
+// ActorClaims identifies the staff member behind an impersonation token.
+type ActorClaims struct {
+	ActorAuthID     string
+	ImpersonationID string
+}
+
+// ActorOf returns the "act" claim of an impersonation token.
+func ActorOf(claims jwt.MapClaims) (ActorClaims, bool) {
+	act, ok := claims["act"].(map[string]any)
+	if !ok {
+		return ActorClaims{}, false
+	}
+
+	actor, _ := act["sub"].(string)
+	impersonationID, _ := claims["imp"].(string)
+
+	return ActorClaims{ActorAuthID: actor, ImpersonationID: impersonationID}, true
+}
+
+// ValidateTokenClaims validates token and returns its claims along with the user.
+func (s *AuthService) ValidateTokenClaims(token string) (bool, *ent.User, jwt.MapClaims) {
 	// Parse the token
 	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (any, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -283,19 +438,19 @@ func (s *AuthService) ValidateToken(token string) (bool, *ent.User) {
 
 	if err != nil {
 		s.config.Logger.Error("Failed to parse token", zap.Error(err))
-		return false, nil
+		return false, nil, nil
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok || !parsedToken.Valid {
 		s.config.Logger.Error("Invalid token claims")
-		return false, nil
+		return false, nil, nil
 	}
 
 	sub, err := claims.GetSubject()
 	if err != nil {
 		s.config.Logger.Error("Failed to get subject from claims", zap.Error(err))
-		return false, nil
+		return false, nil, nil
 	}
 
 	s.config.Logger.Info("Token validated successfully", zap.Any("claims", sub))
@@ -303,30 +458,34 @@ func (s *AuthService) ValidateToken(token string) (bool, *ent.User) {
 	user, err := s.userService.FindUserByAuthID(sub)
 	if err != nil {
 		s.config.Logger.Error("Failed to find user by auth ID", zap.String("auth_id", sub), zap.Error(err))
-		return false, nil
+		return false, nil, nil
 	}
 
-	return parsedToken.Valid, user
+	return parsedToken.Valid, user, claims
 }
 
-func (s *AuthService) RefreshAccessToken(sessionID string) (Token, error) {
+func (s *AuthService) RefreshAccessToken(sessionID string) (Token, *ent.User, error) {
 	session, err := s.sessionRepository.FindSessionByID(s.ctx, sessionID)
 	if err != nil {
 		s.config.Logger.Error("Failed to get session by ID", zap.String("session_id", sessionID), zap.Error(err))
-		return Token{}, fmt.Errorf("failed to get session: %w", err)
+		return Token{}, nil, fmt.Errorf("failed to get session: %w", err)
 	}
 
 	// validate Refresh token
 	isValid, user := s.ValidateToken(session.RefreshToken)
 	if !isValid {
 		s.config.Logger.Info("Invalid or expired refresh token", zap.String("session_id", sessionID))
-		return Token{}, fmt.Errorf("invalid or expired refresh token")
+		return Token{}, nil, fmt.Errorf("invalid or expired refresh token")
+	}
+
+	if err := s.userService.CheckRestriction(user); err != nil {
+		return Token{}, user, err
 	}
 
 	tokens, err := s.GenerateAuthTokens(user)
 	if err != nil {
 		s.config.Logger.Error("Failed to generate new auth tokens", zap.Error(err))
-		return Token{}, fmt.Errorf("failed to generate new auth tokens: %w", err)
+		return Token{}, user, fmt.Errorf("failed to generate new auth tokens: %w", err)
 	}
 
 	_, err = session.Update().
@@ -335,20 +494,33 @@ func (s *AuthService) RefreshAccessToken(sessionID string) (Token, error) {
 
 	if err != nil {
 		s.config.Logger.Error("Failed to update session", zap.Error(err))
-		return Token{}, fmt.Errorf("failed to update session: %w", err)
+		return Token{}, user, fmt.Errorf("failed to update session: %w", err)
 	}
 
-	return tokens, nil
+	return tokens, user, nil
 }
 
-func (s *AuthService) Logout(sessionID string) error {
+// Logout deletes the session and returns the user it belonged to.
+func (s *AuthService) Logout(sessionID string) (*ent.User, error) {
+	session, err := s.sessionRepository.FindSessionByID(s.ctx, sessionID)
+	if err != nil {
+		s.config.Logger.Error("Failed to get session by ID", zap.String("session_id", sessionID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	user, err := session.QueryUser().Only(s.ctx)
+	if err != nil {
+		s.config.Logger.Error("Failed to get session user", zap.String("session_id", sessionID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get session user: %w", err)
+	}
+
 	// Delete the session
-	_, err := s.sessionRepository.DeleteSession(s.ctx, sessionID)
+	_, err = s.sessionRepository.DeleteSession(s.ctx, sessionID)
 	if err != nil {
 		s.config.Logger.Error("Failed to delete session", zap.String("session_id", sessionID), zap.Error(err))
-		return fmt.Errorf("failed to delete session: %w", err)
+		return nil, fmt.Errorf("failed to delete session: %w", err)
 	}
 
 	s.config.Logger.Info("User logged out successfully", zap.String("session_id", sessionID))
-	return nil
+	return user, nil
 }
@@ -0,0 +1,232 @@
+package device
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+// GrantType is the grant_type devices send while polling.
+const GrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+type DeviceHandlerIntr interface {
+	RequestCode(ctx *fiber.Ctx) error
+	Token(ctx *fiber.Ctx) error
+	GetRequest(ctx *fiber.Ctx) error
+	Decide(ctx *fiber.Ctx) error
+}
+
+type DeviceHandler struct {
+	deviceService *DeviceService
+	authService   *auth.AuthService
+	auditService  *audit.AuditService
+	config        *config.Config
+}
+
+func NewDeviceHandler(deviceService *DeviceService, authService *auth.AuthService, auditService *audit.AuditService, config *config.Config) *DeviceHandler {
+	return &DeviceHandler{
+		deviceService: deviceService,
+		authService:   authService,
+		auditService:  auditService,
+		config:        config,
+	}
+}
+
+// RequestDetail is what the phone shows before the user approves a device.
+type RequestDetail struct {
+	UserCode  string    `json:"user_code"`
+	UserAgent string    `json:"user_agent"`
+	IPAddress string    `json:"ip_address"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (h *DeviceHandler) RequestCode(ctx *fiber.Ctx) error {
+	code, err := h.deviceService.RequestCode(ctx.IP(), ctx.Get("User-Agent"))
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to start device sign-in, please try again later",
+		})
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   code,
+	})
+}
+
+type TokenBody struct {
+	GrantType  string `json:"grant_type" xml:"grant_type" form:"grant_type"`
+	DeviceCode string `json:"device_code" xml:"device_code" form:"device_code"`
+}
+
+// Token is polled by the device. Errors carry the RFC 8628 code so the device
+// knows whether to keep polling, slow down or give up.
+func (h *DeviceHandler) Token(ctx *fiber.Ctx) error {
+	body := new(TokenBody)
+	if err := ctx.BodyParser(body); err != nil || body.DeviceCode == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "invalid_request",
+			"message": "Please provide a device code",
+		})
+	}
+
+	if body.GrantType != GrantType {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "unsupported_grant_type",
+			"message": "Only the device_code grant type is supported",
+		})
+	}
+
+	approver, err := h.deviceService.Poll(body.DeviceCode)
+	if err != nil {
+		return h.pollError(ctx, err)
+	}
+
+	tokens, userInfo, sessionId, err := h.authService.LoginUser(approver, ctx.IP(), ctx.Get("User-Agent"))
+	if err != nil {
+		if restriction, ok := user.AsRestriction(err); ok {
+			return restriction.Respond(ctx)
+		}
+
+		h.config.Logger.Error("Failed to create session", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to Login, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{Type: audit.EventDeviceSignIn, Actor: approver, Subject: approver})
+
+	ctx.Cookie(&fiber.Cookie{
+		Name:     "session_id",
+		Value:    sessionId,
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: fiber.CookieSameSiteStrictMode,
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Device signed in successfully",
+		"data": fiber.Map{
+			"access_token": tokens.AccessToken,
+			"user":         userInfo,
+		},
+	})
+}
+
+func (h *DeviceHandler) pollError(ctx *fiber.Ctx, err error) error {
+	messages := map[error]string{
+		ErrAuthorizationPending: "Waiting for the sign-in to be approved",
+		ErrSlowDown:             "Polling too fast, please slow down",
+		ErrAccessDenied:         "The sign-in was denied",
+		ErrExpiredToken:         "The device code has expired, please start again",
+		ErrInvalidGrant:         "The device code is invalid or was already used",
+	}
+
+	for known, message := range messages {
+		if errors.Is(err, known) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"status":  "error",
+				"code":    known.Error(),
+				"message": message,
+			})
+		}
+	}
+
+	h.config.Logger.Error("Failed to poll device authorization", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Failed to sign in device, please try again later",
+	})
+}
+
+func (h *DeviceHandler) GetRequest(ctx *fiber.Ctx) error {
+	request, err := h.deviceService.FindRequest(ctx.Query("user_code"))
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": RequestDetail{
+			UserCode:  FormatUserCode(request.UserCode),
+			UserAgent: request.UserAgent,
+			IPAddress: request.IPAddress,
+			CreatedAt: request.CreateTime,
+			ExpiresAt: request.ExpiresAt,
+		},
+	})
+}
+
+type DecideBody struct {
+	UserCode string `json:"user_code" xml:"user_code" form:"user_code"`
+	Approve  bool   `json:"approve" xml:"approve" form:"approve"`
+}
+
+// Decide approves or denies a device from the signed-in phone.
+func (h *DeviceHandler) Decide(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(DecideBody)
+	if err := ctx.BodyParser(body); err != nil || body.UserCode == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide the code shown on your device",
+		})
+	}
+
+	decide, eventType, message := h.deviceService.Deny, audit.EventDeviceDenied, "Device sign-in denied"
+	if body.Approve {
+		decide, eventType, message = h.deviceService.Approve, audit.EventDeviceApproved, "Device signed in, you can continue on your device"
+	}
+
+	request, err := decide(currentUser, body.UserCode)
+	if err != nil {
+		if restriction, ok := user.AsRestriction(err); ok {
+			return restriction.Respond(ctx)
+		}
+		return h.lookupError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    eventType,
+		Actor:   currentUser,
+		Subject: currentUser,
+		Metadata: map[string]any{
+			"device_user_agent": request.UserAgent,
+			"device_ip_address": request.IPAddress,
+		},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": message,
+	})
+}
+
+func (h *DeviceHandler) lookupError(ctx *fiber.Ctx, err error) error {
+	if ent.IsNotFound(err) || errors.Is(err, ErrAlreadyDecided) {
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "This code is invalid or has expired",
+		})
+	}
+
+	h.config.Logger.Error("Failed to find device authorization", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Failed to find device request, please try again later",
+	})
+}
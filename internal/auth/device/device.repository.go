@@ -0,0 +1,98 @@
+package device
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/deviceauthorization"
+)
+
+type DeviceRepositoryIntr interface {
+	Create(ctx context.Context, deviceCodeHash string, userCode string, userAgent string, ipAddress string, interval int, expiresAt time.Time) (*ent.DeviceAuthorization, error)
+	FindByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (*ent.DeviceAuthorization, error)
+	FindPendingByUserCode(ctx context.Context, userCode string) (*ent.DeviceAuthorization, error)
+	Decide(ctx context.Context, request *ent.DeviceAuthorization, user *ent.User, status deviceauthorization.Status) (bool, error)
+	RecordPoll(ctx context.Context, request *ent.DeviceAuthorization, polledAt time.Time, interval int) error
+	Consume(ctx context.Context, request *ent.DeviceAuthorization) (bool, error)
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+type DeviceRepository struct {
+	client *ent.Client
+}
+
+func NewDeviceRepository(client *ent.Client) *DeviceRepository {
+	return &DeviceRepository{client: client}
+}
+
+func (r *DeviceRepository) Create(ctx context.Context, deviceCodeHash, userCode, userAgent, ipAddress string, interval int, expiresAt time.Time) (*ent.DeviceAuthorization, error) {
+	return r.client.DeviceAuthorization.Create().
+		SetDeviceCodeHash(deviceCodeHash).
+		SetUserCode(userCode).
+		SetUserAgent(userAgent).
+		SetIPAddress(ipAddress).
+		SetInterval(interval).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// FindByDeviceCodeHash returns the request with the approving user loaded.
+func (r *DeviceRepository) FindByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (*ent.DeviceAuthorization, error) {
+	return r.client.DeviceAuthorization.Query().
+		Where(deviceauthorization.DeviceCodeHashEQ(deviceCodeHash)).
+		WithUser().
+		Only(ctx)
+}
+
+func (r *DeviceRepository) FindPendingByUserCode(ctx context.Context, userCode string) (*ent.DeviceAuthorization, error) {
+	return r.client.DeviceAuthorization.Query().
+		Where(
+			deviceauthorization.UserCodeEQ(userCode),
+			deviceauthorization.StatusEQ(deviceauthorization.StatusPending),
+			deviceauthorization.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+}
+
+// Decide approves or denies a pending request. It reports false when the
+// request was decided by someone else in the meantime.
+func (r *DeviceRepository) Decide(ctx context.Context, request *ent.DeviceAuthorization, user *ent.User, status deviceauthorization.Status) (bool, error) {
+	updated, err := r.client.DeviceAuthorization.Update().
+		Where(
+			deviceauthorization.IDEQ(request.ID),
+			deviceauthorization.StatusEQ(deviceauthorization.StatusPending),
+		).
+		SetStatus(status).
+		SetUser(user).
+		Save(ctx)
+
+	return updated == 1, err
+}
+
+func (r *DeviceRepository) RecordPoll(ctx context.Context, request *ent.DeviceAuthorization, polledAt time.Time, interval int) error {
+	return r.client.DeviceAuthorization.UpdateOneID(request.ID).
+		SetLastPolledAt(polledAt).
+		SetInterval(interval).
+		Exec(ctx)
+}
+
+// Consume marks an approved request as used. It reports false when another
+// poll already exchanged it for a session.
+func (r *DeviceRepository) Consume(ctx context.Context, request *ent.DeviceAuthorization) (bool, error) {
+	updated, err := r.client.DeviceAuthorization.Update().
+		Where(
+			deviceauthorization.IDEQ(request.ID),
+			deviceauthorization.StatusEQ(deviceauthorization.StatusApproved),
+		).
+		SetStatus(deviceauthorization.StatusConsumed).
+		Save(ctx)
+
+	return updated == 1, err
+}
+
+func (r *DeviceRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	return r.client.DeviceAuthorization.Delete().
+		Where(deviceauthorization.ExpiresAtLT(before)).
+		Exec(ctx)
+}
@@ -0,0 +1,242 @@
+package device
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/pkg/publicid"
+	"go.uber.org/zap"
+)
+
+const (
+	codeTTL         = 10 * time.Minute
+	defaultInterval = 5
+	// slowDownStep is added to the interval each time a device polls too fast.
+	slowDownStep    = 5
+	cleanupInterval = 1 * time.Hour
+
+	// userCodeAlphabet leaves out vowels and lookalikes so codes are easy to
+	// read off a TV and never spell words.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// Errors returned while polling, named after the RFC 8628 error codes.
+var (
+	ErrAuthorizationPending = errors.New("authorization_pending")
+	ErrSlowDown             = errors.New("slow_down")
+	ErrAccessDenied         = errors.New("access_denied")
+	ErrExpiredToken         = errors.New("expired_token")
+	ErrInvalidGrant         = errors.New("invalid_grant")
+
+	ErrAlreadyDecided = errors.New("device request was already approved or denied")
+)
+
+type DeviceServiceIntr interface {
+	RequestCode(ipAddress string, userAgent string) (*DeviceCode, error)
+	FindRequest(userCode string) (*ent.DeviceAuthorization, error)
+	Approve(user *ent.User, userCode string) (*ent.DeviceAuthorization, error)
+	Deny(user *ent.User, userCode string) (*ent.DeviceAuthorization, error)
+	Poll(deviceCode string) (*ent.User, error)
+	Run()
+}
+
+// DeviceService implements the device authorization grant so TVs and consoles
+// can be signed in from a phone.
+type DeviceService struct {
+	deviceRepository *DeviceRepository
+	authService      *auth.AuthService
+	config           *config.Config
+	ctx              context.Context
+}
+
+func NewDeviceService(deviceRepository *DeviceRepository, authService *auth.AuthService, config *config.Config, ctx context.Context) *DeviceService {
+	return &DeviceService{
+		deviceRepository: deviceRepository,
+		authService:      authService,
+		config:           config,
+		ctx:              ctx,
+	}
+}
+
+// DeviceCode is the response of the device authorization endpoint.
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// RequestCode starts a new device sign-in.
+func (s *DeviceService) RequestCode(ipAddress string, userAgent string) (*DeviceCode, error) {
+	deviceCode := publicid.MustWith(43, publicid.AlphaNumeric())
+	userCode := publicid.MustWith(userCodeLength, userCodeAlphabet)
+
+	_, err := s.deviceRepository.Create(
+		s.ctx,
+		hashCode(deviceCode),
+		userCode,
+		userAgent,
+		ipAddress,
+		defaultInterval,
+		time.Now().Add(codeTTL),
+	)
+	if err != nil {
+		s.config.Logger.Error("Failed to create device authorization", zap.Error(err))
+		return nil, err
+	}
+
+	formatted := FormatUserCode(userCode)
+
+	return &DeviceCode{
+		DeviceCode:              deviceCode,
+		UserCode:                formatted,
+		VerificationURI:         s.config.Device.VerificationURL,
+		VerificationURIComplete: s.config.Device.VerificationURL + "?" + url.Values{"user_code": {formatted}}.Encode(),
+		ExpiresIn:               int(codeTTL.Seconds()),
+		Interval:                defaultInterval,
+	}, nil
+}
+
+// FindRequest returns the pending request for a code typed by the user, so
+// the phone can show which device is asking.
+func (s *DeviceService) FindRequest(userCode string) (*ent.DeviceAuthorization, error) {
+	return s.deviceRepository.FindPendingByUserCode(s.ctx, NormalizeUserCode(userCode))
+}
+
+func (s *DeviceService) Approve(user *ent.User, userCode string) (*ent.DeviceAuthorization, error) {
+	if err := s.authService.CheckRestriction(user); err != nil {
+		return nil, err
+	}
+
+	return s.decide(user, userCode, deviceauthorization.StatusApproved)
+}
+
+func (s *DeviceService) Deny(user *ent.User, userCode string) (*ent.DeviceAuthorization, error) {
+	return s.decide(user, userCode, deviceauthorization.StatusDenied)
+}
+
+func (s *DeviceService) decide(user *ent.User, userCode string, status deviceauthorization.Status) (*ent.DeviceAuthorization, error) {
+	request, err := s.FindRequest(userCode)
+	if err != nil {
+		return nil, err
+	}
+
+	decided, err := s.deviceRepository.Decide(s.ctx, request, user, status)
+	if err != nil {
+		s.config.Logger.Error("Failed to decide device authorization", zap.Error(err))
+		return nil, err
+	}
+	if !decided {
+		return nil, ErrAlreadyDecided
+	}
+
+	return request, nil
+}
+
+// Poll is called by the device until the user approves or denies the
+// request. It returns the approving user exactly once.
+func (s *DeviceService) Poll(deviceCode string) (*ent.User, error) {
+	request, err := s.deviceRepository.FindByDeviceCodeHash(s.ctx, hashCode(deviceCode))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidGrant
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if request.ExpiresAt.Before(now) {
+		return nil, ErrExpiredToken
+	}
+
+	interval := request.Interval
+	tooFast := request.LastPolledAt != nil && now.Sub(*request.LastPolledAt) < time.Duration(interval)*time.Second
+	if tooFast {
+		interval += slowDownStep
+	}
+
+	if err := s.deviceRepository.RecordPoll(s.ctx, request, now, interval); err != nil {
+		s.config.Logger.Error("Failed to record device poll", zap.Error(err))
+		return nil, err
+	}
+
+	if tooFast {
+		return nil, ErrSlowDown
+	}
+
+	switch request.Status {
+	case deviceauthorization.StatusPending:
+		return nil, ErrAuthorizationPending
+	case deviceauthorization.StatusDenied:
+		return nil, ErrAccessDenied
+	case deviceauthorization.StatusConsumed:
+		return nil, ErrInvalidGrant
+	}
+
+	consumed, err := s.deviceRepository.Consume(s.ctx, request)
+	if err != nil {
+		s.config.Logger.Error("Failed to consume device authorization", zap.Error(err))
+		return nil, err
+	}
+	if !consumed || request.Edges.User == nil {
+		return nil, ErrInvalidGrant
+	}
+
+	return request.Edges.User, nil
+}
+
+// Run deletes requests a day after they expired until the context is cancelled.
+func (s *DeviceService) Run() {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.deviceRepository.DeleteExpired(s.ctx, time.Now().Add(-24*time.Hour))
+			if err != nil {
+				s.config.Logger.Error("Failed to delete expired device authorizations", zap.Error(err))
+				continue
+			}
+			if deleted > 0 {
+				s.config.Logger.Info("Deleted expired device authorizations", zap.Int("count", deleted))
+			}
+		}
+	}
+}
+
+// FormatUserCode splits a user code in two halves, e.g. BCDF-GHJK.
+func FormatUserCode(userCode string) string {
+	half := len(userCode) / 2
+	return userCode[:half] + "-" + userCode[half:]
+}
+
+// NormalizeUserCode accepts codes typed in lower case, with or without the dash.
+func NormalizeUserCode(userCode string) string {
+	userCode = strings.ToUpper(userCode)
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, userCode)
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,185 @@
+package emailchange
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type EmailChangeHandlerIntr interface {
+	RequestChange(ctx *fiber.Ctx) error
+	Verify(ctx *fiber.Ctx) error
+	VerifyLink(ctx *fiber.Ctx) error
+}
+
+type EmailChangeHandler struct {
+	emailChangeService *EmailChangeService
+	auditService       *audit.AuditService
+	config             *config.Config
+}
+
+func NewEmailChangeHandler(emailChangeService *EmailChangeService, auditService *audit.AuditService, config *config.Config) *EmailChangeHandler {
+	return &EmailChangeHandler{
+		emailChangeService: emailChangeService,
+		auditService:       auditService,
+		config:             config,
+	}
+}
+
+type RequestChangeBody struct {
+	Email string `json:"email" xml:"email" form:"email"`
+}
+
+func (h *EmailChangeHandler) RequestChange(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(RequestChangeBody)
+	if err := ctx.BodyParser(body); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid email address",
+		})
+	}
+
+	verification, err := h.emailChangeService.RequestChange(currentUser, body.Email)
+	if err != nil {
+		return h.changeError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventEmailChangeRequested,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"email": verification.Email},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "We sent a code and a confirmation link to " + verification.Email,
+	})
+}
+
+type VerifyBody struct {
+	Code string `json:"code" xml:"code" form:"code"`
+}
+
+func (h *EmailChangeHandler) Verify(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(VerifyBody)
+	if err := ctx.BodyParser(body); err != nil || body.Code == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide the code sent to your email",
+		})
+	}
+
+	updated, oldEmail, err := h.emailChangeService.Verify(currentUser, body.Code)
+	if err != nil {
+		return h.changeError(ctx, err)
+	}
+
+	return h.changed(ctx, updated, oldEmail)
+}
+
+type VerifyLinkBody struct {
+	Token string `json:"token" xml:"token" form:"token"`
+}
+
+// VerifyLink confirms an address from the link in the verification email. It
+// is a POST made by the verification page so that mail scanners prefetching
+// the link cannot use it up.
+func (h *EmailChangeHandler) VerifyLink(ctx *fiber.Ctx) error {
+	body := new(VerifyLinkBody)
+	if err := ctx.BodyParser(body); err != nil || body.Token == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid or expired link",
+		})
+	}
+
+	updated, oldEmail, err := h.emailChangeService.VerifyToken(body.Token)
+	if err != nil {
+		if errors.Is(err, ErrNoPendingVerification) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Invalid or expired link",
+			})
+		}
+		return h.changeError(ctx, err)
+	}
+
+	return h.changed(ctx, updated, oldEmail)
+}
+
+func (h *EmailChangeHandler) changed(ctx *fiber.Ctx, updated *ent.User, oldEmail string) error {
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventEmailChanged,
+		Actor:   updated,
+		Subject: updated,
+		Metadata: map[string]any{
+			"old_email": oldEmail,
+			"new_email": updated.Email,
+		},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Email address verified successfully",
+		"data": fiber.Map{
+			"email":             updated.Email,
+			"email_verified_at": updated.EmailVerifiedAt,
+		},
+	})
+}
+
+func (h *EmailChangeHandler) changeError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidEmail):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid email address",
+		})
+	case errors.Is(err, ErrSameEmail):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "This email address is already verified on your account",
+		})
+	case errors.Is(err, ErrEmailTaken):
+		return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "email_taken",
+			"message": "This email address belongs to another account",
+		})
+	case errors.Is(err, ErrTooSoon):
+		return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please wait a minute before asking for another email",
+		})
+	case errors.Is(err, ErrNoPendingVerification):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "No email change in progress or the code expired, please start again",
+		})
+	case errors.Is(err, ErrInvalidCode):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid code",
+		})
+	case errors.Is(err, ErrTooManyAttempts):
+		return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Too many attempts, please start again",
+		})
+	}
+
+	h.config.Logger.Error("Failed to change email", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Failed to change email, please try again later",
+	})
+}
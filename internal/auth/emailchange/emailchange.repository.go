@@ -0,0 +1,126 @@
+package emailchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/user"
+	"github.com/shinplay/internal/db"
+)
+
+type EmailChangeRepositoryIntr interface {
+	Create(ctx context.Context, user *ent.User, email string, codeHash string, tokenHash string, expiresAt time.Time) (*ent.EmailVerification, error)
+	FindPendingByUser(ctx context.Context, userID int) (*ent.EmailVerification, error)
+	FindPendingByTokenHash(ctx context.Context, tokenHash string) (*ent.EmailVerification, error)
+	UseAttempt(ctx context.Context, verification *ent.EmailVerification, maxAttempts int) (bool, error)
+	Complete(ctx context.Context, verification *ent.EmailVerification, user *ent.User) (*ent.User, error)
+}
+
+type EmailChangeRepository struct {
+	client *ent.Client
+}
+
+func NewEmailChangeRepository(client *ent.Client) *EmailChangeRepository {
+	return &EmailChangeRepository{client: client}
+}
+
+// Create starts a new verification, discarding any earlier one still pending.
+func (r *EmailChangeRepository) Create(ctx context.Context, u *ent.User, email, codeHash, tokenHash string, expiresAt time.Time) (*ent.EmailVerification, error) {
+	_, err := r.client.EmailVerification.Delete().
+		Where(
+			emailverification.HasUserWith(user.IDEQ(u.ID)),
+			emailverification.VerifiedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.EmailVerification.Create().
+		SetUser(u).
+		SetEmail(email).
+		SetCodeHash(codeHash).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+func (r *EmailChangeRepository) FindPendingByUser(ctx context.Context, userID int) (*ent.EmailVerification, error) {
+	return r.client.EmailVerification.Query().
+		Where(
+			emailverification.HasUserWith(user.IDEQ(userID)),
+			emailverification.VerifiedAtIsNil(),
+			emailverification.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(emailverification.FieldCreateTime)).
+		First(ctx)
+}
+
+// FindPendingByTokenHash looks up the verification behind an emailed link
+// together with its user.
+func (r *EmailChangeRepository) FindPendingByTokenHash(ctx context.Context, tokenHash string) (*ent.EmailVerification, error) {
+	return r.client.EmailVerification.Query().
+		Where(
+			emailverification.TokenHashEQ(tokenHash),
+			emailverification.VerifiedAtIsNil(),
+			emailverification.ExpiresAtGT(time.Now()),
+		).
+		WithUser().
+		Only(ctx)
+}
+
+// UseAttempt counts an attempt at the code, reporting false once maxAttempts
+// were used. Checking and counting in one statement keeps parallel guesses
+// from going over the limit.
+func (r *EmailChangeRepository) UseAttempt(ctx context.Context, verification *ent.EmailVerification, maxAttempts int) (bool, error) {
+	n, err := r.client.EmailVerification.Update().
+		Where(
+			emailverification.IDEQ(verification.ID),
+			emailverification.AttemptsLT(maxAttempts),
+		).
+		AddAttempts(1).
+		Save(ctx)
+	return n == 1, err
+}
+
+// Complete puts the verified address on the user and closes the verification
+// in one transaction. The partial unique index on verified emails rejects the
+// change if someone else verified the address in the meantime. A guest with
+// a verified address becomes a full account.
+func (r *EmailChangeRepository) Complete(ctx context.Context, verification *ent.EmailVerification, u *ent.User) (*ent.User, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	// the pending check makes a link or code usable only once
+	n, err := tx.EmailVerification.Update().
+		Where(
+			emailverification.IDEQ(verification.ID),
+			emailverification.VerifiedAtIsNil(),
+		).
+		SetVerifiedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+	if n == 0 {
+		return nil, db.Rollback(tx, ErrNoPendingVerification)
+	}
+
+	updated, err := tx.User.UpdateOne(u).
+		SetEmail(verification.Email).
+		SetEmailVerifiedAt(now).
+		SetGuest(false).
+		ClearGuestDeviceHash().
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	return updated, tx.Commit()
+}
@@ -0,0 +1,220 @@
+package emailchange
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/notification"
+	"github.com/shinplay/internal/user"
+	"github.com/shinplay/pkg/publicid"
+	"go.uber.org/zap"
+)
+
+const (
+	verificationTTL = time.Hour
+	resendInterval  = time.Minute
+	codeLength      = 6
+	tokenLength     = 40
+	maxAttempts     = 5
+)
+
+var (
+	ErrInvalidEmail          = errors.New("invalid email address")
+	ErrSameEmail             = errors.New("email address is already verified on this account")
+	ErrEmailTaken            = errors.New("email address belongs to another account")
+	ErrTooSoon               = errors.New("verification email sent too recently")
+	ErrNoPendingVerification = errors.New("no pending email verification")
+	ErrInvalidCode           = errors.New("invalid code")
+	ErrTooManyAttempts       = errors.New("too many attempts")
+)
+
+type EmailChangeServiceIntr interface {
+	RequestChange(user *ent.User, email string) (*ent.EmailVerification, error)
+	Verify(user *ent.User, code string) (*ent.User, string, error)
+	VerifyToken(token string) (*ent.User, string, error)
+}
+
+// EmailChangeService adds, verifies and changes the email address on an
+// account. An address only counts as the user's once they prove they can read
+// mail sent to it, either by typing the code or by opening the link.
+type EmailChangeService struct {
+	emailChangeRepository *EmailChangeRepository
+	userService           *user.UserService
+	notificationService   *notification.NotificationService
+	config                *config.Config
+	ctx                   context.Context
+}
+
+func NewEmailChangeService(
+	emailChangeRepository *EmailChangeRepository,
+	userService *user.UserService,
+	notificationService *notification.NotificationService,
+	config *config.Config,
+	ctx context.Context,
+) *EmailChangeService {
+	return &EmailChangeService{
+		emailChangeRepository: emailChangeRepository,
+		userService:           userService,
+		notificationService:   notificationService,
+		config:                config,
+		ctx:                   ctx,
+	}
+}
+
+// RequestChange mails a code and a link to the new address.
+func (s *EmailChangeService) RequestChange(u *ent.User, email string) (*ent.EmailVerification, error) {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if email == u.Email && u.EmailVerifiedAt != nil {
+		return nil, ErrSameEmail
+	}
+	if err := s.ensureAvailable(u, email); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.emailChangeRepository.FindPendingByUser(s.ctx, u.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if pending != nil && time.Since(pending.CreateTime) < resendInterval {
+		return nil, ErrTooSoon
+	}
+
+	code := publicid.MustWith(codeLength, publicid.Numberic())
+	token := publicid.MustWith(tokenLength, publicid.AlphaNumeric())
+
+	verification, err := s.emailChangeRepository.Create(s.ctx, u, email, hash(code), hash(token), time.Now().Add(verificationTTL))
+	if err != nil {
+		s.config.Logger.Error("Failed to create email verification", zap.Error(err))
+		return nil, err
+	}
+
+	link := s.config.Mail.VerificationURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf(
+		"Your Shinplay verification code is %s.\n\nYou can also confirm this address by opening the link below:\n%s\n\nThe code and link expire in one hour. If you didn't ask for this, you can ignore this email.",
+		code, link,
+	)
+
+	if err := s.notificationService.SendEmail(email, "Confirm your email address", body); err != nil {
+		s.config.Logger.Error("Failed to send verification email", zap.Error(err))
+		return nil, err
+	}
+
+	return verification, nil
+}
+
+// Verify checks the code typed by the signed-in user and puts the address on
+// the account. The previous address is returned so the caller can record it.
+func (s *EmailChangeService) Verify(u *ent.User, code string) (*ent.User, string, error) {
+	verification, err := s.emailChangeRepository.FindPendingByUser(s.ctx, u.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", ErrNoPendingVerification
+		}
+		return nil, "", err
+	}
+
+	ok, err := s.emailChangeRepository.UseAttempt(s.ctx, verification, maxAttempts)
+	if err != nil {
+		s.config.Logger.Error("Failed to record email verification attempt", zap.Error(err))
+		return nil, "", err
+	}
+	if !ok {
+		return nil, "", ErrTooManyAttempts
+	}
+
+	if subtle.ConstantTimeCompare([]byte(verification.CodeHash), []byte(hash(code))) != 1 {
+		return nil, "", ErrInvalidCode
+	}
+
+	return s.complete(verification, u)
+}
+
+// VerifyToken confirms the address from the emailed link, which works without
+// being signed in on the device the mail was opened on.
+func (s *EmailChangeService) VerifyToken(token string) (*ent.User, string, error) {
+	verification, err := s.emailChangeRepository.FindPendingByTokenHash(s.ctx, hash(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", ErrNoPendingVerification
+		}
+		return nil, "", err
+	}
+
+	return s.complete(verification, verification.Edges.User)
+}
+
+func (s *EmailChangeService) complete(verification *ent.EmailVerification, u *ent.User) (*ent.User, string, error) {
+	if err := s.ensureAvailable(u, verification.Email); err != nil {
+		return nil, "", err
+	}
+
+	oldEmail := u.Email
+	oldVerified := u.EmailVerifiedAt != nil
+
+	updated, err := s.emailChangeRepository.Complete(s.ctx, verification, u)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, "", ErrEmailTaken
+		}
+		if errors.Is(err, ErrNoPendingVerification) {
+			return nil, "", err
+		}
+		s.config.Logger.Error("Failed to change email", zap.Error(err))
+		return nil, "", err
+	}
+
+	// only an address the user proved they own hears about the change
+	if oldVerified && oldEmail != updated.Email {
+		go func() {
+			body := "The email address on your Shinplay account was changed to " + updated.Email + ". If this wasn't you, please contact support right away."
+			if err := s.notificationService.SendEmail(oldEmail, "Your email address was changed", body); err != nil {
+				s.config.Logger.Error("Failed to notify previous email address", zap.Error(err))
+			}
+		}()
+	}
+
+	return updated, oldEmail, nil
+}
+
+func (s *EmailChangeService) ensureAvailable(u *ent.User, email string) error {
+	owner, err := s.userService.FindByEmail(email)
+	if err == nil && owner.ID != u.ID {
+		return ErrEmailTaken
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// normalizeEmail accepts a bare address only, no display name, and lowercases
+// it so the uniqueness check cannot be sidestepped with capitals.
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || address.Name != "" {
+		return "", ErrInvalidEmail
+	}
+
+	return email, nil
+}
+
+func hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
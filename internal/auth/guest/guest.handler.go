@@ -0,0 +1,236 @@
+package guest
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/auth/phonechange"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/risk"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+type GuestHandlerIntr interface {
+	SignIn(ctx *fiber.Ctx) error
+	StartPhoneUpgrade(ctx *fiber.Ctx) error
+	CompletePhoneUpgrade(ctx *fiber.Ctx) error
+	UpgradeWithGoogle(ctx *fiber.Ctx) error
+}
+
+type GuestHandler struct {
+	guestService *GuestService
+	authService  *auth.AuthService
+	auditService *audit.AuditService
+	riskEngine   *risk.RiskEngine
+	config       *config.Config
+}
+
+func NewGuestHandler(guestService *GuestService, authService *auth.AuthService, auditService *audit.AuditService, riskEngine *risk.RiskEngine, config *config.Config) *GuestHandler {
+	return &GuestHandler{
+		guestService: guestService,
+		authService:  authService,
+		auditService: auditService,
+		riskEngine:   riskEngine,
+		config:       config,
+	}
+}
+
+type SignInBody struct {
+	DeviceID string `json:"device_id" xml:"device_id" form:"device_id"`
+}
+
+// SignIn signs the device in as a guest, creating the guest on first use.
+func (h *GuestHandler) SignIn(ctx *fiber.Ctx) error {
+	body := new(SignInBody)
+	if err := ctx.BodyParser(body); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid device ID",
+		})
+	}
+
+	guest, created, err := h.guestService.SignIn(body.DeviceID)
+	if err != nil {
+		return h.guestError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventGuestSignIn,
+		Actor:    guest,
+		Subject:  guest,
+		Metadata: map[string]any{"created": created},
+	})
+
+	return h.login(ctx, guest, "Signed in as guest")
+}
+
+type PhoneUpgradeBody struct {
+	PhoneNumber string `json:"phone_number" xml:"phone_number" form:"phone_number"`
+	Otp         string `json:"otp" xml:"otp" form:"otp"`
+}
+
+func (h *GuestHandler) StartPhoneUpgrade(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(PhoneUpgradeBody)
+	if err := ctx.BodyParser(body); err != nil || body.PhoneNumber == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid phone number",
+		})
+	}
+
+	h.riskEngine.RecordOTPSend(body.PhoneNumber, ctx.IP())
+
+	if err := h.guestService.StartPhoneUpgrade(currentUser, body.PhoneNumber); err != nil {
+		return h.guestError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "WhatsApp OTP sent successfully",
+	})
+}
+
+func (h *GuestHandler) CompletePhoneUpgrade(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(PhoneUpgradeBody)
+	if err := ctx.BodyParser(body); err != nil || body.PhoneNumber == "" || body.Otp == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid OTP",
+		})
+	}
+
+	upgraded, merged, err := h.guestService.CompletePhoneUpgrade(currentUser, body.PhoneNumber, body.Otp)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCode) || errors.Is(err, phonechange.ErrInvalidCode) {
+			h.riskEngine.RecordFailure(body.PhoneNumber, ctx.IP())
+		}
+		return h.guestError(ctx, err)
+	}
+
+	h.riskEngine.RecordSuccess(body.PhoneNumber, ctx.IP())
+
+	return h.upgraded(ctx, currentUser, upgraded, merged, "whatsapp")
+}
+
+type GoogleUpgradeBody struct {
+	IDToken string `json:"id_token" xml:"id_token" form:"id_token"`
+}
+
+func (h *GuestHandler) UpgradeWithGoogle(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	// the Authorization header already carries the guest's own token
+	body := new(GoogleUpgradeBody)
+	if err := ctx.BodyParser(body); err != nil || body.IDToken == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a Google ID token",
+		})
+	}
+
+	upgraded, merged, err := h.guestService.UpgradeWithGoogle(currentUser, body.IDToken)
+	if err != nil {
+		return h.guestError(ctx, err)
+	}
+
+	return h.upgraded(ctx, currentUser, upgraded, merged, "google")
+}
+
+// upgraded records the upgrade and signs the device in as the full account.
+func (h *GuestHandler) upgraded(ctx *fiber.Ctx, guest *ent.User, upgraded *ent.User, merged bool, method string) error {
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventGuestUpgraded,
+		Actor:   upgraded,
+		Subject: upgraded,
+		Metadata: map[string]any{
+			"method":        method,
+			"merged":        merged,
+			"guest_auth_id": guest.AuthID,
+		},
+	})
+
+	message := "Account upgraded successfully"
+	if merged {
+		message = "Signed in to your existing account"
+	}
+
+	return h.login(ctx, upgraded, message)
+}
+
+func (h *GuestHandler) login(ctx *fiber.Ctx, u *ent.User, message string) error {
+	tokens, userInfo, sessionId, err := h.authService.LoginUser(u, ctx.IP(), ctx.Get("User-Agent"))
+	if err != nil {
+		return h.guestError(ctx, err)
+	}
+
+	ctx.Cookie(&fiber.Cookie{
+		Name:     "session_id",
+		Value:    sessionId,
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: fiber.CookieSameSiteStrictMode,
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": message,
+		"data": fiber.Map{
+			"access_token": tokens.AccessToken,
+			"user":         userInfo,
+		},
+	})
+}
+
+func (h *GuestHandler) guestError(ctx *fiber.Ctx, err error) error {
+	if restriction, ok := user.AsRestriction(err); ok {
+		return restriction.Respond(ctx)
+	}
+
+	switch {
+	case errors.Is(err, ErrInvalidDeviceID):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid device ID",
+		})
+	case errors.Is(err, ErrNotGuest):
+		return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "not_guest",
+			"message": "This account is already a full account",
+		})
+	case errors.Is(err, phonechange.ErrInvalidPhoneNumber):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid phone number",
+		})
+	case errors.Is(err, phonechange.ErrPhoneTaken):
+		return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"status":  "error",
+			"message": "This phone number was just registered, please request a new code",
+		})
+	case errors.Is(err, ErrInvalidCode), errors.Is(err, phonechange.ErrInvalidCode), errors.Is(err, phonechange.ErrNoPendingRequest):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid OTP or OTP expired",
+		})
+	case errors.Is(err, phonechange.ErrTooManyAttempts):
+		return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Too many attempts, please request a new code",
+		})
+	}
+
+	h.config.Logger.Error("Guest request failed", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Something went wrong, please try again later",
+	})
+}
@@ -0,0 +1,69 @@
+package guest
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/session"
+	"github.com/shinplay/ent/user"
+)
+
+type GuestRepositoryIntr interface {
+	Create(ctx context.Context, deviceHash string) (*ent.User, error)
+	FindByDeviceHash(ctx context.Context, deviceHash string) (*ent.User, error)
+	SetVerifiedEmail(ctx context.Context, guest *ent.User, email string) (*ent.User, error)
+	FindStale(ctx context.Context, before time.Time, afterID int, limit int) ([]*ent.User, error)
+}
+
+type GuestRepository struct {
+	client *ent.Client
+}
+
+func NewGuestRepository(client *ent.Client) *GuestRepository {
+	return &GuestRepository{client: client}
+}
+
+func (r *GuestRepository) Create(ctx context.Context, deviceHash string) (*ent.User, error) {
+	return r.client.User.Create().
+		SetGuest(true).
+		SetGuestDeviceHash(deviceHash).
+		Save(ctx)
+}
+
+func (r *GuestRepository) FindByDeviceHash(ctx context.Context, deviceHash string) (*ent.User, error) {
+	return r.client.User.Query().
+		Where(
+			user.GuestDeviceHashEQ(deviceHash),
+			user.Guest(true),
+			user.StatusNEQ(user.StatusDeleted),
+		).
+		Only(ctx)
+}
+
+// SetVerifiedEmail upgrades the guest in place with an address the identity
+// provider has verified.
+func (r *GuestRepository) SetVerifiedEmail(ctx context.Context, guest *ent.User, email string) (*ent.User, error) {
+	return r.client.User.UpdateOne(guest).
+		SetEmail(email).
+		SetEmailVerifiedAt(time.Now()).
+		SetGuest(false).
+		ClearGuestDeviceHash().
+		Save(ctx)
+}
+
+// FindStale returns guests that have not signed in since before and have no
+// session left to come back with, paged by ID after afterID.
+func (r *GuestRepository) FindStale(ctx context.Context, before time.Time, afterID int, limit int) ([]*ent.User, error) {
+	return r.client.User.Query().
+		Where(
+			user.Guest(true),
+			user.StatusNEQ(user.StatusDeleted),
+			user.UpdateTimeLT(before),
+			user.Not(user.HasSessionsWith(session.ExpiresAtGT(time.Now()))),
+			user.IDGT(afterID),
+		).
+		Order(ent.Asc(user.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
@@ -0,0 +1,265 @@
+package guest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/auth/phonechange"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+const (
+	minDeviceIDLength = 16
+	maxDeviceIDLength = 128
+	cleanupInterval   = time.Hour
+	cleanupBatchSize  = 100
+)
+
+var (
+	ErrInvalidDeviceID = errors.New("invalid device id")
+	ErrNotGuest        = errors.New("account is not a guest")
+	ErrInvalidCode     = errors.New("invalid code")
+)
+
+type GuestServiceIntr interface {
+	SignIn(deviceID string) (*ent.User, bool, error)
+	StartPhoneUpgrade(guest *ent.User, phoneNumber string) error
+	CompletePhoneUpgrade(guest *ent.User, phoneNumber string, code string) (*ent.User, bool, error)
+	UpgradeWithGoogle(guest *ent.User, idToken string) (*ent.User, bool, error)
+	Run()
+}
+
+// GuestService lets people try the app before verifying a phone number. A
+// guest is a regular user flagged as such, bound to the device that created
+// it, and is upgraded in place or merged into an existing account once it
+// proves a phone number or Google account.
+type GuestService struct {
+	guestRepository    *GuestRepository
+	userService        *user.UserService
+	authService        *auth.AuthService
+	phoneChangeService *phonechange.PhoneChangeService
+	config             *config.Config
+	ctx                context.Context
+}
+
+func NewGuestService(
+	guestRepository *GuestRepository,
+	userService *user.UserService,
+	authService *auth.AuthService,
+	phoneChangeService *phonechange.PhoneChangeService,
+	config *config.Config,
+	ctx context.Context,
+) *GuestService {
+	return &GuestService{
+		guestRepository:    guestRepository,
+		userService:        userService,
+		authService:        authService,
+		phoneChangeService: phoneChangeService,
+		config:             config,
+		ctx:                ctx,
+	}
+}
+
+// SignIn returns the guest bound to the device, creating it on first use. The
+// device ID is generated and kept by the app, so it is the guest's only
+// credential and is stored hashed.
+func (s *GuestService) SignIn(deviceID string) (*ent.User, bool, error) {
+	if len(deviceID) < minDeviceIDLength || len(deviceID) > maxDeviceIDLength {
+		return nil, false, ErrInvalidDeviceID
+	}
+
+	deviceHash := hash(deviceID)
+
+	guest, err := s.guestRepository.FindByDeviceHash(s.ctx, deviceHash)
+	if err == nil {
+		return guest, false, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	guest, err = s.guestRepository.Create(s.ctx, deviceHash)
+	if ent.IsConstraintError(err) {
+		// another request from the same device got there first
+		guest, err = s.guestRepository.FindByDeviceHash(s.ctx, deviceHash)
+		return guest, false, err
+	}
+	if err != nil {
+		s.config.Logger.Error("Failed to create guest", zap.Error(err))
+		return nil, false, err
+	}
+
+	return guest, true, nil
+}
+
+// StartPhoneUpgrade sends a code to the phone number. A number that already
+// has an account gets a regular login OTP so the guest can be merged into
+// it, any other number goes through the phone change flow.
+func (s *GuestService) StartPhoneUpgrade(guest *ent.User, phoneNumber string) error {
+	if !guest.Guest {
+		return ErrNotGuest
+	}
+
+	owner, err := s.findByPhone(phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	if owner != nil {
+		if err := s.userService.CheckRestriction(owner); err != nil {
+			return err
+		}
+		return s.authService.SendWhatsAppOTP(phoneNumber)
+	}
+
+	_, err = s.phoneChangeService.RequestChange(guest, phoneNumber)
+	return err
+}
+
+// CompletePhoneUpgrade checks the code sent by StartPhoneUpgrade. It returns
+// the account the guest ended up as and whether it was merged into another.
+func (s *GuestService) CompletePhoneUpgrade(guest *ent.User, phoneNumber string, code string) (*ent.User, bool, error) {
+	if !guest.Guest {
+		return nil, false, ErrNotGuest
+	}
+
+	owner, err := s.findByPhone(phoneNumber)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if owner == nil {
+		upgraded, _, err := s.phoneChangeService.Verify(guest, code)
+		return upgraded, false, err
+	}
+
+	if isValid, _ := s.authService.VerifyWhatsAppOTP(phoneNumber, code); !isValid {
+		return nil, false, ErrInvalidCode
+	}
+
+	merged, err := s.merge(guest, owner)
+	return merged, true, err
+}
+
+// UpgradeWithGoogle upgrades the guest with the verified email of a Google
+// account, merging it into the account that already verified the address.
+func (s *GuestService) UpgradeWithGoogle(guest *ent.User, idToken string) (*ent.User, bool, error) {
+	if !guest.Guest {
+		return nil, false, ErrNotGuest
+	}
+
+	identity, err := s.authService.VerifyGoogleIdentity(idToken)
+	if err != nil {
+		return nil, false, err
+	}
+
+	owner, err := s.userService.FindByEmail(identity.Email)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	if owner != nil {
+		merged, err := s.merge(guest, owner)
+		if err == nil {
+			s.authService.ImportGooglePicture(merged, identity)
+		}
+		return merged, true, err
+	}
+
+	upgraded, err := s.guestRepository.SetVerifiedEmail(s.ctx, guest, identity.Email)
+	if err != nil {
+		s.config.Logger.Error("Failed to upgrade guest", zap.Error(err))
+		return nil, false, err
+	}
+
+	s.authService.ImportGooglePicture(upgraded, identity)
+
+	return upgraded, false, nil
+}
+
+// Run anonymizes stale guests every cleanupInterval until the context is done.
+func (s *GuestService) Run() {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		s.CleanupStale()
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CleanupStale anonymizes guests that have not been back for GuestTTL. A
+// guest that fails is logged and retried on the next run.
+func (s *GuestService) CleanupStale() int {
+	removed := 0
+	lastID := 0
+	before := time.Now().Add(-s.config.Account.GuestTTL)
+
+	for {
+		guests, err := s.guestRepository.FindStale(s.ctx, before, lastID, cleanupBatchSize)
+		if err != nil {
+			s.config.Logger.Error("Failed to find stale guests", zap.Error(err))
+			return removed
+		}
+
+		for _, guest := range guests {
+			lastID = guest.ID
+			if err := s.userService.Anonymize(guest); err != nil {
+				s.config.Logger.Error("Failed to remove stale guest", zap.String("authID", guest.AuthID), zap.Error(err))
+				continue
+			}
+			removed++
+		}
+
+		if len(guests) < cleanupBatchSize {
+			break
+		}
+	}
+
+	if removed > 0 {
+		s.config.Logger.Info("Removed stale guests", zap.Int("count", removed))
+	}
+
+	return removed
+}
+
+func (s *GuestService) merge(guest *ent.User, owner *ent.User) (*ent.User, error) {
+	if err := s.userService.CheckRestriction(owner); err != nil {
+		return nil, err
+	}
+
+	merged, err := s.userService.MergeGuest(guest, owner)
+	if err != nil {
+		s.config.Logger.Error("Failed to merge guest", zap.String("authID", guest.AuthID), zap.Error(err))
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+// findByPhone returns the account registered with the phone number, or nil.
+func (s *GuestService) findByPhone(phoneNumber string) (*ent.User, error) {
+	owner, err := s.userService.FindByPhone(phoneNumber)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return owner, err
+}
+
+func hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -12,6 +12,7 @@ type OTPRepositoryIntr interface {
 	CreateNewOTP(ctx context.Context, user *ent.User) (*ent.OTP, error)
 	FindOTPByUser(ctx context.Context, user *ent.User) (*ent.OTP, error)
 	DeleteOTP(ctx context.Context, otpCode string, user *ent.User) error
+	FindOTPsByUser(ctx context.Context, userID int) ([]*ent.OTP, error)
 }
 
 type OTPRepository struct {
@@ -49,3 +50,10 @@ func (o *OTPRepository) DeleteOTP(ctx context.Context, otpCode string, userId in
 
 	return otpId, nil
 }
+
+func (o *OTPRepository) FindOTPsByUser(ctx context.Context, userID int) ([]*ent.OTP, error) {
+	return o.client.OTP.Query().
+		Where(otp.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(otp.FieldCreateTime)).
+		All(ctx)
+}
@@ -0,0 +1,177 @@
+package pat
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/rbac"
+	"go.uber.org/zap"
+)
+
+type PATHandlerIntr interface {
+	CreateToken(ctx *fiber.Ctx) error
+	ListTokens(ctx *fiber.Ctx) error
+	RevokeToken(ctx *fiber.Ctx) error
+}
+
+type PATHandler struct {
+	patService   *PATService
+	auditService *audit.AuditService
+	config       *config.Config
+}
+
+func NewPATHandler(patService *PATService, auditService *audit.AuditService, config *config.Config) *PATHandler {
+	return &PATHandler{
+		patService:   patService,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+// TokenDetail is a personal access token as shown to its owner. The secret
+// itself is never part of it.
+type TokenDetail struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func newTokenDetail(token *ent.PersonalAccessToken) TokenDetail {
+	return TokenDetail{
+		ID:         token.ID,
+		Name:       token.Name,
+		Prefix:     token.TokenPrefix,
+		Scopes:     token.Scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreateTime,
+	}
+}
+
+type CreateTokenBody struct {
+	Name          string   `json:"name" xml:"name" form:"name"`
+	Scopes        []string `json:"scopes" xml:"scopes" form:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days" xml:"expires_in_days" form:"expires_in_days"`
+}
+
+func (h *PATHandler) CreateToken(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+	permissions, _ := ctx.Locals("permissions").(rbac.Permissions)
+	granted, _ := ctx.Locals("scopes").([]string)
+
+	body := new(CreateTokenBody)
+	if err := ctx.BodyParser(body); err != nil || body.Name == "" || len(body.Name) > 100 {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a token name of at most 100 characters",
+		})
+	}
+
+	token, created, err := h.patService.Create(currentUser, permissions, granted, body.Name, body.Scopes, body.ExpiresInDays)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidScope):
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"status":  "error",
+				"message": "One or more scopes are unknown or not allowed for your account",
+			})
+		case errors.Is(err, ErrInvalidExpiry):
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Tokens must expire within 365 days",
+			})
+		}
+
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to create token, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventAccessTokenCreated,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"token_id": created.ID, "name": created.Name, "scopes": created.Scopes},
+	})
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"status":  "success",
+		"message": "Token created, copy it now as it will not be shown again",
+		"data": fiber.Map{
+			"token":   token,
+			"details": newTokenDetail(created),
+		},
+	})
+}
+
+func (h *PATHandler) ListTokens(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	tokens, err := h.patService.List(currentUser)
+	if err != nil {
+		h.config.Logger.Error("Failed to list personal access tokens", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to list tokens, please try again later",
+		})
+	}
+
+	details := make([]TokenDetail, 0, len(tokens))
+	for _, token := range tokens {
+		details = append(details, newTokenDetail(token))
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   details,
+	})
+}
+
+func (h *PATHandler) RevokeToken(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	id, err := ctx.ParamsInt("tokenId")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid token ID",
+		})
+	}
+
+	revoked, err := h.patService.Revoke(currentUser, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Token not found",
+			})
+		}
+
+		h.config.Logger.Error("Failed to revoke personal access token", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to revoke token, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventAccessTokenRevoked,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"token_id": revoked.ID, "name": revoked.Name},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Token revoked successfully",
+	})
+}
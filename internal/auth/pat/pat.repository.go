@@ -0,0 +1,79 @@
+package pat
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/user"
+)
+
+type PATRepositoryIntr interface {
+	Create(ctx context.Context, user *ent.User, name string, prefix string, hash string, scopes []string, expiresAt time.Time) (*ent.PersonalAccessToken, error)
+	FindByHash(ctx context.Context, hash string) (*ent.PersonalAccessToken, error)
+	FindByUser(ctx context.Context, userID int) ([]*ent.PersonalAccessToken, error)
+	FindByIDForUser(ctx context.Context, id int, userID int) (*ent.PersonalAccessToken, error)
+	Revoke(ctx context.Context, token *ent.PersonalAccessToken) (*ent.PersonalAccessToken, error)
+	TouchLastUsed(ctx context.Context, token *ent.PersonalAccessToken, usedAt time.Time) error
+}
+
+type PATRepository struct {
+	client *ent.Client
+}
+
+func NewPATRepository(client *ent.Client) *PATRepository {
+	return &PATRepository{client: client}
+}
+
+func (r *PATRepository) Create(ctx context.Context, user *ent.User, name, prefix, hash string, scopes []string, expiresAt time.Time) (*ent.PersonalAccessToken, error) {
+	return r.client.PersonalAccessToken.Create().
+		SetUser(user).
+		SetName(name).
+		SetTokenPrefix(prefix).
+		SetTokenHash(hash).
+		SetScopes(scopes).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// FindByHash returns the token with its owner loaded.
+func (r *PATRepository) FindByHash(ctx context.Context, hash string) (*ent.PersonalAccessToken, error) {
+	return r.client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.TokenHashEQ(hash)).
+		WithUser().
+		Only(ctx)
+}
+
+// FindByUser lists the tokens of a user that have not been revoked.
+func (r *PATRepository) FindByUser(ctx context.Context, userID int) ([]*ent.PersonalAccessToken, error) {
+	return r.client.PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.HasUserWith(user.IDEQ(userID)),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		Order(ent.Desc(personalaccesstoken.FieldCreateTime)).
+		All(ctx)
+}
+
+func (r *PATRepository) FindByIDForUser(ctx context.Context, id int, userID int) (*ent.PersonalAccessToken, error) {
+	return r.client.PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.IDEQ(id),
+			personalaccesstoken.HasUserWith(user.IDEQ(userID)),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		Only(ctx)
+}
+
+func (r *PATRepository) Revoke(ctx context.Context, token *ent.PersonalAccessToken) (*ent.PersonalAccessToken, error) {
+	return token.Update().
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+func (r *PATRepository) TouchLastUsed(ctx context.Context, token *ent.PersonalAccessToken, usedAt time.Time) error {
+	return r.client.PersonalAccessToken.UpdateOneID(token.ID).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+}
@@ -0,0 +1,139 @@
+package pat
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/rbac"
+	"github.com/shinplay/pkg/publicid"
+	"go.uber.org/zap"
+)
+
+// TokenPrefix marks personal access tokens so secret scanners can find them
+// and AuthenticateUser can tell them apart from JWTs.
+const TokenPrefix = "shp_pat_"
+
+const (
+	secretLength      = 40
+	displayLength     = len(TokenPrefix) + 6
+	defaultExpiryDays = 30
+	maxExpiryDays     = 365
+
+	// lastUsedPrecision avoids writing to the database on every request.
+	lastUsedPrecision = 1 * time.Minute
+)
+
+var (
+	ErrInvalidToken  = errors.New("invalid, revoked or expired token")
+	ErrInvalidScope  = errors.New("invalid scope")
+	ErrInvalidExpiry = errors.New("invalid expiry")
+)
+
+type PATServiceIntr interface {
+	Create(user *ent.User, permissions rbac.Permissions, granted []string, name string, scopes []string, expiresInDays int) (string, *ent.PersonalAccessToken, error)
+	List(user *ent.User) ([]*ent.PersonalAccessToken, error)
+	Revoke(user *ent.User, id int) (*ent.PersonalAccessToken, error)
+	Authenticate(token string) (*ent.PersonalAccessToken, *ent.User, error)
+}
+
+type PATService struct {
+	patRepository *PATRepository
+	config        *config.Config
+	ctx           context.Context
+}
+
+func NewPATService(patRepository *PATRepository, config *config.Config, ctx context.Context) *PATService {
+	return &PATService{
+		patRepository: patRepository,
+		config:        config,
+		ctx:           ctx,
+	}
+}
+
+// IsPersonalAccessToken reports whether token looks like a personal access token.
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, TokenPrefix)
+}
+
+// Create issues a new token. The plain token is only returned here, the
+// database keeps its hash. Scopes must be self-service scopes or permissions
+// the user already holds. A caller that is itself limited to the granted
+// scopes, such as another token, cannot create a token that exceeds them;
+// granted is nil for first-party sessions.
+func (s *PATService) Create(user *ent.User, permissions rbac.Permissions, granted []string, name string, scopes []string, expiresInDays int) (string, *ent.PersonalAccessToken, error) {
+	if expiresInDays == 0 {
+		expiresInDays = defaultExpiryDays
+	}
+	if expiresInDays < 0 || expiresInDays > maxExpiryDays {
+		return "", nil, ErrInvalidExpiry
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(rbac.SelfServiceScopes, scope) && !permissions.Has(scope) {
+			return "", nil, ErrInvalidScope
+		}
+		if granted != nil && !rbac.Permissions(granted).Has(scope) {
+			return "", nil, ErrInvalidScope
+		}
+	}
+
+	token := TokenPrefix + publicid.MustWith(secretLength, publicid.AlphaNumeric())
+	expiresAt := time.Now().AddDate(0, 0, expiresInDays)
+
+	created, err := s.patRepository.Create(s.ctx, user, name, token[:displayLength], hashToken(token), scopes, expiresAt)
+	if err != nil {
+		s.config.Logger.Error("Failed to create personal access token", zap.Error(err))
+		return "", nil, err
+	}
+
+	return token, created, nil
+}
+
+func (s *PATService) List(user *ent.User) ([]*ent.PersonalAccessToken, error) {
+	return s.patRepository.FindByUser(s.ctx, user.ID)
+}
+
+func (s *PATService) Revoke(user *ent.User, id int) (*ent.PersonalAccessToken, error) {
+	token, err := s.patRepository.FindByIDForUser(s.ctx, id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.patRepository.Revoke(s.ctx, token)
+}
+
+// Authenticate resolves a plain token to its owner and records its use.
+func (s *PATService) Authenticate(token string) (*ent.PersonalAccessToken, *ent.User, error) {
+	found, err := s.patRepository.FindByHash(s.ctx, hashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrInvalidToken
+		}
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if found.RevokedAt != nil || found.ExpiresAt.Before(now) || found.Edges.User == nil {
+		return nil, nil, ErrInvalidToken
+	}
+
+	if found.LastUsedAt == nil || now.Sub(*found.LastUsedAt) >= lastUsedPrecision {
+		if err := s.patRepository.TouchLastUsed(s.ctx, found, now); err != nil {
+			s.config.Logger.Warn("Failed to record personal access token use", zap.Error(err))
+		}
+	}
+
+	return found, found.Edges.User, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
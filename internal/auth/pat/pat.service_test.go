@@ -0,0 +1,68 @@
+package pat
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/enttest"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/rbac"
+	"go.uber.org/zap"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestService(t *testing.T) (*PATService, *ent.User) {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	owner, err := client.User.Create().Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewPATService(NewPATRepository(client), &config.Config{Logger: zap.NewNop()}, context.Background())
+	return service, owner
+}
+
+func TestCreateLimitsScopesToTheCallingToken(t *testing.T) {
+	service, owner := newTestService(t)
+	granted := []string{rbac.ScopeAccountManage}
+	permissions := rbac.Permissions(granted)
+
+	for _, scope := range []string{rbac.ScopeSocialWrite, rbac.ScopeProfileWrite} {
+		_, _, err := service.Create(owner, permissions, granted, "escalated", []string{scope}, 0)
+		if !errors.Is(err, ErrInvalidScope) {
+			t.Errorf("creating a token with %s from an %s token: got %v, want ErrInvalidScope", scope, rbac.ScopeAccountManage, err)
+		}
+	}
+
+	token, created, err := service.Create(owner, permissions, granted, "narrower", []string{rbac.ScopeAccountManage}, 0)
+	if err != nil {
+		t.Fatalf("creating a token within the granted scopes: %v", err)
+	}
+	if !IsPersonalAccessToken(token) || len(created.Scopes) != 1 || created.Scopes[0] != rbac.ScopeAccountManage {
+		t.Errorf("got token %q with scopes %v", token, created.Scopes)
+	}
+}
+
+func TestCreateAllowsSelfServiceScopesFromASession(t *testing.T) {
+	service, owner := newTestService(t)
+
+	_, created, err := service.Create(owner, rbac.Permissions{}, nil, "cli", []string{rbac.ScopeSocialWrite, rbac.ScopeProfileWrite}, 0)
+	if err != nil {
+		t.Fatalf("creating a token from a session: %v", err)
+	}
+	if len(created.Scopes) != 2 {
+		t.Errorf("got scopes %v", created.Scopes)
+	}
+
+	_, _, err = service.Create(owner, rbac.Permissions{}, nil, "admin", []string{rbac.PermissionAll}, 0)
+	if !errors.Is(err, ErrInvalidScope) {
+		t.Errorf("creating a token with a permission the user lacks: got %v, want ErrInvalidScope", err)
+	}
+}
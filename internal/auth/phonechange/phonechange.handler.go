@@ -0,0 +1,162 @@
+package phonechange
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/risk"
+	"go.uber.org/zap"
+)
+
+type PhoneChangeHandlerIntr interface {
+	RequestChange(ctx *fiber.Ctx) error
+	Verify(ctx *fiber.Ctx) error
+}
+
+type PhoneChangeHandler struct {
+	phoneChangeService *PhoneChangeService
+	auditService       *audit.AuditService
+	riskEngine         *risk.RiskEngine
+	config             *config.Config
+}
+
+func NewPhoneChangeHandler(phoneChangeService *PhoneChangeService, auditService *audit.AuditService, riskEngine *risk.RiskEngine, config *config.Config) *PhoneChangeHandler {
+	return &PhoneChangeHandler{
+		phoneChangeService: phoneChangeService,
+		auditService:       auditService,
+		riskEngine:         riskEngine,
+		config:             config,
+	}
+}
+
+type RequestChangeBody struct {
+	PhoneNumber string `json:"phone_number" xml:"phone_number" form:"phone_number"`
+}
+
+func (h *PhoneChangeHandler) RequestChange(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(RequestChangeBody)
+	if err := ctx.BodyParser(body); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid phone number",
+		})
+	}
+
+	h.riskEngine.RecordOTPSend(body.PhoneNumber, ctx.IP())
+
+	if _, err := h.phoneChangeService.RequestChange(currentUser, body.PhoneNumber); err != nil {
+		return h.changeError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventPhoneChangeRequested,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"phone_number": body.PhoneNumber},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "We sent a code to your new number on WhatsApp",
+	})
+}
+
+type VerifyBody struct {
+	Code                string `json:"code" xml:"code" form:"code"`
+	RevokeOtherSessions bool   `json:"revoke_other_sessions" xml:"revoke_other_sessions" form:"revoke_other_sessions"`
+}
+
+func (h *PhoneChangeHandler) Verify(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(VerifyBody)
+	if err := ctx.BodyParser(body); err != nil || body.Code == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide the code sent to your new number",
+		})
+	}
+
+	updated, oldPhoneNumber, err := h.phoneChangeService.Verify(currentUser, body.Code)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCode) {
+			h.riskEngine.RecordFailure(currentUser.PhoneNumber, ctx.IP())
+		}
+		return h.changeError(ctx, err)
+	}
+
+	revoked := 0
+	if body.RevokeOtherSessions {
+		revoked, err = h.phoneChangeService.RevokeOtherSessions(updated, ctx.Cookies("session_id"))
+		if err != nil {
+			h.config.Logger.Error("Failed to revoke other sessions", zap.Error(err))
+		}
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventPhoneChanged,
+		Actor:   updated,
+		Subject: updated,
+		Metadata: map[string]any{
+			"old_phone_number": oldPhoneNumber,
+			"new_phone_number": updated.PhoneNumber,
+			"sessions_revoked": revoked,
+		},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Phone number changed successfully",
+		"data": fiber.Map{
+			"phone_number":     updated.PhoneNumber,
+			"sessions_revoked": revoked,
+		},
+	})
+}
+
+func (h *PhoneChangeHandler) changeError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidPhoneNumber):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid phone number",
+		})
+	case errors.Is(err, ErrSamePhoneNumber):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "This phone number is already on your account",
+		})
+	case errors.Is(err, ErrPhoneTaken):
+		return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "phone_taken",
+			"message": "This phone number belongs to another account",
+		})
+	case errors.Is(err, ErrNoPendingRequest):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "No phone change in progress or the code expired, please start again",
+		})
+	case errors.Is(err, ErrInvalidCode):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid code",
+		})
+	case errors.Is(err, ErrTooManyAttempts):
+		return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Too many attempts, please start again",
+		})
+	}
+
+	h.config.Logger.Error("Failed to change phone number", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Failed to change phone number, please try again later",
+	})
+}
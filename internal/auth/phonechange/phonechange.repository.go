@@ -0,0 +1,100 @@
+package phonechange
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/user"
+	"github.com/shinplay/internal/db"
+)
+
+type PhoneChangeRepositoryIntr interface {
+	Create(ctx context.Context, user *ent.User, newPhoneNumber string, codeHash string, expiresAt time.Time) (*ent.PhoneChangeRequest, error)
+	FindPendingByUser(ctx context.Context, userID int) (*ent.PhoneChangeRequest, error)
+	UseAttempt(ctx context.Context, request *ent.PhoneChangeRequest, maxAttempts int) (bool, error)
+	Complete(ctx context.Context, request *ent.PhoneChangeRequest, user *ent.User) (*ent.User, error)
+}
+
+type PhoneChangeRepository struct {
+	client *ent.Client
+}
+
+func NewPhoneChangeRepository(client *ent.Client) *PhoneChangeRepository {
+	return &PhoneChangeRepository{client: client}
+}
+
+// Create starts a new request, discarding any earlier one still pending.
+func (r *PhoneChangeRepository) Create(ctx context.Context, u *ent.User, newPhoneNumber, codeHash string, expiresAt time.Time) (*ent.PhoneChangeRequest, error) {
+	_, err := r.client.PhoneChangeRequest.Delete().
+		Where(
+			phonechangerequest.HasUserWith(user.IDEQ(u.ID)),
+			phonechangerequest.VerifiedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.PhoneChangeRequest.Create().
+		SetUser(u).
+		SetNewPhoneNumber(newPhoneNumber).
+		SetCodeHash(codeHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+func (r *PhoneChangeRepository) FindPendingByUser(ctx context.Context, userID int) (*ent.PhoneChangeRequest, error) {
+	return r.client.PhoneChangeRequest.Query().
+		Where(
+			phonechangerequest.HasUserWith(user.IDEQ(userID)),
+			phonechangerequest.VerifiedAtIsNil(),
+			phonechangerequest.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(phonechangerequest.FieldCreateTime)).
+		First(ctx)
+}
+
+// UseAttempt counts an attempt at the code, reporting false once maxAttempts
+// were used. Checking and counting in one statement keeps parallel guesses
+// from going over the limit.
+func (r *PhoneChangeRepository) UseAttempt(ctx context.Context, request *ent.PhoneChangeRequest, maxAttempts int) (bool, error) {
+	n, err := r.client.PhoneChangeRequest.Update().
+		Where(
+			phonechangerequest.IDEQ(request.ID),
+			phonechangerequest.AttemptsLT(maxAttempts),
+		).
+		AddAttempts(1).
+		Save(ctx)
+	return n == 1, err
+}
+
+// Complete moves the user to the new number and closes the request in one
+// transaction. The unique index on phone_number rejects the change if the
+// number was taken since the request was made. A guest with a verified
+// number becomes a full account.
+func (r *PhoneChangeRepository) Complete(ctx context.Context, request *ent.PhoneChangeRequest, u *ent.User) (*ent.User, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.User.UpdateOne(u).
+		SetPhoneNumber(request.NewPhoneNumber).
+		SetGuest(false).
+		ClearGuestDeviceHash().
+		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	err = tx.PhoneChangeRequest.UpdateOneID(request.ID).
+		SetVerifiedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	return updated, tx.Commit()
+}
@@ -0,0 +1,176 @@
+package phonechange
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/auth/session"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/notification"
+	"github.com/shinplay/internal/user"
+	"github.com/shinplay/pkg/publicid"
+	"go.uber.org/zap"
+)
+
+const (
+	codeTTL     = 10 * time.Minute
+	codeLength  = 6
+	maxAttempts = 5
+)
+
+var phoneNumberPattern = regexp.MustCompile(`^\+?[0-9]{7,14}$`)
+
+var (
+	ErrInvalidPhoneNumber = errors.New("invalid phone number")
+	ErrSamePhoneNumber    = errors.New("phone number is already on this account")
+	ErrPhoneTaken         = errors.New("phone number belongs to another account")
+	ErrNoPendingRequest   = errors.New("no pending phone change")
+	ErrInvalidCode        = errors.New("invalid code")
+	ErrTooManyAttempts    = errors.New("too many attempts")
+)
+
+type PhoneChangeServiceIntr interface {
+	RequestChange(user *ent.User, newPhoneNumber string) (*ent.PhoneChangeRequest, error)
+	Verify(user *ent.User, code string) (*ent.User, string, error)
+	RevokeOtherSessions(user *ent.User, keepSessionID string) (int, error)
+}
+
+// PhoneChangeService moves an account to a new phone number once the user
+// proves they own it.
+type PhoneChangeService struct {
+	phoneChangeRepository *PhoneChangeRepository
+	sessionRepository     *session.SessionRepository
+	userService           *user.UserService
+	authService           *auth.AuthService
+	notificationService   *notification.NotificationService
+	config                *config.Config
+	ctx                   context.Context
+}
+
+func NewPhoneChangeService(
+	phoneChangeRepository *PhoneChangeRepository,
+	sessionRepository *session.SessionRepository,
+	userService *user.UserService,
+	authService *auth.AuthService,
+	notificationService *notification.NotificationService,
+	config *config.Config,
+	ctx context.Context,
+) *PhoneChangeService {
+	return &PhoneChangeService{
+		phoneChangeRepository: phoneChangeRepository,
+		sessionRepository:     sessionRepository,
+		userService:           userService,
+		authService:           authService,
+		notificationService:   notificationService,
+		config:                config,
+		ctx:                   ctx,
+	}
+}
+
+// RequestChange sends a code to the new number.
+func (s *PhoneChangeService) RequestChange(u *ent.User, newPhoneNumber string) (*ent.PhoneChangeRequest, error) {
+	if !phoneNumberPattern.MatchString(newPhoneNumber) {
+		return nil, ErrInvalidPhoneNumber
+	}
+	if newPhoneNumber == u.PhoneNumber {
+		return nil, ErrSamePhoneNumber
+	}
+	if err := s.ensureAvailable(u, newPhoneNumber); err != nil {
+		return nil, err
+	}
+
+	code := publicid.MustWith(codeLength, publicid.Numberic())
+
+	request, err := s.phoneChangeRepository.Create(s.ctx, u, newPhoneNumber, hashCode(code), time.Now().Add(codeTTL))
+	if err != nil {
+		s.config.Logger.Error("Failed to create phone change request", zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.authService.SendOTPMessage(newPhoneNumber, code); err != nil {
+		s.config.Logger.Error("Failed to send phone change code", zap.Error(err))
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// Verify checks the code and moves the account to the new number. The old
+// number is returned so the caller can record it.
+func (s *PhoneChangeService) Verify(u *ent.User, code string) (*ent.User, string, error) {
+	request, err := s.phoneChangeRepository.FindPendingByUser(s.ctx, u.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", ErrNoPendingRequest
+		}
+		return nil, "", err
+	}
+
+	ok, err := s.phoneChangeRepository.UseAttempt(s.ctx, request, maxAttempts)
+	if err != nil {
+		s.config.Logger.Error("Failed to record phone change attempt", zap.Error(err))
+		return nil, "", err
+	}
+	if !ok {
+		return nil, "", ErrTooManyAttempts
+	}
+
+	if subtle.ConstantTimeCompare([]byte(request.CodeHash), []byte(hashCode(code))) != 1 {
+		return nil, "", ErrInvalidCode
+	}
+
+	if err := s.ensureAvailable(u, request.NewPhoneNumber); err != nil {
+		return nil, "", err
+	}
+
+	oldPhoneNumber := u.PhoneNumber
+
+	updated, err := s.phoneChangeRepository.Complete(s.ctx, request, u)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, "", ErrPhoneTaken
+		}
+		s.config.Logger.Error("Failed to change phone number", zap.Error(err))
+		return nil, "", err
+	}
+
+	if oldPhoneNumber != "" {
+		go func() {
+			message := "The phone number on your Shinplay account was changed. If this wasn't you, please contact support right away."
+			if err := s.notificationService.SendWhatsAppText(oldPhoneNumber, message); err != nil {
+				s.config.Logger.Error("Failed to notify previous phone number", zap.Error(err))
+			}
+		}()
+	}
+
+	return updated, oldPhoneNumber, nil
+}
+
+// RevokeOtherSessions signs the user out everywhere but the current session.
+func (s *PhoneChangeService) RevokeOtherSessions(u *ent.User, keepSessionID string) (int, error) {
+	return s.sessionRepository.DeleteOtherSessions(s.ctx, u.ID, keepSessionID)
+}
+
+func (s *PhoneChangeService) ensureAvailable(u *ent.User, phoneNumber string) error {
+	owner, err := s.userService.FindByPhone(phoneNumber)
+	if err == nil && owner.ID != u.ID {
+		return ErrPhoneTaken
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,276 @@
+package qrlogin
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+const (
+	// browserCookie binds a login request to the browser that started it.
+	browserCookie = "qr_login"
+	cookiePath    = "/auth/qr"
+
+	waitTimeout = 25 * time.Second
+)
+
+type QRLoginHandlerIntr interface {
+	Start(ctx *fiber.Ctx) error
+	Wait(ctx *fiber.Ctx) error
+	GetRequest(ctx *fiber.Ctx) error
+	Decide(ctx *fiber.Ctx) error
+}
+
+type QRLoginHandler struct {
+	qrLoginService *QRLoginService
+	authService    *auth.AuthService
+	auditService   *audit.AuditService
+	config         *config.Config
+}
+
+func NewQRLoginHandler(qrLoginService *QRLoginService, authService *auth.AuthService, auditService *audit.AuditService, config *config.Config) *QRLoginHandler {
+	return &QRLoginHandler{
+		qrLoginService: qrLoginService,
+		authService:    authService,
+		auditService:   auditService,
+		config:         config,
+	}
+}
+
+// RequestDetail is what the phone shows before the user approves a browser.
+type RequestDetail struct {
+	RequestID string    `json:"request_id"`
+	UserAgent string    `json:"user_agent"`
+	IPAddress string    `json:"ip_address"`
+	Location  string    `json:"location"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Start creates a login request for the browser to render as a QR code.
+func (h *QRLoginHandler) Start(ctx *fiber.Ctx) error {
+	request, secret, err := h.qrLoginService.Start(ctx.IP(), ctx.Get("User-Agent"), locate(ctx))
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to start QR login, please try again later",
+		})
+	}
+
+	ctx.Cookie(&fiber.Cookie{
+		Name:     browserCookie,
+		Value:    secret,
+		Path:     cookiePath,
+		Expires:  request.ExpiresAt,
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: fiber.CookieSameSiteStrictMode,
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": fiber.Map{
+			"request_id": request.RequestID,
+			"qr_payload": strings.TrimSuffix(h.config.Server.PublicURL, "/") + "/qr-login/" + request.RequestID,
+			"expires_at": request.ExpiresAt,
+		},
+	})
+}
+
+// Wait is long-polled by the browser until the request is approved, at which
+// point the browser is signed in.
+func (h *QRLoginHandler) Wait(ctx *fiber.Ctx) error {
+	secret := ctx.Cookies(browserCookie)
+	if secret == "" {
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "browser_mismatch",
+			"message": "This login request was started in another browser",
+		})
+	}
+
+	state, approver, err := h.qrLoginService.Wait(ctx.Params("requestId"), secret, waitTimeout)
+	if err != nil {
+		return h.waitError(ctx, err)
+	}
+
+	if state != StateApproved {
+		return ctx.JSON(fiber.Map{
+			"status": "success",
+			"data":   fiber.Map{"state": state},
+		})
+	}
+
+	tokens, userInfo, sessionId, err := h.authService.LoginUser(approver, ctx.IP(), ctx.Get("User-Agent"))
+	if err != nil {
+		if restriction, ok := user.AsRestriction(err); ok {
+			return restriction.Respond(ctx)
+		}
+
+		h.config.Logger.Error("Failed to create session", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to Login, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{Type: audit.EventQRLoginSignIn, Actor: approver, Subject: approver})
+
+	// the request is used up, forget it in the browser too
+	ctx.Cookie(&fiber.Cookie{
+		Name:     browserCookie,
+		Path:     cookiePath,
+		Expires:  time.Now().Add(-time.Hour),
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: fiber.CookieSameSiteStrictMode,
+	})
+	ctx.Cookie(&fiber.Cookie{
+		Name:     "session_id",
+		Value:    sessionId,
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: fiber.CookieSameSiteStrictMode,
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Signed in successfully",
+		"data": fiber.Map{
+			"state":        state,
+			"access_token": tokens.AccessToken,
+			"user":         userInfo,
+		},
+	})
+}
+
+func (h *QRLoginHandler) waitError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, ErrBrowserMismatch):
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "browser_mismatch",
+			"message": "This login request was started in another browser",
+		})
+	case errors.Is(err, ErrDenied):
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "login_denied",
+			"message": "The login was denied on your phone",
+		})
+	case errors.Is(err, ErrExpired) || ent.IsNotFound(err):
+		return ctx.Status(fiber.StatusGone).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "login_expired",
+			"message": "This QR code has expired, please refresh it",
+		})
+	}
+
+	h.config.Logger.Error("Failed to wait for QR login", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Failed to complete QR login, please try again later",
+	})
+}
+
+// GetRequest is called by the app after scanning the QR code.
+func (h *QRLoginHandler) GetRequest(ctx *fiber.Ctx) error {
+	request, err := h.qrLoginService.Scan(ctx.Params("requestId"))
+	if err != nil {
+		return h.lookupError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": RequestDetail{
+			RequestID: request.RequestID,
+			UserAgent: request.UserAgent,
+			IPAddress: request.IPAddress,
+			Location:  request.Location,
+			CreatedAt: request.CreateTime,
+			ExpiresAt: request.ExpiresAt,
+		},
+	})
+}
+
+type DecideBody struct {
+	Approve bool `json:"approve" xml:"approve" form:"approve"`
+}
+
+// Decide approves or denies the browser from the signed-in phone.
+func (h *QRLoginHandler) Decide(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(DecideBody)
+	if err := ctx.BodyParser(body); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body",
+		})
+	}
+
+	decide, eventType, message := h.qrLoginService.Deny, audit.EventQRLoginDenied, "Login denied"
+	if body.Approve {
+		decide, eventType, message = h.qrLoginService.Approve, audit.EventQRLoginApproved, "Login approved"
+	}
+
+	request, err := decide(currentUser, ctx.Params("requestId"))
+	if err != nil {
+		if restriction, ok := user.AsRestriction(err); ok {
+			return restriction.Respond(ctx)
+		}
+		return h.lookupError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    eventType,
+		Actor:   currentUser,
+		Subject: currentUser,
+		Metadata: map[string]any{
+			"browser_user_agent": request.UserAgent,
+			"browser_ip_address": request.IPAddress,
+			"browser_location":   request.Location,
+		},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": message,
+	})
+}
+
+func (h *QRLoginHandler) lookupError(ctx *fiber.Ctx, err error) error {
+	if ent.IsNotFound(err) || errors.Is(err, ErrAlreadyDecided) {
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "This QR code is invalid or has expired",
+		})
+	}
+
+	h.config.Logger.Error("Failed to find QR login request", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Failed to find login request, please try again later",
+	})
+}
+
+// locate returns the approximate location our CDN attaches to requests, or
+// an empty string when the API is reached directly.
+func locate(ctx *fiber.Ctx) string {
+	parts := []string{}
+	for _, header := range []string{"CF-IPCity", "CF-IPCountry"} {
+		if value := ctx.Get(header); value != "" {
+			parts = append(parts, value)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
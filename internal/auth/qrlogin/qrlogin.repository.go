@@ -0,0 +1,97 @@
+package qrlogin
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/qrloginrequest"
+)
+
+type QRLoginRepositoryIntr interface {
+	Create(ctx context.Context, browserSecretHash string, userAgent string, ipAddress string, location string, expiresAt time.Time) (*ent.QRLoginRequest, error)
+	FindByRequestID(ctx context.Context, requestID string) (*ent.QRLoginRequest, error)
+	FindPendingByRequestID(ctx context.Context, requestID string) (*ent.QRLoginRequest, error)
+	MarkScanned(ctx context.Context, request *ent.QRLoginRequest) error
+	Decide(ctx context.Context, request *ent.QRLoginRequest, user *ent.User, status qrloginrequest.Status) (bool, error)
+	Consume(ctx context.Context, request *ent.QRLoginRequest) (bool, error)
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+type QRLoginRepository struct {
+	client *ent.Client
+}
+
+func NewQRLoginRepository(client *ent.Client) *QRLoginRepository {
+	return &QRLoginRepository{client: client}
+}
+
+func (r *QRLoginRepository) Create(ctx context.Context, browserSecretHash, userAgent, ipAddress, location string, expiresAt time.Time) (*ent.QRLoginRequest, error) {
+	return r.client.QRLoginRequest.Create().
+		SetBrowserSecretHash(browserSecretHash).
+		SetUserAgent(userAgent).
+		SetIPAddress(ipAddress).
+		SetLocation(location).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// FindByRequestID returns the request with the approving user loaded.
+func (r *QRLoginRepository) FindByRequestID(ctx context.Context, requestID string) (*ent.QRLoginRequest, error) {
+	return r.client.QRLoginRequest.Query().
+		Where(qrloginrequest.RequestIDEQ(requestID)).
+		WithUser().
+		Only(ctx)
+}
+
+func (r *QRLoginRepository) FindPendingByRequestID(ctx context.Context, requestID string) (*ent.QRLoginRequest, error) {
+	return r.client.QRLoginRequest.Query().
+		Where(
+			qrloginrequest.RequestIDEQ(requestID),
+			qrloginrequest.StatusEQ(qrloginrequest.StatusPending),
+			qrloginrequest.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+}
+
+func (r *QRLoginRepository) MarkScanned(ctx context.Context, request *ent.QRLoginRequest) error {
+	return r.client.QRLoginRequest.UpdateOneID(request.ID).
+		SetScannedAt(time.Now()).
+		Exec(ctx)
+}
+
+// Decide approves or denies a pending request. It reports false when the
+// request was decided in the meantime.
+func (r *QRLoginRepository) Decide(ctx context.Context, request *ent.QRLoginRequest, user *ent.User, status qrloginrequest.Status) (bool, error) {
+	updated, err := r.client.QRLoginRequest.Update().
+		Where(
+			qrloginrequest.IDEQ(request.ID),
+			qrloginrequest.StatusEQ(qrloginrequest.StatusPending),
+		).
+		SetStatus(status).
+		SetUser(user).
+		Save(ctx)
+
+	return updated == 1, err
+}
+
+// Consume marks an approved request as used. It reports false when the
+// browser already exchanged it for a session or the request has expired.
+func (r *QRLoginRepository) Consume(ctx context.Context, request *ent.QRLoginRequest) (bool, error) {
+	updated, err := r.client.QRLoginRequest.Update().
+		Where(
+			qrloginrequest.IDEQ(request.ID),
+			qrloginrequest.StatusEQ(qrloginrequest.StatusApproved),
+			qrloginrequest.ExpiresAtGT(time.Now()),
+		).
+		SetStatus(qrloginrequest.StatusConsumed).
+		Save(ctx)
+
+	return updated == 1, err
+}
+
+func (r *QRLoginRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	return r.client.QRLoginRequest.Delete().
+		Where(qrloginrequest.ExpiresAtLT(before)).
+		Exec(ctx)
+}
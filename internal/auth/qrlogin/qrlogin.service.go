@@ -0,0 +1,266 @@
+package qrlogin
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/pkg/publicid"
+	"go.uber.org/zap"
+)
+
+const (
+	requestTTL      = 2 * time.Minute
+	cleanupInterval = 1 * time.Hour
+
+	// recheckInterval bounds how long a waiting browser misses a decision made
+	// on another server instance, which cannot wake it directly.
+	recheckInterval = 2 * time.Second
+)
+
+// States reported to the waiting browser.
+const (
+	StatePending  = "pending"
+	StateScanned  = "scanned"
+	StateApproved = "approved"
+)
+
+var (
+	ErrBrowserMismatch = errors.New("login request belongs to another browser")
+	ErrExpired         = errors.New("login request expired")
+	ErrDenied          = errors.New("login request denied")
+	ErrAlreadyDecided  = errors.New("login request was already approved or denied")
+)
+
+type QRLoginServiceIntr interface {
+	Start(ipAddress string, userAgent string, location string) (*ent.QRLoginRequest, string, error)
+	Scan(requestID string) (*ent.QRLoginRequest, error)
+	Approve(user *ent.User, requestID string) (*ent.QRLoginRequest, error)
+	Deny(user *ent.User, requestID string) (*ent.QRLoginRequest, error)
+	Wait(requestID string, browserSecret string, timeout time.Duration) (string, *ent.User, error)
+	Run()
+}
+
+// QRLoginService signs a browser in once the user scans its QR code with an
+// already signed-in phone and approves the request.
+type QRLoginService struct {
+	qrLoginRepository *QRLoginRepository
+	authService       *auth.AuthService
+	config            *config.Config
+	ctx               context.Context
+
+	mu      sync.Mutex
+	waiters map[string][]chan struct{}
+}
+
+func NewQRLoginService(qrLoginRepository *QRLoginRepository, authService *auth.AuthService, config *config.Config, ctx context.Context) *QRLoginService {
+	return &QRLoginService{
+		qrLoginRepository: qrLoginRepository,
+		authService:       authService,
+		config:            config,
+		ctx:               ctx,
+		waiters:           make(map[string][]chan struct{}),
+	}
+}
+
+// Start creates a login request for a browser. The returned secret must be
+// kept by the browser, only its holder can collect the session.
+func (s *QRLoginService) Start(ipAddress string, userAgent string, location string) (*ent.QRLoginRequest, string, error) {
+	secret := publicid.MustWith(43, publicid.AlphaNumeric())
+
+	request, err := s.qrLoginRepository.Create(s.ctx, hashSecret(secret), userAgent, ipAddress, location, time.Now().Add(requestTTL))
+	if err != nil {
+		s.config.Logger.Error("Failed to create QR login request", zap.Error(err))
+		return nil, "", err
+	}
+
+	return request, secret, nil
+}
+
+// Scan returns the pending request so the phone can show which browser is
+// asking, and lets the browser know its code was scanned.
+func (s *QRLoginService) Scan(requestID string) (*ent.QRLoginRequest, error) {
+	request, err := s.qrLoginRepository.FindPendingByRequestID(s.ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.ScannedAt == nil {
+		if err := s.qrLoginRepository.MarkScanned(s.ctx, request); err != nil {
+			s.config.Logger.Warn("Failed to mark QR login request as scanned", zap.Error(err))
+		}
+		s.notify(requestID)
+	}
+
+	return request, nil
+}
+
+func (s *QRLoginService) Approve(user *ent.User, requestID string) (*ent.QRLoginRequest, error) {
+	if err := s.authService.CheckRestriction(user); err != nil {
+		return nil, err
+	}
+
+	return s.decide(user, requestID, qrloginrequest.StatusApproved)
+}
+
+func (s *QRLoginService) Deny(user *ent.User, requestID string) (*ent.QRLoginRequest, error) {
+	return s.decide(user, requestID, qrloginrequest.StatusDenied)
+}
+
+func (s *QRLoginService) decide(user *ent.User, requestID string, status qrloginrequest.Status) (*ent.QRLoginRequest, error) {
+	request, err := s.qrLoginRepository.FindPendingByRequestID(s.ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	decided, err := s.qrLoginRepository.Decide(s.ctx, request, user, status)
+	if err != nil {
+		s.config.Logger.Error("Failed to decide QR login request", zap.Error(err))
+		return nil, err
+	}
+	if !decided {
+		return nil, ErrAlreadyDecided
+	}
+
+	s.notify(requestID)
+
+	return request, nil
+}
+
+// Wait blocks until the request is decided or timeout passes, then reports
+// its state. The approving user is returned exactly once, to the browser that
+// started the request.
+func (s *QRLoginService) Wait(requestID string, browserSecret string, timeout time.Duration) (string, *ent.User, error) {
+	deadline := time.Now().Add(timeout)
+
+	wake := s.subscribe(requestID)
+	defer s.unsubscribe(requestID, wake)
+
+	for {
+		state, approver, err := s.check(requestID, browserSecret)
+		if err != nil || state == StateApproved || !time.Now().Before(deadline) {
+			return state, approver, err
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return state, nil, nil
+		case <-wake:
+		case <-time.After(min(recheckInterval, time.Until(deadline))):
+		}
+	}
+}
+
+func (s *QRLoginService) check(requestID string, browserSecret string) (string, *ent.User, error) {
+	request, err := s.qrLoginRepository.FindByRequestID(s.ctx, requestID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(request.BrowserSecretHash), []byte(hashSecret(browserSecret))) != 1 {
+		return "", nil, ErrBrowserMismatch
+	}
+
+	switch request.Status {
+	case qrloginrequest.StatusDenied:
+		return "", nil, ErrDenied
+	case qrloginrequest.StatusConsumed:
+		return "", nil, ErrExpired
+	case qrloginrequest.StatusApproved:
+		consumed, err := s.qrLoginRepository.Consume(s.ctx, request)
+		if err != nil {
+			s.config.Logger.Error("Failed to consume QR login request", zap.Error(err))
+			return "", nil, err
+		}
+		if !consumed || request.Edges.User == nil {
+			return "", nil, ErrExpired
+		}
+		return StateApproved, request.Edges.User, nil
+	}
+
+	if request.ExpiresAt.Before(time.Now()) {
+		return "", nil, ErrExpired
+	}
+	if request.ScannedAt != nil {
+		return StateScanned, nil, nil
+	}
+
+	return StatePending, nil, nil
+}
+
+func (s *QRLoginService) subscribe(requestID string) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wake := make(chan struct{}, 1)
+	s.waiters[requestID] = append(s.waiters[requestID], wake)
+
+	return wake
+}
+
+func (s *QRLoginService) unsubscribe(requestID string, wake chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	waiters := s.waiters[requestID]
+	for i, w := range waiters {
+		if w == wake {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+
+	if len(waiters) == 0 {
+		delete(s.waiters, requestID)
+		return
+	}
+	s.waiters[requestID] = waiters
+}
+
+// notify wakes the browsers waiting on this instance for the request.
+func (s *QRLoginService) notify(requestID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, wake := range s.waiters[requestID] {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run deletes requests a day after they expired until the context is cancelled.
+func (s *QRLoginService) Run() {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.qrLoginRepository.DeleteExpired(s.ctx, time.Now().Add(-24*time.Hour))
+			if err != nil {
+				s.config.Logger.Error("Failed to delete expired QR login requests", zap.Error(err))
+				continue
+			}
+			if deleted > 0 {
+				s.config.Logger.Info("Deleted expired QR login requests", zap.Int("count", deleted))
+			}
+		}
+	}
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
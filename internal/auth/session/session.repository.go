@@ -5,13 +5,22 @@ import (
 	"time"
 
 	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/oauthclient"
 	"github.com/shinplay/ent/session"
+	"github.com/shinplay/ent/user"
 )
 
 type SessionRepositoryIntr interface {
 	CreateNewSession(ctx context.Context, user *ent.User, refreshToken string, expiresAt time.Time, userAgent string, ipAddress string) (*ent.Session, error)
 	FindSessionByID(ctx context.Context, sessionID string) (*ent.Session, error)
 	DeleteSession(ctx context.Context, sessionID string) error
+	FindSessionsByUser(ctx context.Context, userID int) ([]*ent.Session, error)
+	DeleteSessionsByUser(ctx context.Context, userID int) (int, error)
+	DeleteOtherSessions(ctx context.Context, userID int, keepSessionID string) (int, error)
+	CreateClientSession(ctx context.Context, user *ent.User, client *ent.OAuthClient, sessionID string, scopes []string, refreshToken string, expiresAt time.Time, userAgent string, ipAddress string) (*ent.Session, error)
+	FindClientSession(ctx context.Context, sessionID string, clientID int) (*ent.Session, error)
+	ClientSessionExists(ctx context.Context, sessionID string) (bool, error)
+	DeleteClientSessions(ctx context.Context, userID int, clientID int) (int, error)
 }
 
 type SessionRepository struct {
@@ -32,15 +41,89 @@ func (s *SessionRepository) CreateNewSession(ctx context.Context, user *ent.User
 		Save(ctx)
 }
 
+// FindSessionByID finds a first-party session. Sessions created for
+// third-party apps are never returned so their IDs can't be used as cookies.
 func (s *SessionRepository) FindSessionByID(ctx context.Context, sessionID string) (*ent.Session, error) {
 	return s.client.Session.Query().
-		Where(session.SessionID(sessionID)).
+		Where(
+			session.SessionID(sessionID),
+			session.Not(session.HasClient()),
+		).
 		First(ctx)
 }
 
+// CreateClientSession creates a session for a third-party app. The session ID
+// is chosen by the caller so it can be embedded in the refresh token.
+func (s *SessionRepository) CreateClientSession(ctx context.Context, user *ent.User, client *ent.OAuthClient, sessionID string, scopes []string, refreshToken string, expiresAt time.Time, userAgent, ipAddress string) (*ent.Session, error) {
+	return s.client.Session.Create().
+		SetSessionID(sessionID).
+		SetUser(user).
+		SetClient(client).
+		SetScopes(scopes).
+		SetRefreshToken(refreshToken).
+		SetExpiresAt(expiresAt).
+		SetUserAgent(userAgent).
+		SetIPAddress(ipAddress).
+		Save(ctx)
+}
+
+// FindClientSession finds a session created for the given third-party app.
+func (s *SessionRepository) FindClientSession(ctx context.Context, sessionID string, clientID int) (*ent.Session, error) {
+	return s.client.Session.Query().
+		Where(
+			session.SessionID(sessionID),
+			session.HasClientWith(oauthclient.IDEQ(clientID)),
+		).
+		WithUser().
+		Only(ctx)
+}
+
+// DeleteOtherSessions signs the user out everywhere except the given session.
+func (s *SessionRepository) DeleteOtherSessions(ctx context.Context, userID int, keepSessionID string) (int, error) {
+	return s.client.Session.Delete().
+		Where(
+			session.HasUserWith(user.IDEQ(userID)),
+			session.SessionIDNEQ(keepSessionID),
+		).
+		Exec(ctx)
+}
+
+// ClientSessionExists reports whether a third-party app's session is still active.
+func (s *SessionRepository) ClientSessionExists(ctx context.Context, sessionID string) (bool, error) {
+	return s.client.Session.Query().
+		Where(
+			session.SessionID(sessionID),
+			session.HasClient(),
+		).
+		Exist(ctx)
+}
+
+// DeleteClientSessions signs a third-party app out of the user's account.
+func (s *SessionRepository) DeleteClientSessions(ctx context.Context, userID int, clientID int) (int, error) {
+	return s.client.Session.Delete().
+		Where(
+			session.HasUserWith(user.IDEQ(userID)),
+			session.HasClientWith(oauthclient.IDEQ(clientID)),
+		).
+		Exec(ctx)
+}
+
 func (s *SessionRepository) DeleteSession(ctx context.Context, sessionID string) (int, error) {
 	id, err := s.client.Session.Delete().
 		Where(session.SessionID(sessionID)).
 		Exec(ctx)
 	return id, err
 }
+
+func (s *SessionRepository) FindSessionsByUser(ctx context.Context, userID int) ([]*ent.Session, error) {
+	return s.client.Session.Query().
+		Where(session.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(session.FieldCreateTime)).
+		All(ctx)
+}
+
+func (s *SessionRepository) DeleteSessionsByUser(ctx context.Context, userID int) (int, error) {
+	return s.client.Session.Delete().
+		Where(session.HasUserWith(user.IDEQ(userID))).
+		Exec(ctx)
+}
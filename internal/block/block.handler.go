@@ -0,0 +1,178 @@
+package block
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"github.com/shinplay/pkg/cursor"
+)
+
+type BlockHandlerIntr interface {
+	Block(ctx *fiber.Ctx) error
+	Unblock(ctx *fiber.Ctx) error
+	ListBlocked(ctx *fiber.Ctx) error
+	Mute(ctx *fiber.Ctx) error
+	Unmute(ctx *fiber.Ctx) error
+	ListMuted(ctx *fiber.Ctx) error
+}
+
+type BlockHandler struct {
+	blockService *BlockService
+	auditService *audit.AuditService
+	config       *config.Config
+}
+
+func NewBlockHandler(blockService *BlockService, auditService *audit.AuditService, config *config.Config) *BlockHandler {
+	return &BlockHandler{
+		blockService: blockService,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+type PageQuery struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+func (h *BlockHandler) Block(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	target, err := h.blockService.Block(currentUser, ctx.Params("username"))
+	if err != nil {
+		return h.blockError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventUserBlocked,
+		Actor:   currentUser,
+		Subject: currentUser,
+		Metadata: map[string]any{
+			"blocked": target.AuthID,
+		},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Blocked successfully",
+	})
+}
+
+func (h *BlockHandler) Unblock(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	target, err := h.blockService.Unblock(currentUser, ctx.Params("username"))
+	if err != nil {
+		return h.blockError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventUserUnblocked,
+		Actor:   currentUser,
+		Subject: currentUser,
+		Metadata: map[string]any{
+			"unblocked": target.AuthID,
+		},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Unblocked successfully",
+	})
+}
+
+func (h *BlockHandler) ListBlocked(ctx *fiber.Ctx) error {
+	return h.page(ctx, h.blockService.Blocked)
+}
+
+func (h *BlockHandler) Mute(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	if err := h.blockService.Mute(currentUser, ctx.Params("username")); err != nil {
+		return h.blockError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Muted successfully",
+	})
+}
+
+func (h *BlockHandler) Unmute(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	if err := h.blockService.Unmute(currentUser, ctx.Params("username")); err != nil {
+		return h.blockError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Unmuted successfully",
+	})
+}
+
+func (h *BlockHandler) ListMuted(ctx *fiber.Ctx) error {
+	return h.page(ctx, h.blockService.Muted)
+}
+
+// page serves a cursor-paginated list of profiles.
+func (h *BlockHandler) page(ctx *fiber.Ctx, list func(viewer *ent.User, after string, limit int) ([]user.PublicProfile, string, error)) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	query := new(PageQuery)
+	if err := ctx.QueryParser(query); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid query",
+		})
+	}
+
+	profiles, next, err := list(currentUser, query.Cursor, query.Limit)
+	if err != nil {
+		return h.blockError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": fiber.Map{
+			"users":       profiles,
+			"next_cursor": next,
+		},
+	})
+}
+
+func (h *BlockHandler) blockError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, user.ErrProfileNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "User not found",
+		})
+	case errors.Is(err, ErrBlockSelf):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "cannot_block_self",
+			"message": err.Error(),
+		})
+	case errors.Is(err, ErrMuteSelf):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "cannot_mute_self",
+			"message": err.Error(),
+		})
+	case errors.Is(err, cursor.ErrInvalid):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid cursor",
+		})
+	default:
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Something went wrong, please try again later",
+		})
+	}
+}
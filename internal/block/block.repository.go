@@ -0,0 +1,136 @@
+package block
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/internal/db"
+	"github.com/shinplay/internal/follow"
+)
+
+type BlockRepositoryIntr interface {
+	Block(ctx context.Context, blocker *ent.User, blocked *ent.User) error
+	Unblock(ctx context.Context, blocker *ent.User, blocked *ent.User) error
+	ListBlocked(ctx context.Context, blocker *ent.User, after *Cursor, limit int) ([]*ent.Block, error)
+	Mute(ctx context.Context, muter *ent.User, muted *ent.User) error
+	Unmute(ctx context.Context, muter *ent.User, muted *ent.User) error
+	ListMuted(ctx context.Context, muter *ent.User, after *Cursor, limit int) ([]*ent.Mute, error)
+}
+
+// Cursor is where a page of blocks or mutes ended, lists run newest first.
+type Cursor struct {
+	CreateTime time.Time `json:"t"`
+	ID         int       `json:"i"`
+}
+
+type BlockRepository struct {
+	client *ent.Client
+}
+
+func NewBlockRepository(client *ent.Client) *BlockRepository {
+	return &BlockRepository{client: client}
+}
+
+// Block blocks a user and drops the follows between the two in the same
+// transaction. Blocking twice is a no-op.
+func (r *BlockRepository) Block(ctx context.Context, blocker *ent.User, blocked *ent.User) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Block.Create().
+		SetBlockerID(blocker.ID).
+		SetBlockedID(blocked.ID).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return tx.Rollback()
+	}
+	if err != nil {
+		return db.Rollback(tx, err)
+	}
+
+	if err := follow.DeleteBetween(ctx, tx, blocker.ID, blocked.ID); err != nil {
+		return db.Rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *BlockRepository) Unblock(ctx context.Context, blocker *ent.User, blocked *ent.User) error {
+	_, err := r.client.Block.Delete().
+		Where(block.BlockerID(blocker.ID), block.BlockedID(blocked.ID)).
+		Exec(ctx)
+	return err
+}
+
+// ListBlocked returns a page of the blocker's blocks along with the blocked
+// users, a limit of zero lists every block.
+func (r *BlockRepository) ListBlocked(ctx context.Context, blocker *ent.User, after *Cursor, limit int) ([]*ent.Block, error) {
+	query := r.client.Block.Query().
+		Where(block.BlockerID(blocker.ID)).
+		WithBlocked()
+
+	if after != nil {
+		query = query.Where(block.Or(
+			block.CreateTimeLT(after.CreateTime),
+			block.And(block.CreateTimeEQ(after.CreateTime), block.IDLT(after.ID)),
+		))
+	}
+
+	query = query.
+		Order(ent.Desc(block.FieldCreateTime), ent.Desc(block.FieldID))
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	return query.All(ctx)
+}
+
+// Mute mutes a user, muting twice is a no-op.
+func (r *BlockRepository) Mute(ctx context.Context, muter *ent.User, muted *ent.User) error {
+	err := r.client.Mute.Create().
+		SetMuterID(muter.ID).
+		SetMutedID(muted.ID).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (r *BlockRepository) Unmute(ctx context.Context, muter *ent.User, muted *ent.User) error {
+	_, err := r.client.Mute.Delete().
+		Where(mute.MuterID(muter.ID), mute.MutedID(muted.ID)).
+		Exec(ctx)
+	return err
+}
+
+// ListMuted returns a page of the muter's mutes along with the muted users, a
+// limit of zero lists every mute.
+func (r *BlockRepository) ListMuted(ctx context.Context, muter *ent.User, after *Cursor, limit int) ([]*ent.Mute, error) {
+	query := r.client.Mute.Query().
+		Where(mute.MuterID(muter.ID)).
+		WithMuted()
+
+	if after != nil {
+		query = query.Where(mute.Or(
+			mute.CreateTimeLT(after.CreateTime),
+			mute.And(mute.CreateTimeEQ(after.CreateTime), mute.IDLT(after.ID)),
+		))
+	}
+
+	query = query.
+		Order(ent.Desc(mute.FieldCreateTime), ent.Desc(mute.FieldID))
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	return query.All(ctx)
+}
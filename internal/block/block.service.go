@@ -0,0 +1,210 @@
+package block
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"github.com/shinplay/pkg/cursor"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var (
+	ErrBlockSelf = errors.New("you cannot block yourself")
+	ErrMuteSelf  = errors.New("you cannot mute yourself")
+)
+
+type BlockServiceIntr interface {
+	Block(viewer *ent.User, username string) (*ent.User, error)
+	Unblock(viewer *ent.User, username string) (*ent.User, error)
+	Blocked(viewer *ent.User, after string, limit int) ([]user.PublicProfile, string, error)
+	Mute(viewer *ent.User, username string) error
+	Unmute(viewer *ent.User, username string) error
+	Muted(viewer *ent.User, after string, limit int) ([]user.PublicProfile, string, error)
+}
+
+// BlockService manages blocks and mutes. A block keeps two users apart both
+// ways: neither sees the other's profile, finds them in search or lists, or
+// can follow them. A mute only hides the muted user's content from the
+// muter. Queries enforce both through the relation package.
+type BlockService struct {
+	blockRepository *BlockRepository
+	userService     *user.UserService
+	config          *config.Config
+	ctx             context.Context
+}
+
+func NewBlockService(blockRepository *BlockRepository, userService *user.UserService, config *config.Config, ctx context.Context) *BlockService {
+	return &BlockService{
+		blockRepository: blockRepository,
+		userService:     userService,
+		config:          config,
+		ctx:             ctx,
+	}
+}
+
+// Block blocks the user and returns them. Blocking someone who already
+// blocked the viewer is allowed, so the block outlives theirs.
+func (s *BlockService) Block(viewer *ent.User, username string) (*ent.User, error) {
+	target, err := s.userService.FindListedByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.ID == viewer.ID {
+		return nil, ErrBlockSelf
+	}
+
+	if err := s.blockRepository.Block(s.ctx, viewer, target); err != nil {
+		s.config.Logger.Error("Failed to block user", zap.Error(err))
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// Unblock lifts the viewer's block of the user and returns them. Follows
+// removed by the block are not restored.
+func (s *BlockService) Unblock(viewer *ent.User, username string) (*ent.User, error) {
+	target, err := s.findAny(username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.blockRepository.Unblock(s.ctx, viewer, target); err != nil {
+		s.config.Logger.Error("Failed to unblock user", zap.Error(err))
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// Blocked lists who the viewer blocked, newest first.
+func (s *BlockService) Blocked(viewer *ent.User, after string, limit int) ([]user.PublicProfile, string, error) {
+	position, limit, err := page(after, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	blocks, err := s.blockRepository.ListBlocked(s.ctx, viewer, position, limit+1)
+	if err != nil {
+		s.config.Logger.Error("Failed to list blocks", zap.Error(err))
+		return nil, "", err
+	}
+
+	next := ""
+	if len(blocks) > limit {
+		blocks = blocks[:limit]
+		last := blocks[limit-1]
+		next = cursor.Encode(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+
+	users := make([]*ent.User, 0, len(blocks))
+	for _, b := range blocks {
+		if user.IsListed(b.Edges.Blocked) {
+			users = append(users, b.Edges.Blocked)
+		}
+	}
+
+	profiles, err := s.userService.PublicProfiles(viewer, users)
+	return profiles, next, err
+}
+
+func (s *BlockService) Mute(viewer *ent.User, username string) error {
+	target, err := s.userService.FindListedByUsername(username)
+	if err != nil {
+		return err
+	}
+
+	if target.ID == viewer.ID {
+		return ErrMuteSelf
+	}
+
+	if err := s.blockRepository.Mute(s.ctx, viewer, target); err != nil {
+		s.config.Logger.Error("Failed to mute user", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *BlockService) Unmute(viewer *ent.User, username string) error {
+	target, err := s.findAny(username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.blockRepository.Unmute(s.ctx, viewer, target); err != nil {
+		s.config.Logger.Error("Failed to unmute user", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Muted lists who the viewer muted, newest first.
+func (s *BlockService) Muted(viewer *ent.User, after string, limit int) ([]user.PublicProfile, string, error) {
+	position, limit, err := page(after, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	mutes, err := s.blockRepository.ListMuted(s.ctx, viewer, position, limit+1)
+	if err != nil {
+		s.config.Logger.Error("Failed to list mutes", zap.Error(err))
+		return nil, "", err
+	}
+
+	next := ""
+	if len(mutes) > limit {
+		mutes = mutes[:limit]
+		last := mutes[limit-1]
+		next = cursor.Encode(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+
+	users := make([]*ent.User, 0, len(mutes))
+	for _, m := range mutes {
+		if user.IsListed(m.Edges.Muted) {
+			users = append(users, m.Edges.Muted)
+		}
+	}
+
+	profiles, err := s.userService.PublicProfiles(viewer, users)
+	return profiles, next, err
+}
+
+// findAny finds the user to unblock or unmute, who may no longer be listed,
+// such as a suspended account.
+func (s *BlockService) findAny(username string) (*ent.User, error) {
+	target, err := s.userService.FindByUsername(username)
+	if ent.IsNotFound(err) {
+		return nil, user.ErrProfileNotFound
+	}
+
+	return target, err
+}
+
+// page decodes the cursor of a list request and clamps its page size.
+func page(after string, limit int) (*Cursor, int, error) {
+	if limit <= 0 || limit > maxPageSize {
+		limit = defaultPageSize
+	}
+
+	if after == "" {
+		return nil, limit, nil
+	}
+
+	position := new(Cursor)
+	if err := cursor.Decode(after, position); err != nil {
+		return nil, 0, err
+	}
+
+	return position, limit, nil
+}
@@ -0,0 +1,86 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CaptchaVerifier checks CAPTCHA tokens against a siteverify style endpoint
+// (reCAPTCHA, hCaptcha and Turnstile all share the same contract). The
+// verification URL is configurable so it can point to a local stub.
+type CaptchaVerifier struct {
+	verifyURL string
+	secret    string
+	siteKey   string
+	client    *http.Client
+}
+
+// NewCaptchaVerifier creates a new CaptchaVerifier instance.
+func NewCaptchaVerifier(verifyURL string, secret string, siteKey string) *CaptchaVerifier {
+	return &CaptchaVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		siteKey:   siteKey,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (v *CaptchaVerifier) Type() string {
+	return TypeCaptcha
+}
+
+// Issue tells the client which site key to render the widget with.
+func (v *CaptchaVerifier) Issue() (Challenge, error) {
+	return Challenge{
+		Type:    TypeCaptcha,
+		SiteKey: v.siteKey,
+	}, nil
+}
+
+type captchaResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (v *CaptchaVerifier) Verify(ctx context.Context, solution Solution, remoteIP string) error {
+	if solution.Solution == "" {
+		return ErrInvalidSolution
+	}
+
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", solution.Solution)
+	form.Set("remoteip", remoteIP)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("received error status: %s", resp.Status)
+	}
+
+	result := new(captchaResponse)
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if !result.Success {
+		return ErrInvalidSolution
+	}
+
+	return nil
+}
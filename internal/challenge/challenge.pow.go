@@ -0,0 +1,127 @@
+package challenge
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const powTTL = 5 * time.Minute
+
+// ProofOfWorkVerifier is a self-hosted hashcash style challenge. The client
+// must find a solution such that sha256(token + ":" + solution) starts with
+// the requested number of zero bits. Tokens are signed so no state is kept
+// until they are redeemed, after which they are remembered to prevent reuse.
+type ProofOfWorkVerifier struct {
+	secret     []byte
+	difficulty int
+
+	mu   sync.Mutex
+	used map[string]time.Time
+}
+
+// NewProofOfWorkVerifier creates a new ProofOfWorkVerifier instance.
+func NewProofOfWorkVerifier(secret string, difficulty int) *ProofOfWorkVerifier {
+	return &ProofOfWorkVerifier{
+		secret:     []byte(secret),
+		difficulty: difficulty,
+		used:       map[string]time.Time{},
+	}
+}
+
+func (v *ProofOfWorkVerifier) Type() string {
+	return TypeProofOfWork
+}
+
+// Issue returns a token of the form "<nonce>.<expires>.<difficulty>.<signature>".
+func (v *ProofOfWorkVerifier) Issue() (Challenge, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return Challenge{}, fmt.Errorf("failed to generate challenge: %w", err)
+	}
+
+	expiresAt := time.Now().Add(powTTL)
+	payload := fmt.Sprintf("%s.%d.%d", hex.EncodeToString(nonce), expiresAt.Unix(), v.difficulty)
+
+	return Challenge{
+		Type:       TypeProofOfWork,
+		Token:      payload + "." + v.sign(payload),
+		Difficulty: v.difficulty,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+func (v *ProofOfWorkVerifier) Verify(_ context.Context, solution Solution, _ string) error {
+	parts := strings.Split(solution.Token, ".")
+	if len(parts) != 4 {
+		return ErrInvalidSolution
+	}
+
+	payload := strings.Join(parts[:3], ".")
+	if !hmac.Equal([]byte(parts[3]), []byte(v.sign(payload))) {
+		return ErrInvalidSolution
+	}
+
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return ErrInvalidSolution
+	}
+
+	difficulty, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return ErrInvalidSolution
+	}
+
+	hash := sha256.Sum256([]byte(solution.Token + ":" + solution.Solution))
+	if leadingZeroBits(hash[:]) < difficulty {
+		return ErrInvalidSolution
+	}
+
+	return v.redeem(solution.Token, time.Unix(expires, 0))
+}
+
+// redeem marks the token as used, a token can only be redeemed once.
+func (v *ProofOfWorkVerifier) redeem(token string, expiresAt time.Time) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := time.Now()
+	for used, expiry := range v.used {
+		if expiry.Before(now) {
+			delete(v.used, used)
+		}
+	}
+
+	if _, ok := v.used[token]; ok {
+		return ErrInvalidSolution
+	}
+
+	v.used[token] = expiresAt
+	return nil
+}
+
+func (v *ProofOfWorkVerifier) sign(payload string) string {
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte("pow:" + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func leadingZeroBits(hash []byte) int {
+	zeros := 0
+	for _, b := range hash {
+		if b != 0 {
+			return zeros + bits.LeadingZeros8(b)
+		}
+		zeros += 8
+	}
+
+	return zeros
+}
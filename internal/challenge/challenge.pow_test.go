@@ -0,0 +1,184 @@
+package challenge
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testDifficulty = 8
+
+// solve finds a solution with at least difficulty leading zero bits, or with
+// fewer when enough is false.
+func solve(t *testing.T, token string, difficulty int, enough bool) string {
+	t.Helper()
+
+	for i := 0; i < 1<<20; i++ {
+		solution := strconv.Itoa(i)
+		hash := sha256.Sum256([]byte(token + ":" + solution))
+		if (leadingZeroBits(hash[:]) >= difficulty) == enough {
+			return solution
+		}
+	}
+
+	t.Fatal("no solution found")
+	return ""
+}
+
+func TestProofOfWorkVerifier(t *testing.T) {
+	verifier := NewProofOfWorkVerifier("secret", testDifficulty)
+
+	issue := func(t *testing.T) string {
+		t.Helper()
+		challenge, err := verifier.Issue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if challenge.Difficulty != testDifficulty || !challenge.ExpiresAt.After(time.Now()) {
+			t.Fatalf("got difficulty %d expiring at %v", challenge.Difficulty, challenge.ExpiresAt)
+		}
+		return challenge.Token
+	}
+
+	// signed builds a token the verifier accepts the signature of
+	signed := func(expires time.Time, difficulty int) string {
+		payload := fmt.Sprintf("%x.%d.%d", time.Now().UnixNano(), expires.Unix(), difficulty)
+		return payload + "." + verifier.sign(payload)
+	}
+
+	tests := []struct {
+		name  string
+		token func(t *testing.T) string
+		// weak solves the token with too few zero bits
+		weak bool
+		err  error
+	}{
+		{name: "valid", token: issue},
+		{name: "too little work", token: issue, weak: true, err: ErrInvalidSolution},
+		{
+			name:  "expired",
+			token: func(t *testing.T) string { return signed(time.Now().Add(-time.Second), testDifficulty) },
+			err:   ErrInvalidSolution,
+		},
+		{
+			name: "lowered difficulty",
+			token: func(t *testing.T) string {
+				parts := strings.Split(issue(t), ".")
+				parts[2] = "0"
+				return strings.Join(parts, ".")
+			},
+			err: ErrInvalidSolution,
+		},
+		{
+			name: "tampered signature",
+			token: func(t *testing.T) string {
+				token := issue(t)
+				last := "0"
+				if strings.HasSuffix(token, "0") {
+					last = "1"
+				}
+				return token[:len(token)-1] + last
+			},
+			err: ErrInvalidSolution,
+		},
+		{
+			name: "signed with another secret",
+			token: func(t *testing.T) string {
+				challenge, err := NewProofOfWorkVerifier("other", testDifficulty).Issue()
+				if err != nil {
+					t.Fatal(err)
+				}
+				return challenge.Token
+			},
+			err: ErrInvalidSolution,
+		},
+		{name: "malformed", token: func(t *testing.T) string { return "not.a.token" }, err: ErrInvalidSolution},
+		{
+			name:  "unparsable expiry",
+			token: func(t *testing.T) string { payload := "abc.soon.8"; return payload + "." + verifier.sign(payload) },
+			err:   ErrInvalidSolution,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := tt.token(t)
+			solution := solve(t, token, testDifficulty, !tt.weak)
+
+			err := verifier.Verify(context.Background(), Solution{Type: TypeProofOfWork, Token: token, Solution: solution}, "")
+			if !errors.Is(err, tt.err) {
+				t.Errorf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestProofOfWorkVerifierRejectsReplays(t *testing.T) {
+	verifier := NewProofOfWorkVerifier("secret", testDifficulty)
+
+	challenge, err := verifier.Issue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution := Solution{Type: TypeProofOfWork, Token: challenge.Token, Solution: solve(t, challenge.Token, testDifficulty, true)}
+
+	if err := verifier.Verify(context.Background(), solution, ""); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if err := verifier.Verify(context.Background(), solution, ""); !errors.Is(err, ErrInvalidSolution) {
+		t.Errorf("replay: got %v, want ErrInvalidSolution", err)
+	}
+
+	// a second solution to the same token is a replay as well
+	for i := 0; ; i++ {
+		candidate := solution.Solution + "-" + strconv.Itoa(i)
+		hash := sha256.Sum256([]byte(challenge.Token + ":" + candidate))
+		if leadingZeroBits(hash[:]) >= testDifficulty {
+			solution.Solution = candidate
+			break
+		}
+	}
+	if err := verifier.Verify(context.Background(), solution, ""); !errors.Is(err, ErrInvalidSolution) {
+		t.Errorf("another solution: got %v, want ErrInvalidSolution", err)
+	}
+}
+
+func TestProofOfWorkVerifierForgetsExpiredTokens(t *testing.T) {
+	verifier := NewProofOfWorkVerifier("secret", testDifficulty)
+	verifier.used["stale"] = time.Now().Add(-time.Minute)
+	verifier.used["fresh"] = time.Now().Add(time.Minute)
+
+	if err := verifier.redeem("new", time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := verifier.used["stale"]; ok {
+		t.Error("expired token was not forgotten")
+	}
+	if err := verifier.redeem("fresh", time.Now().Add(time.Minute)); !errors.Is(err, ErrInvalidSolution) {
+		t.Errorf("redeeming a used token: got %v, want ErrInvalidSolution", err)
+	}
+}
+
+func TestLeadingZeroBits(t *testing.T) {
+	tests := []struct {
+		hash []byte
+		want int
+	}{
+		{hash: []byte{0x80}, want: 0},
+		{hash: []byte{0x01}, want: 7},
+		{hash: []byte{0x00, 0x10}, want: 11},
+		{hash: []byte{0x00, 0x00}, want: 16},
+	}
+
+	for _, tt := range tests {
+		if got := leadingZeroBits(tt.hash); got != tt.want {
+			t.Errorf("leadingZeroBits(%x) = %d, want %d", tt.hash, got, tt.want)
+		}
+	}
+}
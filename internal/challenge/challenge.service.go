@@ -0,0 +1,74 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type ChallengeServiceIntr interface {
+	Issue() (Challenge, error)
+	Verify(solution Solution, remoteIP string) error
+}
+
+// ChallengeService hands out the configured challenge and verifies solutions
+// to it.
+type ChallengeService struct {
+	verifiers map[string]Verifier
+	provider  string
+	config    *config.Config
+	ctx       context.Context
+}
+
+// NewChallengeService creates a new ChallengeService instance. The CAPTCHA
+// verifier is only registered when a verification URL is configured.
+func NewChallengeService(config *config.Config, ctx context.Context) *ChallengeService {
+	s := &ChallengeService{
+		verifiers: map[string]Verifier{},
+		provider:  config.Challenge.Provider,
+		config:    config,
+		ctx:       ctx,
+	}
+
+	s.Register(NewProofOfWorkVerifier(config.Challenge.Secret, config.Challenge.PowDifficulty))
+
+	if config.Challenge.CaptchaVerifyURL != "" {
+		s.Register(NewCaptchaVerifier(config.Challenge.CaptchaVerifyURL, config.Challenge.CaptchaSecret, config.Challenge.CaptchaSiteKey))
+	}
+
+	if _, ok := s.verifiers[s.provider]; !ok {
+		config.Logger.Warn("Unknown challenge provider, falling back to proof of work", zap.String("provider", s.provider))
+		s.provider = TypeProofOfWork
+	}
+
+	return s
+}
+
+// Register adds or replaces the verifier for its type.
+func (s *ChallengeService) Register(verifier Verifier) {
+	s.verifiers[verifier.Type()] = verifier
+}
+
+// Issue returns a challenge from the configured provider.
+func (s *ChallengeService) Issue() (Challenge, error) {
+	return s.verifiers[s.provider].Issue()
+}
+
+// Verify checks a solution to the configured challenge. Solutions of other
+// registered types are rejected, otherwise proof of work, which is always
+// registered, would get around a configured CAPTCHA.
+func (s *ChallengeService) Verify(solution Solution, remoteIP string) error {
+	if solution.Type != s.provider {
+		return fmt.Errorf("%w: unsupported challenge type %q", ErrInvalidSolution, solution.Type)
+	}
+	verifier := s.verifiers[s.provider]
+
+	if err := verifier.Verify(s.ctx, solution, remoteIP); err != nil {
+		s.config.Logger.Info("Challenge verification failed", zap.String("type", solution.Type), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
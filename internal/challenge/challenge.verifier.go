@@ -0,0 +1,37 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	TypeProofOfWork = "pow"
+	TypeCaptcha     = "captcha"
+)
+
+var ErrInvalidSolution = errors.New("invalid challenge solution")
+
+// Challenge is handed to the client when a challenge is required.
+type Challenge struct {
+	Type       string    `json:"type"`
+	Token      string    `json:"token,omitempty"`
+	Difficulty int       `json:"difficulty,omitempty"`
+	SiteKey    string    `json:"site_key,omitempty"`
+	ExpiresAt  time.Time `json:"expires_at,omitzero"`
+}
+
+// Solution is what the client sends back along with the original request.
+type Solution struct {
+	Type     string `json:"type" xml:"type" form:"type"`
+	Token    string `json:"token" xml:"token" form:"token"`
+	Solution string `json:"solution" xml:"solution" form:"solution"`
+}
+
+// Verifier issues and verifies one kind of challenge.
+type Verifier interface {
+	Type() string
+	Issue() (Challenge, error)
+	Verify(ctx context.Context, solution Solution, remoteIP string) error
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -18,6 +19,8 @@ type ServerConfig struct {
 	Port string
 	Host string
 	CORS string
+	// PublicURL is the externally reachable base URL used when building links.
+	PublicURL string
 }
 
 type WhatsAppConfig struct {
@@ -28,6 +31,72 @@ type WhatsAppConfig struct {
 type GoogleConfig struct {
 	ClientID     string
 	ClientSecret string
+	// ImportPicture copies the Google profile picture as the avatar of users without one.
+	ImportPicture bool
+}
+
+type AdminConfig struct {
+	// BootstrapPhoneNumber is granted the admin role on startup when no admin exists yet.
+	BootstrapPhoneNumber string
+}
+
+type AccountConfig struct {
+	// DeletionGracePeriod is how long a deletion request can be cancelled by logging in again.
+	DeletionGracePeriod time.Duration
+	// GuestTTL is how long a guest account is kept after its last sign in.
+	GuestTTL time.Duration
+}
+
+type ExportConfig struct {
+	// Dir is where data export archives are kept until they expire.
+	Dir string
+	// LinkTTL is how long a data export download link stays valid.
+	LinkTTL time.Duration
+	// SigningSecret signs data export download links.
+	SigningSecret string
+}
+
+type ChallengeConfig struct {
+	// Provider is the challenge handed out when one is required, "pow" or "captcha".
+	Provider string
+	// Secret signs proof-of-work challenge tokens.
+	Secret string
+	// PowDifficulty is the number of leading zero bits a proof-of-work hash needs.
+	PowDifficulty    int
+	CaptchaVerifyURL string
+	CaptchaSecret    string
+	CaptchaSiteKey   string
+}
+
+type DeviceConfig struct {
+	// VerificationURL is the page where users type the code shown on their TV.
+	VerificationURL string
+}
+
+type MailConfig struct {
+	// Provider delivers outgoing email, "smtp" or "log" to only write it to the logs.
+	Provider     string
+	From         string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUser     string
+	SMTPPassword string
+	// VerificationURL is the page the link in an email verification message opens.
+	VerificationURL string
+}
+
+type StorageConfig struct {
+	// Provider keeps uploaded files, "local" or "s3" for any S3-compatible service.
+	Provider string
+	// LocalDir is where the local provider writes files.
+	LocalDir string
+	// PublicURL is the base URL files are served from.
+	PublicURL   string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
 }
 
 type Config struct {
@@ -38,6 +107,13 @@ type Config struct {
 	WhatsApp    WhatsAppConfig
 	JWTSecret   string
 	Google      GoogleConfig
+	Admin       AdminConfig
+	Account     AccountConfig
+	Export      ExportConfig
+	Challenge   ChallengeConfig
+	Device      DeviceConfig
+	Mail        MailConfig
+	Storage     StorageConfig
 	Logger      *zap.Logger
 }
 
@@ -64,17 +140,61 @@ func GetConfig() *Config {
 				Name:     env.DBName,
 			},
 			Server: ServerConfig{
-				Port: env.ServerPort,
-				Host: env.ServerHost,
-				CORS: env.CORS,
+				Port:      env.ServerPort,
+				Host:      env.ServerHost,
+				CORS:      env.CORS,
+				PublicURL: env.PublicURL,
 			},
 			WhatsApp: WhatsAppConfig{
 				Token:   env.WhatsAppToken,
 				PhoneId: env.WhatsAppPhoneId,
 			},
 			Google: GoogleConfig{
-				ClientID:     env.GoogleClientID,
-				ClientSecret: env.GoogleClientSecret,
+				ClientID:      env.GoogleClientID,
+				ClientSecret:  env.GoogleClientSecret,
+				ImportPicture: env.GoogleImportPicture,
+			},
+			Admin: AdminConfig{
+				BootstrapPhoneNumber: env.AdminPhoneNumber,
+			},
+			Account: AccountConfig{
+				DeletionGracePeriod: time.Duration(env.AccountDeletionGraceDays) * 24 * time.Hour,
+				GuestTTL:            time.Duration(env.GuestTTLDays) * 24 * time.Hour,
+			},
+			Export: ExportConfig{
+				Dir:           env.ExportDir,
+				LinkTTL:       time.Duration(env.ExportLinkTTLHours) * time.Hour,
+				SigningSecret: env.ExportSigningSecret,
+			},
+			Challenge: ChallengeConfig{
+				Provider:         env.ChallengeProvider,
+				Secret:           env.ChallengeSecret,
+				PowDifficulty:    env.ChallengePowDifficulty,
+				CaptchaVerifyURL: env.CaptchaVerifyURL,
+				CaptchaSecret:    env.CaptchaSecret,
+				CaptchaSiteKey:   env.CaptchaSiteKey,
+			},
+			Device: DeviceConfig{
+				VerificationURL: env.DeviceVerificationURL,
+			},
+			Mail: MailConfig{
+				Provider:        env.MailProvider,
+				From:            env.MailFrom,
+				SMTPHost:        env.SMTPHost,
+				SMTPPort:        env.SMTPPort,
+				SMTPUser:        env.SMTPUser,
+				SMTPPassword:    env.SMTPPassword,
+				VerificationURL: env.EmailVerificationURL,
+			},
+			Storage: StorageConfig{
+				Provider:    env.StorageProvider,
+				LocalDir:    env.StorageLocalDir,
+				PublicURL:   env.StoragePublicURL,
+				S3Endpoint:  env.S3Endpoint,
+				S3Region:    env.S3Region,
+				S3Bucket:    env.S3Bucket,
+				S3AccessKey: env.S3AccessKey,
+				S3SecretKey: env.S3SecretKey,
 			},
 			Logger: nil,
 		}
@@ -4,32 +4,64 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
 
 // Env Struct to hold environment variables.
 type Env struct {
-	Environment        string
-	ServerPort         string
-	ServerHost         string
-	DBHost             string
-	DBPort             string
-	DBUser             string
-	DBPassword         string
-	DBName             string
-	DBSSLMode          string
-	RedisHost          string
-	RedisPort          string
-	RedisDB            string
-	RedisPassword      string
-	RedisURL           string
-	WhatsAppToken      string
-	WhatsAppPhoneId    string
-	CORS               string
-	JWTSecret          string
-	GoogleClientID     string
-	GoogleClientSecret string
+	Environment              string
+	ServerPort               string
+	ServerHost               string
+	DBHost                   string
+	DBPort                   string
+	DBUser                   string
+	DBPassword               string
+	DBName                   string
+	DBSSLMode                string
+	RedisHost                string
+	RedisPort                string
+	RedisDB                  string
+	RedisPassword            string
+	RedisURL                 string
+	WhatsAppToken            string
+	WhatsAppPhoneId          string
+	CORS                     string
+	JWTSecret                string
+	GoogleClientID           string
+	GoogleClientSecret       string
+	AdminPhoneNumber         string
+	AccountDeletionGraceDays int
+	GuestTTLDays             int
+	PublicURL                string
+	ExportDir                string
+	ExportLinkTTLHours       int
+	ExportSigningSecret      string
+	ChallengeProvider        string
+	ChallengeSecret          string
+	ChallengePowDifficulty   int
+	CaptchaVerifyURL         string
+	CaptchaSecret            string
+	CaptchaSiteKey           string
+	DeviceVerificationURL    string
+	MailProvider             string
+	MailFrom                 string
+	SMTPHost                 string
+	SMTPPort                 string
+	SMTPUser                 string
+	SMTPPassword             string
+	EmailVerificationURL     string
+	GoogleImportPicture      bool
+	StorageProvider          string
+	StorageLocalDir          string
+	StoragePublicURL         string
+	S3Endpoint               string
+	S3Region                 string
+	S3Bucket                 string
+	S3AccessKey              string
+	S3SecretKey              string
 }
 
 // LoadEnv loads environment variables from a .env file.
@@ -48,26 +80,96 @@ func LoadEnv() Env {
 	}
 
 	return Env{
-		Environment:     environment,
-		ServerPort:      os.Getenv("SERVER_PORT"),
-		ServerHost:      os.Getenv("SERVER_HOST"),
-		DBHost:          os.Getenv("DB_HOST"),
-		DBPort:          os.Getenv("DB_PORT"),
-		DBUser:          os.Getenv("DB_USER"),
-		DBPassword:      os.Getenv("DB_PASSWORD"),
-		DBName:          os.Getenv("DB_NAME"),
-		DBSSLMode:       os.Getenv("DB_SSL_MODE"),
-		RedisHost:       os.Getenv("REDIS_HOST"),
-		RedisPort:       os.Getenv("REDIS_PORT"),
-		RedisDB:         os.Getenv("REDIS_DB"),
-		RedisPassword:   os.Getenv("REDIS_PASSWORD"),
-		RedisURL:        os.Getenv("REDIS_URL"),
-		WhatsAppToken:   os.Getenv("WHATSAPP_TOKEN"),
-		WhatsAppPhoneId: os.Getenv("WHATSAPP_PHONE_ID"),
-		CORS:            os.Getenv("CORS"),
+		Environment:              environment,
+		ServerPort:               os.Getenv("SERVER_PORT"),
+		ServerHost:               os.Getenv("SERVER_HOST"),
+		DBHost:                   os.Getenv("DB_HOST"),
+		DBPort:                   os.Getenv("DB_PORT"),
+		DBUser:                   os.Getenv("DB_USER"),
+		DBPassword:               os.Getenv("DB_PASSWORD"),
+		DBName:                   os.Getenv("DB_NAME"),
+		DBSSLMode:                os.Getenv("DB_SSL_MODE"),
+		RedisHost:                os.Getenv("REDIS_HOST"),
+		RedisPort:                os.Getenv("REDIS_PORT"),
+		RedisDB:                  os.Getenv("REDIS_DB"),
+		RedisPassword:            os.Getenv("REDIS_PASSWORD"),
+		RedisURL:                 os.Getenv("REDIS_URL"),
+		WhatsAppToken:            os.Getenv("WHATSAPP_TOKEN"),
+		WhatsAppPhoneId:          os.Getenv("WHATSAPP_PHONE_ID"),
+		CORS:                     os.Getenv("CORS"),
+		AdminPhoneNumber:         os.Getenv("ADMIN_PHONE_NUMBER"),
+		AccountDeletionGraceDays: getEnvInt("ACCOUNT_DELETION_GRACE_DAYS", 30),
+		GuestTTLDays:             getEnvInt("GUEST_TTL_DAYS", 30),
+		PublicURL:                os.Getenv("PUBLIC_URL"),
+		ExportDir:                getEnv("EXPORT_DIR", filepath.Join(os.TempDir(), "shinplay-exports")),
+		ExportLinkTTLHours:       getEnvInt("EXPORT_LINK_TTL_HOURS", 48),
+		ExportSigningSecret:      requireEnv("EXPORT_SIGNING_SECRET"),
+		ChallengeProvider:        getEnv("CHALLENGE_PROVIDER", "pow"),
+		ChallengeSecret:          requireEnv("CHALLENGE_SECRET"),
+		ChallengePowDifficulty:   getEnvInt("CHALLENGE_POW_DIFFICULTY", 20),
+		CaptchaVerifyURL:         os.Getenv("CAPTCHA_VERIFY_URL"),
+		CaptchaSecret:            os.Getenv("CAPTCHA_SECRET"),
+		CaptchaSiteKey:           os.Getenv("CAPTCHA_SITE_KEY"),
+		DeviceVerificationURL:    getEnv("DEVICE_VERIFICATION_URL", os.Getenv("PUBLIC_URL")+"/device"),
+		MailProvider:             getEnv("MAIL_PROVIDER", "log"),
+		MailFrom:                 getEnv("MAIL_FROM", "ShinPlay <no-reply@shinplay.app>"),
+		SMTPHost:                 os.Getenv("SMTP_HOST"),
+		SMTPPort:                 getEnv("SMTP_PORT", "587"),
+		SMTPUser:                 os.Getenv("SMTP_USER"),
+		SMTPPassword:             os.Getenv("SMTP_PASSWORD"),
+		EmailVerificationURL:     getEnv("EMAIL_VERIFICATION_URL", os.Getenv("PUBLIC_URL")+"/verify-email"),
+		GoogleImportPicture:      getEnvBool("GOOGLE_IMPORT_PICTURE", false),
+		StorageProvider:          getEnv("STORAGE_PROVIDER", "local"),
+		StorageLocalDir:          getEnv("STORAGE_LOCAL_DIR", filepath.Join(os.TempDir(), "shinplay-blobs")),
+		StoragePublicURL:         getEnv("STORAGE_PUBLIC_URL", os.Getenv("PUBLIC_URL")+"/blobs"),
+		S3Endpoint:               os.Getenv("S3_ENDPOINT"),
+		S3Region:                 getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:                 os.Getenv("S3_BUCKET"),
+		S3AccessKey:              os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:              os.Getenv("S3_SECRET_KEY"),
 	}
 }
 
+// getEnv reads an environment variable, falling back when it is unset.
+func getEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// requireEnv reads an environment variable that has no safe default, exiting
+// when it is unset.
+func requireEnv(key string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		log.Fatalf("%s must be set", key)
+	}
+
+	return value
+}
+
+// getEnvInt reads an integer environment variable, falling back when it is unset or invalid.
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+// getEnvBool reads a boolean environment variable, falling back when it is unset or invalid.
+func getEnvBool(key string, fallback bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
 func initializeEnvironment() string {
 	environment := os.Getenv("ENV")
 	if environment == "" {
@@ -0,0 +1,115 @@
+package dataexport
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type DataExportHandlerIntr interface {
+	RequestExport(ctx *fiber.Ctx) error
+	GetExport(ctx *fiber.Ctx) error
+	Download(ctx *fiber.Ctx) error
+}
+
+type DataExportHandler struct {
+	dataExportService *DataExportService
+	auditService      *audit.AuditService
+	config            *config.Config
+}
+
+func NewDataExportHandler(dataExportService *DataExportService, auditService *audit.AuditService, config *config.Config) *DataExportHandler {
+	return &DataExportHandler{
+		dataExportService: dataExportService,
+		auditService:      auditService,
+		config:            config,
+	}
+}
+
+func (h *DataExportHandler) RequestExport(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	export, err := h.dataExportService.RequestExport(currentUser)
+	if err != nil {
+		h.config.Logger.Error("Failed to request data export", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to request data export, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventDataExportRequested,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"export_id": export.ExportID},
+	})
+
+	return ctx.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"status":  "success",
+		"message": "We are preparing your data, you will be notified when it is ready",
+		"data": fiber.Map{
+			"export_id": export.ExportID,
+			"status":    export.Status,
+		},
+	})
+}
+
+func (h *DataExportHandler) GetExport(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	export, err := h.dataExportService.FindExport(currentUser, ctx.Params("exportId"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Data export not found",
+			})
+		}
+
+		h.config.Logger.Error("Failed to find data export", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to find data export, please try again later",
+		})
+	}
+
+	data := fiber.Map{
+		"export_id":  export.ExportID,
+		"status":     export.Status,
+		"expires_at": export.ExpiresAt,
+	}
+	if export.FilePath != "" {
+		data["download_url"] = h.dataExportService.DownloadURL(export)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   data,
+	})
+}
+
+// Download serves the archive behind a signed link, no session is required.
+func (h *DataExportHandler) Download(ctx *fiber.Ctx) error {
+	filePath, err := h.dataExportService.OpenSignedExport(ctx.Params("exportId"), ctx.Query("expires"), ctx.Query("signature"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidSignature) || errors.Is(err, ErrExportNotReady) || ent.IsNotFound(err) {
+			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Download link is invalid or has expired",
+			})
+		}
+
+		h.config.Logger.Error("Failed to open data export", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to download data export, please try again later",
+		})
+	}
+
+	return ctx.Download(filePath, "shinplay-data-export.zip")
+}
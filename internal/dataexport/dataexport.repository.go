@@ -0,0 +1,85 @@
+package dataexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/user"
+)
+
+type DataExportRepositoryIntr interface {
+	Create(ctx context.Context, user *ent.User) (*ent.DataExport, error)
+	FindPendingByUser(ctx context.Context, userID int) (*ent.DataExport, error)
+	FindByExportID(ctx context.Context, exportID string) (*ent.DataExport, error)
+	FindByExportIDForUser(ctx context.Context, exportID string, userID int) (*ent.DataExport, error)
+	MarkReady(ctx context.Context, export *ent.DataExport, filePath string, expiresAt time.Time) (*ent.DataExport, error)
+	MarkFailed(ctx context.Context, export *ent.DataExport, reason string, expiresAt time.Time) (*ent.DataExport, error)
+	FindExpired(ctx context.Context, now time.Time) ([]*ent.DataExport, error)
+	Delete(ctx context.Context, export *ent.DataExport) error
+}
+
+type DataExportRepository struct {
+	client *ent.Client
+}
+
+func NewDataExportRepository(client *ent.Client) *DataExportRepository {
+	return &DataExportRepository{client: client}
+}
+
+func (r *DataExportRepository) Create(ctx context.Context, user *ent.User) (*ent.DataExport, error) {
+	return r.client.DataExport.Create().
+		SetUser(user).
+		Save(ctx)
+}
+
+func (r *DataExportRepository) FindPendingByUser(ctx context.Context, userID int) (*ent.DataExport, error) {
+	return r.client.DataExport.Query().
+		Where(
+			dataexport.StatusEQ(dataexport.StatusPending),
+			dataexport.HasUserWith(user.IDEQ(userID)),
+		).
+		First(ctx)
+}
+
+func (r *DataExportRepository) FindByExportID(ctx context.Context, exportID string) (*ent.DataExport, error) {
+	return r.client.DataExport.Query().
+		Where(dataexport.ExportIDEQ(exportID)).
+		Only(ctx)
+}
+
+func (r *DataExportRepository) FindByExportIDForUser(ctx context.Context, exportID string, userID int) (*ent.DataExport, error) {
+	return r.client.DataExport.Query().
+		Where(
+			dataexport.ExportIDEQ(exportID),
+			dataexport.HasUserWith(user.IDEQ(userID)),
+		).
+		Only(ctx)
+}
+
+func (r *DataExportRepository) MarkReady(ctx context.Context, export *ent.DataExport, filePath string, expiresAt time.Time) (*ent.DataExport, error) {
+	return export.Update().
+		SetStatus(dataexport.StatusReady).
+		SetFilePath(filePath).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+func (r *DataExportRepository) MarkFailed(ctx context.Context, export *ent.DataExport, reason string, expiresAt time.Time) (*ent.DataExport, error) {
+	return export.Update().
+		SetStatus(dataexport.StatusFailed).
+		SetError(reason).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+func (r *DataExportRepository) FindExpired(ctx context.Context, now time.Time) ([]*ent.DataExport, error) {
+	return r.client.DataExport.Query().
+		Where(dataexport.ExpiresAtLT(now)).
+		All(ctx)
+}
+
+func (r *DataExportRepository) Delete(ctx context.Context, export *ent.DataExport) error {
+	return r.client.DataExport.DeleteOne(export).Exec(ctx)
+}
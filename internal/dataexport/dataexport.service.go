@@ -0,0 +1,396 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/shinplay/ent"
+	entfollow "github.com/shinplay/ent/follow"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/auth/session"
+	"github.com/shinplay/internal/block"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/follow"
+	"github.com/shinplay/internal/notification"
+	"github.com/shinplay/internal/rbac"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+const cleanupInterval = 1 * time.Hour
+
+var (
+	ErrInvalidSignature = errors.New("invalid or expired download link")
+	ErrExportNotReady   = errors.New("data export is not ready")
+)
+
+type DataExportServiceIntr interface {
+	RequestExport(user *ent.User) (*ent.DataExport, error)
+	FindExport(user *ent.User, exportID string) (*ent.DataExport, error)
+	DownloadURL(export *ent.DataExport) string
+	OpenSignedExport(exportID string, expires string, signature string) (string, error)
+	Run()
+}
+
+// DataExportService assembles "download my data" archives in the background
+// and hands them out through signed, expiring links.
+type DataExportService struct {
+	dataExportRepository *DataExportRepository
+	sessionRepository    *session.SessionRepository
+	auditRepository      *audit.AuditRepository
+	rbacRepository       *rbac.RBACRepository
+	followRepository     *follow.FollowRepository
+	blockRepository      *block.BlockRepository
+	userRepository       *user.UserRepository
+	notificationService  *notification.NotificationService
+	config               *config.Config
+	ctx                  context.Context
+}
+
+// NewDataExportService creates a new DataExportService instance.
+func NewDataExportService(
+	dataExportRepository *DataExportRepository,
+	sessionRepository *session.SessionRepository,
+	auditRepository *audit.AuditRepository,
+	rbacRepository *rbac.RBACRepository,
+	followRepository *follow.FollowRepository,
+	blockRepository *block.BlockRepository,
+	userRepository *user.UserRepository,
+	notificationService *notification.NotificationService,
+	config *config.Config,
+	ctx context.Context,
+) *DataExportService {
+	return &DataExportService{
+		dataExportRepository: dataExportRepository,
+		sessionRepository:    sessionRepository,
+		auditRepository:      auditRepository,
+		rbacRepository:       rbacRepository,
+		followRepository:     followRepository,
+		blockRepository:      blockRepository,
+		userRepository:       userRepository,
+		notificationService:  notificationService,
+		config:               config,
+		ctx:                  ctx,
+	}
+}
+
+// RequestExport queues a new export, or returns the one still being assembled.
+func (s *DataExportService) RequestExport(user *ent.User) (*ent.DataExport, error) {
+	pending, err := s.dataExportRepository.FindPendingByUser(s.ctx, user.ID)
+	if err == nil {
+		return pending, nil
+	}
+	if !ent.IsNotFound(err) {
+		s.config.Logger.Error("Failed to look up pending data export", zap.Error(err))
+		return nil, err
+	}
+
+	export, err := s.dataExportRepository.Create(s.ctx, user)
+	if err != nil {
+		s.config.Logger.Error("Failed to create data export", zap.Error(err))
+		return nil, err
+	}
+
+	go s.assemble(export, user)
+
+	s.config.Logger.Info("Data export requested", zap.String("authID", user.AuthID), zap.String("exportID", export.ExportID))
+	return export, nil
+}
+
+func (s *DataExportService) FindExport(user *ent.User, exportID string) (*ent.DataExport, error) {
+	return s.dataExportRepository.FindByExportIDForUser(s.ctx, exportID, user.ID)
+}
+
+func (s *DataExportService) assemble(export *ent.DataExport, user *ent.User) {
+	filePath, err := s.writeArchive(export, user)
+	if err != nil {
+		s.config.Logger.Error("Failed to assemble data export", zap.String("exportID", export.ExportID), zap.Error(err))
+		if _, err := s.dataExportRepository.MarkFailed(s.ctx, export, err.Error(), time.Now().Add(s.config.Export.LinkTTL)); err != nil {
+			s.config.Logger.Error("Failed to mark data export as failed", zap.Error(err))
+		}
+		return
+	}
+
+	ready, err := s.dataExportRepository.MarkReady(s.ctx, export, filePath, time.Now().Add(s.config.Export.LinkTTL))
+	if err != nil {
+		// the account may have been erased while the archive was written
+		s.config.Logger.Error("Failed to mark data export as ready", zap.Error(err))
+		if err := os.Remove(filePath); err != nil {
+			s.config.Logger.Error("Failed to remove data export archive", zap.String("exportID", export.ExportID), zap.Error(err))
+		}
+		return
+	}
+	export = ready
+
+	message := fmt.Sprintf("Your Shinplay data export is ready. Download it before %s: %s",
+		export.ExpiresAt.Format(time.RFC1123), s.DownloadURL(export))
+	if err := s.notificationService.NotifyUser(user, message); err != nil {
+		s.config.Logger.Warn("Failed to notify user about data export", zap.String("exportID", export.ExportID), zap.Error(err))
+	}
+}
+
+func (s *DataExportService) writeArchive(export *ent.DataExport, user *ent.User) (string, error) {
+	if err := os.MkdirAll(s.config.Export.Dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	files, err := s.collect(user)
+	if err != nil {
+		return "", err
+	}
+
+	filePath := filepath.Join(s.config.Export.Dir, export.ExportID+".zip")
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	for name, content := range files {
+		w, err := archive.Create(name)
+		if err != nil {
+			return "", fmt.Errorf("failed to add %s to archive: %w", name, err)
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(content); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return "", fmt.Errorf("failed to finish archive: %w", err)
+	}
+
+	return filePath, nil
+}
+
+// collect gathers everything we store about the user, keyed by file name.
+func (s *DataExportService) collect(user *ent.User) (map[string]any, error) {
+	roles, err := s.rbacRepository.FindRolesByUser(s.ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load roles: %w", err)
+	}
+
+	roleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleNames = append(roleNames, role.Name)
+	}
+
+	sessions, err := s.sessionRepository.FindSessionsByUser(s.ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sessions: %w", err)
+	}
+
+	sessionHistory := make([]map[string]any, 0, len(sessions))
+	for _, session := range sessions {
+		sessionHistory = append(sessionHistory, map[string]any{
+			"user_agent":  session.UserAgent,
+			"ip_address":  session.IPAddress,
+			"created_at":  session.CreateTime,
+			"last_active": session.UpdateTime,
+			"expires_at":  session.ExpiresAt,
+		})
+	}
+
+	events, err := s.auditRepository.Find(s.ctx, audit.Filter{SubjectID: user.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load auth events: %w", err)
+	}
+
+	authEvents := make([]map[string]any, 0, len(events))
+	for _, event := range events {
+		authEvents = append(authEvents, map[string]any{
+			"event":      event.EventType,
+			"ip_address": event.IPAddress,
+			"user_agent": event.UserAgent,
+			"created_at": event.CreateTime,
+		})
+	}
+
+	connections := map[string][]map[string]any{}
+	for name, filter := range map[string]follow.ListFilter{
+		"following": {FollowerID: user.ID, Status: entfollow.StatusAccepted},
+		"followers": {FolloweeID: user.ID, Status: entfollow.StatusAccepted},
+	} {
+		follows, err := s.followRepository.List(s.ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s: %w", name, err)
+		}
+
+		connections[name] = make([]map[string]any, 0, len(follows))
+		for _, f := range follows {
+			other := f.Edges.Follower
+			if filter.FollowerID != 0 {
+				other = f.Edges.Followee
+			}
+			connections[name] = append(connections[name], map[string]any{
+				"username": other.Username,
+				"since":    f.AcceptedAt,
+			})
+		}
+	}
+
+	blocks, err := s.blockRepository.ListBlocked(s.ctx, user, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blocks: %w", err)
+	}
+
+	connections["blocked"] = make([]map[string]any, 0, len(blocks))
+	for _, b := range blocks {
+		connections["blocked"] = append(connections["blocked"], map[string]any{
+			"username": b.Edges.Blocked.Username,
+			"since":    b.CreateTime,
+		})
+	}
+
+	mutes, err := s.blockRepository.ListMuted(s.ctx, user, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mutes: %w", err)
+	}
+
+	connections["muted"] = make([]map[string]any, 0, len(mutes))
+	for _, m := range mutes {
+		connections["muted"] = append(connections["muted"], map[string]any{
+			"username": m.Edges.Muted.Username,
+			"since":    m.CreateTime,
+		})
+	}
+
+	history, err := s.userRepository.FindUsernameHistory(s.ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load username history: %w", err)
+	}
+
+	usernames := make([]map[string]any, 0, len(history))
+	for _, h := range history {
+		usernames = append(usernames, map[string]any{
+			"username":    h.Username,
+			"released_at": h.CreateTime,
+		})
+	}
+
+	identities := []map[string]any{}
+	if user.PhoneNumber != "" {
+		identities = append(identities, map[string]any{"type": "phone", "value": user.PhoneNumber})
+	}
+	if user.Email != "" {
+		identities = append(identities, map[string]any{"type": "email", "value": user.Email, "verified_at": user.EmailVerifiedAt})
+	}
+
+	return map[string]any{
+		"profile.json": map[string]any{
+			"auth_id":        user.AuthID,
+			"username":       user.Username,
+			"first_name":     user.FirstName,
+			"last_name":      user.LastName,
+			"display_name":   user.DisplayName,
+			"bio":            user.Bio,
+			"birthday":       user.Birthday,
+			"locale":         user.Locale,
+			"timezone":       user.Timezone,
+			"avatar_urls":    user.AvatarUrls,
+			"settings":       user.Settings,
+			"login_count":    user.LoginCount,
+			"status":         user.Status,
+			"roles":          roleNames,
+			"past_usernames": usernames,
+			"created_at":     user.CreateTime,
+			"updated_at":     user.UpdateTime,
+		},
+		"identities.json":  identities,
+		"connections.json": connections,
+		"sessions.json":    sessionHistory,
+		"auth_events.json": authEvents,
+	}, nil
+}
+
+// DownloadURL returns a link to the archive signed until the export expires.
+func (s *DataExportService) DownloadURL(export *ent.DataExport) string {
+	expires := strconv.FormatInt(export.ExpiresAt.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(export.ExportID, expires))
+
+	return s.config.Server.PublicURL + "/exports/" + export.ExportID + "/download?" + query.Encode()
+}
+
+// OpenSignedExport validates a download link and returns the archive path.
+func (s *DataExportService) OpenSignedExport(exportID string, expires string, signature string) (string, error) {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return "", ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(exportID, expires))) {
+		return "", ErrInvalidSignature
+	}
+
+	export, err := s.dataExportRepository.FindByExportID(s.ctx, exportID)
+	if err != nil {
+		return "", err
+	}
+
+	if export.FilePath == "" {
+		return "", ErrExportNotReady
+	}
+
+	return export.FilePath, nil
+}
+
+func (s *DataExportService) sign(exportID string, expires string) string {
+	mac := hmac.New(sha256.New, []byte(s.config.Export.SigningSecret))
+	mac.Write([]byte(exportID + ":" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Run removes expired archives every cleanupInterval until the context is done.
+func (s *DataExportService) Run() {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		s.removeExpired()
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *DataExportService) removeExpired() {
+	exports, err := s.dataExportRepository.FindExpired(s.ctx, time.Now())
+	if err != nil {
+		s.config.Logger.Error("Failed to find expired data exports", zap.Error(err))
+		return
+	}
+
+	for _, export := range exports {
+		if export.FilePath != "" {
+			if err := os.Remove(export.FilePath); err != nil && !os.IsNotExist(err) {
+				s.config.Logger.Error("Failed to remove data export archive", zap.String("exportID", export.ExportID), zap.Error(err))
+				continue
+			}
+		}
+
+		if err := s.dataExportRepository.Delete(s.ctx, export); err != nil {
+			s.config.Logger.Error("Failed to delete data export", zap.String("exportID", export.ExportID), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/shinplay/ent"
+)
+
+// Rollback aborts the transaction and returns the error that caused it.
+func Rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+
+	return err
+}
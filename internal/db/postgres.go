@@ -27,6 +27,11 @@ func InitializeDatabase(config *config.Config) *ent.Client {
 		panic("Failed to connect to database: " + err.Error())
 	}
 
+	// the trigram indexes of the user search need pg_trgm
+	if _, err := client.ExecContext(context.Background(), "CREATE EXTENSION IF NOT EXISTS pg_trgm"); err != nil {
+		config.Logger.Fatal("failed creating pg_trgm extension", zap.Error(err))
+	}
+
 	if err := client.Schema.Create(context.Background()); err != nil {
 		config.Logger.Fatal("failed creating schema resources: %v", zap.Error(err))
 	}
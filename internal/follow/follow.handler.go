@@ -0,0 +1,181 @@
+package follow
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"github.com/shinplay/pkg/cursor"
+)
+
+type FollowHandlerIntr interface {
+	Follow(ctx *fiber.Ctx) error
+	Unfollow(ctx *fiber.Ctx) error
+	ListFollowers(ctx *fiber.Ctx) error
+	ListFollowing(ctx *fiber.Ctx) error
+	ListRequests(ctx *fiber.Ctx) error
+	AcceptRequest(ctx *fiber.Ctx) error
+	DeclineRequest(ctx *fiber.Ctx) error
+}
+
+type FollowHandler struct {
+	followService *FollowService
+	config        *config.Config
+}
+
+func NewFollowHandler(followService *FollowService, config *config.Config) *FollowHandler {
+	return &FollowHandler{
+		followService: followService,
+		config:        config,
+	}
+}
+
+type PageQuery struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+func (h *FollowHandler) Follow(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	f, err := h.followService.Follow(currentUser, ctx.Params("username"))
+	if err != nil {
+		return h.followError(ctx, err)
+	}
+
+	message := "Followed successfully"
+	if f.Status == follow.StatusPending {
+		message = "Follow request sent"
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": message,
+		"data": fiber.Map{
+			"status": f.Status,
+		},
+	})
+}
+
+func (h *FollowHandler) Unfollow(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	if err := h.followService.Unfollow(currentUser, ctx.Params("username")); err != nil {
+		return h.followError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Unfollowed successfully",
+	})
+}
+
+func (h *FollowHandler) ListFollowers(ctx *fiber.Ctx) error {
+	return h.page(ctx, func(viewer *ent.User, query *PageQuery) ([]user.PublicProfile, string, error) {
+		return h.followService.Followers(viewer, ctx.Params("username"), query.Cursor, query.Limit)
+	})
+}
+
+func (h *FollowHandler) ListFollowing(ctx *fiber.Ctx) error {
+	return h.page(ctx, func(viewer *ent.User, query *PageQuery) ([]user.PublicProfile, string, error) {
+		return h.followService.Following(viewer, ctx.Params("username"), query.Cursor, query.Limit)
+	})
+}
+
+func (h *FollowHandler) ListRequests(ctx *fiber.Ctx) error {
+	return h.page(ctx, func(viewer *ent.User, query *PageQuery) ([]user.PublicProfile, string, error) {
+		return h.followService.Requests(viewer, query.Cursor, query.Limit)
+	})
+}
+
+func (h *FollowHandler) AcceptRequest(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	if err := h.followService.AcceptRequest(currentUser, ctx.Params("username")); err != nil {
+		return h.followError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Follow request accepted",
+	})
+}
+
+func (h *FollowHandler) DeclineRequest(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	if err := h.followService.DeclineRequest(currentUser, ctx.Params("username")); err != nil {
+		return h.followError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Follow request declined",
+	})
+}
+
+// page serves a cursor-paginated list of profiles.
+func (h *FollowHandler) page(ctx *fiber.Ctx, list func(viewer *ent.User, query *PageQuery) ([]user.PublicProfile, string, error)) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	query := new(PageQuery)
+	if err := ctx.QueryParser(query); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid query",
+		})
+	}
+
+	profiles, next, err := list(currentUser, query)
+	if err != nil {
+		return h.followError(ctx, err)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": fiber.Map{
+			"users":       profiles,
+			"next_cursor": next,
+		},
+	})
+}
+
+func (h *FollowHandler) followError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, user.ErrProfileNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "User not found",
+		})
+	case errors.Is(err, ErrFollowSelf):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "cannot_follow_self",
+			"message": err.Error(),
+		})
+	case errors.Is(err, ErrConnectionsHidden):
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "private_account",
+			"message": err.Error(),
+		})
+	case errors.Is(err, ErrNoFollowRequest):
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": err.Error(),
+		})
+	case errors.Is(err, cursor.ErrInvalid):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid cursor",
+		})
+	default:
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Something went wrong, please try again later",
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package follow
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/user"
+	"github.com/shinplay/internal/db"
+	"github.com/shinplay/internal/relation"
+)
+
+type FollowRepositoryIntr interface {
+	Find(ctx context.Context, followerID int, followeeID int) (*ent.Follow, error)
+	Create(ctx context.Context, follower *ent.User, followee *ent.User, accepted bool) (*ent.Follow, error)
+	Accept(ctx context.Context, f *ent.Follow) error
+	Delete(ctx context.Context, f *ent.Follow) error
+	List(ctx context.Context, filter ListFilter) ([]*ent.Follow, error)
+}
+
+// Cursor is where a page of follows ended, lists run newest first.
+type Cursor struct {
+	CreateTime time.Time `json:"t"`
+	ID         int       `json:"i"`
+}
+
+// ListFilter selects the follows of one user in one direction.
+type ListFilter struct {
+	// FollowerID lists who the user follows, FolloweeID who follows the user.
+	FollowerID int
+	FolloweeID int
+	Status     follow.Status
+	// ViewerID leaves out the users the viewer blocked or was blocked by.
+	ViewerID int
+	After    *Cursor
+	// Limit of zero lists every follow.
+	Limit int
+}
+
+type FollowRepository struct {
+	client *ent.Client
+}
+
+func NewFollowRepository(client *ent.Client) *FollowRepository {
+	return &FollowRepository{client: client}
+}
+
+func (r *FollowRepository) Find(ctx context.Context, followerID int, followeeID int) (*ent.Follow, error) {
+	return r.client.Follow.Query().
+		Where(follow.FollowerID(followerID), follow.FolloweeID(followeeID)).
+		Only(ctx)
+}
+
+// Create follows followee, or asks to when not accepted. The unique index on
+// the pair rejects a second follow.
+func (r *FollowRepository) Create(ctx context.Context, follower *ent.User, followee *ent.User, accepted bool) (*ent.Follow, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.Follow.Create().
+		SetFollowerID(follower.ID).
+		SetFolloweeID(followee.ID).
+		SetStatus(follow.StatusPending)
+	if accepted {
+		create.SetStatus(follow.StatusAccepted).SetAcceptedAt(time.Now())
+	}
+
+	f, err := create.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	if accepted {
+		if err := addCounts(ctx, tx, f, 1); err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	return f, tx.Commit()
+}
+
+// Accept turns a follow request into a follow. Accepting twice is a no-op.
+func (r *FollowRepository) Accept(ctx context.Context, f *ent.Follow) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the status check keeps the counts right when two accepts race
+	n, err := tx.Follow.Update().
+		Where(follow.IDEQ(f.ID), follow.StatusEQ(follow.StatusPending)).
+		SetStatus(follow.StatusAccepted).
+		SetAcceptedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return db.Rollback(tx, err)
+	}
+
+	if n > 0 {
+		if err := addCounts(ctx, tx, f, 1); err != nil {
+			return db.Rollback(tx, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Delete unfollows, or withdraws or declines a follow request.
+func (r *FollowRepository) Delete(ctx context.Context, f *ent.Follow) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteFollow(ctx, tx, f); err != nil {
+		return db.Rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// List returns a page of follows along with the user on the other end.
+func (r *FollowRepository) List(ctx context.Context, filter ListFilter) ([]*ent.Follow, error) {
+	query := r.client.Follow.Query().Where(follow.StatusEQ(filter.Status))
+
+	if filter.FollowerID != 0 {
+		query = query.
+			Where(follow.FollowerID(filter.FollowerID), follow.HasFolloweeWith(relation.Unblocked(filter.ViewerID))).
+			WithFollowee()
+	} else {
+		query = query.
+			Where(follow.FolloweeID(filter.FolloweeID), follow.HasFollowerWith(relation.Unblocked(filter.ViewerID))).
+			WithFollower()
+	}
+
+	if filter.After != nil {
+		query = query.Where(follow.Or(
+			follow.CreateTimeLT(filter.After.CreateTime),
+			follow.And(follow.CreateTimeEQ(filter.After.CreateTime), follow.IDLT(filter.After.ID)),
+		))
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	return query.
+		Order(ent.Desc(follow.FieldCreateTime), ent.Desc(follow.FieldID)).
+		All(ctx)
+}
+
+// DeleteBetween removes the follows and follow requests between two users in
+// either direction within tx, as blocking does.
+func DeleteBetween(ctx context.Context, tx *ent.Tx, a int, b int) error {
+	follows, err := tx.Follow.Query().
+		Where(follow.Or(
+			follow.And(follow.FollowerID(a), follow.FolloweeID(b)),
+			follow.And(follow.FollowerID(b), follow.FolloweeID(a)),
+		)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range follows {
+		if err := deleteFollow(ctx, tx, f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteFollow removes f within tx, taking it off the counts if it was
+// accepted by then, whatever status f was read with.
+func deleteFollow(ctx context.Context, tx *ent.Tx, f *ent.Follow) error {
+	accepted, err := tx.Follow.Delete().
+		Where(follow.IDEQ(f.ID), follow.StatusEQ(follow.StatusAccepted)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if accepted > 0 {
+		return addCounts(ctx, tx, f, -1)
+	}
+
+	_, err = tx.Follow.Delete().Where(follow.IDEQ(f.ID)).Exec(ctx)
+	return err
+}
+
+// addCounts moves the follower and following counts of both ends of f.
+func addCounts(ctx context.Context, tx *ent.Tx, f *ent.Follow, delta int) error {
+	if err := tx.User.Update().Where(user.IDEQ(f.FollowerID)).AddFollowingCount(delta).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.User.Update().Where(user.IDEQ(f.FolloweeID)).AddFollowerCount(delta).Exec(ctx)
+}
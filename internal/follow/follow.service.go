@@ -0,0 +1,269 @@
+package follow
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"github.com/shinplay/pkg/cursor"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// Me stands for the signed-in user where a username is expected.
+	Me = "me"
+)
+
+var (
+	ErrFollowSelf        = errors.New("you cannot follow yourself")
+	ErrConnectionsHidden = errors.New("only followers can see who a private account follows and is followed by")
+	ErrNoFollowRequest   = errors.New("no pending follow request from this user")
+)
+
+type FollowServiceIntr interface {
+	Follow(viewer *ent.User, username string) (*ent.Follow, error)
+	Unfollow(viewer *ent.User, username string) error
+	Followers(viewer *ent.User, username string, after string, limit int) ([]user.PublicProfile, string, error)
+	Following(viewer *ent.User, username string, after string, limit int) ([]user.PublicProfile, string, error)
+	Requests(viewer *ent.User, after string, limit int) ([]user.PublicProfile, string, error)
+	AcceptRequest(viewer *ent.User, username string) error
+	DeclineRequest(viewer *ent.User, username string) error
+}
+
+// FollowService maintains the social graph. Following a private account
+// sends a request its owner accepts or declines, following anyone else
+// takes effect at once.
+type FollowService struct {
+	followRepository *FollowRepository
+	userService      *user.UserService
+	config           *config.Config
+	ctx              context.Context
+}
+
+func NewFollowService(followRepository *FollowRepository, userService *user.UserService, config *config.Config, ctx context.Context) *FollowService {
+	return &FollowService{
+		followRepository: followRepository,
+		userService:      userService,
+		config:           config,
+		ctx:              ctx,
+	}
+}
+
+// Follow follows the user, or asks to if the account is private. Following
+// someone again returns the existing follow.
+func (s *FollowService) Follow(viewer *ent.User, username string) (*ent.Follow, error) {
+	target, err := s.userService.FindVisibleByUsername(viewer, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.ID == viewer.ID {
+		return nil, ErrFollowSelf
+	}
+
+	existing, err := s.followRepository.Find(s.ctx, viewer.ID, target.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		s.config.Logger.Error("Failed to find follow", zap.Error(err))
+		return nil, err
+	}
+
+	if existing == nil {
+		created, err := s.followRepository.Create(s.ctx, viewer, target, !target.Private)
+		if !ent.IsConstraintError(err) {
+			if err != nil {
+				s.config.Logger.Error("Failed to follow user", zap.Error(err))
+			}
+			return created, err
+		}
+
+		// followed concurrently, carry on with the winner
+		if existing, err = s.followRepository.Find(s.ctx, viewer.ID, target.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// the account was made public since the request was sent
+	if existing.Status == follow.StatusPending && !target.Private {
+		if err := s.followRepository.Accept(s.ctx, existing); err != nil {
+			s.config.Logger.Error("Failed to accept follow request", zap.Error(err))
+			return nil, err
+		}
+		existing.Status = follow.StatusAccepted
+	}
+
+	return existing, nil
+}
+
+// Unfollow stops following the user, or withdraws the request to. It is a
+// no-op when the viewer does not follow them. Users who are no longer listed,
+// such as suspended accounts, can still be unfollowed.
+func (s *FollowService) Unfollow(viewer *ent.User, username string) error {
+	target, err := s.userService.FindByUsername(username)
+	if ent.IsNotFound(err) {
+		return user.ErrProfileNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.followRepository.Find(s.ctx, viewer.ID, target.ID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.followRepository.Delete(s.ctx, existing); err != nil {
+		s.config.Logger.Error("Failed to unfollow user", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Followers lists who follows the user, newest first.
+func (s *FollowService) Followers(viewer *ent.User, username string, after string, limit int) ([]user.PublicProfile, string, error) {
+	owner, err := s.connectionsOf(viewer, username)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.list(viewer, ListFilter{FolloweeID: owner.ID, Status: follow.StatusAccepted}, after, limit)
+}
+
+// Following lists who the user follows, newest first.
+func (s *FollowService) Following(viewer *ent.User, username string, after string, limit int) ([]user.PublicProfile, string, error) {
+	owner, err := s.connectionsOf(viewer, username)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.list(viewer, ListFilter{FollowerID: owner.ID, Status: follow.StatusAccepted}, after, limit)
+}
+
+// Requests lists who asked to follow the viewer, newest first.
+func (s *FollowService) Requests(viewer *ent.User, after string, limit int) ([]user.PublicProfile, string, error) {
+	return s.list(viewer, ListFilter{FolloweeID: viewer.ID, Status: follow.StatusPending}, after, limit)
+}
+
+func (s *FollowService) AcceptRequest(viewer *ent.User, username string) error {
+	request, err := s.pendingRequest(viewer, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.followRepository.Accept(s.ctx, request); err != nil {
+		s.config.Logger.Error("Failed to accept follow request", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *FollowService) DeclineRequest(viewer *ent.User, username string) error {
+	request, err := s.pendingRequest(viewer, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.followRepository.Delete(s.ctx, request); err != nil {
+		s.config.Logger.Error("Failed to decline follow request", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *FollowService) pendingRequest(viewer *ent.User, username string) (*ent.Follow, error) {
+	follower, err := s.userService.FindVisibleByUsername(viewer, username)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.followRepository.Find(s.ctx, follower.ID, viewer.ID)
+	if ent.IsNotFound(err) || (err == nil && request.Status != follow.StatusPending) {
+		return nil, ErrNoFollowRequest
+	}
+
+	return request, err
+}
+
+// connectionsOf finds the user whose followers or following the viewer asked
+// for, checking the viewer may see them.
+func (s *FollowService) connectionsOf(viewer *ent.User, username string) (*ent.User, error) {
+	if username == Me {
+		return viewer, nil
+	}
+
+	owner, err := s.userService.FindVisibleByUsername(viewer, username)
+	if err != nil {
+		return nil, err
+	}
+
+	relationship, err := s.userService.Relationship(viewer, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !relationship.CanSeeConnections(owner) {
+		return nil, ErrConnectionsHidden
+	}
+
+	return owner, nil
+}
+
+// list returns a page of the users on the other end of the filtered follows
+// and the cursor of the next page, empty on the last one.
+func (s *FollowService) list(viewer *ent.User, filter ListFilter, after string, limit int) ([]user.PublicProfile, string, error) {
+	if limit <= 0 || limit > maxPageSize {
+		limit = defaultPageSize
+	}
+	// one extra to know whether there is a next page
+	filter.Limit = limit + 1
+	filter.ViewerID = viewer.ID
+
+	if after != "" {
+		filter.After = new(Cursor)
+		if err := cursor.Decode(after, filter.After); err != nil {
+			return nil, "", err
+		}
+	}
+
+	follows, err := s.followRepository.List(s.ctx, filter)
+	if err != nil {
+		s.config.Logger.Error("Failed to list follows", zap.Error(err))
+		return nil, "", err
+	}
+
+	next := ""
+	if len(follows) > limit {
+		follows = follows[:limit]
+		last := follows[limit-1]
+		next = cursor.Encode(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+
+	users := make([]*ent.User, 0, len(follows))
+	for _, f := range follows {
+		other := f.Edges.Follower
+		if filter.FollowerID != 0 {
+			other = f.Edges.Followee
+		}
+		// restricted accounts keep their follows but are not shown
+		if other != nil && user.IsListed(other) {
+			users = append(users, other)
+		}
+	}
+
+	profiles, err := s.userService.PublicProfiles(viewer, users)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return profiles, next, nil
+}
@@ -2,15 +2,45 @@ package internal
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/internal/admin"
+	"github.com/shinplay/internal/audit"
 	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/auth/device"
+	"github.com/shinplay/internal/auth/emailchange"
+	"github.com/shinplay/internal/auth/guest"
+	"github.com/shinplay/internal/auth/pat"
+	"github.com/shinplay/internal/auth/phonechange"
+	"github.com/shinplay/internal/auth/qrlogin"
+	"github.com/shinplay/internal/block"
+	"github.com/shinplay/internal/dataexport"
+	"github.com/shinplay/internal/follow"
+	"github.com/shinplay/internal/impersonation"
+	"github.com/shinplay/internal/oauth"
+	"github.com/shinplay/internal/rbac"
+	"github.com/shinplay/internal/risk"
 	"github.com/shinplay/internal/user"
 	"go.uber.org/dig"
 )
 
 type Routes struct {
 	dig.In
-	AuthHandler *auth.AuthHandler
-	UserHandler *user.UserHandler
+	AuthHandler          *auth.AuthHandler
+	UserHandler          *user.UserHandler
+	RBACHandler          *rbac.RBACHandler
+	AdminHandler         *admin.AdminHandler
+	DataExportHandler    *dataexport.DataExportHandler
+	AuditHandler         *audit.AuditHandler
+	RiskHandler          *risk.RiskHandler
+	PATHandler           *pat.PATHandler
+	OAuthHandler         *oauth.OAuthHandler
+	DeviceHandler        *device.DeviceHandler
+	QRLoginHandler       *qrlogin.QRLoginHandler
+	ImpersonationHandler *impersonation.ImpersonationHandler
+	PhoneChangeHandler   *phonechange.PhoneChangeHandler
+	EmailChangeHandler   *emailchange.EmailChangeHandler
+	GuestHandler         *guest.GuestHandler
+	FollowHandler        *follow.FollowHandler
+	BlockHandler         *block.BlockHandler
 }
 
 func HealthCheck(ctx *fiber.Ctx) error {
@@ -0,0 +1,172 @@
+package impersonation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type ImpersonationHandlerIntr interface {
+	Start(ctx *fiber.Ctx) error
+	Stop(ctx *fiber.Ctx) error
+	Track(ctx *fiber.Ctx) error
+}
+
+type ImpersonationHandler struct {
+	impersonationService *ImpersonationService
+	auditService         *audit.AuditService
+	config               *config.Config
+}
+
+func NewImpersonationHandler(impersonationService *ImpersonationService, auditService *audit.AuditService, config *config.Config) *ImpersonationHandler {
+	return &ImpersonationHandler{
+		impersonationService: impersonationService,
+		auditService:         auditService,
+		config:               config,
+	}
+}
+
+type StartBody struct {
+	Reason          string `json:"reason" xml:"reason" form:"reason"`
+	DurationMinutes int    `json:"duration_minutes" xml:"duration_minutes" form:"duration_minutes"`
+}
+
+func (h *ImpersonationHandler) Start(ctx *fiber.Ctx) error {
+	staff := ctx.Locals("user").(*ent.User)
+
+	body := new(StartBody)
+	if err := ctx.BodyParser(body); err != nil || body.Reason == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a reason for impersonating this user",
+		})
+	}
+
+	duration := time.Duration(body.DurationMinutes) * time.Minute
+	token, imp, subject, err := h.impersonationService.Start(staff, ctx.Params("authId"), body.Reason, duration)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"status":  "error",
+				"message": "User not found",
+			})
+		case errors.Is(err, ErrSelfImpersonation), errors.Is(err, ErrStaffTarget), errors.Is(err, ErrInvalidDuration):
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"status":  "error",
+				"message": err.Error(),
+			})
+		}
+
+		h.config.Logger.Error("Failed to start impersonation", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to start impersonation, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventAdminImpersonationStarted,
+		Actor:   staff,
+		Subject: subject,
+		Metadata: map[string]any{
+			"impersonation_id": imp.ImpersonationID,
+			"reason":           imp.Reason,
+			"expires_at":       imp.ExpiresAt,
+		},
+	})
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"status":  "success",
+		"message": "Impersonation started",
+		"data": fiber.Map{
+			"access_token":     token,
+			"impersonation_id": imp.ImpersonationID,
+			"expires_at":       imp.ExpiresAt,
+		},
+	})
+}
+
+// Stop ends the impersonation the request is made under.
+func (h *ImpersonationHandler) Stop(ctx *fiber.Ctx) error {
+	imp, ok := ctx.Locals("impersonation").(*ent.Impersonation)
+	if !ok {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "You are not impersonating anyone",
+		})
+	}
+
+	if _, err := h.impersonationService.Stop(imp); err != nil {
+		h.config.Logger.Error("Failed to stop impersonation", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to stop impersonation, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventAdminImpersonationStopped,
+		Actor:    imp.Edges.Actor,
+		Subject:  imp.Edges.Subject,
+		Metadata: map[string]any{"impersonation_id": imp.ImpersonationID},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Impersonation stopped",
+	})
+}
+
+// Track runs after AuthenticateUser. For impersonation tokens it checks that
+// the impersonation is still active, marks the response and records the
+// request against the staff account.
+func (h *ImpersonationHandler) Track(ctx *fiber.Ctx) error {
+	actor, ok := ctx.Locals("actor").(auth.ActorClaims)
+	if !ok {
+		return ctx.Next()
+	}
+
+	// already tracked by an earlier route the request passed through
+	if ctx.Locals("impersonation") != nil {
+		return ctx.Next()
+	}
+
+	subject := ctx.Locals("user").(*ent.User)
+
+	imp, err := h.impersonationService.Resolve(actor, subject)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			h.config.Logger.Warn("Rejected impersonation token", zap.Error(err))
+		}
+		return ctx.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Impersonation has ended",
+		})
+	}
+
+	ctx.Locals("impersonation", imp)
+	ctx.Set(HeaderImpersonatedBy, imp.Edges.Actor.AuthID)
+
+	err = ctx.Next()
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventAdminImpersonationRequest,
+		Actor:   imp.Edges.Actor,
+		Subject: subject,
+		Metadata: map[string]any{
+			"impersonation_id": imp.ImpersonationID,
+			"method":           ctx.Method(),
+			"path":             ctx.Path(),
+			"status":           ctx.Response().StatusCode(),
+		},
+	})
+
+	return err
+}
@@ -0,0 +1,24 @@
+package impersonation
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+)
+
+// HeaderImpersonatedBy is set on every response served to an impersonation
+// token so clients can show that staff are acting as the user.
+const HeaderImpersonatedBy = "X-Impersonated-By"
+
+// Block rejects sensitive operations, such as deleting the account or
+// changing how the user signs in, while staff are impersonating the user.
+func Block(ctx *fiber.Ctx) error {
+	if _, impersonating := ctx.Locals("impersonation").(*ent.Impersonation); impersonating {
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "impersonation_forbidden",
+			"message": "This action is not allowed while impersonating a user",
+		})
+	}
+
+	return ctx.Next()
+}
@@ -0,0 +1,52 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/impersonation"
+)
+
+type ImpersonationRepositoryIntr interface {
+	Create(ctx context.Context, actor *ent.User, subject *ent.User, reason string, expiresAt time.Time) (*ent.Impersonation, error)
+	FindActive(ctx context.Context, impersonationID string) (*ent.Impersonation, error)
+	End(ctx context.Context, impersonation *ent.Impersonation) (*ent.Impersonation, error)
+}
+
+type ImpersonationRepository struct {
+	client *ent.Client
+}
+
+func NewImpersonationRepository(client *ent.Client) *ImpersonationRepository {
+	return &ImpersonationRepository{client: client}
+}
+
+func (r *ImpersonationRepository) Create(ctx context.Context, actor, subject *ent.User, reason string, expiresAt time.Time) (*ent.Impersonation, error) {
+	return r.client.Impersonation.Create().
+		SetActor(actor).
+		SetSubject(subject).
+		SetReason(reason).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// FindActive returns an impersonation that has neither ended nor expired, with
+// both users loaded.
+func (r *ImpersonationRepository) FindActive(ctx context.Context, impersonationID string) (*ent.Impersonation, error) {
+	return r.client.Impersonation.Query().
+		Where(
+			impersonation.ImpersonationIDEQ(impersonationID),
+			impersonation.EndedAtIsNil(),
+			impersonation.ExpiresAtGT(time.Now()),
+		).
+		WithActor().
+		WithSubject().
+		Only(ctx)
+}
+
+func (r *ImpersonationRepository) End(ctx context.Context, imp *ent.Impersonation) (*ent.Impersonation, error) {
+	return imp.Update().
+		SetEndedAt(time.Now()).
+		Save(ctx)
+}
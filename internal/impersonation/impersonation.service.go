@@ -0,0 +1,145 @@
+package impersonation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/rbac"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultDuration = 15 * time.Minute
+	MaxDuration     = 1 * time.Hour
+)
+
+var (
+	ErrSelfImpersonation = errors.New("staff cannot impersonate themselves")
+	ErrStaffTarget       = errors.New("staff accounts cannot be impersonated")
+	ErrInvalidDuration   = errors.New("invalid impersonation duration")
+	ErrActorMismatch     = errors.New("impersonation token does not match the impersonation")
+	ErrActorNotPermitted = errors.New("staff member may no longer impersonate users")
+)
+
+type ImpersonationServiceIntr interface {
+	Start(actor *ent.User, subjectAuthID string, reason string, duration time.Duration) (string, *ent.Impersonation, *ent.User, error)
+	Resolve(claims auth.ActorClaims, subject *ent.User) (*ent.Impersonation, error)
+	Stop(impersonation *ent.Impersonation) (*ent.Impersonation, error)
+}
+
+// ImpersonationService lets support staff act as a user for a short time,
+// with every step recorded against the staff account.
+type ImpersonationService struct {
+	impersonationRepository *ImpersonationRepository
+	userService             *user.UserService
+	rbacService             *rbac.RBACService
+	authService             *auth.AuthService
+	config                  *config.Config
+	ctx                     context.Context
+}
+
+func NewImpersonationService(
+	impersonationRepository *ImpersonationRepository,
+	userService *user.UserService,
+	rbacService *rbac.RBACService,
+	authService *auth.AuthService,
+	config *config.Config,
+	ctx context.Context,
+) *ImpersonationService {
+	return &ImpersonationService{
+		impersonationRepository: impersonationRepository,
+		userService:             userService,
+		rbacService:             rbacService,
+		authService:             authService,
+		config:                  config,
+		ctx:                     ctx,
+	}
+}
+
+// Start begins impersonating the user and returns an access token carrying
+// the staff member in its "act" claim. No session is created, so the token
+// cannot be refreshed past the impersonation.
+func (s *ImpersonationService) Start(actor *ent.User, subjectAuthID string, reason string, duration time.Duration) (string, *ent.Impersonation, *ent.User, error) {
+	if duration == 0 {
+		duration = DefaultDuration
+	}
+	if duration < 0 || duration > MaxDuration {
+		return "", nil, nil, ErrInvalidDuration
+	}
+
+	subject, err := s.userService.FindUserByAuthID(subjectAuthID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if subject.ID == actor.ID {
+		return "", nil, subject, ErrSelfImpersonation
+	}
+
+	// acting as another staff member would let support escalate privileges
+	roles, _, err := s.rbacService.PermissionsForUser(subject)
+	if err != nil {
+		return "", nil, subject, err
+	}
+	if len(roles) > 0 {
+		return "", nil, subject, ErrStaffTarget
+	}
+
+	imp, err := s.impersonationRepository.Create(s.ctx, actor, subject, reason, time.Now().Add(duration))
+	if err != nil {
+		s.config.Logger.Error("Failed to start impersonation", zap.Error(err))
+		return "", nil, subject, err
+	}
+
+	tokens, err := s.authService.GenerateAuthTokensWithClaims(subject, jwt.MapClaims{
+		"act": map[string]any{"sub": actor.AuthID},
+		"imp": imp.ImpersonationID,
+		"exp": imp.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		s.config.Logger.Error("Failed to generate impersonation token", zap.Error(err))
+		return "", nil, subject, err
+	}
+
+	return tokens.AccessToken, imp, subject, nil
+}
+
+// Resolve checks that the impersonation behind a token is still active and
+// belongs to the staff member and user named in it. The staff member is
+// loaded afresh on every request and must still be allowed to impersonate.
+func (s *ImpersonationService) Resolve(claims auth.ActorClaims, subject *ent.User) (*ent.Impersonation, error) {
+	imp, err := s.impersonationRepository.FindActive(s.ctx, claims.ImpersonationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if imp.Edges.Actor.AuthID != claims.ActorAuthID || imp.Edges.Subject.ID != subject.ID {
+		return nil, ErrActorMismatch
+	}
+
+	// staff who lose their own access or the permission lose the
+	// impersonation with it
+	if err := s.authService.CheckRestriction(imp.Edges.Actor); err != nil {
+		return nil, err
+	}
+
+	_, permissions, err := s.rbacService.PermissionsForUser(imp.Edges.Actor)
+	if err != nil {
+		return nil, err
+	}
+	if !permissions.Has(rbac.PermissionImpersonate) {
+		return nil, ErrActorNotPermitted
+	}
+
+	return imp, nil
+}
+
+func (s *ImpersonationService) Stop(imp *ent.Impersonation) (*ent.Impersonation, error) {
+	return s.impersonationRepository.End(s.ctx, imp)
+}
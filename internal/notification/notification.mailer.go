@@ -0,0 +1,104 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+var ErrInvalidMail = errors.New("invalid mail")
+
+// Mail is a plain text email.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers email, the implementation is picked by MAIL_PROVIDER.
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
+
+// NewMailer returns the mailer configured for this environment.
+func NewMailer(config *config.Config) Mailer {
+	switch config.Mail.Provider {
+	case "smtp":
+		return &SMTPMailer{config: config}
+	default:
+		if config.IsProduction() {
+			config.Logger.Warn("Mail provider is not configured, emails will only be logged")
+		}
+		return &LogMailer{config: config}
+	}
+}
+
+// LogMailer writes emails to the log instead of sending them, for local
+// development.
+type LogMailer struct {
+	config *config.Config
+}
+
+func (m *LogMailer) Send(ctx context.Context, mail Mail) error {
+	m.config.Logger.Info("Sending email",
+		zap.String("to", mail.To),
+		zap.String("subject", mail.Subject),
+		zap.String("body", mail.Body),
+	)
+
+	return nil
+}
+
+// SMTPMailer sends emails through an SMTP relay.
+type SMTPMailer struct {
+	config *config.Config
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, mail Mail) error {
+	// header values must not be able to smuggle in extra headers
+	if strings.ContainsAny(mail.To+mail.Subject, "\r\n") {
+		return ErrInvalidMail
+	}
+
+	mailConfig := m.config.Mail
+
+	var auth smtp.Auth
+	if mailConfig.SMTPUser != "" {
+		auth = smtp.PlainAuth("", mailConfig.SMTPUser, mailConfig.SMTPPassword, mailConfig.SMTPHost)
+	}
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", mailConfig.From)
+	fmt.Fprintf(&message, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mail.Subject)
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	message.WriteString("\r\n")
+	message.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+
+	addr := net.JoinHostPort(mailConfig.SMTPHost, mailConfig.SMTPPort)
+	if err := smtp.SendMail(addr, auth, envelopeAddress(mailConfig.From), []string{mail.To}, []byte(message.String())); err != nil {
+		m.config.Logger.Error("Error sending email", zap.Error(err))
+		return fmt.Errorf("error sending email: %w", err)
+	}
+
+	return nil
+}
+
+// envelopeAddress strips the display name from an address like
+// "ShinPlay <no-reply@shinplay.app>".
+func envelopeAddress(from string) string {
+	if start := strings.LastIndex(from, "<"); start >= 0 {
+		return strings.TrimSuffix(from[start+1:], ">")
+	}
+
+	return from
+}
@@ -0,0 +1,94 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type NotificationServiceIntr interface {
+	NotifyUser(user *ent.User, message string) error
+	SendWhatsAppText(phoneNumber string, message string) error
+	SendEmail(to string, subject string, body string) error
+}
+
+// NotificationService delivers account notifications to users through the
+// channels they have registered.
+type NotificationService struct {
+	mailer Mailer
+	config *config.Config
+	ctx    context.Context
+}
+
+// NewNotificationService creates a new NotificationService instance.
+func NewNotificationService(mailer Mailer, config *config.Config, ctx context.Context) *NotificationService {
+	return &NotificationService{
+		mailer: mailer,
+		config: config,
+		ctx:    ctx,
+	}
+}
+
+// NotifyUser sends the message to the user's phone number over WhatsApp.
+func (s *NotificationService) NotifyUser(user *ent.User, message string) error {
+	if user.PhoneNumber == "" {
+		s.config.Logger.Warn("User has no channel to notify", zap.String("authID", user.AuthID))
+		return fmt.Errorf("user %s has no notification channel", user.AuthID)
+	}
+
+	return s.SendWhatsAppText(user.PhoneNumber, message)
+}
+
+// SendWhatsAppText sends a plain text WhatsApp message.
+func (s *NotificationService) SendWhatsAppText(phoneNumber string, message string) error {
+	url := "https://graph.facebook.com/v22.0/" + s.config.WhatsApp.PhoneId + "/messages"
+
+	payload := map[string]any{
+		"messaging_product": "whatsapp",
+		"to":                phoneNumber,
+		"type":              "text",
+		"text": map[string]any{
+			"preview_url": true,
+			"body":        message,
+		},
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		s.config.Logger.Error("Error marshalling payload", zap.Error(err))
+		return fmt.Errorf("error marshalling payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(s.ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		s.config.Logger.Error("Error creating request", zap.Error(err))
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.config.WhatsApp.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		s.config.Logger.Error("Error sending request", zap.Error(err))
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		s.config.Logger.Error("Received error status from WhatsApp API", zap.String("status", resp.Status))
+		return fmt.Errorf("received error status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// SendEmail sends a plain text email through the configured mailer.
+func (s *NotificationService) SendEmail(to string, subject string, body string) error {
+	return s.mailer.Send(s.ctx, Mail{To: to, Subject: subject, Body: body})
+}
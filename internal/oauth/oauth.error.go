@@ -0,0 +1,39 @@
+package oauth
+
+import "fmt"
+
+// Error codes defined by RFC 6749.
+const (
+	ErrorInvalidRequest       = "invalid_request"
+	ErrorInvalidClient        = "invalid_client"
+	ErrorInvalidGrant         = "invalid_grant"
+	ErrorUnauthorizedClient   = "unauthorized_client"
+	ErrorUnsupportedGrantType = "unsupported_grant_type"
+	ErrorUnsupportedResponse  = "unsupported_response_type"
+	ErrorInvalidScope         = "invalid_scope"
+	ErrorAccessDenied         = "access_denied"
+	ErrorServerError          = "server_error"
+)
+
+// OAuthError is an error that is reported to the client in the format the
+// OAuth 2.0 spec requires rather than our usual response shape.
+type OAuthError struct {
+	Code        string
+	Description string
+
+	// Redirectable errors are sent back to the client's redirect URI. Errors
+	// about the client or the redirect URI itself must not be.
+	Redirectable bool
+}
+
+func (e *OAuthError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+func newError(code string, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description}
+}
+
+func newRedirectableError(code string, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description, Redirectable: true}
+}
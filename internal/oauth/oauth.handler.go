@@ -0,0 +1,382 @@
+package oauth
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type OAuthHandlerIntr interface {
+	GetAuthorization(ctx *fiber.Ctx) error
+	Authorize(ctx *fiber.Ctx) error
+	Token(ctx *fiber.Ctx) error
+	UserInfo(ctx *fiber.Ctx) error
+	ListConsents(ctx *fiber.Ctx) error
+	RevokeConsent(ctx *fiber.Ctx) error
+	RegisterClient(ctx *fiber.Ctx) error
+	ListClients(ctx *fiber.Ctx) error
+}
+
+type OAuthHandler struct {
+	oauthService *OAuthService
+	auditService *audit.AuditService
+	config       *config.Config
+}
+
+func NewOAuthHandler(oauthService *OAuthService, auditService *audit.AuditService, config *config.Config) *OAuthHandler {
+	return &OAuthHandler{
+		oauthService: oauthService,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+// ClientDetail is a client as shown on the consent screen and to staff.
+type ClientDetail struct {
+	ClientID     string    `json:"client_id"`
+	Name         string    `json:"name"`
+	HomepageURL  string    `json:"homepage_url,omitempty"`
+	RedirectURIs []string  `json:"redirect_uris,omitempty"`
+	Scopes       []string  `json:"scopes,omitempty"`
+	Confidential bool      `json:"confidential"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func newClientDetail(client *ent.OAuthClient) ClientDetail {
+	return ClientDetail{
+		ClientID:     client.ClientID,
+		Name:         client.Name,
+		HomepageURL:  client.HomepageURL,
+		RedirectURIs: client.RedirectUris,
+		Scopes:       client.Scopes,
+		Confidential: client.ClientSecretHash != "",
+		CreatedAt:    client.CreateTime,
+	}
+}
+
+// ConsentDetail is a consent as listed to the user who gave it.
+type ConsentDetail struct {
+	Client    ClientDetail      `json:"client"`
+	Scopes    []ScopeDefinition `json:"scopes"`
+	GrantedAt time.Time         `json:"granted_at"`
+}
+
+// GetAuthorization validates an authorization request and returns what the
+// consent screen needs to show.
+func (h *OAuthHandler) GetAuthorization(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	request := new(AuthorizationRequest)
+	if err := ctx.QueryParser(request); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid authorization request",
+		})
+	}
+
+	authorization, err := h.oauthService.PrepareAuthorization(currentUser, *request)
+	if err != nil {
+		return h.authorizationError(ctx, *request, err)
+	}
+
+	client := newClientDetail(authorization.Client)
+	client.RedirectURIs, client.Scopes = nil, nil
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": fiber.Map{
+			"client":          client,
+			"scopes":          DescribeScopes(authorization.Scopes),
+			"consent_granted": authorization.ConsentGranted,
+		},
+	})
+}
+
+type AuthorizeBody struct {
+	AuthorizationRequest
+	Approve bool `json:"approve"`
+}
+
+// Authorize records the user's decision on the consent screen and returns
+// where the browser should be sent next.
+func (h *OAuthHandler) Authorize(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	body := new(AuthorizeBody)
+	if err := ctx.BodyParser(body); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid authorization request",
+		})
+	}
+
+	if !body.Approve {
+		redirectTo, err := h.oauthService.Deny(currentUser, body.AuthorizationRequest)
+		if err != nil {
+			return h.authorizationError(ctx, body.AuthorizationRequest, err)
+		}
+
+		return ctx.JSON(fiber.Map{
+			"status": "success",
+			"data":   fiber.Map{"redirect_to": redirectTo},
+		})
+	}
+
+	authorization, redirectTo, err := h.oauthService.Approve(currentUser, body.AuthorizationRequest)
+	if err != nil {
+		return h.authorizationError(ctx, body.AuthorizationRequest, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventOAuthAuthorized,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"client_id": authorization.Client.ClientID, "scopes": authorization.Scopes},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   fiber.Map{"redirect_to": redirectTo},
+	})
+}
+
+// authorizationError reports a failed authorization request. Errors that may
+// be shared with the client come with a redirect URI carrying them.
+func (h *OAuthHandler) authorizationError(ctx *fiber.Ctx, request AuthorizationRequest, err error) error {
+	var oauthErr *OAuthError
+	if !errors.As(err, &oauthErr) {
+		h.config.Logger.Error("Failed to process authorization request", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to process authorization request, please try again later",
+		})
+	}
+
+	response := fiber.Map{
+		"status":  "error",
+		"code":    oauthErr.Code,
+		"message": oauthErr.Description,
+	}
+	if oauthErr.Redirectable {
+		response["data"] = fiber.Map{"redirect_to": ErrorRedirect(request, oauthErr)}
+	}
+
+	return ctx.Status(fiber.StatusBadRequest).JSON(response)
+}
+
+// Token is the OAuth 2.0 token endpoint. Its responses follow RFC 6749 so
+// that standard client libraries can talk to it.
+func (h *OAuthHandler) Token(ctx *fiber.Ctx) error {
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+
+	request := new(TokenRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return tokenError(ctx, newError(ErrorInvalidRequest, "The request body could not be parsed"))
+	}
+
+	if clientID, secret, ok := basicAuth(ctx.Get(fiber.HeaderAuthorization)); ok {
+		request.ClientID, request.ClientSecret = clientID, secret
+	}
+
+	response, user, err := h.oauthService.Exchange(*request, ctx.IP(), ctx.Get("User-Agent"))
+	if err != nil {
+		var oauthErr *OAuthError
+		if !errors.As(err, &oauthErr) {
+			h.config.Logger.Error("Failed to issue OAuth tokens", zap.Error(err))
+			oauthErr = newError(ErrorServerError, "Failed to issue tokens, please try again later")
+		}
+
+		return tokenError(ctx, oauthErr)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventOAuthTokenIssued,
+		Actor:    user,
+		Subject:  user,
+		Metadata: map[string]any{"client_id": request.ClientID, "grant_type": request.GrantType, "scope": response.Scope},
+	})
+
+	return ctx.JSON(response)
+}
+
+func tokenError(ctx *fiber.Ctx, err *OAuthError) error {
+	status := fiber.StatusBadRequest
+	switch err.Code {
+	case ErrorInvalidClient:
+		status = fiber.StatusUnauthorized
+	case ErrorServerError:
+		status = fiber.StatusInternalServerError
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":             err.Code,
+		"error_description": err.Description,
+	})
+}
+
+// basicAuth reads client credentials sent with HTTP Basic authentication.
+func basicAuth(header string) (string, string, bool) {
+	encoded, ok := strings.CutPrefix(header, "Basic ")
+	if !ok {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+
+	clientID, secret, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", false
+	}
+
+	// credentials are form-encoded before being put in the header
+	clientID, errID := url.QueryUnescape(clientID)
+	secret, errSecret := url.QueryUnescape(secret)
+
+	return clientID, secret, errID == nil && errSecret == nil
+}
+
+// UserInfo returns the signed-in user's claims allowed by the token's scopes.
+func (h *OAuthHandler) UserInfo(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+	scopes, _ := ctx.Locals("scopes").([]string)
+
+	return ctx.JSON(UserInfo(currentUser, scopes))
+}
+
+func (h *OAuthHandler) ListConsents(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	consents, err := h.oauthService.ListConsents(currentUser)
+	if err != nil {
+		h.config.Logger.Error("Failed to list OAuth consents", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to list connected apps, please try again later",
+		})
+	}
+
+	results := make([]ConsentDetail, 0, len(consents))
+	for _, consent := range consents {
+		client := newClientDetail(consent.Edges.Client)
+		client.RedirectURIs, client.Scopes = nil, nil
+
+		results = append(results, ConsentDetail{
+			Client:    client,
+			Scopes:    DescribeScopes(consent.Scopes),
+			GrantedAt: consent.UpdateTime,
+		})
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   results,
+	})
+}
+
+func (h *OAuthHandler) RevokeConsent(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+	clientID := ctx.Params("clientId")
+
+	if _, err := h.oauthService.RevokeConsent(currentUser, clientID); err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Connected app not found",
+			})
+		}
+
+		h.config.Logger.Error("Failed to revoke OAuth consent", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to disconnect app, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventOAuthConsentRevoked,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"client_id": clientID},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "App disconnected successfully",
+	})
+}
+
+func (h *OAuthHandler) RegisterClient(ctx *fiber.Ctx) error {
+	staff := ctx.Locals("user").(*ent.User)
+
+	registration := new(ClientRegistration)
+	if err := ctx.BodyParser(registration); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body",
+		})
+	}
+
+	client, secret, err := h.oauthService.RegisterClient(*registration)
+	if err != nil {
+		if errors.Is(err, ErrInvalidClient) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Please provide a name, absolute redirect URIs and known scopes",
+			})
+		}
+
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to register client, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventAdminOAuthClientRegistered,
+		Actor:    staff,
+		Metadata: map[string]any{"client_id": client.ClientID, "name": client.Name, "scopes": client.Scopes},
+	})
+
+	data := fiber.Map{"client": newClientDetail(client)}
+	if secret != "" {
+		data["client_secret"] = secret
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"status":  "success",
+		"message": "Client registered, copy the secret now as it will not be shown again",
+		"data":    data,
+	})
+}
+
+func (h *OAuthHandler) ListClients(ctx *fiber.Ctx) error {
+	clients, err := h.oauthService.ListClients()
+	if err != nil {
+		h.config.Logger.Error("Failed to list OAuth clients", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to list clients, please try again later",
+		})
+	}
+
+	results := make([]ClientDetail, 0, len(clients))
+	for _, client := range clients {
+		results = append(results, newClientDetail(client))
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   results,
+	})
+}
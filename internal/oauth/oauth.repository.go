@@ -0,0 +1,153 @@
+package oauth
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthclient"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/user"
+)
+
+type OAuthRepositoryIntr interface {
+	CreateClient(ctx context.Context, client ClientRegistration, secretHash string) (*ent.OAuthClient, error)
+	FindClients(ctx context.Context) ([]*ent.OAuthClient, error)
+	FindClientByClientID(ctx context.Context, clientID string) (*ent.OAuthClient, error)
+	FindConsent(ctx context.Context, userID int, clientID int) (*ent.OAuthConsent, error)
+	SaveConsent(ctx context.Context, user *ent.User, client *ent.OAuthClient, scopes []string) (*ent.OAuthConsent, error)
+	FindConsentsByUser(ctx context.Context, userID int) ([]*ent.OAuthConsent, error)
+	DeleteConsent(ctx context.Context, consent *ent.OAuthConsent) error
+	CreateCode(ctx context.Context, user *ent.User, client *ent.OAuthClient, codeHash string, redirectURI string, scopes []string, codeChallenge string, expiresAt time.Time) (*ent.OAuthAuthorizationCode, error)
+	RedeemCode(ctx context.Context, codeHash string) (*ent.OAuthAuthorizationCode, error)
+	DeleteExpiredCodes(ctx context.Context, now time.Time) (int, error)
+}
+
+type OAuthRepository struct {
+	client *ent.Client
+}
+
+func NewOAuthRepository(client *ent.Client) *OAuthRepository {
+	return &OAuthRepository{client: client}
+}
+
+func (r *OAuthRepository) CreateClient(ctx context.Context, client ClientRegistration, secretHash string) (*ent.OAuthClient, error) {
+	create := r.client.OAuthClient.Create().
+		SetName(client.Name).
+		SetRedirectUris(client.RedirectURIs).
+		SetScopes(client.Scopes)
+
+	if client.HomepageURL != "" {
+		create.SetHomepageURL(client.HomepageURL)
+	}
+	if secretHash != "" {
+		create.SetClientSecretHash(secretHash)
+	}
+
+	return create.Save(ctx)
+}
+
+func (r *OAuthRepository) FindClients(ctx context.Context) ([]*ent.OAuthClient, error) {
+	return r.client.OAuthClient.Query().
+		Order(ent.Desc(oauthclient.FieldCreateTime)).
+		All(ctx)
+}
+
+// FindClientByClientID finds a client that has not been disabled.
+func (r *OAuthRepository) FindClientByClientID(ctx context.Context, clientID string) (*ent.OAuthClient, error) {
+	return r.client.OAuthClient.Query().
+		Where(
+			oauthclient.ClientIDEQ(clientID),
+			oauthclient.DisabledAtIsNil(),
+		).
+		Only(ctx)
+}
+
+func (r *OAuthRepository) FindConsent(ctx context.Context, userID int, clientID int) (*ent.OAuthConsent, error) {
+	return r.client.OAuthConsent.Query().
+		Where(
+			oauthconsent.HasUserWith(user.IDEQ(userID)),
+			oauthconsent.HasClientWith(oauthclient.IDEQ(clientID)),
+		).
+		WithClient().
+		Only(ctx)
+}
+
+// SaveConsent records the scopes the user granted, replacing earlier consent
+// for the same client.
+func (r *OAuthRepository) SaveConsent(ctx context.Context, user *ent.User, client *ent.OAuthClient, scopes []string) (*ent.OAuthConsent, error) {
+	consent, err := r.FindConsent(ctx, user.ID, client.ID)
+	if ent.IsNotFound(err) {
+		return r.client.OAuthConsent.Create().
+			SetUser(user).
+			SetClient(client).
+			SetScopes(scopes).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return consent.Update().
+		SetScopes(scopes).
+		Save(ctx)
+}
+
+func (r *OAuthRepository) FindConsentsByUser(ctx context.Context, userID int) ([]*ent.OAuthConsent, error) {
+	return r.client.OAuthConsent.Query().
+		Where(oauthconsent.HasUserWith(user.IDEQ(userID))).
+		WithClient().
+		Order(ent.Desc(oauthconsent.FieldUpdateTime)).
+		All(ctx)
+}
+
+func (r *OAuthRepository) DeleteConsent(ctx context.Context, consent *ent.OAuthConsent) error {
+	return r.client.OAuthConsent.DeleteOne(consent).Exec(ctx)
+}
+
+func (r *OAuthRepository) CreateCode(ctx context.Context, user *ent.User, client *ent.OAuthClient, codeHash, redirectURI string, scopes []string, codeChallenge string, expiresAt time.Time) (*ent.OAuthAuthorizationCode, error) {
+	return r.client.OAuthAuthorizationCode.Create().
+		SetUser(user).
+		SetClient(client).
+		SetCodeHash(codeHash).
+		SetRedirectURI(redirectURI).
+		SetScopes(scopes).
+		SetCodeChallenge(codeChallenge).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// RedeemCode marks an unexpired code as used and returns it with its user and
+// client. Marking and checking happen in one statement so a code can only be
+// redeemed once, even by concurrent requests.
+func (r *OAuthRepository) RedeemCode(ctx context.Context, codeHash string) (*ent.OAuthAuthorizationCode, error) {
+	now := time.Now()
+
+	updated, err := r.client.OAuthAuthorizationCode.Update().
+		Where(
+			oauthauthorizationcode.CodeHashEQ(codeHash),
+			oauthauthorizationcode.UsedAtIsNil(),
+			oauthauthorizationcode.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrCodeNotRedeemable
+	}
+
+	return r.client.OAuthAuthorizationCode.Query().
+		Where(oauthauthorizationcode.CodeHashEQ(codeHash)).
+		WithUser().
+		WithClient().
+		Only(ctx)
+}
+
+func (r *OAuthRepository) DeleteExpiredCodes(ctx context.Context, now time.Time) (int, error) {
+	return r.client.OAuthAuthorizationCode.Delete().
+		Where(oauthauthorizationcode.ExpiresAtLT(now)).
+		Exec(ctx)
+}
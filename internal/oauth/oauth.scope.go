@@ -0,0 +1,70 @@
+package oauth
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/shinplay/internal/rbac"
+)
+
+// Scopes only third-party apps ask for. Profile scopes are shared with
+// personal access tokens so the same routes accept both.
+const (
+	ScopePhone = "phone"
+	ScopeEmail = "email"
+)
+
+// ScopeDefinition is a scope as shown on the consent screen.
+type ScopeDefinition struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Scopes lists every scope a client can be registered for, in the order they
+// are shown to users. Managing the account itself is never delegated.
+var Scopes = []ScopeDefinition{
+	{Name: rbac.ScopeProfileRead, Description: "View your Shinplay profile"},
+	{Name: rbac.ScopeProfileWrite, Description: "Update your Shinplay profile"},
+	{Name: ScopePhone, Description: "See your phone number"},
+	{Name: ScopeEmail, Description: "See your email address"},
+}
+
+// IsKnownScope reports whether scope is defined in Scopes.
+func IsKnownScope(scope string) bool {
+	return slices.ContainsFunc(Scopes, func(d ScopeDefinition) bool { return d.Name == scope })
+}
+
+// DescribeScopes returns the definitions of scopes, in catalog order.
+func DescribeScopes(scopes []string) []ScopeDefinition {
+	described := []ScopeDefinition{}
+	for _, definition := range Scopes {
+		if slices.Contains(scopes, definition.Name) {
+			described = append(described, definition)
+		}
+	}
+
+	return described
+}
+
+// ParseScope splits a space separated scope parameter, dropping duplicates.
+func ParseScope(scope string) []string {
+	scopes := []string{}
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+
+	return scopes
+}
+
+// containsAll reports whether every scope in subset is in set.
+func containsAll(set []string, subset []string) bool {
+	for _, scope := range subset {
+		if !slices.Contains(set, scope) {
+			return false
+		}
+	}
+
+	return true
+}
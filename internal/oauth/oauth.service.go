@@ -0,0 +1,534 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/auth"
+	"github.com/shinplay/internal/auth/session"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/rbac"
+	"github.com/shinplay/pkg/publicid"
+	"go.uber.org/zap"
+)
+
+const (
+	codeTTL         = 10 * time.Minute
+	sessionTTL      = 30 * 24 * time.Hour
+	cleanupInterval = 1 * time.Hour
+
+	codeChallengeMethodS256 = "S256"
+)
+
+var (
+	ErrCodeNotRedeemable = errors.New("authorization code is invalid, expired or already used")
+	ErrInvalidClient     = errors.New("invalid client registration")
+)
+
+type OAuthServiceIntr interface {
+	RegisterClient(registration ClientRegistration) (*ent.OAuthClient, string, error)
+	ListClients() ([]*ent.OAuthClient, error)
+	PrepareAuthorization(user *ent.User, request AuthorizationRequest) (*Authorization, error)
+	Approve(user *ent.User, request AuthorizationRequest) (*Authorization, string, error)
+	Deny(user *ent.User, request AuthorizationRequest) (string, error)
+	Exchange(request TokenRequest, ipAddress string, userAgent string) (*TokenResponse, *ent.User, error)
+	ListConsents(user *ent.User) ([]*ent.OAuthConsent, error)
+	RevokeConsent(user *ent.User, clientID string) (*ent.OAuthConsent, error)
+	Run()
+}
+
+// OAuthService lets third-party apps sign users in with their Shinplay
+// account through the authorization code flow with PKCE.
+type OAuthService struct {
+	oauthRepository   *OAuthRepository
+	sessionRepository *session.SessionRepository
+	authService       *auth.AuthService
+	config            *config.Config
+	ctx               context.Context
+}
+
+func NewOAuthService(oauthRepository *OAuthRepository, sessionRepository *session.SessionRepository, authService *auth.AuthService, config *config.Config, ctx context.Context) *OAuthService {
+	return &OAuthService{
+		oauthRepository:   oauthRepository,
+		sessionRepository: sessionRepository,
+		authService:       authService,
+		config:            config,
+		ctx:               ctx,
+	}
+}
+
+// ClientRegistration describes a client being registered by staff.
+type ClientRegistration struct {
+	Name         string   `json:"name"`
+	HomepageURL  string   `json:"homepage_url"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+
+	// Confidential clients can keep a secret, e.g. a partner's backend.
+	// Mobile and single page apps are public and rely on PKCE alone.
+	Confidential bool `json:"confidential"`
+}
+
+// AuthorizationRequest holds the parameters of a request to /oauth/authorize.
+type AuthorizationRequest struct {
+	ResponseType        string `json:"response_type" query:"response_type"`
+	ClientID            string `json:"client_id" query:"client_id"`
+	RedirectURI         string `json:"redirect_uri" query:"redirect_uri"`
+	Scope               string `json:"scope" query:"scope"`
+	State               string `json:"state" query:"state"`
+	CodeChallenge       string `json:"code_challenge" query:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method" query:"code_challenge_method"`
+}
+
+// Authorization is a validated authorization request, used to render the
+// consent screen.
+type Authorization struct {
+	Client         *ent.OAuthClient
+	Scopes         []string
+	ConsentGranted bool
+}
+
+// TokenRequest holds the parameters of a request to /oauth/token.
+type TokenRequest struct {
+	GrantType    string `form:"grant_type"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+// TokenResponse is the successful response of /oauth/token.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+// RegisterClient creates a client. The secret of confidential clients is only
+// returned here, the database keeps its hash.
+func (s *OAuthService) RegisterClient(registration ClientRegistration) (*ent.OAuthClient, string, error) {
+	if strings.TrimSpace(registration.Name) == "" || len(registration.RedirectURIs) == 0 || len(registration.Scopes) == 0 {
+		return nil, "", ErrInvalidClient
+	}
+
+	for _, redirectURI := range registration.RedirectURIs {
+		parsed, err := url.Parse(redirectURI)
+		if err != nil || parsed.Scheme == "" || parsed.Fragment != "" {
+			return nil, "", ErrInvalidClient
+		}
+		// plain http is only acceptable for apps running on the user's machine
+		if parsed.Scheme == "http" && parsed.Hostname() != "localhost" && parsed.Hostname() != "127.0.0.1" {
+			return nil, "", ErrInvalidClient
+		}
+	}
+
+	for _, scope := range registration.Scopes {
+		if !IsKnownScope(scope) {
+			return nil, "", ErrInvalidClient
+		}
+	}
+
+	secret, secretHash := "", ""
+	if registration.Confidential {
+		secret = publicid.MustWith(48, publicid.AlphaNumeric())
+		secretHash = hashSecret(secret)
+	}
+
+	client, err := s.oauthRepository.CreateClient(s.ctx, registration, secretHash)
+	if err != nil {
+		s.config.Logger.Error("Failed to register OAuth client", zap.Error(err))
+		return nil, "", err
+	}
+
+	return client, secret, nil
+}
+
+func (s *OAuthService) ListClients() ([]*ent.OAuthClient, error) {
+	return s.oauthRepository.FindClients(s.ctx)
+}
+
+// PrepareAuthorization validates an authorization request on behalf of the
+// signed-in user and reports whether they already consented to it.
+func (s *OAuthService) PrepareAuthorization(user *ent.User, request AuthorizationRequest) (*Authorization, error) {
+	client, err := s.oauthRepository.FindClientByClientID(s.ctx, request.ClientID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, newError(ErrorInvalidClient, "Unknown client")
+		}
+		return nil, err
+	}
+
+	if !slices.Contains(client.RedirectUris, request.RedirectURI) {
+		return nil, newError(ErrorInvalidRequest, "The redirect_uri is not registered for this client")
+	}
+
+	if request.ResponseType != "code" {
+		return nil, newRedirectableError(ErrorUnsupportedResponse, "Only the code response type is supported")
+	}
+
+	if request.CodeChallenge == "" || request.CodeChallengeMethod != codeChallengeMethodS256 {
+		return nil, newRedirectableError(ErrorInvalidRequest, "PKCE with the S256 method is required")
+	}
+
+	scopes := ParseScope(request.Scope)
+	if len(scopes) == 0 || !containsAll(client.Scopes, scopes) {
+		return nil, newRedirectableError(ErrorInvalidScope, "The requested scope is invalid or not allowed for this client")
+	}
+
+	authorization := &Authorization{Client: client, Scopes: scopes}
+
+	consent, err := s.oauthRepository.FindConsent(s.ctx, user.ID, client.ID)
+	if err == nil {
+		authorization.ConsentGranted = containsAll(consent.Scopes, scopes)
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return authorization, nil
+}
+
+// Approve records the user's consent and returns the URI to send the user
+// back to, carrying a fresh authorization code.
+func (s *OAuthService) Approve(user *ent.User, request AuthorizationRequest) (*Authorization, string, error) {
+	authorization, err := s.PrepareAuthorization(user, request)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := s.oauthRepository.SaveConsent(s.ctx, user, authorization.Client, authorization.Scopes); err != nil {
+		s.config.Logger.Error("Failed to save OAuth consent", zap.Error(err))
+		return nil, "", err
+	}
+
+	code := publicid.MustWith(43, publicid.AlphaNumeric())
+	_, err = s.oauthRepository.CreateCode(
+		s.ctx,
+		user,
+		authorization.Client,
+		hashSecret(code),
+		request.RedirectURI,
+		authorization.Scopes,
+		request.CodeChallenge,
+		time.Now().Add(codeTTL),
+	)
+	if err != nil {
+		s.config.Logger.Error("Failed to create OAuth authorization code", zap.Error(err))
+		return nil, "", err
+	}
+
+	return authorization, redirectWith(request.RedirectURI, url.Values{"code": {code}, "state": {request.State}}), nil
+}
+
+// Deny returns the URI telling the client that the user declined.
+func (s *OAuthService) Deny(user *ent.User, request AuthorizationRequest) (string, error) {
+	if _, err := s.PrepareAuthorization(user, request); err != nil {
+		return "", err
+	}
+
+	return ErrorRedirect(request, newRedirectableError(ErrorAccessDenied, "The user denied the request")), nil
+}
+
+// ErrorRedirect returns the URI reporting err to the client.
+func ErrorRedirect(request AuthorizationRequest, err *OAuthError) string {
+	return redirectWith(request.RedirectURI, url.Values{
+		"error":             {err.Code},
+		"error_description": {err.Description},
+		"state":             {request.State},
+	})
+}
+
+// Exchange handles the token endpoint for both supported grant types.
+func (s *OAuthService) Exchange(request TokenRequest, ipAddress string, userAgent string) (*TokenResponse, *ent.User, error) {
+	client, err := s.authenticateClient(request.ClientID, request.ClientSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch request.GrantType {
+	case "authorization_code":
+		return s.exchangeCode(client, request, ipAddress, userAgent)
+	case "refresh_token":
+		return s.refresh(client, request)
+	default:
+		return nil, nil, newError(ErrorUnsupportedGrantType, "Only authorization_code and refresh_token grants are supported")
+	}
+}
+
+func (s *OAuthService) exchangeCode(client *ent.OAuthClient, request TokenRequest, ipAddress string, userAgent string) (*TokenResponse, *ent.User, error) {
+	code, err := s.oauthRepository.RedeemCode(s.ctx, hashSecret(request.Code))
+	if err != nil {
+		if errors.Is(err, ErrCodeNotRedeemable) || ent.IsNotFound(err) {
+			return nil, nil, newError(ErrorInvalidGrant, "The authorization code is invalid, expired or already used")
+		}
+		return nil, nil, err
+	}
+
+	if code.Edges.Client.ID != client.ID || code.RedirectURI != request.RedirectURI {
+		return nil, nil, newError(ErrorInvalidGrant, "The authorization code was not issued to this client or redirect_uri")
+	}
+
+	if !verifyCodeChallenge(code.CodeChallenge, request.CodeVerifier) {
+		return nil, nil, newError(ErrorInvalidGrant, "The code_verifier does not match the code_challenge")
+	}
+
+	user := code.Edges.User
+	if err := s.authService.CheckRestriction(user); err != nil {
+		return nil, user, newError(ErrorAccessDenied, "The account is not allowed to sign in")
+	}
+
+	sessionID := publicid.MustWith(30, publicid.AlphaNumeric())
+	tokens, err := s.mint(user, client, sessionID, code.Scopes)
+	if err != nil {
+		return nil, user, err
+	}
+
+	_, err = s.sessionRepository.CreateClientSession(
+		s.ctx,
+		user,
+		client,
+		sessionID,
+		code.Scopes,
+		tokens.RefreshToken,
+		time.Now().Add(sessionTTL),
+		userAgent,
+		ipAddress,
+	)
+	if err != nil {
+		s.config.Logger.Error("Failed to create OAuth session", zap.Error(err))
+		return nil, user, err
+	}
+
+	return newTokenResponse(tokens, code.Scopes), user, nil
+}
+
+func (s *OAuthService) refresh(client *ent.OAuthClient, request TokenRequest) (*TokenResponse, *ent.User, error) {
+	invalid := newError(ErrorInvalidGrant, "The refresh token is invalid, expired or revoked")
+
+	isValid, _, claims := s.authService.ValidateTokenClaims(request.RefreshToken)
+	if !isValid {
+		return nil, nil, invalid
+	}
+
+	// refresh tokens issued before the typ claim carry none, access tokens
+	// are never accepted here
+	if typ, _ := claims["typ"].(string); typ == auth.TokenTypeAccess {
+		return nil, nil, invalid
+	}
+
+	sessionID, _ := claims["sid"].(string)
+	clientID, _ := claims["client_id"].(string)
+	if sessionID == "" || clientID != client.ClientID {
+		return nil, nil, invalid
+	}
+
+	clientSession, err := s.sessionRepository.FindClientSession(s.ctx, sessionID, client.ID)
+	if err != nil {
+		return nil, nil, invalid
+	}
+
+	user := clientSession.Edges.User
+
+	// refresh tokens rotate, seeing an old one again means it leaked
+	if subtle.ConstantTimeCompare([]byte(clientSession.RefreshToken), []byte(request.RefreshToken)) != 1 {
+		s.config.Logger.Warn("OAuth refresh token reused, revoking session", zap.String("client_id", client.ClientID))
+		if _, err := s.sessionRepository.DeleteSession(s.ctx, sessionID); err != nil {
+			s.config.Logger.Error("Failed to revoke OAuth session", zap.Error(err))
+		}
+		return nil, user, invalid
+	}
+
+	if err := s.authService.CheckRestriction(user); err != nil {
+		return nil, user, newError(ErrorAccessDenied, "The account is not allowed to sign in")
+	}
+
+	scopes := clientSession.Scopes
+	if requested := ParseScope(request.Scope); len(requested) > 0 {
+		if !containsAll(scopes, requested) {
+			return nil, user, newError(ErrorInvalidScope, "The requested scope exceeds the one originally granted")
+		}
+		scopes = requested
+	}
+
+	tokens, err := s.mint(user, client, sessionID, scopes)
+	if err != nil {
+		return nil, user, err
+	}
+
+	_, err = clientSession.Update().
+		SetRefreshToken(tokens.RefreshToken).
+		SetScopes(scopes).
+		Save(s.ctx)
+	if err != nil {
+		s.config.Logger.Error("Failed to update OAuth session", zap.Error(err))
+		return nil, user, err
+	}
+
+	return newTokenResponse(tokens, scopes), user, nil
+}
+
+// mint issues tokens through the same machinery as first-party sessions,
+// limited to the granted scopes and bound to the client's session.
+func (s *OAuthService) mint(user *ent.User, client *ent.OAuthClient, sessionID string, scopes []string) (auth.Token, error) {
+	tokens, err := s.authService.GenerateAuthTokensWithClaims(user, jwt.MapClaims{
+		"scope":     strings.Join(scopes, " "),
+		"client_id": client.ClientID,
+		"sid":       sessionID,
+	})
+	if err != nil {
+		s.config.Logger.Error("Failed to generate OAuth tokens", zap.Error(err))
+	}
+
+	return tokens, err
+}
+
+func (s *OAuthService) authenticateClient(clientID string, secret string) (*ent.OAuthClient, error) {
+	invalid := newError(ErrorInvalidClient, "Client authentication failed")
+
+	client, err := s.oauthRepository.FindClientByClientID(s.ctx, clientID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	if client.ClientSecretHash == "" {
+		// public clients must not present a secret
+		if secret != "" {
+			return nil, invalid
+		}
+		return client, nil
+	}
+
+	if subtle.ConstantTimeCompare([]byte(client.ClientSecretHash), []byte(hashSecret(secret))) != 1 {
+		return nil, invalid
+	}
+
+	return client, nil
+}
+
+func (s *OAuthService) ListConsents(user *ent.User) ([]*ent.OAuthConsent, error) {
+	return s.oauthRepository.FindConsentsByUser(s.ctx, user.ID)
+}
+
+// RevokeConsent removes the consent given to a client and signs the client
+// out of the user's account.
+func (s *OAuthService) RevokeConsent(user *ent.User, clientID string) (*ent.OAuthConsent, error) {
+	client, err := s.oauthRepository.FindClientByClientID(s.ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	consent, err := s.oauthRepository.FindConsent(s.ctx, user.ID, client.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.oauthRepository.DeleteConsent(s.ctx, consent); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.sessionRepository.DeleteClientSessions(s.ctx, user.ID, client.ID); err != nil {
+		s.config.Logger.Error("Failed to revoke OAuth sessions", zap.Error(err))
+		return nil, err
+	}
+
+	return consent, nil
+}
+
+// Run deletes expired authorization codes until the context is cancelled.
+func (s *OAuthService) Run() {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.oauthRepository.DeleteExpiredCodes(s.ctx, time.Now())
+			if err != nil {
+				s.config.Logger.Error("Failed to delete expired OAuth codes", zap.Error(err))
+				continue
+			}
+			if deleted > 0 {
+				s.config.Logger.Info("Deleted expired OAuth codes", zap.Int("count", deleted))
+			}
+		}
+	}
+}
+
+// UserInfo returns the claims about user that the scopes allow a client to see.
+func UserInfo(user *ent.User, scopes []string) map[string]any {
+	info := map[string]any{"sub": user.AuthID}
+
+	if slices.Contains(scopes, rbac.ScopeProfileRead) {
+		info["username"] = user.Username
+		info["first_name"] = user.FirstName
+		info["last_name"] = user.LastName
+	}
+	if slices.Contains(scopes, ScopePhone) {
+		info["phone_number"] = user.PhoneNumber
+	}
+	if slices.Contains(scopes, ScopeEmail) {
+		info["email"] = user.Email
+		info["email_verified"] = user.EmailVerifiedAt != nil
+	}
+
+	return info
+}
+
+func newTokenResponse(tokens auth.Token, scopes []string) *TokenResponse {
+	return &TokenResponse{
+		AccessToken:  tokens.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(auth.AccessTokenTTL.Seconds()),
+		RefreshToken: tokens.RefreshToken,
+		Scope:        strings.Join(scopes, " "),
+	}
+}
+
+// verifyCodeChallenge checks a PKCE verifier against its S256 challenge.
+func verifyCodeChallenge(challenge string, verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+func redirectWith(redirectURI string, params url.Values) string {
+	if params.Get("state") == "" {
+		params.Del("state")
+	}
+
+	separator := "?"
+	if strings.Contains(redirectURI, "?") {
+		separator = "&"
+	}
+
+	return redirectURI + separator + params.Encode()
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,93 @@
+package rbac
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type RBACHandlerIntr interface {
+	AssignRole(ctx *fiber.Ctx) error
+	RevokeRole(ctx *fiber.Ctx) error
+}
+
+type RBACHandler struct {
+	rbacService  *RBACService
+	auditService *audit.AuditService
+	config       *config.Config
+}
+
+func NewRBACHandler(rbacService *RBACService, auditService *audit.AuditService, config *config.Config) *RBACHandler {
+	return &RBACHandler{
+		rbacService:  rbacService,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+type RoleBody struct {
+	Role string `json:"role" xml:"role" form:"role"`
+}
+
+func (h *RBACHandler) AssignRole(ctx *fiber.Ctx) error {
+	body := new(RoleBody)
+	if err := ctx.BodyParser(body); err != nil || body.Role == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide a valid role",
+		})
+	}
+
+	user, err := h.rbacService.AssignRole(ctx.Params("authId"), body.Role)
+	if err != nil {
+		return h.roleError(ctx, err)
+	}
+
+	h.recordRoleChange(ctx, audit.EventAdminRoleAssigned, user, body.Role)
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Role assigned successfully",
+	})
+}
+
+func (h *RBACHandler) RevokeRole(ctx *fiber.Ctx) error {
+	user, err := h.rbacService.RevokeRole(ctx.Params("authId"), ctx.Params("role"))
+	if err != nil {
+		return h.roleError(ctx, err)
+	}
+
+	h.recordRoleChange(ctx, audit.EventAdminRoleRevoked, user, ctx.Params("role"))
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Role revoked successfully",
+	})
+}
+
+func (h *RBACHandler) recordRoleChange(ctx *fiber.Ctx, eventType string, subject *ent.User, role string) {
+	actor, _ := ctx.Locals("user").(*ent.User)
+	h.auditService.Record(ctx, audit.Event{
+		Type:     eventType,
+		Actor:    actor,
+		Subject:  subject,
+		Metadata: map[string]any{"role": role},
+	})
+}
+
+func (h *RBACHandler) roleError(ctx *fiber.Ctx, err error) error {
+	if ent.IsNotFound(err) {
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "User or role not found",
+		})
+	}
+
+	h.config.Logger.Error("Failed to update user roles", zap.Error(err))
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"status":  "error",
+		"message": "Failed to update user roles, please try again later",
+	})
+}
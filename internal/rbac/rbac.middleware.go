@@ -0,0 +1,56 @@
+package rbac
+
+import (
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequirePermission only lets the request through when the authenticated user
+// holds the permission. It must run after AuthHandler.AuthenticateUser.
+func RequirePermission(permission string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		permissions, _ := ctx.Locals("permissions").(Permissions)
+		if !permissions.Has(permission) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"status":  "error",
+				"message": "You do not have permission to perform this action",
+			})
+		}
+
+		return ctx.Next()
+	}
+}
+
+// RequireRole only lets the request through when the authenticated user has
+// been assigned any of the roles. It must run after AuthHandler.AuthenticateUser.
+func RequireRole(allowed ...string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		roles, _ := ctx.Locals("roles").([]string)
+		if !slices.ContainsFunc(allowed, func(role string) bool { return slices.Contains(roles, role) }) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"status":  "error",
+				"message": "You do not have permission to perform this action",
+			})
+		}
+
+		return ctx.Next()
+	}
+}
+
+// RequireScope rejects scoped tokens that were not granted the scope. Requests
+// authenticated with a regular session token are not scoped and pass through.
+func RequireScope(scope string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		scopes, scoped := ctx.Locals("scopes").([]string)
+		if scoped && !slices.Contains(scopes, scope) {
+			return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"status":  "error",
+				"code":    "insufficient_scope",
+				"message": "This token is not allowed to perform this action",
+			})
+		}
+
+		return ctx.Next()
+	}
+}
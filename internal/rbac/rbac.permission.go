@@ -0,0 +1,90 @@
+package rbac
+
+import "strings"
+
+// Permission strings are written as "<resource>:<action>". A trailing "*"
+// grants every action on a resource and a bare "*" grants everything.
+const (
+	PermissionAll            = "*"
+	PermissionUsersRead      = "users:read"
+	PermissionUsersWrite     = "users:write"
+	PermissionUsersBan       = "users:ban"
+	PermissionSessionsRevoke = "sessions:revoke"
+	PermissionRolesManage    = "roles:manage"
+	PermissionAuditRead      = "audit:read"
+	PermissionRiskRead       = "risk:read"
+	PermissionRiskManage     = "risk:manage"
+	PermissionOAuthManage    = "oauth:manage"
+	PermissionImpersonate    = "users:impersonate"
+)
+
+// Scopes restrict what a token such as a personal access token may do on the
+// self-service routes. Session tokens are never scoped.
+const (
+	ScopeProfileRead   = "profile:read"
+	ScopeProfileWrite  = "profile:write"
+	ScopeAccountManage = "account:manage"
+	ScopeSocialRead    = "social:read"
+	ScopeSocialWrite   = "social:write"
+)
+
+// SelfServiceScopes can be granted to tokens by any user.
+var SelfServiceScopes = []string{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeAccountManage,
+	ScopeSocialRead,
+	ScopeSocialWrite,
+}
+
+// GuestScopes limit what a guest account may do until it is upgraded.
+var GuestScopes = []string{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+}
+
+const (
+	RoleAdmin   = "admin"
+	RoleSupport = "support"
+)
+
+// DefaultRoles are created on startup so that permissions can be granted
+// without touching the database by hand.
+var DefaultRoles = map[string][]string{
+	RoleAdmin: {PermissionAll},
+	RoleSupport: {
+		PermissionUsersRead,
+		PermissionSessionsRevoke,
+		PermissionAuditRead,
+		PermissionRiskRead,
+	},
+}
+
+type Permissions []string
+
+// Has reports whether the permission is granted, honouring wildcards.
+func (p Permissions) Has(permission string) bool {
+	for _, granted := range p {
+		if granted == PermissionAll || granted == permission {
+			return true
+		}
+
+		if strings.HasSuffix(granted, ":*") && strings.HasPrefix(permission, strings.TrimSuffix(granted, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Restrict narrows the permissions down to the ones also allowed by scopes.
+func (p Permissions) Restrict(scopes []string) Permissions {
+	restricted := Permissions{}
+	for _, scope := range scopes {
+		if p.Has(scope) {
+			restricted = append(restricted, scope)
+		}
+	}
+
+	return restricted
+}
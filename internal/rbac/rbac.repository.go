@@ -0,0 +1,67 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/role"
+	"github.com/shinplay/ent/user"
+)
+
+type RBACRepositoryIntr interface {
+	FindRoleByName(ctx context.Context, name string) (*ent.Role, error)
+	EnsureRole(ctx context.Context, name string, permissions []string) (*ent.Role, error)
+	FindRolesByUser(ctx context.Context, user *ent.User) ([]*ent.Role, error)
+	AssignRole(ctx context.Context, user *ent.User, role *ent.Role) error
+	RevokeRole(ctx context.Context, user *ent.User, role *ent.Role) error
+	CountUsersWithRole(ctx context.Context, name string) (int, error)
+}
+
+type RBACRepository struct {
+	client *ent.Client
+}
+
+func NewRBACRepository(client *ent.Client) *RBACRepository {
+	return &RBACRepository{client: client}
+}
+
+func (r *RBACRepository) FindRoleByName(ctx context.Context, name string) (*ent.Role, error) {
+	return r.client.Role.Query().Where(role.NameEQ(name)).Only(ctx)
+}
+
+// EnsureRole creates the role if it is missing, otherwise it resets its permissions.
+func (r *RBACRepository) EnsureRole(ctx context.Context, name string, permissions []string) (*ent.Role, error) {
+	existing, err := r.FindRoleByName(ctx, name)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+
+		return r.client.Role.Create().
+			SetName(name).
+			SetPermissions(permissions).
+			Save(ctx)
+	}
+
+	return existing.Update().
+		SetPermissions(permissions).
+		Save(ctx)
+}
+
+func (r *RBACRepository) FindRolesByUser(ctx context.Context, user *ent.User) ([]*ent.Role, error) {
+	return r.client.User.QueryRoles(user).All(ctx)
+}
+
+func (r *RBACRepository) AssignRole(ctx context.Context, user *ent.User, role *ent.Role) error {
+	return r.client.User.UpdateOne(user).AddRoles(role).Exec(ctx)
+}
+
+func (r *RBACRepository) RevokeRole(ctx context.Context, user *ent.User, role *ent.Role) error {
+	return r.client.User.UpdateOne(user).RemoveRoles(role).Exec(ctx)
+}
+
+func (r *RBACRepository) CountUsersWithRole(ctx context.Context, name string) (int, error) {
+	return r.client.User.Query().
+		Where(user.HasRolesWith(role.NameEQ(name))).
+		Count(ctx)
+}
@@ -0,0 +1,137 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/user"
+	"go.uber.org/zap"
+)
+
+type RBACServiceIntr interface {
+	PermissionsForUser(user *ent.User) ([]string, Permissions, error)
+	AssignRole(authID string, roleName string) (*ent.User, error)
+	RevokeRole(authID string, roleName string) (*ent.User, error)
+	Bootstrap() error
+}
+
+// RBACService resolves the roles and permissions granted to users.
+type RBACService struct {
+	rbacRepository *RBACRepository
+	userService    *user.UserService
+	config         *config.Config
+	ctx            context.Context
+}
+
+// NewRBACService creates a new RBACService instance.
+func NewRBACService(rbacRepository *RBACRepository, userService *user.UserService, config *config.Config, ctx context.Context) *RBACService {
+	return &RBACService{
+		rbacRepository: rbacRepository,
+		userService:    userService,
+		config:         config,
+		ctx:            ctx,
+	}
+}
+
+// PermissionsForUser returns the role names assigned to the user along with
+// the union of their permissions.
+func (s *RBACService) PermissionsForUser(user *ent.User) ([]string, Permissions, error) {
+	roles, err := s.rbacRepository.FindRolesByUser(s.ctx, user)
+	if err != nil {
+		s.config.Logger.Error("Failed to load roles for user", zap.String("authID", user.AuthID), zap.Error(err))
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(roles))
+	permissions := Permissions{}
+	for _, role := range roles {
+		names = append(names, role.Name)
+		permissions = append(permissions, role.Permissions...)
+	}
+
+	return names, permissions, nil
+}
+
+func (s *RBACService) AssignRole(authID string, roleName string) (*ent.User, error) {
+	user, role, err := s.findUserAndRole(authID, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbacRepository.AssignRole(s.ctx, user, role); err != nil {
+		s.config.Logger.Error("Failed to assign role", zap.String("authID", authID), zap.String("role", roleName), zap.Error(err))
+		return nil, err
+	}
+
+	s.config.Logger.Info("Role assigned", zap.String("authID", authID), zap.String("role", roleName))
+	return user, nil
+}
+
+func (s *RBACService) RevokeRole(authID string, roleName string) (*ent.User, error) {
+	user, role, err := s.findUserAndRole(authID, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rbacRepository.RevokeRole(s.ctx, user, role); err != nil {
+		s.config.Logger.Error("Failed to revoke role", zap.String("authID", authID), zap.String("role", roleName), zap.Error(err))
+		return nil, err
+	}
+
+	s.config.Logger.Info("Role revoked", zap.String("authID", authID), zap.String("role", roleName))
+	return user, nil
+}
+
+func (s *RBACService) findUserAndRole(authID string, roleName string) (*ent.User, *ent.Role, error) {
+	user, err := s.userService.FindUserByAuthID(authID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role, err := s.rbacRepository.FindRoleByName(s.ctx, roleName)
+	if err != nil {
+		s.config.Logger.Info("Failed to find role", zap.String("role", roleName), zap.Error(err))
+		return nil, nil, err
+	}
+
+	return user, role, nil
+}
+
+// Bootstrap makes sure the default roles exist and grants the admin role to
+// the configured phone number while nobody holds it yet.
+func (s *RBACService) Bootstrap() error {
+	for name, permissions := range DefaultRoles {
+		if _, err := s.rbacRepository.EnsureRole(s.ctx, name, permissions); err != nil {
+			s.config.Logger.Error("Failed to ensure default role", zap.String("role", name), zap.Error(err))
+			return fmt.Errorf("failed to ensure role %s: %w", name, err)
+		}
+	}
+
+	phoneNumber := s.config.Admin.BootstrapPhoneNumber
+	if phoneNumber == "" {
+		return nil
+	}
+
+	admins, err := s.rbacRepository.CountUsersWithRole(s.ctx, RoleAdmin)
+	if err != nil {
+		return fmt.Errorf("failed to count admins: %w", err)
+	}
+
+	if admins > 0 {
+		return nil
+	}
+
+	user, err := s.userService.FindOrCreateByPhone(phoneNumber)
+	if err != nil {
+		return fmt.Errorf("failed to find or create bootstrap admin: %w", err)
+	}
+
+	if _, err := s.AssignRole(user.AuthID, RoleAdmin); err != nil {
+		return fmt.Errorf("failed to grant bootstrap admin: %w", err)
+	}
+
+	s.config.Logger.Info("Bootstrap admin granted", zap.String("authID", user.AuthID))
+	return nil
+}
@@ -0,0 +1,24 @@
+// Package relation holds the query predicates that keep users who blocked
+// each other apart. Every user-facing query that finds other users applies
+// them, so a block is enforced the same way everywhere.
+package relation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// Unblocked matches the users the viewer may see: neither has blocked the
+// other. It matches every user for an anonymous viewer, whose ID is zero.
+func Unblocked(viewerID int) predicate.User {
+	if viewerID == 0 {
+		return func(*sql.Selector) {}
+	}
+
+	return user.And(
+		user.Not(user.HasBlockedByWith(block.BlockerID(viewerID))),
+		user.Not(user.HasBlocksWith(block.BlockedID(viewerID))),
+	)
+}
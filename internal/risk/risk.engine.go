@@ -0,0 +1,379 @@
+package risk
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+const sweepInterval = 1 * time.Minute
+
+// Action is the escalation level decided for a request.
+type Action string
+
+const (
+	ActionAllow     Action = "allow"
+	ActionDelay     Action = "delay"
+	ActionChallenge Action = "challenge"
+	ActionBlock     Action = "block"
+)
+
+// severity orders actions so the strictest one wins.
+var severity = map[Action]int{
+	ActionAllow:     0,
+	ActionDelay:     1,
+	ActionChallenge: 2,
+	ActionBlock:     3,
+}
+
+// Dimension is what failures are counted against.
+type Dimension string
+
+const (
+	DimensionPhone   Dimension = "phone"
+	DimensionIP      Dimension = "ip"
+	DimensionIPRange Dimension = "range"
+)
+
+// Policy describes how failures counted over Window escalate for a dimension.
+type Policy struct {
+	Window         time.Duration
+	DelayAfter     int
+	ChallengeAfter int
+	BlockAfter     int
+	BlockFor       time.Duration
+	MaxDelay       time.Duration
+}
+
+// DefaultPolicies are tuned so a person mistyping an OTP is never blocked
+// while a script cycling through codes or numbers quickly is.
+var DefaultPolicies = map[Dimension]Policy{
+	DimensionPhone:   {Window: 15 * time.Minute, DelayAfter: 3, ChallengeAfter: 5, BlockAfter: 10, BlockFor: 30 * time.Minute, MaxDelay: 5 * time.Second},
+	DimensionIP:      {Window: 15 * time.Minute, DelayAfter: 5, ChallengeAfter: 10, BlockAfter: 20, BlockFor: 1 * time.Hour, MaxDelay: 5 * time.Second},
+	DimensionIPRange: {Window: 15 * time.Minute, DelayAfter: 20, ChallengeAfter: 40, BlockAfter: 100, BlockFor: 1 * time.Hour, MaxDelay: 5 * time.Second},
+}
+
+// VolumePolicies decide when the number of OTPs sent, rather than failures,
+// asks for a challenge. Only Window and ChallengeAfter are used.
+var VolumePolicies = map[Dimension]Policy{
+	DimensionPhone:   {Window: 1 * time.Hour, ChallengeAfter: 3},
+	DimensionIP:      {Window: 1 * time.Hour, ChallengeAfter: 5},
+	DimensionIPRange: {Window: 1 * time.Hour, ChallengeAfter: 20},
+}
+
+// Decision is the outcome of evaluating a request.
+type Decision struct {
+	Action       Action        `json:"action"`
+	Delay        time.Duration `json:"delay,omitempty"`
+	BlockedUntil time.Time     `json:"blocked_until,omitzero"`
+	Key          string        `json:"key,omitempty"`
+}
+
+// Block is an active temporary block.
+type Block struct {
+	Key       string    `json:"key"`
+	Failures  int       `json:"failures"`
+	BlockedAt time.Time `json:"blocked_at"`
+	Until     time.Time `json:"until"`
+}
+
+// KeyState is the current view of a single tracked key.
+type KeyState struct {
+	Key      string   `json:"key"`
+	Failures int      `json:"failures"`
+	Sends    int      `json:"otp_sends"`
+	Decision Decision `json:"decision"`
+}
+
+type RiskEngineIntr interface {
+	Evaluate(phoneNumber string, ip string) Decision
+	EvaluateOTPSend(phoneNumber string, ip string) Decision
+	RecordFailure(phoneNumber string, ip string)
+	RecordOTPSend(phoneNumber string, ip string)
+	RecordSuccess(phoneNumber string, ip string)
+	State(phoneNumber string, ip string) []KeyState
+	Blocks() []Block
+	Unblock(key string) bool
+	Run()
+}
+
+// RiskEngine tracks authentication failures per phone number, per IP and per
+// IP range over sliding windows and escalates from delays to challenges to
+// temporary blocks. OTP send volume is tracked the same way and only ever
+// escalates to a challenge. State is kept in memory and is per instance.
+type RiskEngine struct {
+	mu             sync.Mutex
+	failures       map[string][]time.Time
+	sends          map[string][]time.Time
+	blocks         map[string]Block
+	policies       map[Dimension]Policy
+	volumePolicies map[Dimension]Policy
+	config         *config.Config
+	ctx            context.Context
+}
+
+// NewRiskEngine creates a new RiskEngine instance.
+func NewRiskEngine(config *config.Config, ctx context.Context) *RiskEngine {
+	return &RiskEngine{
+		failures:       map[string][]time.Time{},
+		sends:          map[string][]time.Time{},
+		blocks:         map[string]Block{},
+		policies:       DefaultPolicies,
+		volumePolicies: VolumePolicies,
+		config:         config,
+		ctx:            ctx,
+	}
+}
+
+// Evaluate returns the strictest decision across every key the request maps to.
+func (e *RiskEngine) Evaluate(phoneNumber string, ip string) Decision {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	decision := Decision{Action: ActionAllow}
+
+	for dimension, key := range keysFor(phoneNumber, ip) {
+		decision = stricter(decision, e.decide(dimension, key, now))
+	}
+
+	return decision
+}
+
+// EvaluateOTPSend is Evaluate plus the OTP send volume signals.
+func (e *RiskEngine) EvaluateOTPSend(phoneNumber string, ip string) Decision {
+	decision := e.Evaluate(phoneNumber, ip)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	for dimension, key := range keysFor(phoneNumber, ip) {
+		policy := e.volumePolicies[dimension]
+		if count(e.sends, key, policy.Window, now) >= policy.ChallengeAfter {
+			decision = stricter(decision, Decision{Action: ActionChallenge, Key: key})
+		}
+	}
+
+	return decision
+}
+
+func stricter(current Decision, candidate Decision) Decision {
+	if severity[candidate.Action] > severity[current.Action] ||
+		(candidate.Action == current.Action && candidate.Delay > current.Delay) {
+		return candidate
+	}
+
+	return current
+}
+
+// decide must be called with the lock held.
+func (e *RiskEngine) decide(dimension Dimension, key string, now time.Time) Decision {
+	if block, ok := e.blocks[key]; ok && block.Until.After(now) {
+		return Decision{Action: ActionBlock, BlockedUntil: block.Until, Key: key}
+	}
+
+	policy := e.policies[dimension]
+	failures := count(e.failures, key, policy.Window, now)
+
+	switch {
+	case failures >= policy.ChallengeAfter:
+		return Decision{Action: ActionChallenge, Key: key}
+	case failures >= policy.DelayAfter:
+		// double the delay for every failure past the threshold, counted from
+		// the last failure so callers that wait it out are let through
+		delay := min(time.Second<<min(failures-policy.DelayAfter, 16), policy.MaxDelay)
+		last := e.failures[key][failures-1]
+		if wait := last.Add(delay).Sub(now); wait > 0 {
+			return Decision{Action: ActionDelay, Delay: wait, Key: key}
+		}
+	}
+
+	return Decision{Action: ActionAllow}
+}
+
+// count must be called with the lock held. It drops timestamps that fell out
+// of the window.
+func count(counters map[string][]time.Time, key string, window time.Duration, now time.Time) int {
+	timestamps := counters[key]
+	cutoff := now.Add(-window)
+
+	start := sort.Search(len(timestamps), func(i int) bool { return timestamps[i].After(cutoff) })
+	if start > 0 {
+		timestamps = slices.Clone(timestamps[start:])
+		if len(timestamps) == 0 {
+			delete(counters, key)
+		} else {
+			counters[key] = timestamps
+		}
+	}
+
+	return len(timestamps)
+}
+
+// RecordFailure counts a failed attempt and blocks keys over their threshold.
+func (e *RiskEngine) RecordFailure(phoneNumber string, ip string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	for dimension, key := range keysFor(phoneNumber, ip) {
+		e.failures[key] = append(e.failures[key], now)
+
+		policy := e.policies[dimension]
+		failures := count(e.failures, key, policy.Window, now)
+		if failures >= policy.BlockAfter {
+			if _, blocked := e.blocks[key]; !blocked {
+				e.config.Logger.Warn("Temporarily blocking key after repeated failures", zap.String("key", key), zap.Int("failures", failures))
+			}
+			e.blocks[key] = Block{Key: key, Failures: failures, BlockedAt: now, Until: now.Add(policy.BlockFor)}
+		}
+	}
+}
+
+// RecordOTPSend counts an OTP sent towards the volume signals.
+func (e *RiskEngine) RecordOTPSend(phoneNumber string, ip string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	for _, key := range keysFor(phoneNumber, ip) {
+		e.sends[key] = append(e.sends[key], now)
+	}
+}
+
+// RecordSuccess forgets the failures of the phone number after a successful
+// sign in. IP based counters are kept since an IP may be shared by attackers.
+func (e *RiskEngine) RecordSuccess(phoneNumber string, ip string) {
+	if phoneNumber == "" {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	delete(e.failures, string(DimensionPhone)+":"+phoneNumber)
+}
+
+// State describes every key the request maps to, for the admin API.
+func (e *RiskEngine) State(phoneNumber string, ip string) []KeyState {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	states := []KeyState{}
+	for dimension, key := range keysFor(phoneNumber, ip) {
+		states = append(states, KeyState{
+			Key:      key,
+			Failures: count(e.failures, key, e.policies[dimension].Window, now),
+			Sends:    count(e.sends, key, e.volumePolicies[dimension].Window, now),
+			Decision: e.decide(dimension, key, now),
+		})
+	}
+
+	return states
+}
+
+// Blocks returns the currently active blocks, the most recent first.
+func (e *RiskEngine) Blocks() []Block {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	blocks := []Block{}
+	for _, block := range e.blocks {
+		if block.Until.After(now) {
+			blocks = append(blocks, block)
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].BlockedAt.After(blocks[j].BlockedAt) })
+	return blocks
+}
+
+// Unblock lifts a block and clears the failures counted against the key.
+func (e *RiskEngine) Unblock(key string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	_, ok := e.blocks[key]
+	delete(e.blocks, key)
+	delete(e.failures, key)
+
+	return ok
+}
+
+// Run drops expired blocks and stale counters every sweepInterval until the
+// context is done.
+func (e *RiskEngine) Run() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+			e.sweep()
+		}
+	}
+}
+
+func (e *RiskEngine) sweep() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	for key, block := range e.blocks {
+		if !block.Until.After(now) {
+			delete(e.blocks, key)
+		}
+	}
+
+	// the widest window decides when a counter is stale
+	for key := range e.failures {
+		count(e.failures, key, maxWindow(e.policies), now)
+	}
+	for key := range e.sends {
+		count(e.sends, key, maxWindow(e.volumePolicies), now)
+	}
+}
+
+func maxWindow(policies map[Dimension]Policy) time.Duration {
+	var window time.Duration
+	for _, policy := range policies {
+		window = max(window, policy.Window)
+	}
+
+	return window
+}
+
+// keysFor maps a request to the keys failures are counted against.
+func keysFor(phoneNumber string, ip string) map[Dimension]string {
+	keys := map[Dimension]string{}
+
+	if phoneNumber != "" {
+		keys[DimensionPhone] = string(DimensionPhone) + ":" + phoneNumber
+	}
+
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		addr = addr.Unmap()
+		keys[DimensionIP] = string(DimensionIP) + ":" + addr.String()
+
+		bits := 48
+		if addr.Is4() {
+			bits = 24
+		}
+		if prefix, err := addr.Prefix(bits); err == nil {
+			keys[DimensionIPRange] = string(DimensionIPRange) + ":" + prefix.String()
+		}
+	}
+
+	return keys
+}
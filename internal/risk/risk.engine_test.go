@@ -0,0 +1,209 @@
+package risk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+const testPhone = "+15550000000"
+
+var testPolicies = map[Dimension]Policy{
+	DimensionPhone:   {Window: 15 * time.Minute, DelayAfter: 2, ChallengeAfter: 4, BlockAfter: 6, BlockFor: 30 * time.Minute, MaxDelay: 3 * time.Second},
+	DimensionIP:      {Window: 15 * time.Minute, DelayAfter: 100, ChallengeAfter: 100, BlockAfter: 100, BlockFor: time.Hour, MaxDelay: time.Second},
+	DimensionIPRange: {Window: 15 * time.Minute, DelayAfter: 100, ChallengeAfter: 100, BlockAfter: 100, BlockFor: time.Hour, MaxDelay: time.Second},
+}
+
+func newTestEngine() *RiskEngine {
+	engine := NewRiskEngine(&config.Config{Logger: zap.NewNop()}, context.Background())
+	engine.policies = testPolicies
+	return engine
+}
+
+func TestRiskEngineEscalates(t *testing.T) {
+	engine := newTestEngine()
+
+	steps := []struct {
+		failures int
+		action   Action
+		maxDelay time.Duration
+	}{
+		{failures: 0, action: ActionAllow},
+		{failures: 1, action: ActionAllow},
+		{failures: 2, action: ActionDelay, maxDelay: time.Second},
+		{failures: 3, action: ActionDelay, maxDelay: 2 * time.Second},
+		{failures: 4, action: ActionChallenge},
+		{failures: 5, action: ActionChallenge},
+		{failures: 6, action: ActionBlock},
+	}
+
+	recorded := 0
+	for _, step := range steps {
+		for ; recorded < step.failures; recorded++ {
+			engine.RecordFailure(testPhone, "203.0.113.7")
+		}
+
+		decision := engine.Evaluate(testPhone, "203.0.113.7")
+		if decision.Action != step.action {
+			t.Fatalf("after %d failures: got %s, want %s", step.failures, decision.Action, step.action)
+		}
+
+		if step.action == ActionDelay && (decision.Delay <= step.maxDelay/2 || decision.Delay > step.maxDelay) {
+			t.Errorf("after %d failures: got a delay of %v, want up to %v", step.failures, decision.Delay, step.maxDelay)
+		}
+		if step.action != ActionAllow && decision.Key != "phone:"+testPhone {
+			t.Errorf("after %d failures: got key %q", step.failures, decision.Key)
+		}
+	}
+
+	decision := engine.Evaluate(testPhone, "")
+	if until := time.Until(decision.BlockedUntil); until < 29*time.Minute || until > 30*time.Minute {
+		t.Errorf("got a block until %v, want about 30 minutes from now", decision.BlockedUntil)
+	}
+
+	// other numbers behind the same IP are not affected
+	if decision := engine.Evaluate("+15550000001", "203.0.113.7"); decision.Action != ActionAllow {
+		t.Errorf("another number: got %s, want allow", decision.Action)
+	}
+}
+
+func TestRiskEngineDelayIsCountedFromTheLastFailure(t *testing.T) {
+	engine := newTestEngine()
+	key := "phone:" + testPhone
+	now := time.Now()
+
+	// two failures are past DelayAfter, a one second delay
+	engine.failures[key] = []time.Time{now.Add(-3 * time.Second), now.Add(-500 * time.Millisecond)}
+	if decision := engine.decide(DimensionPhone, key, now); decision.Action != ActionDelay || decision.Delay != 500*time.Millisecond {
+		t.Errorf("got %s for %v, want a delay of the remaining 500ms", decision.Action, decision.Delay)
+	}
+
+	// a caller that waited the delay out is let through
+	engine.failures[key] = []time.Time{now.Add(-3 * time.Second), now.Add(-2 * time.Second)}
+	if decision := engine.decide(DimensionPhone, key, now); decision.Action != ActionAllow {
+		t.Errorf("got %s, want allow once the delay has passed", decision.Action)
+	}
+}
+
+func TestRiskEngineDelayIsCapped(t *testing.T) {
+	engine := newTestEngine()
+	engine.policies = map[Dimension]Policy{
+		DimensionPhone: {Window: time.Hour, DelayAfter: 1, ChallengeAfter: 100, BlockAfter: 100, MaxDelay: 3 * time.Second},
+	}
+	key := "phone:" + testPhone
+	now := time.Now()
+
+	for i := 0; i < 40; i++ {
+		engine.failures[key] = append(engine.failures[key], now)
+	}
+
+	if decision := engine.decide(DimensionPhone, key, now); decision.Action != ActionDelay || decision.Delay != 3*time.Second {
+		t.Errorf("got %s for %v, want the 3s maximum", decision.Action, decision.Delay)
+	}
+}
+
+func TestRiskEngineForgetsFailuresOutsideTheWindow(t *testing.T) {
+	engine := newTestEngine()
+	key := "phone:" + testPhone
+	now := time.Now()
+
+	for i := 0; i < 5; i++ {
+		engine.failures[key] = append(engine.failures[key], now.Add(-20*time.Minute))
+	}
+
+	if decision := engine.decide(DimensionPhone, key, now); decision.Action != ActionAllow {
+		t.Errorf("got %s, want allow", decision.Action)
+	}
+	if _, ok := engine.failures[key]; ok {
+		t.Error("stale failures were not dropped")
+	}
+}
+
+func TestRiskEngineRecordSuccessClearsThePhoneOnly(t *testing.T) {
+	engine := newTestEngine()
+	engine.policies = map[Dimension]Policy{
+		DimensionPhone: testPolicies[DimensionPhone],
+		DimensionIP:    {Window: 15 * time.Minute, DelayAfter: 100, ChallengeAfter: 4, BlockAfter: 100},
+	}
+
+	for i := 0; i < 4; i++ {
+		engine.RecordFailure(testPhone, "203.0.113.7")
+	}
+	engine.RecordSuccess(testPhone, "203.0.113.7")
+
+	if decision := engine.Evaluate(testPhone, ""); decision.Action != ActionAllow {
+		t.Errorf("phone: got %s, want allow", decision.Action)
+	}
+	if decision := engine.Evaluate("", "203.0.113.7"); decision.Action != ActionChallenge {
+		t.Errorf("ip: got %s, want challenge", decision.Action)
+	}
+}
+
+func TestRiskEngineUnblock(t *testing.T) {
+	engine := newTestEngine()
+
+	for i := 0; i < 6; i++ {
+		engine.RecordFailure(testPhone, "")
+	}
+	if blocks := engine.Blocks(); len(blocks) != 1 || blocks[0].Key != "phone:"+testPhone {
+		t.Fatalf("got blocks %v", blocks)
+	}
+
+	if !engine.Unblock("phone:" + testPhone) {
+		t.Fatal("Unblock reported no block")
+	}
+	if decision := engine.Evaluate(testPhone, ""); decision.Action != ActionAllow {
+		t.Errorf("got %s, want allow after unblocking", decision.Action)
+	}
+	if engine.Unblock("phone:" + testPhone) {
+		t.Error("Unblock reported a block that was already lifted")
+	}
+}
+
+func TestRiskEngineChallengesOTPVolume(t *testing.T) {
+	engine := newTestEngine()
+
+	for i := 0; i < VolumePolicies[DimensionPhone].ChallengeAfter; i++ {
+		if decision := engine.EvaluateOTPSend(testPhone, ""); decision.Action != ActionAllow {
+			t.Fatalf("send %d: got %s, want allow", i+1, decision.Action)
+		}
+		engine.RecordOTPSend(testPhone, "")
+	}
+
+	if decision := engine.EvaluateOTPSend(testPhone, ""); decision.Action != ActionChallenge {
+		t.Errorf("got %s, want challenge", decision.Action)
+	}
+	// sends alone never block or delay a sign in
+	if decision := engine.Evaluate(testPhone, ""); decision.Action != ActionAllow {
+		t.Errorf("Evaluate: got %s, want allow", decision.Action)
+	}
+}
+
+func TestKeysFor(t *testing.T) {
+	tests := []struct {
+		phone, ip string
+		want      map[Dimension]string
+	}{
+		{phone: testPhone, want: map[Dimension]string{DimensionPhone: "phone:" + testPhone}},
+		{ip: "203.0.113.7", want: map[Dimension]string{DimensionIP: "ip:203.0.113.7", DimensionIPRange: "range:203.0.113.0/24"}},
+		{ip: "::ffff:203.0.113.7", want: map[Dimension]string{DimensionIP: "ip:203.0.113.7", DimensionIPRange: "range:203.0.113.0/24"}},
+		{ip: "2001:db8:1:2::7", want: map[Dimension]string{DimensionIP: "ip:2001:db8:1:2::7", DimensionIPRange: "range:2001:db8:1::/48"}},
+		{ip: "not an ip", want: map[Dimension]string{}},
+	}
+
+	for _, tt := range tests {
+		got := keysFor(tt.phone, tt.ip)
+		if len(got) != len(tt.want) {
+			t.Errorf("keysFor(%q, %q) = %v, want %v", tt.phone, tt.ip, got, tt.want)
+			continue
+		}
+		for dimension, key := range tt.want {
+			if got[dimension] != key {
+				t.Errorf("keysFor(%q, %q)[%s] = %q, want %q", tt.phone, tt.ip, dimension, got[dimension], key)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package risk
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
+	"github.com/shinplay/internal/challenge"
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+type RiskHandlerIntr interface {
+	Guard(ctx *fiber.Ctx) error
+	GuardOTPSend(ctx *fiber.Ctx) error
+	GetState(ctx *fiber.Ctx) error
+	ListBlocks(ctx *fiber.Ctx) error
+	Unblock(ctx *fiber.Ctx) error
+}
+
+type RiskHandler struct {
+	riskEngine       *RiskEngine
+	challengeService *challenge.ChallengeService
+	auditService     *audit.AuditService
+	config           *config.Config
+}
+
+func NewRiskHandler(riskEngine *RiskEngine, challengeService *challenge.ChallengeService, auditService *audit.AuditService, config *config.Config) *RiskHandler {
+	return &RiskHandler{
+		riskEngine:       riskEngine,
+		challengeService: challengeService,
+		auditService:     auditService,
+		config:           config,
+	}
+}
+
+type guardBody struct {
+	PhoneNumber string              `json:"phone_number" xml:"phone_number" form:"phone_number"`
+	Challenge   *challenge.Solution `json:"challenge" xml:"challenge" form:"-"`
+}
+
+// Guard applies the failure based risk decision to a sign in endpoint.
+func (h *RiskHandler) Guard(ctx *fiber.Ctx) error {
+	body := new(guardBody)
+	_ = ctx.BodyParser(body) // the phone number is optional, e.g. for Google sign in
+
+	return h.apply(ctx, h.riskEngine.Evaluate(body.PhoneNumber, ctx.IP()), body)
+}
+
+// GuardOTPSend is Guard for the send OTP endpoint, it also challenges callers
+// sending an unusual number of OTPs.
+func (h *RiskHandler) GuardOTPSend(ctx *fiber.Ctx) error {
+	body := new(guardBody)
+	_ = ctx.BodyParser(body)
+
+	return h.apply(ctx, h.riskEngine.EvaluateOTPSend(body.PhoneNumber, ctx.IP()), body)
+}
+
+// apply asks delayed requests to retry later, rejects blocked ones and lets
+// challenged ones through only with a valid challenge solution.
+func (h *RiskHandler) apply(ctx *fiber.Ctx, decision Decision, body *guardBody) error {
+	switch decision.Action {
+	case ActionBlock:
+		h.config.Logger.Info("Blocked sign in attempt", zap.String("key", decision.Key))
+		retryAfter := int(math.Ceil(time.Until(decision.BlockedUntil).Seconds()))
+		ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfter))
+		return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "too_many_attempts",
+			"message": "Too many failed attempts, please try again later",
+			"data": fiber.Map{
+				"retry_after": retryAfter,
+			},
+		})
+	case ActionChallenge:
+		if body.Challenge != nil && h.challengeService.Verify(*body.Challenge, ctx.IP()) == nil {
+			return ctx.Next()
+		}
+
+		issued, err := h.challengeService.Issue()
+		if err != nil {
+			h.config.Logger.Error("Failed to issue challenge", zap.Error(err))
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Something went wrong, please try again later",
+			})
+		}
+
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "challenge_required",
+			"message": "Please complete the challenge to continue",
+			"data": fiber.Map{
+				"challenge": issued,
+			},
+		})
+	case ActionDelay:
+		// answered rather than slept on so a burst cannot hold every worker
+		retryAfter := int(math.Ceil(decision.Delay.Seconds()))
+		ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfter))
+		return ctx.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "slow_down",
+			"message": "Please wait a moment before trying again",
+			"data": fiber.Map{
+				"retry_after": retryAfter,
+			},
+		})
+	}
+
+	return ctx.Next()
+}
+
+type StateQuery struct {
+	PhoneNumber string `query:"phone_number"`
+	IP          string `query:"ip"`
+}
+
+func (h *RiskHandler) GetState(ctx *fiber.Ctx) error {
+	query := new(StateQuery)
+	if err := ctx.QueryParser(query); err != nil || (query.PhoneNumber == "" && query.IP == "") {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide phone_number or ip",
+		})
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   h.riskEngine.State(query.PhoneNumber, query.IP),
+	})
+}
+
+func (h *RiskHandler) ListBlocks(ctx *fiber.Ctx) error {
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data":   h.riskEngine.Blocks(),
+	})
+}
+
+// Unblock lifts the block on the key given in the query, e.g. ?key=ip:203.0.113.7
+func (h *RiskHandler) Unblock(ctx *fiber.Ctx) error {
+	key := ctx.Query("key")
+	if !h.riskEngine.Unblock(key) {
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "No active block for this key",
+		})
+	}
+
+	actor, _ := ctx.Locals("user").(*ent.User)
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventAdminRiskUnblocked,
+		Actor:    actor,
+		Metadata: map[string]any{"key": key},
+	})
+
+	h.config.Logger.Info("Risk block lifted", zap.String("key", key))
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Block lifted successfully",
+	})
+}
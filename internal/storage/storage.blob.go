@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/shinplay/internal/config"
+)
+
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// BlobStore keeps publicly readable files such as avatars. Keys are slash
+// separated paths like "avatars/abc/512.jpg".
+type BlobStore interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	Delete(ctx context.Context, key string) error
+	// URL returns the public address of the blob.
+	URL(key string) string
+}
+
+// NewBlobStore returns the blob store configured by STORAGE_PROVIDER.
+func NewBlobStore(config *config.Config) BlobStore {
+	switch config.Storage.Provider {
+	case "s3":
+		return NewS3BlobStore(config)
+	default:
+		return NewLocalBlobStore(config)
+	}
+}
+
+// validKey rejects keys that could escape the store's root.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+
+	return true
+}
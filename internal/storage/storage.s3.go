@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shinplay/internal/config"
+)
+
+const s3Service = "s3"
+
+// S3BlobStore keeps blobs in an S3-compatible bucket such as AWS S3, MinIO
+// or Cloudflare R2. Requests use path-style addressing and are signed with
+// AWS Signature Version 4. The bucket must allow public reads of the keys it
+// is given, e.g. through a bucket policy.
+type S3BlobStore struct {
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	publicURL string
+	client    *http.Client
+}
+
+func NewS3BlobStore(config *config.Config) *S3BlobStore {
+	storage := config.Storage
+
+	publicURL := storage.PublicURL
+	if publicURL == "" {
+		publicURL = strings.TrimSuffix(storage.S3Endpoint, "/") + "/" + storage.S3Bucket
+	}
+
+	return &S3BlobStore{
+		endpoint:  strings.TrimSuffix(storage.S3Endpoint, "/"),
+		region:    storage.S3Region,
+		bucket:    storage.S3Bucket,
+		accessKey: storage.S3AccessKey,
+		secretKey: storage.S3SecretKey,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (s *S3BlobStore) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	return s.do(ctx, http.MethodPut, key, map[string]string{
+		"content-type": contentType,
+		// keys are never reused for different content
+		"cache-control": "public, max-age=31536000, immutable",
+	}, data)
+}
+
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	return s.do(ctx, http.MethodDelete, key, nil, nil)
+}
+
+func (s *S3BlobStore) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+func (s *S3BlobStore) do(ctx context.Context, method string, key string, headers map[string]string, body []byte) error {
+	if !validKey(key) {
+		return ErrInvalidKey
+	}
+
+	endpoint, err := url.Parse(s.endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+
+	path := "/" + s.bucket + "/" + uriEncode(key, false)
+
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	s.sign(req, endpoint.Host, path, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending S3 request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("S3 %s %s: %s: %s", method, key, resp.Status, message)
+	}
+
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req, signing
+// every header it carries.
+func (s *S3BlobStore) sign(req *http.Request, host string, path string, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("host", host)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	names := make([]string, 0, len(req.Header))
+	canonical := map[string]string{}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		names = append(names, lower)
+		canonical[lower] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + canonical[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		"", // no query string
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature,
+	))
+	// net/http sends Host from the URL, not the header map
+	req.Header.Del("host")
+}
+
+// uriEncode escapes s the way SigV4 expects, leaving only unreserved
+// characters and, unless encodeSlash, the path separator as they are.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,236 @@
+package user
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // registers the PNG decoder
+	"net/http"
+	"strconv"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/pkg/publicid"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+)
+
+const (
+	// MaxAvatarBytes matches the default request body limit of the server.
+	MaxAvatarBytes     = 4 << 20
+	minAvatarDimension = 64
+	maxAvatarDimension = 4096
+	avatarQuality      = 85
+)
+
+// avatarSizes are the square variants generated for every avatar, largest
+// first. Sizes larger than the uploaded image are skipped.
+var avatarSizes = []int{512, 256, 64}
+
+var avatarContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+var (
+	ErrAvatarTooLarge          = errors.New("avatar is too large")
+	ErrAvatarUnsupportedFormat = errors.New("avatar must be a JPEG, PNG or WebP image")
+	ErrAvatarDimensions        = fmt.Errorf("avatar must be between %d and %d pixels on each side", minAvatarDimension, maxAvatarDimension)
+)
+
+// avatarVariant is one resized rendition of an avatar, ready to upload.
+type avatarVariant struct {
+	size int
+	data []byte
+}
+
+// processAvatar validates an uploaded image and renders its square variants.
+// Every variant is re-encoded as JPEG, which drops EXIF and any other
+// metadata the upload carried.
+func processAvatar(data []byte) ([]avatarVariant, error) {
+	if len(data) > MaxAvatarBytes {
+		return nil, ErrAvatarTooLarge
+	}
+
+	if !avatarContentTypes[http.DetectContentType(data)] {
+		return nil, ErrAvatarUnsupportedFormat
+	}
+
+	// check the dimensions before decoding so a tiny file cannot claim a huge canvas
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrAvatarUnsupportedFormat
+	}
+	if config.Width < minAvatarDimension || config.Height < minAvatarDimension ||
+		config.Width > maxAvatarDimension || config.Height > maxAvatarDimension {
+		return nil, ErrAvatarDimensions
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrAvatarUnsupportedFormat
+	}
+
+	crop := squareCrop(src.Bounds())
+	orientation := jpegOrientation(data)
+
+	var variants []avatarVariant
+	for _, size := range avatarSizes {
+		if size > crop.Dx() && len(variants) > 0 {
+			continue
+		}
+		size = min(size, crop.Dx())
+
+		dst := image.NewRGBA(image.Rect(0, 0, size, size))
+		// transparent areas are flattened onto white since JPEG has no alpha
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Over, nil)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, orient(dst, orientation), &jpeg.Options{Quality: avatarQuality}); err != nil {
+			return nil, err
+		}
+		variants = append(variants, avatarVariant{size: size, data: buf.Bytes()})
+	}
+
+	return variants, nil
+}
+
+// squareCrop returns the largest centered square inside bounds. Since it is
+// centered, it can be taken before the EXIF orientation is applied.
+func squareCrop(bounds image.Rectangle) image.Rectangle {
+	side := min(bounds.Dx(), bounds.Dy())
+	x := bounds.Min.X + (bounds.Dx()-side)/2
+	y := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	return image.Rect(x, y, x+side, y+side)
+}
+
+// orient applies an EXIF orientation to a square image so it displays
+// upright once the tag is gone.
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	n := src.Bounds().Dx() - 1
+	dst := image.NewRGBA(src.Bounds())
+	for y := 0; y <= n; y++ {
+		for x := 0; x <= n; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				dx, dy = n-x, y
+			case 3: // rotated 180°
+				dx, dy = n-x, n-y
+			case 4: // mirrored vertically
+				dx, dy = x, n-y
+			case 5: // mirrored along the main diagonal
+				dx, dy = y, x
+			case 6: // rotated 90° clockwise to display
+				dx, dy = n-y, x
+			case 7: // mirrored along the anti-diagonal
+				dx, dy = n-y, n-x
+			case 8: // rotated 90° counterclockwise to display
+				dx, dy = y, n-x
+			}
+			dst.SetRGBA(dx, dy, src.RGBAAt(x, y))
+		}
+	}
+
+	return dst
+}
+
+// jpegOrientation reads the EXIF orientation tag of a JPEG, returning 1 (as
+// stored) when the image is not a JPEG or carries no tag.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// start of scan, the headers are over
+		if marker == 0xDA {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+
+	return 1
+}
+
+// exifOrientation finds the orientation tag in the first IFD of a TIFF block.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	entries := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < entries; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+
+	return 1
+}
+
+// newAvatarPrefix returns a fresh key prefix for a user's avatar. Each upload
+// gets its own prefix so the variants can be cached forever.
+func newAvatarPrefix(u *ent.User) string {
+	return "avatars/" + u.AuthID + "/" + publicid.Must()
+}
+
+func avatarVariantKey(prefix string, size int) string {
+	return prefix + "/" + strconv.Itoa(size) + ".jpg"
+}
+
+// avatarBlobKeys returns the keys of every stored variant of u's avatar.
+func avatarBlobKeys(u *ent.User) []string {
+	if u.AvatarKey == "" {
+		return nil
+	}
+
+	keys := make([]string, 0, len(u.AvatarUrls))
+	for size := range u.AvatarUrls {
+		keys = append(keys, u.AvatarKey+"/"+size+".jpg")
+	}
+
+	return keys
+}
@@ -0,0 +1,246 @@
+package user
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+var (
+	testRed  = color.RGBA{R: 255, A: 255}
+	testBlue = color.RGBA{B: 255, A: 255}
+)
+
+// testImage is blue with a red top-left quadrant, so its orientation can be
+// told from the output.
+func testImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if x < width/2 && y < height/2 {
+				img.SetRGBA(x, y, testRed)
+			} else {
+				img.SetRGBA(x, y, testBlue)
+			}
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// withOrientation inserts an APP1 segment carrying the EXIF orientation right
+// after the start of image marker.
+func withOrientation(data []byte, order binary.ByteOrder, orientation uint16) []byte {
+	tiff := []byte("II")
+	if order == binary.BigEndian {
+		tiff = []byte("MM")
+	}
+	appender := order.(binary.AppendByteOrder)
+	tiff = appender.AppendUint16(tiff, 42)
+	tiff = appender.AppendUint32(tiff, 8)
+	tiff = appender.AppendUint16(tiff, 1)
+	tiff = appender.AppendUint16(tiff, 0x0112)
+	tiff = appender.AppendUint16(tiff, 3)
+	tiff = appender.AppendUint32(tiff, 1)
+	tiff = appender.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0)
+	tiff = appender.AppendUint32(tiff, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func TestProcessAvatarRendersSquareVariants(t *testing.T) {
+	variants, err := processAvatar(encodePNG(t, testImage(300, 200)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 512 and 256 are larger than the 200 pixel crop, only the first is kept
+	// at the crop size
+	want := []int{200, 64}
+	if len(variants) != len(want) {
+		t.Fatalf("got %d variants, want %d", len(variants), len(want))
+	}
+
+	for i, variant := range variants {
+		if variant.size != want[i] {
+			t.Errorf("variant %d: got size %d, want %d", i, variant.size, want[i])
+		}
+
+		img, format, err := image.Decode(bytes.NewReader(variant.data))
+		if err != nil {
+			t.Fatalf("variant %d: %v", i, err)
+		}
+		if format != "jpeg" {
+			t.Errorf("variant %d: got format %s, want jpeg", i, format)
+		}
+		if bounds := img.Bounds(); bounds.Dx() != want[i] || bounds.Dy() != want[i] {
+			t.Errorf("variant %d: got %v, want a %d pixel square", i, bounds, want[i])
+		}
+	}
+}
+
+func TestProcessAvatarCropsTheCenter(t *testing.T) {
+	// a 200x100 image cropped to its center 100x100 square keeps the right
+	// half of the red quadrant in the top-left corner
+	variants, err := processAvatar(encodePNG(t, testImage(200, 100)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(variants[0].data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isRed(img.At(25, 25)) || isRed(img.At(75, 25)) {
+		t.Errorf("got %v at the top-left and %v at the top-right, want red then blue", img.At(25, 25), img.At(75, 25))
+	}
+}
+
+func TestProcessAvatarAppliesEXIFOrientation(t *testing.T) {
+	tests := []struct {
+		name        string
+		orientation uint16
+		order       binary.ByteOrder
+		red         image.Point
+	}{
+		{name: "as stored", orientation: 1, order: binary.LittleEndian, red: image.Pt(25, 25)},
+		{name: "mirrored", orientation: 2, order: binary.BigEndian, red: image.Pt(75, 25)},
+		{name: "rotated 180", orientation: 3, order: binary.LittleEndian, red: image.Pt(75, 75)},
+		{name: "rotated clockwise", orientation: 6, order: binary.BigEndian, red: image.Pt(75, 25)},
+		{name: "rotated counterclockwise", orientation: 8, order: binary.LittleEndian, red: image.Pt(25, 75)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := withOrientation(encodeJPEG(t, testImage(100, 100)), tt.order, tt.orientation)
+			if got := jpegOrientation(data); got != int(tt.orientation) {
+				t.Fatalf("jpegOrientation: got %d, want %d", got, tt.orientation)
+			}
+
+			variants, err := processAvatar(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			img, _, err := image.Decode(bytes.NewReader(variants[0].data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !isRed(img.At(tt.red.X, tt.red.Y)) {
+				t.Errorf("got %v at %v, want red", img.At(tt.red.X, tt.red.Y), tt.red)
+			}
+			if bytes.Contains(variants[0].data, []byte("Exif")) {
+				t.Error("variant still carries EXIF data")
+			}
+		})
+	}
+}
+
+func TestProcessAvatarRejectsInvalidUploads(t *testing.T) {
+	tests := []struct {
+		name string
+		data func(t *testing.T) []byte
+		err  error
+	}{
+		{
+			name: "too many bytes",
+			data: func(t *testing.T) []byte { return make([]byte, MaxAvatarBytes+1) },
+			err:  ErrAvatarTooLarge,
+		},
+		{
+			name: "not an image",
+			data: func(t *testing.T) []byte { return []byte("definitely not an image") },
+			err:  ErrAvatarUnsupportedFormat,
+		},
+		{
+			name: "gif",
+			data: func(t *testing.T) []byte { return []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00") },
+			err:  ErrAvatarUnsupportedFormat,
+		},
+		{
+			name: "truncated png",
+			data: func(t *testing.T) []byte { return encodePNG(t, testImage(100, 100))[:40] },
+			err:  ErrAvatarUnsupportedFormat,
+		},
+		{
+			name: "too small",
+			data: func(t *testing.T) []byte { return encodePNG(t, testImage(minAvatarDimension-1, 100)) },
+			err:  ErrAvatarDimensions,
+		},
+		{
+			name: "too wide",
+			data: func(t *testing.T) []byte {
+				return encodePNG(t, image.NewGray(image.Rect(0, 0, maxAvatarDimension+1, 64)))
+			},
+			err: ErrAvatarDimensions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := processAvatar(tt.data(t))
+			if !errors.Is(err, tt.err) {
+				t.Errorf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestJpegOrientationIgnoresMalformedData(t *testing.T) {
+	jpg := encodeJPEG(t, testImage(64, 64))
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "png", data: encodePNG(t, testImage(64, 64))},
+		{name: "no exif", data: jpg},
+		{name: "truncated segment", data: withOrientation(jpg, binary.BigEndian, 6)[:12]},
+		{name: "bad byte order", data: bytes.Replace(withOrientation(jpg, binary.BigEndian, 6), []byte("MM"), []byte("XX"), 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != 1 {
+				t.Errorf("got %d, want 1", got)
+			}
+		})
+	}
+}
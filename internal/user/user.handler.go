@@ -1,29 +1,50 @@
 package user
 
 import (
+	"errors"
+	"io"
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/shinplay/ent"
+	"github.com/shinplay/internal/audit"
 	"github.com/shinplay/internal/config"
+	"github.com/shinplay/pkg/cursor"
 	"go.uber.org/zap"
 )
 
 type UserHandlerInterface interface {
 	CheckUsernameAvailability(ctx *fiber.Ctx) (bool, error)
 	ChangeUsername(ctx *fiber.Ctx) error
+	DeleteAccount(ctx *fiber.Ctx) error
+	GetMe(ctx *fiber.Ctx) error
+	UpdateMe(ctx *fiber.Ctx) error
+	GetPublicProfile(ctx *fiber.Ctx) error
+	SearchUsers(ctx *fiber.Ctx) error
+	UploadAvatar(ctx *fiber.Ctx) error
+	DeleteAvatar(ctx *fiber.Ctx) error
+	GetSettings(ctx *fiber.Ctx) error
+	UpdateSettings(ctx *fiber.Ctx) error
 }
 
 type UserHandler struct {
-	config      *config.Config
-	userService *UserService
+	config       *config.Config
+	userService  *UserService
+	auditService *audit.AuditService
 }
 
-func NewUserHandler(userService *UserService, config *config.Config) *UserHandler {
+func NewUserHandler(userService *UserService, auditService *audit.AuditService, config *config.Config) *UserHandler {
 	return &UserHandler{
-		config:      config,
-		userService: userService,
+		config:       config,
+		userService:  userService,
+		auditService: auditService,
 	}
 }
 
+// usernameSuggestionCount is how many alternatives are offered for a
+// username that cannot be claimed.
+const usernameSuggestionCount = 5
+
 type UsernameQuery struct {
 	Check string `json:"check" xml:"check" form:"check" query:"check" param:"check"`
 }
@@ -34,16 +55,27 @@ func (h *UserHandler) CheckUsernameAvailability(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	currentUser := ctx.Locals("user").(*ent.User)
+
 	h.config.Logger.Info("Checking username availability", zap.String("username", query.Check))
-	_, err := h.userService.FindByUsername(query.Check)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return ctx.JSON(fiber.Map{"available": true})
-		}
+	err := h.userService.CheckUsername(currentUser, query.Check)
+	if err == nil {
+		return ctx.JSON(fiber.Map{"available": true})
+	}
+
+	code, message, ok := UsernameErrorOf(err)
+	if !ok {
+		h.config.Logger.Error("Failed to check username availability", zap.Error(err))
 		return err
 	}
 
-	return ctx.JSON(fiber.Map{"available": false})
+	// suggestions are a nicety, the answer stands without them
+	suggestions, err := h.userService.SuggestUsernames(currentUser, query.Check, usernameSuggestionCount)
+	if err != nil {
+		suggestions = []string{}
+	}
+
+	return ctx.JSON(fiber.Map{"available": false, "code": code, "reason": message, "suggestions": suggestions})
 }
 
 type ChangeUsernameBody struct {
@@ -59,16 +91,406 @@ func (h *UserHandler) ChangeUsername(ctx *fiber.Ctx) error {
 	currentUser := ctx.Locals("user").(*ent.User)
 
 	h.config.Logger.Info("Changing username", zap.String("authId", currentUser.AuthID), zap.String("newUsername", body.NewUsername))
-	_, usernameTaken, err := h.userService.ChangeUsername(currentUser.AuthID, body.NewUsername)
-	if usernameTaken {
-		h.config.Logger.Warn("Username already taken", zap.String("newUsername", body.NewUsername))
-		return ctx.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "Username already taken"})
+	updated, err := h.userService.ChangeUsername(currentUser, body.NewUsername)
+	if err != nil {
+		code, message, ok := UsernameErrorOf(err)
+		if !ok {
+			h.config.Logger.Error("Failed to change username", zap.Error(err))
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"status":  "error",
+				"message": "Failed to change username",
+			})
+		}
+
+		status := fiber.StatusConflict
+		data := fiber.Map{}
+		switch code {
+		case "invalid_username":
+			status = fiber.StatusBadRequest
+		case "username_change_cooldown":
+			status = fiber.StatusTooManyRequests
+			data["next_change_at"] = currentUser.UsernameChangedAt.Add(UsernameChangeCooldown)
+		}
+
+		return ctx.Status(status).JSON(fiber.Map{
+			"status":  "error",
+			"code":    code,
+			"message": message,
+			"data":    data,
+		})
 	}
 
+	if updated.Username != currentUser.Username {
+		h.auditService.Record(ctx, audit.Event{
+			Type:     audit.EventUsernameChanged,
+			Actor:    currentUser,
+			Subject:  currentUser,
+			Metadata: map[string]any{"old_username": currentUser.Username, "new_username": updated.Username},
+		})
+	}
+
+	data := fiber.Map{"username": updated.Username}
+	if updated.UsernameChangedAt != nil {
+		data["next_change_at"] = updated.UsernameChangedAt.Add(UsernameChangeCooldown)
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Username changed successfully",
+		"data":    data,
+	})
+}
+
+// UsernameErrorOf maps a username policy error to a code and message for the
+// client, ok is false for unexpected errors.
+func UsernameErrorOf(err error) (code string, message string, ok bool) {
+	var invalid *InvalidUsernameError
+	switch {
+	case errors.As(err, &invalid):
+		return "invalid_username", invalid.Reason, true
+	case errors.Is(err, ErrUsernameTaken):
+		return "username_taken", "Username already taken", true
+	case errors.Is(err, ErrUsernameReserved):
+		return "username_reserved", "This username is not available", true
+	case errors.Is(err, ErrUsernameHeld):
+		return "username_held", "This username was recently released and is not available yet", true
+	case errors.Is(err, ErrUsernameCooldown):
+		return "username_change_cooldown", "You can only change your username once every 30 days", true
+	}
+
+	return "", "", false
+}
+
+func (h *UserHandler) GetMe(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Profile fetched successfully",
+		"data":    NewProfile(currentUser),
+	})
+}
+
+func (h *UserHandler) UpdateMe(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	changes := new(ProfileChanges)
+	if err := ctx.BodyParser(changes); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide the fields to update",
+		})
+	}
+
+	if changes.IsEmpty() {
+		return ctx.JSON(fiber.Map{
+			"status":  "success",
+			"message": "Nothing to update",
+			"data":    NewProfile(currentUser),
+		})
+	}
+
+	updated, err := h.userService.UpdateProfile(currentUser, *changes)
+	if err != nil {
+		if validation, ok := AsValidation(err); ok {
+			return validation.Respond(ctx)
+		}
+
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to update profile, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventProfileUpdated,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"fields": changes.Fields()},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Profile updated successfully",
+		"data":    NewProfile(updated),
+	})
+}
+
+// GetPublicProfile serves GET /users/:username to anyone. It shares the path
+// with the signed-in user's own routes, such as /users/me, so reserved names
+// are passed on to them.
+func (h *UserHandler) GetPublicProfile(ctx *fiber.Ctx) error {
+	username := ctx.Params("username")
+	if isReservedUsername(NormalizeUsername(username)) {
+		return ctx.Next()
+	}
+
+	viewer, _ := ctx.Locals("user").(*ent.User)
+
+	profile, err := h.userService.PublicProfile(viewer, username)
+	if errors.Is(err, ErrProfileNotFound) {
+		return ctx.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"status":  "error",
+			"message": "User not found",
+		})
+	}
 	if err != nil {
-		h.config.Logger.Error("Failed to change username", zap.Error(err))
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to change username"})
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to fetch profile, please try again later",
+		})
 	}
 
-	return ctx.JSON(fiber.Map{"message": "Username changed successfully"})
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Profile fetched successfully",
+		"data":    profile,
+	})
+}
+
+type SearchQuery struct {
+	Query  string `query:"q"`
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+// SearchUsers finds people by username, display name or first and last name.
+func (h *UserHandler) SearchUsers(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	query := new(SearchQuery)
+	if err := ctx.QueryParser(query); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid query",
+		})
+	}
+
+	profiles, next, err := h.userService.SearchUsers(currentUser, query.Query, query.Cursor, query.Limit)
+	switch {
+	case errors.Is(err, ErrInvalidSearchQuery), errors.Is(err, cursor.ErrInvalid):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": err.Error(),
+		})
+	case err != nil:
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to search users, please try again later",
+		})
+	}
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": fiber.Map{
+			"users":       profiles,
+			"next_cursor": next,
+		},
+	})
+}
+
+// UploadAvatar takes the image either as the "avatar" field of a multipart
+// form or as the raw request body.
+func (h *UserHandler) UploadAvatar(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	data, err := avatarUpload(ctx)
+	if errors.Is(err, ErrAvatarTooLarge) {
+		return avatarError(ctx, err)
+	}
+	if err != nil || len(data) == 0 {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide an image",
+		})
+	}
+
+	updated, err := h.userService.SetAvatar(currentUser, data)
+	if err != nil {
+		return avatarError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventAvatarUpdated,
+		Actor:   currentUser,
+		Subject: currentUser,
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Avatar updated successfully",
+		"data":    NewProfile(updated),
+	})
+}
+
+func (h *UserHandler) DeleteAvatar(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	if currentUser.AvatarKey == "" {
+		return ctx.JSON(fiber.Map{
+			"status":  "success",
+			"message": "No avatar to remove",
+			"data":    NewProfile(currentUser),
+		})
+	}
+
+	updated, err := h.userService.RemoveAvatar(currentUser)
+	if err != nil {
+		return avatarError(ctx, err)
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:    audit.EventAvatarRemoved,
+		Actor:   currentUser,
+		Subject: currentUser,
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Avatar removed successfully",
+		"data":    NewProfile(updated),
+	})
+}
+
+func (h *UserHandler) GetSettings(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	return ctx.JSON(fiber.Map{
+		"status": "success",
+		"data": fiber.Map{
+			"settings": ResolveSettings(currentUser),
+			"defaults": DefaultSettings(),
+		},
+	})
+}
+
+func (h *UserHandler) UpdateSettings(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	changes := SettingsChanges{}
+	if err := ctx.BodyParser(&changes); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Please provide the settings to update",
+		})
+	}
+
+	if len(changes) == 0 {
+		return ctx.JSON(fiber.Map{
+			"status":  "success",
+			"message": "Nothing to update",
+			"data": fiber.Map{
+				"settings": ResolveSettings(currentUser),
+				"defaults": DefaultSettings(),
+			},
+		})
+	}
+
+	updated, err := h.userService.UpdateSettings(currentUser, changes)
+	if err != nil {
+		if validation, ok := AsValidation(err); ok {
+			return validation.Respond(ctx)
+		}
+
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to update settings, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventSettingsUpdated,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"settings": changes.Fields()},
+	})
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Settings updated successfully",
+		"data": fiber.Map{
+			"settings": ResolveSettings(updated),
+			"defaults": DefaultSettings(),
+		},
+	})
+}
+
+func avatarUpload(ctx *fiber.Ctx) ([]byte, error) {
+	if !strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		return ctx.Body(), nil
+	}
+
+	header, err := ctx.FormFile("avatar")
+	if err != nil {
+		return nil, err
+	}
+	if header.Size > MaxAvatarBytes {
+		return nil, ErrAvatarTooLarge
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+func avatarError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, ErrAvatarTooLarge):
+		return ctx.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "avatar_too_large",
+			"message": err.Error(),
+		})
+	case errors.Is(err, ErrAvatarUnsupportedFormat):
+		return ctx.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "avatar_unsupported_format",
+			"message": err.Error(),
+		})
+	case errors.Is(err, ErrAvatarDimensions):
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"code":    "avatar_invalid_dimensions",
+			"message": err.Error(),
+		})
+	default:
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to update avatar, please try again later",
+		})
+	}
+}
+
+func (h *UserHandler) DeleteAccount(ctx *fiber.Ctx) error {
+	currentUser := ctx.Locals("user").(*ent.User)
+
+	user, err := h.userService.ScheduleDeletion(currentUser)
+	if err != nil {
+		h.config.Logger.Error("Failed to schedule account deletion", zap.Error(err))
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Failed to delete account, please try again later",
+		})
+	}
+
+	h.auditService.Record(ctx, audit.Event{
+		Type:     audit.EventAccountDeletionRequested,
+		Actor:    currentUser,
+		Subject:  currentUser,
+		Metadata: map[string]any{"deletion_scheduled_at": user.DeletionScheduledAt},
+	})
+
+	ctx.ClearCookie("session_id")
+
+	return ctx.JSON(fiber.Map{
+		"status":  "success",
+		"message": "Account scheduled for deletion, log in again before the deadline to cancel",
+		"data": fiber.Map{
+			"deletion_scheduled_at": user.DeletionScheduledAt,
+		},
+	})
 }
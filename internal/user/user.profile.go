@@ -0,0 +1,264 @@
+package user
+
+import (
+	"errors"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata" // timezones are validated even where the host has no zoneinfo
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+)
+
+const (
+	birthdayLayout    = "2006-01-02"
+	maxNameLength     = 50
+	maxBioLength      = 300
+	CodeInvalidFields = "validation_failed"
+)
+
+var localePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// UserInfo is the signed-in user's view of their own account, returned on
+// sign in and by GET /users/me.
+type UserInfo struct {
+	AuthID        string  `json:"auth_id"`
+	PhoneNumber   string  `json:"phone_number"`
+	UserName      string  `json:"username"`
+	Email         string  `json:"email"`
+	EmailVerified bool    `json:"email_verified"`
+	FirstName     string  `json:"first_name"`
+	LastName      string  `json:"last_name"`
+	DisplayName   string  `json:"display_name"`
+	Bio           string  `json:"bio"`
+	Birthday      *string `json:"birthday"`
+	Locale        string  `json:"locale"`
+	Timezone      string  `json:"timezone"`
+	Guest         bool    `json:"guest"`
+	Private       bool    `json:"private"`
+	// AvatarURLs maps each avatar size in pixels to its URL.
+	AvatarURLs map[string]string `json:"avatar_urls"`
+}
+
+// NewUserInfo builds the UserInfo of u.
+func NewUserInfo(u *ent.User) UserInfo {
+	info := UserInfo{
+		AuthID:        u.AuthID,
+		PhoneNumber:   u.PhoneNumber,
+		UserName:      u.Username,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerifiedAt != nil,
+		FirstName:     u.FirstName,
+		LastName:      u.LastName,
+		DisplayName:   u.DisplayName,
+		Bio:           u.Bio,
+		Locale:        u.Locale,
+		Timezone:      u.Timezone,
+		Guest:         u.Guest,
+		Private:       u.Private,
+		AvatarURLs:    u.AvatarUrls,
+	}
+
+	if u.Birthday != nil {
+		birthday := u.Birthday.Format(birthdayLayout)
+		info.Birthday = &birthday
+	}
+
+	return info
+}
+
+// Profile is UserInfo along with the account timestamps and who may see
+// each field of the public profile.
+type Profile struct {
+	UserInfo
+	Visibility     map[string]Visibility `json:"visibility"`
+	FollowerCount  int                   `json:"follower_count"`
+	FollowingCount int                   `json:"following_count"`
+	CreateTime     time.Time             `json:"create_time"`
+	UpdateTime     time.Time             `json:"update_time"`
+}
+
+func NewProfile(u *ent.User) Profile {
+	return Profile{
+		UserInfo:       NewUserInfo(u),
+		Visibility:     ProfileVisibility(u),
+		FollowerCount:  u.FollowerCount,
+		FollowingCount: u.FollowingCount,
+		CreateTime:     u.CreateTime,
+		UpdateTime:     u.UpdateTime,
+	}
+}
+
+// ProfileChanges holds the fields a user may edit on their own profile.
+// Fields left out are untouched and an empty string clears the field.
+type ProfileChanges struct {
+	FirstName   *string `json:"first_name"`
+	LastName    *string `json:"last_name"`
+	DisplayName *string `json:"display_name"`
+	Bio         *string `json:"bio"`
+	Birthday    *string `json:"birthday"`
+	Locale      *string `json:"locale"`
+	Timezone    *string `json:"timezone"`
+	// Visibility changes who may see public profile fields, fields left out
+	// keep their current visibility.
+	Visibility map[string]Visibility `json:"visibility"`
+	// Private makes new follows of the account wait for approval.
+	Private *bool `json:"private"`
+
+	birthday *time.Time
+}
+
+// IsEmpty reports whether no field was sent.
+func (c *ProfileChanges) IsEmpty() bool {
+	return c.FirstName == nil && c.LastName == nil && c.DisplayName == nil && c.Bio == nil &&
+		c.Birthday == nil && c.Locale == nil && c.Timezone == nil && len(c.Visibility) == 0 && c.Private == nil
+}
+
+// Fields lists the names of the fields that were sent, for the audit log.
+func (c *ProfileChanges) Fields() []string {
+	fields := []string{}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"first_name", c.FirstName},
+		{"last_name", c.LastName},
+		{"display_name", c.DisplayName},
+		{"bio", c.Bio},
+		{"birthday", c.Birthday},
+		{"locale", c.Locale},
+		{"timezone", c.Timezone},
+	} {
+		if field.value != nil {
+			fields = append(fields, field.name)
+		}
+	}
+
+	if len(c.Visibility) > 0 {
+		fields = append(fields, "visibility")
+	}
+	if c.Private != nil {
+		fields = append(fields, "private")
+	}
+
+	return fields
+}
+
+// Validate trims the sent fields and checks them, returning a
+// *ValidationError naming every invalid field.
+func (c *ProfileChanges) Validate(now time.Time) error {
+	invalid := map[string]string{}
+
+	for name, value := range map[string]*string{
+		"first_name":   c.FirstName,
+		"last_name":    c.LastName,
+		"display_name": c.DisplayName,
+	} {
+		if value == nil {
+			continue
+		}
+		*value = strings.TrimSpace(*value)
+		if utf8.RuneCountInString(*value) > maxNameLength {
+			invalid[name] = "Must be at most 50 characters"
+		} else if hasControl(*value, false) {
+			invalid[name] = "Contains characters that are not allowed"
+		}
+	}
+
+	if c.Bio != nil {
+		*c.Bio = strings.TrimSpace(*c.Bio)
+		if utf8.RuneCountInString(*c.Bio) > maxBioLength {
+			invalid["bio"] = "Must be at most 300 characters"
+		} else if hasControl(*c.Bio, true) {
+			invalid["bio"] = "Contains characters that are not allowed"
+		}
+	}
+
+	if c.Birthday != nil && *c.Birthday != "" {
+		birthday, err := time.Parse(birthdayLayout, *c.Birthday)
+		switch {
+		case err != nil:
+			invalid["birthday"] = "Must be a date like 2000-01-31"
+		case birthday.After(now):
+			invalid["birthday"] = "Must not be in the future"
+		case birthday.Year() < 1900:
+			invalid["birthday"] = "Must be after 1900"
+		default:
+			c.birthday = &birthday
+		}
+	}
+
+	if c.Locale != nil {
+		*c.Locale = strings.TrimSpace(*c.Locale)
+		if *c.Locale != "" && (len(*c.Locale) > 35 || !localePattern.MatchString(*c.Locale)) {
+			invalid["locale"] = "Must be a language tag like en-IN"
+		}
+	}
+
+	if c.Timezone != nil {
+		*c.Timezone = strings.TrimSpace(*c.Timezone)
+		// LoadLocation also accepts "Local", which means nothing to other devices
+		if _, err := time.LoadLocation(*c.Timezone); *c.Timezone != "" && (err != nil || *c.Timezone == "Local") {
+			invalid["timezone"] = "Must be a time zone like Asia/Kolkata"
+		}
+	}
+
+	for field, visibility := range c.Visibility {
+		if _, ok := defaultVisibility[field]; !ok {
+			invalid["visibility."+field] = "Not a public profile field"
+		} else if !validVisibility(visibility) {
+			invalid["visibility."+field] = "Must be public, followers or private"
+		}
+	}
+
+	if len(invalid) > 0 {
+		return &ValidationError{Fields: invalid}
+	}
+
+	return nil
+}
+
+// hasControl reports whether s contains control characters, newlines are
+// allowed only in multiline fields.
+func hasControl(s string, multiline bool) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		if multiline && r == '\n' {
+			return false
+		}
+		return unicode.IsControl(r)
+	}) >= 0
+}
+
+// ValidationError lists the fields of a request that failed validation with
+// a message for each.
+type ValidationError struct {
+	Fields map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid fields: " + strings.Join(slices.Sorted(maps.Keys(e.Fields)), ", ")
+}
+
+// Respond writes the validation failure as a 400 response.
+func (e *ValidationError) Respond(ctx *fiber.Ctx) error {
+	return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"status":  "error",
+		"code":    CodeInvalidFields,
+		"message": "Some fields are invalid",
+		"data": fiber.Map{
+			"fields": e.Fields,
+		},
+	})
+}
+
+// AsValidation unwraps a *ValidationError from err.
+func AsValidation(err error) (*ValidationError, bool) {
+	var validation *ValidationError
+	ok := errors.As(err, &validation)
+	return validation, ok
+}
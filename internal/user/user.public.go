@@ -0,0 +1,175 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"time"
+
+	"github.com/shinplay/ent"
+)
+
+// Visibility says who may see a public profile field.
+type Visibility string
+
+const (
+	VisibilityPublic    Visibility = "public"
+	VisibilityFollowers Visibility = "followers"
+	VisibilityPrivate   Visibility = "private"
+)
+
+// Public profile fields whose visibility the owner controls. Username and
+// display name are always public, they are how people find each other.
+const (
+	ProfileFieldName     = "name"
+	ProfileFieldBio      = "bio"
+	ProfileFieldAvatar   = "avatar"
+	ProfileFieldBirthday = "birthday"
+	ProfileFieldJoined   = "joined"
+)
+
+// defaultVisibility applies to fields the owner has not set. It is not
+// stored, so changing it here changes it for everyone who kept the default.
+var defaultVisibility = map[string]Visibility{
+	ProfileFieldName:     VisibilityPublic,
+	ProfileFieldBio:      VisibilityPublic,
+	ProfileFieldAvatar:   VisibilityPublic,
+	ProfileFieldBirthday: VisibilityPrivate,
+	ProfileFieldJoined:   VisibilityPublic,
+}
+
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ProfileVisibility returns the visibility of every public profile field of
+// u, filling in the defaults.
+func ProfileVisibility(u *ent.User) map[string]Visibility {
+	visibility := maps.Clone(defaultVisibility)
+	for field, value := range u.ProfileVisibility {
+		if _, ok := visibility[field]; ok && validVisibility(Visibility(value)) {
+			visibility[field] = Visibility(value)
+		}
+	}
+
+	return visibility
+}
+
+func validVisibility(v Visibility) bool {
+	return v == VisibilityPublic || v == VisibilityFollowers || v == VisibilityPrivate
+}
+
+// Relationship is how the viewer of a profile relates to its owner.
+type Relationship struct {
+	self bool
+	// Following is true once the viewer follows the owner.
+	Following bool `json:"following"`
+	// FollowedBy is true when the owner follows the viewer.
+	FollowedBy bool `json:"followed_by"`
+	// Requested is true while the viewer's request to follow a private
+	// account waits for approval.
+	Requested bool `json:"requested"`
+	// Muting is true when the viewer muted the owner.
+	Muting bool `json:"muting"`
+}
+
+// IsSelf reports whether the viewer is looking at their own profile.
+func (r Relationship) IsSelf() bool {
+	return r.self
+}
+
+// Mutual reports whether the viewer and the owner follow each other.
+func (r Relationship) Mutual() bool {
+	return r.Following && r.FollowedBy
+}
+
+func (r Relationship) MarshalJSON() ([]byte, error) {
+	type relationship Relationship
+	return json.Marshal(struct {
+		relationship
+		Mutual bool `json:"mutual"`
+	}{relationship(r), r.Mutual()})
+}
+
+func (r Relationship) canSee(v Visibility) bool {
+	switch v {
+	case VisibilityPublic:
+		return true
+	case VisibilityFollowers:
+		return r.self || r.Following
+	default:
+		return r.self
+	}
+}
+
+// CanSeeConnections reports whether the viewer may list who the owner
+// follows and is followed by. Only followers may for a private account.
+func (r Relationship) CanSeeConnections(owner *ent.User) bool {
+	return !owner.Private || r.self || r.Following
+}
+
+// PublicProfile is what other people, signed in or not, see of a user. It
+// never carries contact details or internal identifiers, and fields hidden
+// from the viewer are left out.
+type PublicProfile struct {
+	UserName       string `json:"username"`
+	DisplayName    string `json:"display_name"`
+	Private        bool   `json:"private"`
+	FollowerCount  int    `json:"follower_count"`
+	FollowingCount int    `json:"following_count"`
+	// Relationship is left out for anonymous viewers and on one's own profile.
+	Relationship *Relationship     `json:"relationship,omitempty"`
+	FirstName    string            `json:"first_name,omitempty"`
+	LastName     string            `json:"last_name,omitempty"`
+	Bio          string            `json:"bio,omitempty"`
+	AvatarURLs   map[string]string `json:"avatar_urls,omitempty"`
+	Birthday     *string           `json:"birthday,omitempty"`
+	JoinedAt     *time.Time        `json:"joined_at,omitempty"`
+}
+
+// newPublicProfile projects u for a viewer with the given relation, which is
+// nil for anonymous viewers.
+func newPublicProfile(u *ent.User, viewer *Relationship) PublicProfile {
+	profile := PublicProfile{
+		UserName:       u.Username,
+		DisplayName:    u.DisplayName,
+		Private:        u.Private,
+		FollowerCount:  u.FollowerCount,
+		FollowingCount: u.FollowingCount,
+	}
+
+	var relation Relationship
+	if viewer != nil {
+		relation = *viewer
+		if !relation.self {
+			profile.Relationship = viewer
+		}
+	}
+
+	visibility := ProfileVisibility(u)
+	if relation.canSee(visibility[ProfileFieldName]) {
+		profile.FirstName = u.FirstName
+		profile.LastName = u.LastName
+	}
+	if relation.canSee(visibility[ProfileFieldBio]) {
+		profile.Bio = u.Bio
+	}
+	if relation.canSee(visibility[ProfileFieldAvatar]) {
+		profile.AvatarURLs = u.AvatarUrls
+	}
+	if relation.canSee(visibility[ProfileFieldBirthday]) && u.Birthday != nil {
+		birthday := u.Birthday.Format(birthdayLayout)
+		profile.Birthday = &birthday
+	}
+	if relation.canSee(visibility[ProfileFieldJoined]) {
+		joinedAt := u.CreateTime
+		profile.JoinedAt = &joinedAt
+	}
+
+	return profile
+}
+
+// IsListed reports whether u can be looked up by other people at all.
+// Restricted, deleted and guest accounts have no public profile, a
+// suspension that ran out no longer counts.
+func IsListed(u *ent.User) bool {
+	return CheckRestriction(u) == nil && !u.Guest && u.Username != ""
+}
@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/shinplay/internal/config"
+	"go.uber.org/zap"
+)
+
+const (
+	purgeInterval  = 1 * time.Hour
+	purgeBatchSize = 100
+)
+
+// AccountPurger anonymizes accounts whose deletion grace period has passed.
+type AccountPurger struct {
+	userRepository *UserRepository
+	userService    *UserService
+	config         *config.Config
+	ctx            context.Context
+}
+
+// NewAccountPurger creates a new AccountPurger instance.
+func NewAccountPurger(userRepository *UserRepository, userService *UserService, config *config.Config, ctx context.Context) *AccountPurger {
+	return &AccountPurger{
+		userRepository: userRepository,
+		userService:    userService,
+		config:         config,
+		ctx:            ctx,
+	}
+}
+
+// Run purges due accounts every purgeInterval until the context is done.
+func (p *AccountPurger) Run() {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.PurgeDue()
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeDue anonymizes every account scheduled for deletion before now. An
+// account that fails is logged and retried on the next run.
+func (p *AccountPurger) PurgeDue() int {
+	purged := 0
+	lastID := 0
+
+	for {
+		users, err := p.userRepository.FindDueForDeletion(p.ctx, time.Now(), lastID, purgeBatchSize)
+		if err != nil {
+			p.config.Logger.Error("Failed to find accounts due for deletion", zap.Error(err))
+			return purged
+		}
+
+		for _, user := range users {
+			lastID = user.ID
+			if err := p.userService.Anonymize(user); err != nil {
+				p.config.Logger.Error("Failed to purge account", zap.String("authID", user.AuthID), zap.Error(err))
+				continue
+			}
+			purged++
+		}
+
+		if len(users) < purgeBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		p.config.Logger.Info("Purged deleted accounts", zap.Int("count", purged))
+	}
+
+	return purged
+}
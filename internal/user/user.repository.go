@@ -2,10 +2,30 @@ package user
 
 import (
 	"context"
+	"maps"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/block"
+	"github.com/shinplay/ent/dataexport"
+	"github.com/shinplay/ent/deviceauthorization"
+	"github.com/shinplay/ent/emailverification"
+	"github.com/shinplay/ent/follow"
+	"github.com/shinplay/ent/mute"
+	"github.com/shinplay/ent/oauthauthorizationcode"
+	"github.com/shinplay/ent/oauthconsent"
+	"github.com/shinplay/ent/otp"
+	"github.com/shinplay/ent/personalaccesstoken"
+	"github.com/shinplay/ent/phonechangerequest"
+	"github.com/shinplay/ent/qrloginrequest"
+	"github.com/shinplay/ent/session"
 	"github.com/shinplay/ent/user"
+	"github.com/shinplay/ent/usernamehistory"
 	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/db"
+	"github.com/shinplay/internal/relation"
+	"github.com/shinplay/pkg/publicid"
 	"go.uber.org/zap"
 )
 
@@ -13,9 +33,54 @@ type UserRepositoryIntr interface {
 	GetByPhoneNumber(ctx context.Context, phoneNumber string) (*ent.User, error)
 	CreateByPhoneNumber(ctx context.Context, phoneNumber string) (*ent.User, error)
 	FindByEmail(ctx context.Context, email string) (*ent.User, error)
+	FindUnclaimedByEmail(ctx context.Context, email string) (*ent.User, error)
 	CreateByEmail(ctx context.Context, email string) (*ent.User, error)
+	MarkEmailVerified(ctx context.Context, user *ent.User) (*ent.User, error)
 	FindByUsername(ctx context.Context, username string) (*ent.User, error)
-	UpdateUsername(ctx context.Context, userID string, newUsername string) error
+	FindByNormalizedUsername(ctx context.Context, normalized string) (*ent.User, error)
+	FindVisibleByUsername(ctx context.Context, username string, viewerID int) (*ent.User, error)
+	ChangeUsername(ctx context.Context, user *ent.User, username string, normalized string, holdUntil time.Time) (*ent.User, error)
+	IsUsernameHeld(ctx context.Context, normalized string, userID int) (bool, error)
+	FindUsernameHistory(ctx context.Context, userID int) ([]*ent.UsernameHistory, error)
+	UnavailableUsernames(ctx context.Context, normalized []string, userID int) (map[string]bool, error)
+	FindUsernamesAfter(ctx context.Context, afterID int, limit int) ([]*ent.User, error)
+	SetUsernameNormalized(ctx context.Context, user *ent.User, normalized string) error
+	FindByAuthID(ctx context.Context, authID string) (*ent.User, error)
+	Search(ctx context.Context, filter SearchFilter, limit int) ([]*ent.User, error)
+	SearchDirectory(ctx context.Context, query string, viewerID int, after *SearchCursor, limit int) ([]SearchMatch, error)
+	FollowsBetween(ctx context.Context, viewerID int, ids []int) ([]*ent.Follow, error)
+	MutedAmong(ctx context.Context, viewerID int, ids []int) ([]int, error)
+	Update(ctx context.Context, user *ent.User, changes UserChanges) (*ent.User, error)
+	UpdateProfile(ctx context.Context, user *ent.User, changes ProfileChanges) (*ent.User, error)
+	SetAvatar(ctx context.Context, user *ent.User, key string, urls map[string]string) (*ent.User, error)
+	ClearAvatar(ctx context.Context, user *ent.User) (*ent.User, error)
+	UpdateSettings(ctx context.Context, user *ent.User, values map[string]any) (*ent.User, error)
+	IncrementLoginCount(ctx context.Context, user *ent.User) error
+	SetRestriction(ctx context.Context, user *ent.User, status user.Status, until *time.Time, reason string, actorAuthID string) (*ent.User, error)
+	ClearRestriction(ctx context.Context, user *ent.User) (*ent.User, error)
+	ScheduleDeletion(ctx context.Context, user *ent.User, scheduledAt time.Time) (*ent.User, error)
+	CancelDeletion(ctx context.Context, user *ent.User) (*ent.User, error)
+	RevokePersonalAccessTokens(ctx context.Context, user *ent.User) (int, error)
+	FindDueForDeletion(ctx context.Context, now time.Time, afterID int, limit int) ([]*ent.User, error)
+	Anonymize(ctx context.Context, user *ent.User) ([]string, error)
+	MergeGuest(ctx context.Context, guest *ent.User, into *ent.User) (*ent.User, []string, error)
+}
+
+// SearchFilter narrows down a user lookup, empty fields are ignored.
+type SearchFilter struct {
+	PhoneNumber string
+	Email       string
+	Username    string
+	AuthID      string
+}
+
+// UserChanges holds the profile fields to update, nil fields are left untouched.
+type UserChanges struct {
+	Username    *string `json:"username"`
+	Email       *string `json:"email"`
+	PhoneNumber *string `json:"phone_number"`
+	FirstName   *string `json:"first_name"`
+	LastName    *string `json:"last_name"`
 }
 
 type UserRepository struct {
@@ -39,31 +104,685 @@ func (r *UserRepository) GetByPhoneNumber(ctx context.Context, phoneNumber strin
 	return r.client.User.Query().Where(user.PhoneNumberEQ(phoneNumber)).Only(ctx)
 }
 
-// FindByEmail implements UserRepository.
+// FindByEmail returns the account that verified the address, unverified
+// copies of it on other accounts are ignored.
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*ent.User, error) {
-	return r.client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	return r.client.User.Query().
+		Where(
+			user.EmailEQ(email),
+			user.EmailVerifiedAtNotNil(),
+		).
+		Only(ctx)
 }
 
-// CreateByEmail implements UserRepository.
+// FindUnclaimedByEmail finds an email-only account created by Google sign-in
+// before addresses were verified. Accounts with a phone number are skipped as
+// their email may have been typed in by anyone.
+func (r *UserRepository) FindUnclaimedByEmail(ctx context.Context, email string) (*ent.User, error) {
+	return r.client.User.Query().
+		Where(
+			user.EmailEQ(email),
+			user.EmailVerifiedAtIsNil(),
+			user.Or(user.PhoneNumberIsNil(), user.PhoneNumberEQ("")),
+		).
+		Order(ent.Asc(user.FieldCreateTime)).
+		First(ctx)
+}
+
+// CreateByEmail creates an account for an address the identity provider has
+// already verified.
 func (r *UserRepository) CreateByEmail(ctx context.Context, email string) (*ent.User, error) {
 	return r.client.User.Create().
 		SetEmail(email).
+		SetEmailVerifiedAt(time.Now()).
 		Save(ctx)
 }
 
-// FindByUsername implements UserRepository.
+// MarkEmailVerified implements UserRepository.
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, u *ent.User) (*ent.User, error) {
+	return r.client.User.UpdateOne(u).
+		SetEmailVerifiedAt(time.Now()).
+		Save(ctx)
+}
+
+// FindByUsername finds the user whose username reads the same as username,
+// ignoring case and lookalike characters.
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*ent.User, error) {
-	return r.client.User.Query().Where(user.UsernameEQ(username)).Only(ctx)
+	return r.FindByNormalizedUsername(ctx, NormalizeUsername(username))
+}
+
+// FindByNormalizedUsername implements UserRepository.
+func (r *UserRepository) FindByNormalizedUsername(ctx context.Context, normalized string) (*ent.User, error) {
+	return r.client.User.Query().Where(user.UsernameNormalizedEQ(normalized)).Only(ctx)
 }
 
-// UpdateUsername implements UserRepository.
-func (r *UserRepository) UpdateUsername(ctx context.Context, authId string, newUsername string) (*ent.User, error) {
-	user, err := r.client.User.Query().Where(user.AuthIDEQ(authId)).Only(ctx)
+// FindVisibleByUsername implements UserRepository. Users the viewer blocked
+// or was blocked by are not found.
+func (r *UserRepository) FindVisibleByUsername(ctx context.Context, username string, viewerID int) (*ent.User, error) {
+	return r.client.User.Query().
+		Where(user.UsernameNormalizedEQ(NormalizeUsername(username)), relation.Unblocked(viewerID)).
+		Only(ctx)
+}
+
+// ChangeUsername sets the username and records the one it replaces in the
+// history, holding it until holdUntil. The unique index on the normalized
+// username rejects the change if someone else claimed it concurrently.
+func (r *UserRepository) ChangeUsername(ctx context.Context, u *ent.User, username string, normalized string, holdUntil time.Time) (*ent.User, error) {
+	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.client.User.UpdateOne(user).
-		SetUsername(newUsername).
+	if u.Username != "" && (u.UsernameNormalized == nil || *u.UsernameNormalized != normalized) {
+		err := tx.UsernameHistory.Create().
+			SetUser(u).
+			SetUsername(u.Username).
+			SetUsernameNormalized(NormalizeUsername(u.Username)).
+			SetHoldUntil(holdUntil).
+			Exec(ctx)
+		if err != nil {
+			return nil, db.Rollback(tx, err)
+		}
+	}
+
+	updated, err := tx.User.UpdateOne(u).
+		SetUsername(username).
+		SetUsernameNormalized(normalized).
+		SetUsernameChangedAt(time.Now()).
 		Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	return updated, tx.Commit()
+}
+
+// IsUsernameHeld reports whether someone other than the user released the
+// username recently enough that it is still held for them.
+func (r *UserRepository) IsUsernameHeld(ctx context.Context, normalized string, userID int) (bool, error) {
+	return r.client.UsernameHistory.Query().
+		Where(
+			usernamehistory.UsernameNormalizedEQ(normalized),
+			usernamehistory.HoldUntilGT(time.Now()),
+			usernamehistory.Not(usernamehistory.HasUserWith(user.IDEQ(userID))),
+		).
+		Exist(ctx)
+}
+
+// UnavailableUsernames checks a batch of normalized usernames with one query
+// for owners and one for holds, returning the ones the user cannot claim.
+func (r *UserRepository) UnavailableUsernames(ctx context.Context, normalized []string, userID int) (map[string]bool, error) {
+	unavailable := map[string]bool{}
+	if len(normalized) == 0 {
+		return unavailable, nil
+	}
+
+	var owned []string
+	err := r.client.User.Query().
+		Where(
+			user.UsernameNormalizedIn(normalized...),
+			user.IDNEQ(userID),
+		).
+		Select(user.FieldUsernameNormalized).
+		Scan(ctx, &owned)
+	if err != nil {
+		return nil, err
+	}
+
+	var held []string
+	err = r.client.UsernameHistory.Query().
+		Where(
+			usernamehistory.UsernameNormalizedIn(normalized...),
+			usernamehistory.HoldUntilGT(time.Now()),
+			usernamehistory.Not(usernamehistory.HasUserWith(user.IDEQ(userID))),
+		).
+		Select(usernamehistory.FieldUsernameNormalized).
+		Scan(ctx, &held)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range append(owned, held...) {
+		unavailable[name] = true
+	}
+
+	return unavailable, nil
+}
+
+// FindUsernameHistory returns the usernames the user has released, newest
+// first.
+func (r *UserRepository) FindUsernameHistory(ctx context.Context, userID int) ([]*ent.UsernameHistory, error) {
+	return r.client.UsernameHistory.Query().
+		Where(usernamehistory.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(usernamehistory.FieldCreateTime)).
+		All(ctx)
+}
+
+// FindUsernamesAfter returns users with a username, paged by ID, loading only
+// what checking the normalized username needs.
+func (r *UserRepository) FindUsernamesAfter(ctx context.Context, afterID int, limit int) ([]*ent.User, error) {
+	return r.client.User.Query().
+		Where(
+			user.UsernameNEQ(""),
+			user.IDGT(afterID),
+		).
+		Order(ent.Asc(user.FieldID)).
+		Select(user.FieldAuthID, user.FieldUsername, user.FieldUsernameNormalized).
+		Limit(limit).
+		All(ctx)
+}
+
+// SetUsernameNormalized implements UserRepository.
+func (r *UserRepository) SetUsernameNormalized(ctx context.Context, u *ent.User, normalized string) error {
+	return r.client.User.UpdateOne(u).
+		SetUsernameNormalized(normalized).
+		Exec(ctx)
+}
+
+// FindByAuthID implements UserRepository.
+func (r *UserRepository) FindByAuthID(ctx context.Context, authID string) (*ent.User, error) {
+	return r.client.User.Query().Where(user.AuthIDEQ(authID)).Only(ctx)
+}
+
+// Search implements UserRepository.
+func (r *UserRepository) Search(ctx context.Context, filter SearchFilter, limit int) ([]*ent.User, error) {
+	query := r.client.User.Query()
+
+	if filter.AuthID != "" {
+		query = query.Where(user.AuthIDEQ(filter.AuthID))
+	}
+	if filter.PhoneNumber != "" {
+		query = query.Where(user.PhoneNumberContains(filter.PhoneNumber))
+	}
+	if filter.Email != "" {
+		query = query.Where(user.EmailContainsFold(filter.Email))
+	}
+	if filter.Username != "" {
+		query = query.Where(user.UsernameContainsFold(filter.Username))
+	}
+
+	return query.
+		Order(ent.Desc(user.FieldCreateTime)).
+		Limit(limit).
+		All(ctx)
+}
+
+// SearchDirectory implements UserRepository. It finds the listed users whose
+// username or names match the query, best matches first, leaving out anyone
+// the viewer blocked or was blocked by.
+func (r *UserRepository) SearchDirectory(ctx context.Context, query string, viewerID int, after *SearchCursor, limit int) ([]SearchMatch, error) {
+	search := newUserSearch(query)
+
+	var rows []struct {
+		ID    int     `json:"id"`
+		Rank  int     `json:"search_rank"`
+		Score float64 `json:"search_score"`
+	}
+	err := r.client.User.Query().
+		Where(
+			unrestricted(time.Now()),
+			user.Guest(false),
+			user.UsernameNEQ(""),
+			relation.Unblocked(viewerID),
+			func(s *sql.Selector) {
+				search.match(s)
+				if after != nil {
+					search.after(s, *after)
+				}
+			},
+		).
+		Limit(limit).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(user.FieldID)).
+				AppendSelectExprAs(search.rank(s), "search_rank").
+				AppendSelectExprAs(search.score(s), "search_score").
+				OrderExpr(sql.Raw("search_rank, search_score DESC, " + s.C(user.FieldID)))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	users, err := r.client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	matches := make([]SearchMatch, 0, len(rows))
+	for _, row := range rows {
+		// deleted between the two queries
+		if byID[row.ID] == nil {
+			continue
+		}
+		matches = append(matches, SearchMatch{
+			User:   byID[row.ID],
+			Cursor: SearchCursor{Rank: row.Rank, Score: row.Score, ID: row.ID},
+		})
+	}
+
+	return matches, nil
+}
+
+// FollowsBetween implements UserRepository. It returns the viewer's follows
+// and follow requests of the users, and their accepted follows of the viewer.
+func (r *UserRepository) FollowsBetween(ctx context.Context, viewerID int, ids []int) ([]*ent.Follow, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return r.client.Follow.Query().
+		Where(follow.Or(
+			follow.And(follow.FollowerID(viewerID), follow.FolloweeIDIn(ids...)),
+			follow.And(follow.FolloweeID(viewerID), follow.FollowerIDIn(ids...), follow.StatusEQ(follow.StatusAccepted)),
+		)).
+		All(ctx)
+}
+
+// MutedAmong implements UserRepository. It returns which of the users the
+// viewer muted.
+func (r *UserRepository) MutedAmong(ctx context.Context, viewerID int, ids []int) ([]int, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return r.client.User.Query().
+		Where(user.IDIn(ids...), user.HasMutedByWith(mute.MuterID(viewerID))).
+		IDs(ctx)
+}
+
+// Update implements UserRepository. The username is left alone, it goes
+// through UserService.SetUsername so the username policy applies.
+func (r *UserRepository) Update(ctx context.Context, u *ent.User, changes UserChanges) (*ent.User, error) {
+	update := r.client.User.UpdateOne(u)
+
+	if changes.Email != nil && *changes.Email != u.Email {
+		// an address set by someone else has not been verified by the user
+		update.SetEmail(*changes.Email).ClearEmailVerifiedAt()
+	}
+	if changes.PhoneNumber != nil {
+		update.SetPhoneNumber(*changes.PhoneNumber)
+	}
+	if changes.FirstName != nil {
+		update.SetFirstName(*changes.FirstName)
+	}
+	if changes.LastName != nil {
+		update.SetLastName(*changes.LastName)
+	}
+
+	return update.Save(ctx)
+}
+
+// UpdateProfile implements UserRepository. Changes must have been validated,
+// empty strings clear the field.
+func (r *UserRepository) UpdateProfile(ctx context.Context, u *ent.User, changes ProfileChanges) (*ent.User, error) {
+	update := r.client.User.UpdateOne(u)
+
+	setOrClear(changes.FirstName, update.SetFirstName, update.ClearFirstName)
+	setOrClear(changes.LastName, update.SetLastName, update.ClearLastName)
+	setOrClear(changes.DisplayName, update.SetDisplayName, update.ClearDisplayName)
+	setOrClear(changes.Bio, update.SetBio, update.ClearBio)
+	setOrClear(changes.Locale, update.SetLocale, update.ClearLocale)
+	setOrClear(changes.Timezone, update.SetTimezone, update.ClearTimezone)
+
+	if changes.Private != nil {
+		update.SetPrivate(*changes.Private)
+	}
+
+	if changes.Birthday != nil {
+		if changes.birthday != nil {
+			update.SetBirthday(*changes.birthday)
+		} else {
+			update.ClearBirthday()
+		}
+	}
+
+	if len(changes.Visibility) > 0 {
+		visibility := maps.Clone(u.ProfileVisibility)
+		if visibility == nil {
+			visibility = map[string]string{}
+		}
+		for field, value := range changes.Visibility {
+			visibility[field] = string(value)
+		}
+		update.SetProfileVisibility(visibility)
+	}
+
+	return update.Save(ctx)
+}
+
+// SetAvatar implements UserRepository.
+func (r *UserRepository) SetAvatar(ctx context.Context, u *ent.User, key string, urls map[string]string) (*ent.User, error) {
+	return r.client.User.UpdateOne(u).SetAvatarKey(key).SetAvatarUrls(urls).Save(ctx)
+}
+
+// ClearAvatar implements UserRepository.
+func (r *UserRepository) ClearAvatar(ctx context.Context, u *ent.User) (*ent.User, error) {
+	return r.client.User.UpdateOne(u).ClearAvatarKey().ClearAvatarUrls().Save(ctx)
+}
+
+// UpdateSettings implements UserRepository. Values must have been validated,
+// a nil value removes the setting so the default applies again. The stored
+// settings are re-read under a row lock so concurrent updates of different
+// settings do not overwrite each other.
+func (r *UserRepository) UpdateSettings(ctx context.Context, u *ent.User, values map[string]any) (*ent.User, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := tx.User.Query().Where(user.IDEQ(u.ID)).ForUpdate().Only(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	stored := maps.Clone(current.Settings)
+	if stored == nil {
+		stored = map[string]any{}
+	}
+	for name, value := range values {
+		if value == nil {
+			delete(stored, name)
+		} else {
+			stored[name] = value
+		}
+	}
+
+	update := tx.User.UpdateOne(current)
+	if len(stored) == 0 {
+		update.ClearSettings()
+	} else {
+		update.SetSettings(stored)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	return updated, tx.Commit()
+}
+
+// setOrClear applies a partial update to an optional string field.
+func setOrClear(value *string, set func(string) *ent.UserUpdateOne, clear func() *ent.UserUpdateOne) {
+	switch {
+	case value == nil:
+	case *value == "":
+		clear()
+	default:
+		set(*value)
+	}
+}
+
+// IncrementLoginCount implements UserRepository.
+func (r *UserRepository) IncrementLoginCount(ctx context.Context, u *ent.User) error {
+	return r.client.User.UpdateOne(u).AddLoginCount(1).Exec(ctx)
+}
+
+// SetRestriction implements UserRepository.
+func (r *UserRepository) SetRestriction(ctx context.Context, u *ent.User, status user.Status, until *time.Time, reason string, actorAuthID string) (*ent.User, error) {
+	update := r.client.User.UpdateOne(u).
+		SetStatus(status).
+		SetRestrictionReason(reason).
+		SetRestrictedBy(actorAuthID).
+		SetRestrictedAt(time.Now())
+
+	if until != nil {
+		update.SetSuspendedUntil(*until)
+	} else {
+		update.ClearSuspendedUntil()
+	}
+
+	return update.Save(ctx)
+}
+
+// ClearRestriction implements UserRepository.
+func (r *UserRepository) ClearRestriction(ctx context.Context, u *ent.User) (*ent.User, error) {
+	return r.client.User.UpdateOne(u).
+		SetStatus(user.StatusActive).
+		ClearSuspendedUntil().
+		ClearRestrictionReason().
+		ClearRestrictedBy().
+		ClearRestrictedAt().
+		Save(ctx)
+}
+
+// ScheduleDeletion implements UserRepository.
+func (r *UserRepository) ScheduleDeletion(ctx context.Context, u *ent.User, scheduledAt time.Time) (*ent.User, error) {
+	return r.client.User.UpdateOne(u).
+		SetDeletionRequestedAt(time.Now()).
+		SetDeletionScheduledAt(scheduledAt).
+		Save(ctx)
+}
+
+// CancelDeletion implements UserRepository.
+func (r *UserRepository) CancelDeletion(ctx context.Context, u *ent.User) (*ent.User, error) {
+	return r.client.User.UpdateOne(u).
+		ClearDeletionRequestedAt().
+		ClearDeletionScheduledAt().
+		Save(ctx)
+}
+
+// RevokePersonalAccessTokens implements UserRepository.
+func (r *UserRepository) RevokePersonalAccessTokens(ctx context.Context, u *ent.User) (int, error) {
+	return r.client.PersonalAccessToken.Update().
+		Where(
+			personalaccesstoken.HasUserWith(user.IDEQ(u.ID)),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+// FindDueForDeletion implements UserRepository. Users are paged by ID so an
+// account that fails to purge does not hold up the ones after it.
+func (r *UserRepository) FindDueForDeletion(ctx context.Context, now time.Time, afterID int, limit int) ([]*ent.User, error) {
+	return r.client.User.Query().
+		Where(
+			user.StatusNEQ(user.StatusDeleted),
+			user.DeletionScheduledAtLTE(now),
+			user.IDGT(afterID),
+		).
+		Order(ent.Asc(user.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Anonymize implements UserRepository. It removes the sessions and OTPs of the
+// user and strips every identifying field so phone number, email and username
+// can be claimed again. The auth ID is rotated so old tokens stop resolving.
+// It returns the data export archives the caller has to delete.
+func (r *UserRepository) Anonymize(ctx context.Context, u *ent.User) ([]string, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	archives, err := anonymize(ctx, tx, u)
+	if err != nil {
+		return nil, db.Rollback(tx, err)
+	}
+
+	return archives, tx.Commit()
+}
+
+// MergeGuest folds a guest into an existing account once the guest proves
+// they own it. Profile fields the account has not filled in are taken over
+// from the guest, then the guest is anonymized like a deleted account and its
+// data export archives are returned for the caller to delete.
+func (r *UserRepository) MergeGuest(ctx context.Context, guest *ent.User, into *ent.User) (*ent.User, []string, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the guest goes first so its username is free to move
+	archives, err := anonymize(ctx, tx, guest)
+	if err != nil {
+		return nil, nil, db.Rollback(tx, err)
+	}
+
+	update := tx.User.UpdateOne(into)
+	if into.Username == "" && guest.Username != "" {
+		update.SetUsername(guest.Username).SetUsernameNormalized(NormalizeUsername(guest.Username))
+	}
+	if into.FirstName == "" && guest.FirstName != "" {
+		update.SetFirstName(guest.FirstName)
+	}
+	if into.LastName == "" && guest.LastName != "" {
+		update.SetLastName(guest.LastName)
+	}
+	if into.DisplayName == "" && guest.DisplayName != "" {
+		update.SetDisplayName(guest.DisplayName)
+	}
+	if into.Bio == "" && guest.Bio != "" {
+		update.SetBio(guest.Bio)
+	}
+	if into.Birthday == nil && guest.Birthday != nil {
+		update.SetBirthday(*guest.Birthday)
+	}
+	if into.Locale == "" && guest.Locale != "" {
+		update.SetLocale(guest.Locale)
+	}
+	if into.Timezone == "" && guest.Timezone != "" {
+		update.SetTimezone(guest.Timezone)
+	}
+	if into.AvatarKey == "" && guest.AvatarKey != "" {
+		update.SetAvatarKey(guest.AvatarKey).SetAvatarUrls(guest.AvatarUrls)
+	}
+	if into.ProfileVisibility == nil && guest.ProfileVisibility != nil {
+		update.SetProfileVisibility(guest.ProfileVisibility)
+	}
+	if into.Settings == nil && guest.Settings != nil {
+		update.SetSettings(guest.Settings)
+	}
+
+	merged, err := update.Save(ctx)
+	if err != nil {
+		return nil, nil, db.Rollback(tx, err)
+	}
+
+	return merged, archives, tx.Commit()
+}
+
+// anonymize runs Anonymize inside tx.
+func anonymize(ctx context.Context, tx *ent.Tx, u *ent.User) ([]string, error) {
+	exports, err := tx.DataExport.Query().Where(dataexport.HasUserWith(user.IDEQ(u.ID))).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	archives := []string{}
+	for _, export := range exports {
+		if export.FilePath != "" {
+			archives = append(archives, export.FilePath)
+		}
+	}
+
+	if _, err := tx.DataExport.Delete().Where(dataexport.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Session.Delete().Where(session.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.OTP.Delete().Where(otp.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.PersonalAccessToken.Delete().Where(personalaccesstoken.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.OAuthConsent.Delete().Where(oauthconsent.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.OAuthAuthorizationCode.Delete().Where(oauthauthorizationcode.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.DeviceAuthorization.Delete().Where(deviceauthorization.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.QRLoginRequest.Delete().Where(qrloginrequest.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.PhoneChangeRequest.Delete().Where(phonechangerequest.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.EmailVerification.Delete().Where(emailverification.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.UsernameHistory.Delete().Where(usernamehistory.HasUserWith(user.IDEQ(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	// the counts of everyone the user followed or was followed by drop by one
+	if _, err := tx.User.Update().
+		Where(user.HasFollowersWith(follow.FollowerID(u.ID), follow.StatusEQ(follow.StatusAccepted))).
+		AddFollowerCount(-1).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.User.Update().
+		Where(user.HasFollowingWith(follow.FolloweeID(u.ID), follow.StatusEQ(follow.StatusAccepted))).
+		AddFollowingCount(-1).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Follow.Delete().Where(follow.Or(follow.FollowerID(u.ID), follow.FolloweeID(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Block.Delete().Where(block.Or(block.BlockerID(u.ID), block.BlockedID(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Mute.Delete().Where(mute.Or(mute.MuterID(u.ID), mute.MutedID(u.ID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return archives, tx.User.UpdateOne(u).
+		SetAuthID(publicid.Must()).
+		SetStatus(user.StatusDeleted).
+		ClearUsername().
+		ClearUsernameNormalized().
+		ClearUsernameChangedAt().
+		ClearEmail().
+		ClearEmailVerifiedAt().
+		ClearPhoneNumber().
+		ClearFirstName().
+		ClearLastName().
+		ClearDisplayName().
+		ClearBio().
+		ClearBirthday().
+		ClearLocale().
+		ClearTimezone().
+		ClearAvatarKey().
+		ClearAvatarUrls().
+		ClearProfileVisibility().
+		ClearSettings().
+		SetPrivate(false).
+		SetFollowerCount(0).
+		SetFollowingCount(0).
+		ClearRoles().
+		ClearGuestDeviceHash().
+		Exec(ctx)
 }
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/predicate"
+	"github.com/shinplay/ent/user"
+)
+
+// Error codes returned to clients so they can show an appeal message.
+const (
+	CodeAccountSuspended = "account_suspended"
+	CodeAccountBanned    = "account_banned"
+	CodeAccountDeleted   = "account_deleted"
+)
+
+// RestrictionError is returned whenever a suspended or banned user tries to
+// sign in or use the API.
+type RestrictionError struct {
+	Code   string
+	Reason string
+	Until  *time.Time
+}
+
+func (e *RestrictionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Reason)
+}
+
+// Respond writes the restriction as a 403 response.
+func (e *RestrictionError) Respond(ctx *fiber.Ctx) error {
+	message := "Your account has been banned"
+	switch e.Code {
+	case CodeAccountSuspended:
+		message = "Your account has been suspended"
+	case CodeAccountDeleted:
+		message = "This account has been deleted"
+	}
+
+	return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+		"status":  "error",
+		"code":    e.Code,
+		"message": message,
+		"data": fiber.Map{
+			"reason": e.Reason,
+			"until":  e.Until,
+		},
+	})
+}
+
+// CheckRestriction returns a *RestrictionError when the user may not use the
+// API. Suspensions whose end date has passed are treated as lifted.
+func CheckRestriction(u *ent.User) error {
+	if u == nil {
+		return nil
+	}
+
+	switch u.Status {
+	case user.StatusDeleted:
+		return &RestrictionError{Code: CodeAccountDeleted}
+	case user.StatusBanned:
+		return &RestrictionError{Code: CodeAccountBanned, Reason: u.RestrictionReason}
+	case user.StatusSuspended:
+		if u.SuspendedUntil != nil && u.SuspendedUntil.Before(time.Now()) {
+			return nil
+		}
+		return &RestrictionError{Code: CodeAccountSuspended, Reason: u.RestrictionReason, Until: u.SuspendedUntil}
+	}
+
+	return nil
+}
+
+// unrestricted matches the accounts CheckRestriction lets in at now.
+func unrestricted(now time.Time) predicate.User {
+	return user.Or(
+		user.StatusEQ(user.StatusActive),
+		user.And(user.StatusEQ(user.StatusSuspended), user.SuspendedUntilLT(now)),
+	)
+}
+
+// AsRestriction unwraps a *RestrictionError from err.
+func AsRestriction(err error) (*RestrictionError, bool) {
+	var restriction *RestrictionError
+	ok := errors.As(err, &restriction)
+	return restriction, ok
+}
@@ -0,0 +1,145 @@
+package user
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/user"
+)
+
+const (
+	minSearchLength    = 2
+	maxSearchLength    = 50
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+)
+
+var ErrInvalidSearchQuery = errors.New("search query must be between 2 and 50 characters")
+
+// SearchCursor is where a page of search results ended. Results are ordered
+// by rank, prefix matches first, then by descending score and by ID.
+type SearchCursor struct {
+	Rank  int     `json:"r"`
+	Score float64 `json:"s"`
+	ID    int     `json:"i"`
+}
+
+// SearchMatch is a user found by a search and its position in the results.
+type SearchMatch struct {
+	User   *ent.User
+	Cursor SearchCursor
+}
+
+// searchColumns are matched against the query, usernames by their
+// normalized form so confusable spellings still find each other.
+var searchColumns = []string{user.FieldDisplayName, user.FieldFirstName, user.FieldLastName}
+
+// userSearch builds the SQL of a user search. Postgres ranks with pg_trgm,
+// other dialects, such as SQLite in tests, only match substrings and give
+// every match the same score.
+type userSearch struct {
+	query      string
+	normalized string
+}
+
+func newUserSearch(query string) userSearch {
+	return userSearch{
+		query:      strings.ToLower(query),
+		normalized: NormalizeUsername(query),
+	}
+}
+
+// match filters the users the query finds.
+func (q userSearch) match(s *sql.Selector) {
+	s.Where(sql.P(func(b *sql.Builder) {
+		b.Wrap(func(b *sql.Builder) {
+			q.prefix(s, b)
+			if s.Dialect() == dialect.Postgres {
+				b.WriteString(" OR ").WriteString(s.C(user.FieldUsernameNormalized)).WriteString(" % ").Arg(q.normalized)
+				for _, column := range searchColumns {
+					b.WriteString(" OR ").WriteString(s.C(column)).WriteString(" % ").Arg(q.query)
+				}
+				return
+			}
+			b.WriteString(" OR ")
+			q.like(s, b, "%"+escapeLike(q.normalized)+"%", "%"+escapeLike(q.query)+"%")
+		})
+	}))
+}
+
+// prefix writes the condition of a prefix match, which outranks any fuzzy one.
+func (q userSearch) prefix(s *sql.Selector, b *sql.Builder) {
+	b.Wrap(func(b *sql.Builder) {
+		q.like(s, b, escapeLike(q.normalized)+"%", escapeLike(q.query)+"%")
+	})
+}
+
+func (q userSearch) like(s *sql.Selector, b *sql.Builder, usernamePattern string, namePattern string) {
+	// Postgres' LIKE is case-sensitive, SQLite's is not for ASCII
+	like := " LIKE "
+	if s.Dialect() == dialect.Postgres {
+		like = " ILIKE "
+	}
+
+	b.WriteString(s.C(user.FieldUsernameNormalized)).WriteString(" LIKE ").Arg(usernamePattern).WriteString(` ESCAPE '\'`)
+	for _, column := range searchColumns {
+		b.WriteString(" OR ").WriteString(s.C(column)).WriteString(like).Arg(namePattern).WriteString(` ESCAPE '\'`)
+	}
+}
+
+// rank is 0 for prefix matches and 1 for the rest.
+func (q userSearch) rank(s *sql.Selector) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("CASE WHEN ")
+		q.prefix(s, b)
+		b.WriteString(" THEN 0 ELSE 1 END")
+	})
+}
+
+// score is the best trigram similarity of any column to the query.
+func (q userSearch) score(s *sql.Selector) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		if s.Dialect() != dialect.Postgres {
+			b.WriteString("0.0")
+			return
+		}
+
+		// float8 so the score survives the round trip through a cursor exactly
+		b.WriteString("GREATEST(similarity(").WriteString(s.C(user.FieldUsernameNormalized)).WriteString(", ").Arg(q.normalized).WriteString(")")
+		for _, column := range searchColumns {
+			b.WriteString(", similarity(").WriteString(s.C(column)).WriteString(", ").Arg(q.query).WriteString(")")
+		}
+		b.WriteString(")::float8")
+	})
+}
+
+// after keeps the results that come after the cursor.
+func (q userSearch) after(s *sql.Selector, cursor SearchCursor) {
+	s.Where(sql.P(func(b *sql.Builder) {
+		b.Wrap(func(b *sql.Builder) {
+			b.Join(q.rank(s)).WriteString(", -").Join(q.score(s)).WriteString(", ").WriteString(s.C(user.FieldID))
+		})
+		b.WriteString(" > ")
+		b.Wrap(func(b *sql.Builder) {
+			b.Arg(cursor.Rank).Comma().Arg(-cursor.Score).Comma().Arg(cursor.ID)
+		})
+	}))
+}
+
+// escapeLike escapes the LIKE wildcards in s, which usernames may contain.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// cleanSearchQuery trims a search query, reporting whether it is long enough
+// to search for.
+func cleanSearchQuery(query string) (string, bool) {
+	query = strings.Join(strings.Fields(query), " ")
+	length := utf8.RuneCountInString(query)
+
+	return query, length >= minSearchLength && length <= maxSearchLength
+}
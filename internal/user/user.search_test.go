@@ -0,0 +1,198 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/enttest"
+	"github.com/shinplay/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newSearchRepository(t *testing.T) (*UserRepository, *ent.Client) {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	return NewUserRepository(client), client
+}
+
+func createSearchUser(t *testing.T, client *ent.Client, username string, displayName string) *ent.User {
+	t.Helper()
+
+	u, err := client.User.Create().
+		SetUsername(username).
+		SetUsernameNormalized(NormalizeUsername(username)).
+		SetDisplayName(displayName).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating %s: %v", username, err)
+	}
+
+	return u
+}
+
+func searchUsernames(matches []SearchMatch) []string {
+	usernames := make([]string, 0, len(matches))
+	for _, match := range matches {
+		usernames = append(usernames, match.User.Username)
+	}
+	return usernames
+}
+
+func TestSearchDirectoryRanksPrefixMatchesFirst(t *testing.T) {
+	repository, client := newSearchRepository(t)
+	ctx := context.Background()
+
+	createSearchUser(t, client, "natalie", "Natalie")
+	createSearchUser(t, client, "bob", "Bob")
+	createSearchUser(t, client, "alice", "Alice")
+	createSearchUser(t, client, "rosalind", "Ali Rosa")
+
+	matches, err := repository.SearchDirectory(ctx, "ali", 0, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"alice", "rosalind", "natalie"}
+	got := searchUsernames(matches)
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	for i, rank := range []int{0, 0, 1} {
+		if matches[i].Cursor.Rank != rank {
+			t.Errorf("%s has rank %d, want %d", got[i], matches[i].Cursor.Rank, rank)
+		}
+	}
+}
+
+func TestSearchDirectoryTreatsWildcardsLiterally(t *testing.T) {
+	repository, client := newSearchRepository(t)
+	ctx := context.Background()
+
+	createSearchUser(t, client, "a_b", "")
+	createSearchUser(t, client, "axb", "")
+	createSearchUser(t, client, "ab", "Fifty%Off")
+	createSearchUser(t, client, "cd", "Fifty Off")
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"a_b", "a_b"},
+		{"y%o", "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			matches, err := repository.SearchDirectory(ctx, tt.query, 0, nil, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := searchUsernames(matches)
+			if len(got) != 1 || got[0] != tt.want {
+				t.Fatalf("got %v, want [%s]", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchDirectoryPages(t *testing.T) {
+	repository, client := newSearchRepository(t)
+	ctx := context.Background()
+
+	want := map[string]bool{}
+	for _, username := range []string{"sam", "samir", "sameer", "samantha", "isam", "hussam", "osama"} {
+		createSearchUser(t, client, username, "")
+		want[username] = true
+	}
+
+	seen := map[string]bool{}
+	order := []int{}
+	var after *SearchCursor
+	for page := 0; ; page++ {
+		if page > len(want) {
+			t.Fatal("paging did not end")
+		}
+
+		matches, err := repository.SearchDirectory(ctx, "sam", 0, after, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(matches) == 0 {
+			break
+		}
+
+		for _, match := range matches {
+			if seen[match.User.Username] {
+				t.Fatalf("%s returned twice", match.User.Username)
+			}
+			seen[match.User.Username] = true
+			order = append(order, match.Cursor.Rank)
+		}
+		after = &matches[len(matches)-1].Cursor
+	}
+
+	if len(seen) != len(want) {
+		t.Fatalf("got %v, want %v", seen, want)
+	}
+	for i := 1; i < len(order); i++ {
+		if order[i] < order[i-1] {
+			t.Fatalf("ranks out of order across pages: %v", order)
+		}
+	}
+}
+
+func TestSearchDirectoryLeavesOutUnlistedUsers(t *testing.T) {
+	repository, client := newSearchRepository(t)
+	ctx := context.Background()
+
+	createSearchUser(t, client, "kim", "")
+	suspended := createSearchUser(t, client, "kimberly", "")
+	expired := createSearchUser(t, client, "kimi", "")
+	guest := createSearchUser(t, client, "kimora", "")
+
+	client.User.UpdateOne(suspended).SetStatus(user.StatusSuspended).SetSuspendedUntil(time.Now().Add(time.Hour)).ExecX(ctx)
+	client.User.UpdateOne(expired).SetStatus(user.StatusSuspended).SetSuspendedUntil(time.Now().Add(-time.Hour)).ExecX(ctx)
+	client.User.UpdateOne(guest).SetGuest(true).ExecX(ctx)
+
+	matches, err := repository.SearchDirectory(ctx, "kim", 0, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := searchUsernames(matches)
+	if len(got) != 2 || got[0] != "kim" || got[1] != "kimi" {
+		t.Fatalf("got %v, want [kim kimi]", got)
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"alice", "alice"},
+		{"a_b", `a\_b`},
+		{"50%", `50\%`},
+		{`back\slash`, `back\\slash`},
+		{`\_%`, `\\\_\%`},
+	}
+
+	for _, tt := range tests {
+		if got := escapeLike(tt.in); got != tt.want {
+			t.Errorf("escapeLike(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
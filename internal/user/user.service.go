@@ -2,35 +2,72 @@ package user
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
 
 	"github.com/shinplay/ent"
+	"github.com/shinplay/ent/follow"
 	"github.com/shinplay/ent/user"
+	"github.com/shinplay/internal/auth/session"
 	"github.com/shinplay/internal/config"
+	"github.com/shinplay/internal/storage"
+	"github.com/shinplay/pkg/cursor"
 	"go.uber.org/zap"
 )
 
 type UserServiceIntr interface {
 	FindOrCreateByPhone(phoneNumber string) (*ent.User, error)
 	FindOrCreateByEmail(email string) (*ent.User, error)
+	FindByEmail(email string) (*ent.User, error)
 	FindByPhone(phoneNumber string) (*ent.User, error)
 	FindByUsername(username string) (*ent.User, error)
-	ChangeUsername(userID string, newUsername string) error
+	ChangeUsername(user *ent.User, newUsername string) (*ent.User, error)
+	SetUsername(user *ent.User, newUsername string) (*ent.User, error)
+	CheckUsername(user *ent.User, username string) error
+	SuggestUsernames(user *ent.User, requested string, limit int) ([]string, error)
+	BackfillUsernames() error
 	FindUserByAuthID(authID string) (*ent.User, error)
+	RecordLogin(user *ent.User) error
+	CheckRestriction(user *ent.User) error
+	ScheduleDeletion(user *ent.User) (*ent.User, error)
+	CancelDeletion(user *ent.User) error
+	UpdateProfile(user *ent.User, changes ProfileChanges) (*ent.User, error)
+	UpdateSettings(user *ent.User, changes SettingsChanges) (*ent.User, error)
+	PublicProfile(viewer *ent.User, username string) (PublicProfile, error)
+	FindListedByUsername(username string) (*ent.User, error)
+	FindVisibleByUsername(viewer *ent.User, username string) (*ent.User, error)
+	PublicProfiles(viewer *ent.User, users []*ent.User) ([]PublicProfile, error)
+	Relationship(viewer *ent.User, owner *ent.User) (Relationship, error)
+	Relationships(viewer *ent.User, owners []*ent.User) (map[int]Relationship, error)
+	SearchUsers(viewer *ent.User, query string, after string, limit int) ([]PublicProfile, string, error)
+	SetAvatar(user *ent.User, data []byte) (*ent.User, error)
+	RemoveAvatar(user *ent.User) (*ent.User, error)
+	ImportAvatar(user *ent.User, pictureURL string) error
+	Anonymize(user *ent.User) error
+	MergeGuest(guest *ent.User, into *ent.User) (*ent.User, error)
 }
 
 // UserService provides methods to manage user-related operations.
 type UserService struct {
-	ctx            context.Context
-	userRepository *UserRepository
-	config         *config.Config
+	ctx               context.Context
+	userRepository    *UserRepository
+	sessionRepository *session.SessionRepository
+	blobStore         storage.BlobStore
+	config            *config.Config
 }
 
 // NewUserService creates a new UserService instance.
-func NewUserService(userRepository *UserRepository, config *config.Config, ctx context.Context) *UserService {
+func NewUserService(userRepository *UserRepository, sessionRepository *session.SessionRepository, blobStore storage.BlobStore, config *config.Config, ctx context.Context) *UserService {
 	return &UserService{
-		config:         config,
-		ctx:            ctx,
-		userRepository: userRepository,
+		config:            config,
+		ctx:               ctx,
+		userRepository:    userRepository,
+		sessionRepository: sessionRepository,
+		blobStore:         blobStore,
 	}
 }
 
@@ -64,26 +101,43 @@ func (s *UserService) FindByPhone(phoneNumber string) (*ent.User, error) {
 	return user, nil
 }
 
+// FindOrCreateByEmail signs in with an address the identity provider has
+// verified. Only an account that verified the same address is matched, or a
+// legacy email-only account, which is marked verified on the way.
 func (s *UserService) FindOrCreateByEmail(email string) (*ent.User, error) {
-	user, err := s.userRepository.FindByEmail(context.Background(), email)
+	user, err := s.userRepository.FindByEmail(s.ctx, email)
+	if err == nil {
+		return user, nil
+	}
+	if !ent.IsNotFound(err) {
+		s.config.Logger.Info("Failed to get user by email", zap.Error(err))
+		return nil, err
+	}
 
+	user, err = s.userRepository.FindUnclaimedByEmail(s.ctx, email)
+	if err == nil {
+		return s.userRepository.MarkEmailVerified(s.ctx, user)
+	}
+	if !ent.IsNotFound(err) {
+		s.config.Logger.Info("Failed to get user by email", zap.Error(err))
+		return nil, err
+	}
+
+	s.config.Logger.Info("User not found, creating new user", zap.String("email", email))
+	user, err = s.userRepository.CreateByEmail(s.ctx, email)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			s.config.Logger.Info("User not found, creating new user", zap.String("email", email))
-			user, err = s.userRepository.CreateByEmail(context.Background(), email)
-			if err != nil {
-				s.config.Logger.Error("Failed to create user by email", zap.Error(err))
-				return nil, err
-			}
-		} else {
-			s.config.Logger.Info("Failed to get user by email", zap.Error(err))
-			return nil, err
-		}
+		s.config.Logger.Error("Failed to create user by email", zap.Error(err))
+		return nil, err
 	}
 
 	return user, nil
 }
 
+// FindByEmail returns the account that verified the address.
+func (s *UserService) FindByEmail(email string) (*ent.User, error) {
+	return s.userRepository.FindByEmail(s.ctx, email)
+}
+
 func (s *UserService) FindByUsername(username string) (*ent.User, error) {
 	user, err := s.userRepository.FindByUsername(s.ctx, username)
 	if err != nil {
@@ -94,27 +148,157 @@ func (s *UserService) FindByUsername(username string) (*ent.User, error) {
 	return user, nil
 }
 
-func (s *UserService) ChangeUsername(userID string, newUsername string) (*ent.User, bool, error) {
-	// check if the new username is already taken
-	existingUser, err := s.userRepository.FindByUsername(s.ctx, newUsername)
-	if err != nil && !ent.IsNotFound(err) {
-		s.config.Logger.Error("Failed to check existing username", zap.Error(err))
-		return nil, false, err
+// ChangeUsername applies the username policy and claims the username. The
+// first username can be set at any time, changing it afterwards is limited
+// to once per UsernameChangeCooldown.
+func (s *UserService) ChangeUsername(user *ent.User, newUsername string) (*ent.User, error) {
+	return s.changeUsername(user, newUsername, true)
+}
+
+// SetUsername is ChangeUsername for support staff, who are not held to the
+// cooldown. The rest of the policy, history and hold still apply.
+func (s *UserService) SetUsername(user *ent.User, newUsername string) (*ent.User, error) {
+	return s.changeUsername(user, newUsername, false)
+}
+
+func (s *UserService) changeUsername(user *ent.User, newUsername string, cooldown bool) (*ent.User, error) {
+	username, normalized, err := CleanUsername(newUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	if username == user.Username {
+		return user, nil
 	}
 
-	if existingUser != nil {
-		s.config.Logger.Info("Username is already taken", zap.String("newUsername", newUsername))
-		return nil, true, nil
+	if cooldown && user.UsernameChangedAt != nil && time.Since(*user.UsernameChangedAt) < UsernameChangeCooldown {
+		return nil, ErrUsernameCooldown
 	}
 
-	user, err := s.userRepository.UpdateUsername(s.ctx, userID, newUsername)
+	if err := s.checkUsernameClaimable(user, normalized); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.userRepository.ChangeUsername(s.ctx, user, username, normalized, time.Now().Add(UsernameHoldPeriod))
 	if err != nil {
-		s.config.Logger.Error("Failed to change username", zap.String("userID", userID), zap.Error(err))
-		return nil, false, err
+		if ent.IsConstraintError(err) {
+			return nil, ErrUsernameTaken
+		}
+		s.config.Logger.Error("Failed to change username", zap.String("authID", user.AuthID), zap.Error(err))
+		return nil, err
 	}
 
-	s.config.Logger.Info("Username changed successfully", zap.String("userID", userID), zap.String("newUsername", newUsername))
-	return user, false, nil
+	s.config.Logger.Info("Username changed successfully", zap.String("authID", user.AuthID), zap.String("newUsername", username))
+	return updated, nil
+}
+
+// CheckUsername reports whether the user could claim the username, returning
+// the policy error explaining why not.
+func (s *UserService) CheckUsername(user *ent.User, username string) error {
+	_, normalized, err := CleanUsername(username)
+	if err != nil {
+		return err
+	}
+
+	return s.checkUsernameClaimable(user, normalized)
+}
+
+// SuggestUsernames returns up to limit usernames the user could claim instead
+// of requested, best first. Every candidate goes through the username policy
+// and availability is checked for all of them in one batch.
+func (s *UserService) SuggestUsernames(user *ent.User, requested string, limit int) ([]string, error) {
+	type candidate struct{ username, normalized string }
+
+	candidates := []candidate{}
+	seen := map[string]bool{}
+	normalized := []string{}
+
+	for _, name := range usernameCandidates(user, requested) {
+		username, norm, err := CleanUsername(name)
+		if err != nil || seen[norm] {
+			continue
+		}
+		seen[norm] = true
+		candidates = append(candidates, candidate{username, norm})
+		normalized = append(normalized, norm)
+	}
+
+	unavailable, err := s.userRepository.UnavailableUsernames(s.ctx, normalized, user.ID)
+	if err != nil {
+		s.config.Logger.Error("Failed to check username suggestions", zap.Error(err))
+		return nil, err
+	}
+
+	suggestions := []string{}
+	for _, c := range candidates {
+		if len(suggestions) == limit {
+			break
+		}
+		if !unavailable[c.normalized] && c.username != user.Username {
+			suggestions = append(suggestions, c.username)
+		}
+	}
+
+	return suggestions, nil
+}
+
+// checkUsernameClaimable rejects usernames held by someone else. It is only
+// a friendly early answer, the unique index has the final say.
+func (s *UserService) checkUsernameClaimable(user *ent.User, normalized string) error {
+	owner, err := s.userRepository.FindByNormalizedUsername(s.ctx, normalized)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if owner != nil && owner.ID != user.ID {
+		return ErrUsernameTaken
+	}
+
+	held, err := s.userRepository.IsUsernameHeld(s.ctx, normalized, user.ID)
+	if err != nil {
+		return err
+	}
+	if held {
+		return ErrUsernameHeld
+	}
+
+	return nil
+}
+
+// BackfillUsernames fills the normalized username of accounts created before
+// it existed and updates those normalized by an older NormalizeUsername.
+// Usernames that collide once normalized are left for support to resolve,
+// the first one keeps it.
+func (s *UserService) BackfillUsernames() error {
+	lastID := 0
+
+	for {
+		users, err := s.userRepository.FindUsernamesAfter(s.ctx, lastID, 100)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			lastID = user.ID
+
+			normalized := NormalizeUsername(user.Username)
+			if user.UsernameNormalized != nil && *user.UsernameNormalized == normalized {
+				continue
+			}
+
+			err := s.userRepository.SetUsernameNormalized(s.ctx, user, normalized)
+			if ent.IsConstraintError(err) {
+				s.config.Logger.Warn("Username collides once normalized", zap.String("authID", user.AuthID), zap.String("username", user.Username))
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(users) < 100 {
+			return nil
+		}
+	}
 }
 
 func (s *UserService) FindUserByAuthID(authID string) (*ent.User, error) {
@@ -126,3 +310,426 @@ func (s *UserService) FindUserByAuthID(authID string) (*ent.User, error) {
 
 	return user, nil
 }
+
+// RecordLogin bumps the login counter of the user.
+func (s *UserService) RecordLogin(user *ent.User) error {
+	err := s.userRepository.IncrementLoginCount(s.ctx, user)
+	if err != nil {
+		s.config.Logger.Error("Failed to record login", zap.String("authID", user.AuthID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// CheckRestriction returns a *RestrictionError when the user is suspended or banned.
+func (s *UserService) CheckRestriction(user *ent.User) error {
+	err := CheckRestriction(user)
+	if err != nil {
+		s.config.Logger.Info("Restricted user rejected", zap.String("authID", user.AuthID), zap.String("status", user.Status.String()))
+	}
+
+	return err
+}
+
+// ScheduleDeletion marks the account for deletion after the configured grace
+// period, signs the user out of every device and revokes their personal
+// access tokens.
+func (s *UserService) ScheduleDeletion(user *ent.User) (*ent.User, error) {
+	scheduledAt := time.Now().Add(s.config.Account.DeletionGracePeriod)
+
+	user, err := s.userRepository.ScheduleDeletion(s.ctx, user, scheduledAt)
+	if err != nil {
+		s.config.Logger.Error("Failed to schedule account deletion", zap.Error(err))
+		return nil, err
+	}
+
+	if _, err := s.sessionRepository.DeleteSessionsByUser(s.ctx, user.ID); err != nil {
+		s.config.Logger.Error("Failed to revoke sessions of deleted account", zap.String("authID", user.AuthID), zap.Error(err))
+		return nil, err
+	}
+
+	if _, err := s.userRepository.RevokePersonalAccessTokens(s.ctx, user); err != nil {
+		s.config.Logger.Error("Failed to revoke personal access tokens of deleted account", zap.String("authID", user.AuthID), zap.Error(err))
+		return nil, err
+	}
+
+	s.config.Logger.Info("Account deletion scheduled", zap.String("authID", user.AuthID), zap.Time("scheduledAt", scheduledAt))
+	return user, nil
+}
+
+// CancelDeletion withdraws a pending deletion request, it is a no-op otherwise.
+func (s *UserService) CancelDeletion(user *ent.User) error {
+	if user.DeletionScheduledAt == nil {
+		return nil
+	}
+
+	if _, err := s.userRepository.CancelDeletion(s.ctx, user); err != nil {
+		s.config.Logger.Error("Failed to cancel account deletion", zap.String("authID", user.AuthID), zap.Error(err))
+		return err
+	}
+
+	s.config.Logger.Info("Account deletion cancelled", zap.String("authID", user.AuthID))
+	return nil
+}
+
+// UpdateProfile validates and applies the changes a user made to their own
+// profile. Invalid fields are reported as a *ValidationError.
+func (s *UserService) UpdateProfile(user *ent.User, changes ProfileChanges) (*ent.User, error) {
+	if err := changes.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.userRepository.UpdateProfile(s.ctx, user, changes)
+	if err != nil {
+		s.config.Logger.Error("Failed to update profile", zap.String("authID", user.AuthID), zap.Error(err))
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// UpdateSettings changes the user's settings, settings left out keep their
+// current value.
+func (s *UserService) UpdateSettings(user *ent.User, changes SettingsChanges) (*ent.User, error) {
+	values, err := changes.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.userRepository.UpdateSettings(s.ctx, user, values)
+	if err != nil {
+		s.config.Logger.Error("Failed to update settings", zap.String("authID", user.AuthID), zap.Error(err))
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// PublicProfile returns the profile of the user with the given username as
+// the viewer may see it. The viewer is nil when not signed in. Accounts
+// without a public profile are reported as ErrProfileNotFound.
+func (s *UserService) PublicProfile(viewer *ent.User, username string) (PublicProfile, error) {
+	owner, err := s.FindVisibleByUsername(viewer, username)
+	if err != nil {
+		return PublicProfile{}, err
+	}
+
+	profiles, err := s.PublicProfiles(viewer, []*ent.User{owner})
+	if err != nil {
+		return PublicProfile{}, err
+	}
+
+	return profiles[0], nil
+}
+
+// FindListedByUsername finds a user other people may look up, reporting
+// anyone else as ErrProfileNotFound.
+func (s *UserService) FindListedByUsername(username string) (*ent.User, error) {
+	owner, err := s.userRepository.FindByUsername(s.ctx, username)
+	if ent.IsNotFound(err) {
+		return nil, ErrProfileNotFound
+	}
+	if err != nil {
+		s.config.Logger.Error("Failed to find user by username", zap.Error(err))
+		return nil, err
+	}
+
+	if !IsListed(owner) {
+		return nil, ErrProfileNotFound
+	}
+
+	return owner, nil
+}
+
+// FindVisibleByUsername is FindListedByUsername for a viewer, who does not
+// find users they blocked or were blocked by. The viewer is nil when not
+// signed in.
+func (s *UserService) FindVisibleByUsername(viewer *ent.User, username string) (*ent.User, error) {
+	if viewer == nil {
+		return s.FindListedByUsername(username)
+	}
+
+	owner, err := s.userRepository.FindVisibleByUsername(s.ctx, username, viewer.ID)
+	if ent.IsNotFound(err) {
+		return nil, ErrProfileNotFound
+	}
+	if err != nil {
+		s.config.Logger.Error("Failed to find user by username", zap.Error(err))
+		return nil, err
+	}
+
+	if !IsListed(owner) {
+		return nil, ErrProfileNotFound
+	}
+
+	return owner, nil
+}
+
+// SearchUsers finds listed users by username or name for the viewer. It
+// returns a page of at most limit profiles and the cursor of the next page,
+// empty on the last one.
+func (s *UserService) SearchUsers(viewer *ent.User, query string, after string, limit int) ([]PublicProfile, string, error) {
+	query, ok := cleanSearchQuery(query)
+	if !ok {
+		return nil, "", ErrInvalidSearchQuery
+	}
+
+	if limit <= 0 || limit > maxSearchLimit {
+		limit = defaultSearchLimit
+	}
+
+	var position *SearchCursor
+	if after != "" {
+		position = new(SearchCursor)
+		if err := cursor.Decode(after, position); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// one extra to know whether there is a next page
+	viewerID := 0
+	if viewer != nil {
+		viewerID = viewer.ID
+	}
+
+	matches, err := s.userRepository.SearchDirectory(s.ctx, query, viewerID, position, limit+1)
+	if err != nil {
+		s.config.Logger.Error("Failed to search users", zap.Error(err))
+		return nil, "", err
+	}
+
+	next := ""
+	if len(matches) > limit {
+		matches = matches[:limit]
+		next = cursor.Encode(matches[limit-1].Cursor)
+	}
+
+	users := make([]*ent.User, 0, len(matches))
+	for _, match := range matches {
+		users = append(users, match.User)
+	}
+
+	profiles, err := s.PublicProfiles(viewer, users)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return profiles, next, nil
+}
+
+// PublicProfiles projects users for the viewer, in the same order.
+func (s *UserService) PublicProfiles(viewer *ent.User, users []*ent.User) ([]PublicProfile, error) {
+	relationships, err := s.Relationships(viewer, users)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]PublicProfile, 0, len(users))
+	for _, u := range users {
+		var relationship *Relationship
+		if viewer != nil {
+			r := relationships[u.ID]
+			relationship = &r
+		}
+		profiles = append(profiles, newPublicProfile(u, relationship))
+	}
+
+	return profiles, nil
+}
+
+// Relationship works out how the viewer relates to the owner of a profile.
+func (s *UserService) Relationship(viewer *ent.User, owner *ent.User) (Relationship, error) {
+	relationships, err := s.Relationships(viewer, []*ent.User{owner})
+	if err != nil {
+		return Relationship{}, err
+	}
+
+	return relationships[owner.ID], nil
+}
+
+// Relationships works out how the viewer relates to each of the owners,
+// keyed by their ID. Anonymous viewers have no relationships.
+func (s *UserService) Relationships(viewer *ent.User, owners []*ent.User) (map[int]Relationship, error) {
+	relationships := make(map[int]Relationship, len(owners))
+	if viewer == nil {
+		return relationships, nil
+	}
+
+	ids := make([]int, 0, len(owners))
+	for _, owner := range owners {
+		if owner.ID != viewer.ID {
+			ids = append(ids, owner.ID)
+		}
+	}
+
+	follows, err := s.userRepository.FollowsBetween(s.ctx, viewer.ID, ids)
+	if err != nil {
+		s.config.Logger.Error("Failed to load follows", zap.Error(err))
+		return nil, err
+	}
+
+	muted, err := s.userRepository.MutedAmong(s.ctx, viewer.ID, ids)
+	if err != nil {
+		s.config.Logger.Error("Failed to load mutes", zap.Error(err))
+		return nil, err
+	}
+
+	for _, owner := range owners {
+		relationships[owner.ID] = Relationship{self: owner.ID == viewer.ID}
+	}
+	for _, id := range muted {
+		r := relationships[id]
+		r.Muting = true
+		relationships[id] = r
+	}
+	for _, f := range follows {
+		if f.FollowerID == viewer.ID {
+			r := relationships[f.FolloweeID]
+			r.Following = f.Status == follow.StatusAccepted
+			r.Requested = f.Status == follow.StatusPending
+			relationships[f.FolloweeID] = r
+		} else {
+			r := relationships[f.FollowerID]
+			r.FollowedBy = true
+			relationships[f.FollowerID] = r
+		}
+	}
+
+	return relationships, nil
+}
+
+// SetAvatar processes an uploaded image and makes it the user's avatar. The
+// previous avatar is removed from the blob store once the new one is saved.
+func (s *UserService) SetAvatar(user *ent.User, data []byte) (*ent.User, error) {
+	variants, err := processAvatar(data)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := newAvatarPrefix(user)
+	urls := make(map[string]string, len(variants))
+	keys := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		key := avatarVariantKey(prefix, variant.size)
+		if err := s.blobStore.Put(s.ctx, key, "image/jpeg", variant.data); err != nil {
+			s.config.Logger.Error("Failed to store avatar", zap.String("authID", user.AuthID), zap.Error(err))
+			s.deleteBlobs(keys)
+			return nil, err
+		}
+		keys = append(keys, key)
+		urls[fmt.Sprint(variant.size)] = s.blobStore.URL(key)
+	}
+
+	updated, err := s.userRepository.SetAvatar(s.ctx, user, prefix, urls)
+	if err != nil {
+		s.config.Logger.Error("Failed to save avatar", zap.String("authID", user.AuthID), zap.Error(err))
+		s.deleteBlobs(keys)
+		return nil, err
+	}
+
+	s.deleteBlobs(avatarBlobKeys(user))
+	return updated, nil
+}
+
+// RemoveAvatar clears the user's avatar and deletes its variants.
+func (s *UserService) RemoveAvatar(user *ent.User) (*ent.User, error) {
+	updated, err := s.userRepository.ClearAvatar(s.ctx, user)
+	if err != nil {
+		s.config.Logger.Error("Failed to remove avatar", zap.String("authID", user.AuthID), zap.Error(err))
+		return nil, err
+	}
+
+	s.deleteBlobs(avatarBlobKeys(user))
+	return updated, nil
+}
+
+// ImportAvatar downloads an image from an identity provider, such as the
+// Google profile picture, and makes it the user's avatar.
+func (s *UserService) ImportAvatar(user *ent.User, pictureURL string) error {
+	parsed, err := url.Parse(pictureURL)
+	if err != nil || parsed.Scheme != "https" {
+		return fmt.Errorf("refusing to import avatar from %q", pictureURL)
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error downloading avatar: %s", resp.Status)
+	}
+
+	// one extra byte so oversized pictures are rejected rather than truncated
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxAvatarBytes+1))
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.SetAvatar(user, data); err != nil {
+		return err
+	}
+
+	s.config.Logger.Info("Imported avatar", zap.String("authID", user.AuthID))
+	return nil
+}
+
+// Anonymize strips the account as UserRepository.Anonymize does and deletes
+// the files it owned.
+func (s *UserService) Anonymize(user *ent.User) error {
+	archives, err := s.userRepository.Anonymize(s.ctx, user)
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(avatarBlobKeys(user))
+	s.deleteArchives(archives)
+	return nil
+}
+
+// MergeGuest folds a guest into an existing account as
+// UserRepository.MergeGuest does. The guest's avatar is deleted unless the
+// account took it over, its data exports are always deleted.
+func (s *UserService) MergeGuest(guest *ent.User, into *ent.User) (*ent.User, error) {
+	merged, archives, err := s.userRepository.MergeGuest(s.ctx, guest, into)
+	if err != nil {
+		return nil, err
+	}
+
+	if merged.AvatarKey != guest.AvatarKey {
+		s.deleteBlobs(avatarBlobKeys(guest))
+	}
+	s.deleteArchives(archives)
+
+	return merged, nil
+}
+
+// deleteArchives removes the data export archives of an erased account,
+// failures are only logged like those of deleteBlobs.
+func (s *UserService) deleteArchives(paths []string) {
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			s.config.Logger.Warn("Failed to delete data export archive", zap.String("path", path), zap.Error(err))
+		}
+	}
+}
+
+// deleteBlobs removes files that are no longer referenced. Failures are only
+// logged, an orphaned file is not worth failing the request over.
+func (s *UserService) deleteBlobs(keys []string) {
+	for _, key := range keys {
+		if err := s.blobStore.Delete(s.ctx, key); err != nil {
+			s.config.Logger.Warn("Failed to delete blob", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
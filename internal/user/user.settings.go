@@ -0,0 +1,148 @@
+package user
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/shinplay/ent"
+)
+
+type settingKind int
+
+const (
+	settingBool settingKind = iota
+	settingChoice
+	settingLanguage
+)
+
+// setting describes one user preference. Only values a user changed are
+// stored, so changing a default here applies to everyone who kept it.
+type setting struct {
+	kind     settingKind
+	fallback any
+	// choices lists the values of a settingChoice.
+	choices []string
+}
+
+var settings = map[string]setting{
+	"language":                {kind: settingLanguage, fallback: "en"},
+	"theme":                   {kind: settingChoice, fallback: "system", choices: []string{"system", "light", "dark"}},
+	"notifications.email":     {kind: settingBool, fallback: true},
+	"notifications.sms":       {kind: settingBool, fallback: true},
+	"notifications.push":      {kind: settingBool, fallback: true},
+	"content.sensitive_media": {kind: settingChoice, fallback: "blur", choices: []string{"show", "blur", "hide"}},
+	"content.autoplay_videos": {kind: settingBool, fallback: true},
+}
+
+// parse decodes a JSON value of the setting, returning a message for the
+// user when it is not valid.
+func (s setting) parse(raw json.RawMessage) (any, string) {
+	switch s.kind {
+	case settingBool:
+		var value bool
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, "Must be true or false"
+		}
+		return value, ""
+	case settingChoice:
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil || !slices.Contains(s.choices, value) {
+			return nil, "Must be one of " + strings.Join(s.choices, ", ")
+		}
+		return value, ""
+	default:
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, "Must be a language tag like en-IN"
+		}
+		value = strings.TrimSpace(value)
+		if len(value) > 35 || !localePattern.MatchString(value) {
+			return nil, "Must be a language tag like en-IN"
+		}
+		return value, ""
+	}
+}
+
+// valid reports whether a stored value still fits the setting, values saved
+// before a setting changed type or lost a choice fall back to the default.
+func (s setting) valid(value any) bool {
+	switch s.kind {
+	case settingBool:
+		_, ok := value.(bool)
+		return ok
+	case settingChoice:
+		choice, ok := value.(string)
+		return ok && slices.Contains(s.choices, choice)
+	default:
+		language, ok := value.(string)
+		return ok && localePattern.MatchString(language)
+	}
+}
+
+// Settings maps every setting name to its value.
+type Settings map[string]any
+
+// DefaultSettings returns the value of every setting a user has not changed.
+func DefaultSettings() Settings {
+	defaults := Settings{}
+	for name, s := range settings {
+		defaults[name] = s.fallback
+	}
+	return defaults
+}
+
+// ResolveSettings returns the settings of u, the defaults filled in for
+// those they did not change.
+func ResolveSettings(u *ent.User) Settings {
+	resolved := DefaultSettings()
+	for name, value := range u.Settings {
+		if s, ok := settings[name]; ok && s.valid(value) {
+			resolved[name] = value
+		}
+	}
+	return resolved
+}
+
+// SettingsChanges holds the settings sent to PATCH /users/me/settings. A
+// null value resets the setting to its default.
+type SettingsChanges map[string]json.RawMessage
+
+// Fields lists the names of the settings that were sent, for the audit log.
+func (c SettingsChanges) Fields() []string {
+	return slices.Sorted(maps.Keys(c))
+}
+
+// Values checks the sent settings and decodes them, a nil value stands for
+// a reset. It returns a *ValidationError naming every invalid setting.
+func (c SettingsChanges) Values() (map[string]any, error) {
+	invalid := map[string]string{}
+	values := map[string]any{}
+
+	for name, raw := range c {
+		s, ok := settings[name]
+		if !ok {
+			invalid[name] = "Not a setting"
+			continue
+		}
+
+		if string(raw) == "null" {
+			values[name] = nil
+			continue
+		}
+
+		value, message := s.parse(raw)
+		if message != "" {
+			invalid[name] = message
+			continue
+		}
+		values[name] = value
+	}
+
+	if len(invalid) > 0 {
+		return nil, &ValidationError{Fields: invalid}
+	}
+
+	return values, nil
+}
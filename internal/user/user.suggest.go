@@ -0,0 +1,93 @@
+package user
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/shinplay/ent"
+	"golang.org/x/text/unicode/norm"
+)
+
+// usernameSuffixes are appended to a taken username before falling back to
+// numbers.
+var usernameSuffixes = []string{"plays", "hq", "live", "tv", "go", "x"}
+
+// usernameCandidates lists alternatives to requested in the order they are
+// offered: the requested name with a word suffix, then names the user gave
+// us, then numeric suffixes. Candidates may still be invalid or taken.
+func usernameCandidates(u *ent.User, requested string) []string {
+	bases := []string{}
+	if base := usernameBase(requested); base != "" {
+		bases = append(bases, base)
+	}
+
+	first, last := usernameBase(u.FirstName), usernameBase(u.LastName)
+	if first != "" {
+		bases = append(bases, first)
+	}
+
+	candidates := []string{}
+	if len(bases) > 0 {
+		for _, suffix := range usernameSuffixes {
+			candidates = append(candidates, bases[0]+"_"+suffix)
+		}
+	}
+
+	if first != "" && last != "" {
+		candidates = append(candidates,
+			first+"."+last,
+			first+"_"+last,
+			first+last,
+			first+string([]rune(last)[:1]),
+			string([]rune(first)[:1])+last,
+		)
+	}
+	if first != "" {
+		candidates = append(candidates, first)
+	}
+	if display := usernameBase(u.DisplayName); display != "" {
+		candidates = append(candidates, display)
+	}
+
+	for _, base := range bases {
+		if u.Birthday != nil {
+			candidates = append(candidates, base+strconv.Itoa(u.Birthday.Year()))
+		}
+		for range 4 {
+			candidates = append(candidates, base+strconv.Itoa(rand.IntN(90)+10))
+		}
+		for range 4 {
+			candidates = append(candidates, base+strconv.Itoa(rand.IntN(9000)+1000))
+		}
+	}
+
+	return candidates
+}
+
+// usernameBase turns free text such as a name into something usable as the
+// start of a username: allowed characters only, spaces become underscores
+// and it leaves room for a suffix.
+func usernameBase(text string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKC.String(strings.TrimSpace(text)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc):
+			b.WriteRune(unicode.ToLower(r))
+		case r == ' ' || r == '-' || r == '_' || r == '.':
+			b.WriteRune('_')
+		}
+	}
+
+	base := strings.Trim(b.String(), "_")
+	for strings.Contains(base, "__") {
+		base = strings.ReplaceAll(base, "__", "_")
+	}
+
+	if runes := []rune(base); len(runes) > maxUsernameLength-6 {
+		base = strings.TrimRight(string(runes[:maxUsernameLength-6]), "_")
+	}
+
+	return base
+}
@@ -0,0 +1,166 @@
+package user
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	minUsernameLength = 3
+	maxUsernameLength = 30
+
+	// UsernameChangeCooldown is how long a user waits between username changes.
+	UsernameChangeCooldown = 30 * 24 * time.Hour
+	// UsernameHoldPeriod is how long a released username stays reserved for
+	// its previous owner, so nobody can pick it up to impersonate them.
+	UsernameHoldPeriod = 90 * 24 * time.Hour
+)
+
+var (
+	ErrUsernameTaken    = errors.New("username is taken")
+	ErrUsernameReserved = errors.New("username is reserved")
+	ErrUsernameHeld     = errors.New("username was released recently")
+	ErrUsernameCooldown = errors.New("username was changed recently")
+)
+
+// InvalidUsernameError explains why a username breaks the policy.
+type InvalidUsernameError struct {
+	Reason string
+}
+
+func (e *InvalidUsernameError) Error() string {
+	return "invalid username: " + e.Reason
+}
+
+// reservedUsernames can never be claimed, they are compared after folding.
+var reservedUsernames = []string{
+	"about", "account", "accounts", "admin", "administrator", "api", "app",
+	"auth", "billing", "blog", "contact", "developer", "developers", "help",
+	"home", "login", "logout", "me", "mod", "moderator", "news", "null",
+	"official", "oauth", "password", "privacy", "root", "security",
+	"settings", "shinplay", "signin", "signup", "staff", "status", "support",
+	"system", "team", "terms", "undefined", "user", "username", "users", "www",
+}
+
+// blockedWords may not be a username or a part of one between underscores
+// and dots. Whole parts are matched so names such as "therapist" that merely
+// contain a word stay allowed.
+var blockedWords = []string{
+	"fuck", "shit", "cunt", "bitch", "whore", "slut", "nazi", "hitler",
+	"rapist", "pedo", "asshole", "bastard", "shinplay",
+}
+
+// confusables folds characters that render like a latin letter onto it, the
+// way Unicode's confusable skeletons do. Only the common ones are listed.
+var confusables = map[rune]rune{
+	// digits
+	'0': 'o', '1': 'l',
+	// latin lookalikes
+	'ı': 'i', 'ſ': 's', 'ɡ': 'g', 'ɑ': 'a',
+	// cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'һ': 'h', 'і': 'i', 'ї': 'i',
+	'ј': 'j', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o', 'р': 'p', 'с': 'c',
+	'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w',
+	// greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'i', 'κ': 'k', 'ν': 'v',
+	'ο': 'o', 'ρ': 'p', 'τ': 't', 'υ': 'u', 'χ': 'x',
+}
+
+// multiCharConfusables fold letter pairs that read as a single letter.
+var multiCharConfusables = strings.NewReplacer("rn", "m", "vv", "w")
+
+// CleanUsername applies the username policy. It returns the username as it
+// will be displayed along with the normalized form uniqueness is checked on.
+//
+// Usernames are 3 to 30 letters, digits, underscores and dots, start with a
+// letter or digit, and cannot end with or repeat a dot. Letters from any
+// script are allowed; lookalikes are folded together when normalizing so
+// "pаypal" with a cyrillic "а" collides with "paypal".
+func CleanUsername(username string) (string, string, error) {
+	username = norm.NFKC.String(strings.TrimSpace(username))
+
+	length := utf8.RuneCountInString(username)
+	if length < minUsernameLength || length > maxUsernameLength {
+		return "", "", &InvalidUsernameError{Reason: "Usernames must be 3 to 30 characters long"}
+	}
+
+	for i, r := range username {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+		case unicode.In(r, unicode.Mn, unicode.Mc) && i > 0:
+			// combining marks are part of letters in scripts such as Devanagari
+		case (r == '_' || r == '.') && i > 0:
+		default:
+			return "", "", &InvalidUsernameError{Reason: "Usernames can only contain letters, numbers, underscores and dots, and must start with a letter or number"}
+		}
+	}
+
+	if strings.HasSuffix(username, ".") || strings.Contains(username, "..") {
+		return "", "", &InvalidUsernameError{Reason: "Usernames cannot end with a dot or contain two dots in a row"}
+	}
+
+	normalized := NormalizeUsername(username)
+
+	if isReservedUsername(normalized) {
+		return "", "", ErrUsernameReserved
+	}
+
+	return username, normalized, nil
+}
+
+// NormalizeUsername folds case and lookalike characters so that usernames
+// that read the same normalize to the same string.
+func NormalizeUsername(username string) string {
+	folded := strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if replacement, ok := confusables[r]; ok {
+			return replacement
+		}
+		return r
+	}, norm.NFKC.String(username))
+
+	// strip accents so "josé" and "jose" collide, marks of other scripts,
+	// such as Devanagari vowel signs, tell different names apart and stay
+	var stripped strings.Builder
+	accented := false
+	for _, r := range norm.NFD.String(folded) {
+		if unicode.Is(unicode.Mn, r) {
+			if accented {
+				continue
+			}
+		} else {
+			accented = unicode.In(r, unicode.Latin, unicode.Greek, unicode.Cyrillic)
+		}
+		stripped.WriteRune(r)
+	}
+	folded = stripped.String()
+
+	return norm.NFC.String(multiCharConfusables.Replace(folded))
+}
+
+func isReservedUsername(normalized string) bool {
+	bare := strings.NewReplacer("_", "", ".", "").Replace(normalized)
+
+	for _, reserved := range reservedUsernames {
+		if bare == NormalizeUsername(reserved) {
+			return true
+		}
+	}
+
+	parts := append(strings.FieldsFunc(normalized, func(r rune) bool {
+		return r == '_' || r == '.'
+	}), bare)
+	for _, word := range blockedWords {
+		if slices.Contains(parts, NormalizeUsername(word)) {
+			return true
+		}
+	}
+
+	return false
+}
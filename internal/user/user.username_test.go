@@ -0,0 +1,96 @@
+package user
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCleanUsername(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		username   string
+		normalized string
+		err        error
+		invalid    bool
+	}{
+		{name: "plain", input: "alice", username: "alice", normalized: "alice"},
+		{name: "keeps case for display", input: "Alice_Smith", username: "Alice_Smith", normalized: "alice_smith"},
+		{name: "trims spaces", input: "  bob.builder ", username: "bob.builder", normalized: "bob.builder"},
+		{name: "compatibility forms", input: "ｂｏｂｂｙ", username: "bobby", normalized: "bobby"},
+		{name: "non-latin script", input: "अनिल", username: "अनिल", normalized: "अनिल"},
+		{name: "too short", input: "ab", invalid: true},
+		{name: "too long", input: "abcdefghijklmnopqrstuvwxyz12345", invalid: true},
+		{name: "leading underscore", input: "_alice", invalid: true},
+		{name: "leading mark", input: "́alice", invalid: true},
+		{name: "space inside", input: "al ice", invalid: true},
+		{name: "symbol", input: "alice!", invalid: true},
+		{name: "trailing dot", input: "alice.", invalid: true},
+		{name: "double dot", input: "al..ice", invalid: true},
+		{name: "reserved", input: "admin", err: ErrUsernameReserved},
+		{name: "reserved ignoring separators", input: "ad_min", err: ErrUsernameReserved},
+		{name: "reserved lookalike", input: "аdmin", err: ErrUsernameReserved},
+		{name: "reserved with digit lookalike", input: "supp0rt", err: ErrUsernameReserved},
+		{name: "blocked word", input: "nazi", err: ErrUsernameReserved},
+		{name: "blocked word as a part", input: "big_nazi.fan", err: ErrUsernameReserved},
+		{name: "blocked word inside a word", input: "therapist", username: "therapist", normalized: "therapist"},
+		{name: "blocked word with lookalikes", input: "ped0_fan", err: ErrUsernameReserved},
+		{name: "blocked word with cyrillic", input: "nаzi.fan", err: ErrUsernameReserved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			username, normalized, err := CleanUsername(tt.input)
+
+			var invalid *InvalidUsernameError
+			switch {
+			case tt.invalid:
+				if !errors.As(err, &invalid) {
+					t.Fatalf("got %v, want an InvalidUsernameError", err)
+				}
+			case tt.err != nil:
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got %v, want %v", err, tt.err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if username != tt.username || normalized != tt.normalized {
+					t.Errorf("got (%q, %q), want (%q, %q)", username, normalized, tt.username, tt.normalized)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeUsername(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{name: "case", a: "PayPal", b: "paypal", equal: true},
+		{name: "cyrillic lookalike", a: "pаypal", b: "paypal", equal: true},
+		{name: "greek lookalike", a: "pαypαl", b: "paypal", equal: true},
+		{name: "digit lookalikes", a: "g00gle", b: "google", equal: true},
+		{name: "one and l", a: "1ucy", b: "lucy", equal: true},
+		{name: "rn reads as m", a: "rnary", b: "mary", equal: true},
+		{name: "vv reads as w", a: "vvilliam", b: "william", equal: true},
+		{name: "latin accents", a: "josé", b: "jose", equal: true},
+		{name: "decomposed accents", a: "josé", b: "josé", equal: true},
+		{name: "cyrillic accents", a: "ёлка", b: "елка", equal: true},
+		{name: "devanagari vowel signs", a: "किम", b: "कम", equal: false},
+		{name: "different names", a: "alice", b: "alicia", equal: false},
+		{name: "separators", a: "al_ice", b: "al.ice", equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NormalizeUsername(tt.a), NormalizeUsername(tt.b)
+			if (a == b) != tt.equal {
+				t.Errorf("NormalizeUsername(%q) = %q, NormalizeUsername(%q) = %q, want equal %v", tt.a, a, tt.b, b, tt.equal)
+			}
+		})
+	}
+}
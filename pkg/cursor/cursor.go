@@ -0,0 +1,35 @@
+// Package cursor encodes the position of a page in a keyset-paginated list as
+// an opaque string clients hand back to get the next page.
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalid = errors.New("invalid cursor")
+
+// Encode returns the cursor for a position, which is any JSON-encodable value.
+func Encode(position any) string {
+	data, err := json.Marshal(position)
+	if err != nil {
+		panic("cursor: unencodable position: " + err.Error())
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode reads a cursor made by Encode into position.
+func Decode(cursor string, position any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalid
+	}
+
+	if err := json.Unmarshal(data, position); err != nil {
+		return ErrInvalid
+	}
+
+	return nil
+}